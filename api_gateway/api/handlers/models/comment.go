@@ -5,8 +5,9 @@ type IdCommentRequest struct {
 }
 
 type CommentRequest struct {
-	PostId string `json:"post_id"`
-	Text   string `json:"text"`
+	PostId   string `json:"post_id"`
+	ParentId string `json:"parent_id"`
+	Text     string `json:"text"`
 }
 
 type Comment struct {
@@ -17,8 +18,11 @@ type Comment struct {
 	UserName     string `json:"user_name"`
 	UserType     string `json:"user_type"`
 	PostUserName string `json:"post_user_name"`
+	ParentId     string `json:"parent_id"`
 	Text         string `json:"text"`
 	CreatedAt    string `json:"created_at"`
+	// held or hidden comments are returned only to their authors
+	ModerationStatus string `json:"moderation_status"`
 }
 
 type Comments struct {
@@ -0,0 +1,51 @@
+package models
+
+// ReportRequest reports a post, comment or user. Reason is spam, harassment,
+// hate, violence, nudity, misinformation or other.
+type ReportRequest struct {
+	TargetType string `json:"target_type" example:"post"`
+	TargetId   string `json:"target_id"`
+	Reason     string `json:"reason" example:"spam"`
+	Details    string `json:"details"`
+}
+
+type Report struct {
+	Id         string `json:"id"`
+	CaseId     string `json:"case_id"`
+	ReporterId string `json:"reporter_id"`
+	Reason     string `json:"reason"`
+	Details    string `json:"details"`
+	CreatedAt  string `json:"created_at"`
+}
+
+// ResolveRequest closes the case with dismiss, hide, remove, warn or suspend,
+// SuspendHours is required for suspend. Note is sent to the owner.
+type ResolveRequest struct {
+	Action       string `json:"action" example:"hide"`
+	Note         string `json:"note"`
+	SuspendHours int64  `json:"suspend_hours"`
+}
+
+// Case collects all reports of one target, Source is user for reported
+// targets and auto for content held by the classifier.
+type Case struct {
+	Id         string   `json:"id"`
+	TargetType string   `json:"target_type"`
+	TargetId   string   `json:"target_id"`
+	OwnerId    string   `json:"owner_id"`
+	Status     string   `json:"status"`
+	Source     string   `json:"source"`
+	Reasons    []string `json:"reasons"`
+	Reports    int64    `json:"reports"`
+	AssigneeId string   `json:"assignee_id"`
+	Action     string   `json:"action"`
+	Note       string   `json:"note"`
+	CreatedAt  string   `json:"created_at"`
+	UpdatedAt  string   `json:"updated_at"`
+	ResolvedAt string   `json:"resolved_at"`
+	ReportList []Report `json:"report_list,omitempty"`
+}
+
+type Cases struct {
+	Cases []Case `json:"cases"`
+}
@@ -0,0 +1,41 @@
+package models
+
+type Notification struct {
+	Id        string `json:"id"`
+	ActorId   string `json:"actor_id"`
+	ActorName string `json:"actor_name"`
+	Type      string `json:"type"`
+	PostId    string `json:"post_id"`
+	CommentId string `json:"comment_id"`
+	Text      string `json:"text"`
+	Read      bool   `json:"read"`
+	CreatedAt string `json:"created_at"`
+}
+
+type Notifications struct {
+	Notifications []Notification `json:"notifications"`
+	Unread        int64          `json:"unread"`
+}
+
+// MarkReadRequest marks notifications with given Ids as read, or all of them
+// if All is true.
+type MarkReadRequest struct {
+	Ids []string `json:"ids"`
+	All bool     `json:"all"`
+}
+
+type MarkRead struct {
+	Updated int64 `json:"updated"`
+}
+
+// Preference is set per notification type: comment, reply, like, follow or
+// mention. Email notifications are sent in a periodic digest.
+type Preference struct {
+	Type  string `json:"type"`
+	InApp bool   `json:"in_app"`
+	Email bool   `json:"email"`
+}
+
+type Preferences struct {
+	Preferences []Preference `json:"preferences"`
+}
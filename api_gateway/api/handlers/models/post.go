@@ -4,27 +4,53 @@ type IdPostRequest struct {
 	Id string `json:"id"`
 }
 
+// Status is draft, scheduled or published (default), PublishAt is required
+// for scheduled posts. Visibility is public (default), followers or private.
 type PostRequest struct {
-	Title       string `json:"title"`
-	Description string `json:"description"`
+	Title       string   `json:"title"`
+	Description string   `json:"description"`
+	Status      string   `json:"status"`
+	PublishAt   string   `json:"publish_at" example:"2023-01-02T15:04:05Z"`
+	Visibility  string   `json:"visibility"`
+	Tags        []string `json:"tags"`
 }
 
 type UpdatePostRequest struct {
-	Id          string `json:"id"`
-	Title       string `json:"title"`
-	Description string `json:"description"`
+	Id          string   `json:"id"`
+	Title       string   `json:"title"`
+	Description string   `json:"description"`
+	Status      string   `json:"status"`
+	PublishAt   string   `json:"publish_at" example:"2023-01-02T15:04:05Z"`
+	Visibility  string   `json:"visibility"`
+	Tags        []string `json:"tags"` // kept when omitted, empty tags remove tags of the post
+	// update fails with 409 if the post has another version, If-Match header is preferred
+	ExpectedVersion int64 `json:"expected_version"`
+}
+
+type LikeRequest struct {
+	IsLiked bool `json:"is_liked"`
 }
 
 type Post struct {
-	Id          string `json:"id"`
-	Title       string `json:"title"`
-	Description string `json:"description"`
-	Likes       int64  `json:"likes"`
-	UserId      string `json:"user_id"`
-	UserName    string `json:"user_name"`
-	Comments    int64  `json:"comments"`
-	CreatedAt   string `json:"created_at"`
-	UpdatedAt   string `json:"update_at"`
+	Id          string       `json:"id"`
+	Title       string       `json:"title"`
+	Description string       `json:"description"`
+	Likes       int64        `json:"likes"`
+	UserId      string       `json:"user_id"`
+	UserName    string       `json:"user_name"`
+	Comments    int64        `json:"comments"`
+	Status      string       `json:"status"`
+	PublishAt   string       `json:"publish_at"`
+	Visibility  string       `json:"visibility"`
+	Edited      bool         `json:"edited"`
+	EditedAt    string       `json:"edited_at"`
+	Tags        []string     `json:"tags"`
+	CreatedAt   string       `json:"created_at"`
+	UpdatedAt   string       `json:"update_at"`
+	Attachments []Attachment `json:"attachments"`
+	Version     int64        `json:"version"`
+	// held or hidden posts are returned only to their authors
+	ModerationStatus string `json:"moderation_status"`
 }
 
 type Posts struct {
@@ -43,3 +69,53 @@ type DeletedPost struct {
 	UpdatedAt   string `json:"update_at"`
 	DeletedAt   string `json:"deleted_at"`
 }
+
+type Attachment struct {
+	Id           string `json:"id"`
+	PostId       string `json:"post_id"`
+	FileName     string `json:"file_name"`
+	MimeType     string `json:"mime_type"`
+	Size         int64  `json:"size"`
+	HasThumbnail bool   `json:"has_thumbnail"`
+	CreatedAt    string `json:"created_at"`
+}
+
+type Attachments struct {
+	Attachments []Attachment `json:"attachments"`
+}
+
+type Revision struct {
+	PostId       string `json:"post_id"`
+	Revision     int64  `json:"revision"`
+	Title        string `json:"title"`
+	Description  string `json:"description"`
+	EditorId     string `json:"editor_id"`
+	RestoredFrom int64  `json:"restored_from"`
+	CreatedAt    string `json:"created_at"`
+}
+
+type Revisions struct {
+	Revisions []Revision `json:"revisions"`
+}
+
+// Op is equal, insert or delete
+type DiffLine struct {
+	Op   string `json:"op"`
+	Text string `json:"text"`
+}
+
+type RevisionDiff struct {
+	From        int64      `json:"from"`
+	To          int64      `json:"to"`
+	Title       []DiffLine `json:"title"`
+	Description []DiffLine `json:"description"`
+}
+
+type Tag struct {
+	Name  string `json:"name"`
+	Posts int64  `json:"posts"`
+}
+
+type Tags struct {
+	Tags []Tag `json:"tags"`
+}
@@ -17,6 +17,8 @@ type UpdateUserRequest struct {
 	FirstName string `json:"first_name"`
 	LastName  string `json:"last_name"`
 	Email     string `json:"email"`
+	// update fails with 409 if the user has another version, If-Match header is preferred
+	ExpectedVersion int64 `json:"expected_version"`
 }
 
 type Users struct {
@@ -32,6 +34,7 @@ type User struct {
 	Posts     int64  `json:"posts"`
 	CreatedAt string `json:"created_at"`
 	UpdatedAt string `json:"updated_at"`
+	Version   int64  `json:"version"`
 }
 
 type DeletedUser struct {
@@ -44,4 +47,54 @@ type DeletedUser struct {
 	CreatedAt string `json:"created_at"`
 	UpdatedAt string `json:"updated_at"`
 	DeletedAt string `json:"deleted_at"`
+	// posts and comments are erased with the account after it
+	PurgeAfter string `json:"purge_after"`
+}
+
+type DataExport struct {
+	Id          string `json:"id"`
+	Status      string `json:"status"`
+	Error       string `json:"error"`
+	CreatedAt   string `json:"created_at"`
+	CompletedAt string `json:"completed_at"`
+}
+
+type Follow struct {
+	FollowerId  string `json:"follower_id"`
+	FollowingId string `json:"following_id"`
+	Following   bool   `json:"following"`
+}
+
+// Relation is a block or mute of the target by the user
+type Relation struct {
+	UserId   string `json:"user_id"`
+	TargetId string `json:"target_id"`
+	Active   bool   `json:"active"`
+}
+
+// SuspendRequest suspends the user until the time, empty Until lifts the
+// suspension
+type SuspendRequest struct {
+	Until  string `json:"until" example:"2023-01-02T15:04:05Z"`
+	Reason string `json:"reason"`
+}
+
+// BanRequest bans the user until the time or forever if Until is empty, Lift
+// lifts the ban
+type BanRequest struct {
+	Lift   bool   `json:"lift"`
+	Until  string `json:"until" example:"2023-01-02T15:04:05Z"`
+	Reason string `json:"reason"`
+}
+
+// AccountStatus is active, suspended or banned, Until is empty when the state
+// does not expire. Content of shadow banned users is shown only to them.
+type AccountStatus struct {
+	Id                string `json:"id"`
+	State             string `json:"state"`
+	Until             string `json:"until"`
+	Reason            string `json:"reason"`
+	ShadowBanned      bool   `json:"shadow_banned"`
+	ShadowBannedUntil string `json:"shadow_banned_until"`
+	ShadowBanReason   string `json:"shadow_ban_reason"`
 }
@@ -0,0 +1,200 @@
+package v1
+
+import (
+	"io"
+	"net/http"
+	"strconv"
+
+	"github.com/burxondv/new-services/api-gateway/api/handlers/models"
+	pp "github.com/burxondv/new-services/api-gateway/genproto/post"
+	"github.com/burxondv/new-services/api-gateway/pkg/cache"
+	l "github.com/burxondv/new-services/api-gateway/pkg/logger"
+	"github.com/google/uuid"
+
+	"github.com/gin-gonic/gin"
+)
+
+// User
+// @Summary Upload attachment
+// @Tags Attachment
+// @Description Upload image or file to own post. Images get thumbnail
+// @Security ApiKeyAuth
+// @Accept multipart/form-data
+// @Produce json
+// @Param id path string true "Post ID"
+// @Param file formData file true "File"
+// @Success 201 {object} models.Attachment
+// @Failure 400 string Error models.Error
+// @Failure 413 string Error models.Error
+// @Failure 500 string Error models.Error
+// @Router /v1/posts/{id}/attachments [post]
+func (h *handlerV1) UploadAttachment(c *gin.Context) {
+	// 1MB is left for multipart headers
+	c.Request.Body = http.MaxBytesReader(c.Writer, c.Request.Body, h.cfg.MaxAttachmentSize+1<<20)
+
+	fileHeader, err := c.FormFile("file")
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": err.Error(),
+		})
+		h.reqLog(c).Error("failed to get form file", l.Error(err))
+		return
+	}
+
+	if fileHeader.Size > h.cfg.MaxAttachmentSize {
+		c.JSON(http.StatusRequestEntityTooLarge, gin.H{
+			"error": "file is too large",
+		})
+		return
+	}
+
+	file, err := fileHeader.Open()
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": err.Error(),
+		})
+		h.reqLog(c).Error("failed to open form file", l.Error(err))
+		return
+	}
+	defer file.Close()
+
+	data, err := io.ReadAll(file)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": err.Error(),
+		})
+		h.reqLog(c).Error("failed to read form file", l.Error(err))
+		return
+	}
+
+	// random uuid...
+	id, err := uuid.NewRandom()
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": err.Error(),
+		})
+		h.reqLog(c).Error("failed to generating uuid", l.Error(err))
+		return
+	}
+
+	claims := GetClaims(h, c)
+	reqId := claims["sub"].(string)
+
+	response, err := h.serviceManager.PostService().UploadAttachment(c.Request.Context(), &pp.AttachmentRequest{
+		Id:       id.String(),
+		PostId:   c.Param("id"),
+		UserId:   reqId,
+		FileName: fileHeader.Filename,
+		Data:     data,
+	})
+	if err != nil {
+		c.JSON(httpStatus(err), gin.H{
+			"error": err.Error(),
+		})
+		h.reqLog(c).Error("failed to upload attachment", l.Error(err))
+		return
+	}
+	h.invalidate(c.Request.Context(), cache.PostResource(response.PostId))
+
+	c.JSON(http.StatusCreated, attachmentModel(response))
+}
+
+// Super-Admin | Admin | User
+// @Summary Get post attachments
+// @Tags Attachment
+// @Description Get attachments of the post
+// @Security ApiKeyAuth
+// @Produce json
+// @Param id path string true "Post ID"
+// @Success 200 {object} models.Attachments
+// @Failure 400 string Error models.Error
+// @Failure 404 string Error models.Error
+// @Failure 500 string Error models.Error
+// @Router /v1/posts/{id}/attachments [get]
+func (h *handlerV1) GetAttachments(c *gin.Context) {
+	response, err := h.serviceManager.PostService().GetAttachments(c.Request.Context(), &pp.Request{Str: c.Param("id")})
+	if err != nil {
+		c.JSON(httpStatus(err), gin.H{
+			"error": err.Error(),
+		})
+		h.reqLog(c).Error("failed to get attachments", l.Error(err))
+		return
+	}
+
+	attachments := models.Attachments{}
+	for _, val := range response.Attachments {
+		attachments.Attachments = append(attachments.Attachments, attachmentModel(val))
+	}
+
+	c.JSON(http.StatusOK, attachments)
+}
+
+// Super-Admin | Admin | User
+// @Summary Download attachment
+// @Tags Attachment
+// @Description Download attachment file or its thumbnail
+// @Security ApiKeyAuth
+// @Produce octet-stream
+// @Param id path string true "Attachment ID"
+// @Param thumbnail query bool false "Thumbnail"
+// @Success 200 {file} file
+// @Failure 404 string Error models.Error
+// @Failure 500 string Error models.Error
+// @Router /v1/attachments/{id} [get]
+func (h *handlerV1) DownloadAttachment(c *gin.Context) {
+	thumbnail, _ := strconv.ParseBool(c.Query("thumbnail"))
+
+	response, err := h.serviceManager.PostService().GetAttachmentContent(c.Request.Context(), &pp.AttachmentContentRequest{
+		Id:        c.Param("id"),
+		Thumbnail: thumbnail,
+	})
+	if err != nil {
+		c.JSON(httpStatus(err), gin.H{
+			"error": err.Error(),
+		})
+		h.reqLog(c).Error("failed to download attachment", l.Error(err))
+		return
+	}
+
+	c.Header("Content-Disposition", "inline; filename="+strconv.Quote(response.FileName))
+	c.Header("X-Content-Type-Options", "nosniff")
+	c.Data(http.StatusOK, response.MimeType, response.Data)
+}
+
+// Super-Admin | Admin | User
+// @Summary Delete attachment
+// @Tags Attachment
+// @Description Delete attachment, its blobs are removed later
+// @Security ApiKeyAuth
+// @Produce json
+// @Param id path string true "Attachment ID"
+// @Success 200 {object} models.Attachment
+// @Failure 403 string Error models.Error
+// @Failure 404 string Error models.Error
+// @Failure 500 string Error models.Error
+// @Router /v1/attachments/{id} [delete]
+func (h *handlerV1) DeleteAttachment(c *gin.Context) {
+	response, err := h.serviceManager.PostService().DeleteAttachment(c.Request.Context(), &pp.Request{Str: c.Param("id")})
+	if err != nil {
+		c.JSON(httpStatus(err), gin.H{
+			"error": err.Error(),
+		})
+		h.reqLog(c).Error("failed to delete attachment", l.Error(err))
+		return
+	}
+	h.invalidate(c.Request.Context(), cache.PostResource(response.PostId))
+
+	c.JSON(http.StatusOK, attachmentModel(response))
+}
+
+func attachmentModel(att *pp.AttachmentResponse) models.Attachment {
+	return models.Attachment{
+		Id:           att.Id,
+		PostId:       att.PostId,
+		FileName:     att.FileName,
+		MimeType:     att.MimeType,
+		Size:         att.FileSize,
+		HasThumbnail: att.HasThumbnail,
+		CreatedAt:    att.CreatedAt,
+	}
+}
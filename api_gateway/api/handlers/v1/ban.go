@@ -0,0 +1,167 @@
+package v1
+
+import (
+	"net/http"
+
+	"github.com/burxondv/new-services/api-gateway/api/handlers/models"
+	pu "github.com/burxondv/new-services/api-gateway/genproto/user"
+	"github.com/burxondv/new-services/api-gateway/pkg/cache"
+	l "github.com/burxondv/new-services/api-gateway/pkg/logger"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Super-Admin | Admin
+// @Summary Get account status
+// @Tags Ban
+// @Description Get suspension, ban and shadow ban of the user
+// @Security ApiKeyAuth
+// @Produce json
+// @Param id path string true "User ID"
+// @Success 200 {object} models.AccountStatus
+// @Failure 404 string Error models.Error
+// @Failure 500 string Error models.Error
+// @Router /v1/users/{id}/account [get]
+func (h *handlerV1) GetAccountStatus(c *gin.Context) {
+	response, err := h.serviceManager.UserService().GetAccountStatus(c.Request.Context(), &pu.Request{Str: c.Param("id")})
+	if err != nil {
+		c.JSON(httpStatus(err), gin.H{
+			"error": err.Error(),
+		})
+		h.reqLog(c).Error("failed to get account status", l.Error(err))
+		return
+	}
+
+	c.JSON(http.StatusOK, accountModel(response))
+}
+
+// Super-Admin | Admin
+// @Summary Suspend user
+// @Tags Ban
+// @Description Suspend the user until the time, empty time lifts the suspension. Suspended user can not log in and write
+// @Security ApiKeyAuth
+// @Accept json
+// @Produce json
+// @Param id path string true "User ID"
+// @Param Suspend body models.SuspendRequest true "Suspend"
+// @Success 200 {object} models.AccountStatus
+// @Failure 400 string Error models.Error
+// @Failure 404 string Error models.Error
+// @Failure 500 string Error models.Error
+// @Router /v1/users/{id}/suspend [put]
+func (h *handlerV1) SuspendUser(c *gin.Context) {
+	var body models.SuspendRequest
+
+	err := c.ShouldBindJSON(&body)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": err.Error(),
+		})
+		h.reqLog(c).Error("failed to bind JSON", l.Error(err))
+		return
+	}
+
+	id := c.Param("id")
+	_, err = h.serviceManager.UserService().SuspendUser(c.Request.Context(), &pu.SuspendRequest{
+		Id:     id,
+		Until:  body.Until,
+		Reason: body.Reason,
+	})
+	if err != nil {
+		c.JSON(httpStatus(err), gin.H{
+			"error": err.Error(),
+		})
+		h.reqLog(c).Error("failed to suspend user", l.Error(err))
+		return
+	}
+	h.invalidate(c.Request.Context(), cache.AccountResource(id))
+
+	h.GetAccountStatus(c)
+}
+
+// Super-Admin | Admin
+// @Summary Ban user
+// @Tags Ban
+// @Description Ban the user until the time or forever, banned user can not log in and write
+// @Security ApiKeyAuth
+// @Accept json
+// @Produce json
+// @Param id path string true "User ID"
+// @Param Ban body models.BanRequest true "Ban"
+// @Success 200 {object} models.AccountStatus
+// @Failure 400 string Error models.Error
+// @Failure 404 string Error models.Error
+// @Failure 500 string Error models.Error
+// @Router /v1/users/{id}/ban [put]
+func (h *handlerV1) BanUser(c *gin.Context) {
+	h.banUser(c, false)
+}
+
+// Super-Admin | Admin
+// @Summary Shadow ban user
+// @Tags Ban
+// @Description Shadow ban the user until the time or forever, content of the user is shown only to the user
+// @Security ApiKeyAuth
+// @Accept json
+// @Produce json
+// @Param id path string true "User ID"
+// @Param Ban body models.BanRequest true "Ban"
+// @Success 200 {object} models.AccountStatus
+// @Failure 400 string Error models.Error
+// @Failure 404 string Error models.Error
+// @Failure 500 string Error models.Error
+// @Router /v1/users/{id}/shadow-ban [put]
+func (h *handlerV1) ShadowBanUser(c *gin.Context) {
+	h.banUser(c, true)
+}
+
+func (h *handlerV1) banUser(c *gin.Context, shadow bool) {
+	var body models.BanRequest
+
+	err := c.ShouldBindJSON(&body)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": err.Error(),
+		})
+		h.reqLog(c).Error("failed to bind JSON", l.Error(err))
+		return
+	}
+
+	req := &pu.BanRequest{
+		Id:     c.Param("id"),
+		Lift:   body.Lift,
+		Until:  body.Until,
+		Reason: body.Reason,
+	}
+
+	var response *pu.AccountResponse
+	if shadow {
+		response, err = h.serviceManager.UserService().ShadowBanUser(c.Request.Context(), req)
+	} else {
+		response, err = h.serviceManager.UserService().BanUser(c.Request.Context(), req)
+	}
+	if err != nil {
+		c.JSON(httpStatus(err), gin.H{
+			"error": err.Error(),
+		})
+		h.reqLog(c).Error("failed to ban user", l.Error(err))
+		return
+	}
+
+	// cached posts of shadow banned user expire by their TTL
+	h.invalidate(c.Request.Context(), cache.AccountResource(req.Id), cache.UserResource(req.Id))
+
+	c.JSON(http.StatusOK, accountModel(response))
+}
+
+func accountModel(res *pu.AccountResponse) models.AccountStatus {
+	return models.AccountStatus{
+		Id:                res.Id,
+		State:             res.State,
+		Until:             res.Until,
+		Reason:            res.Reason,
+		ShadowBanned:      res.ShadowBanned,
+		ShadowBannedUntil: res.ShadowBannedUntil,
+		ShadowBanReason:   res.ShadowBanReason,
+	}
+}
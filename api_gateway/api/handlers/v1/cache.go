@@ -0,0 +1,40 @@
+package v1
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/burxondv/new-services/api-gateway/pkg/cache"
+	l "github.com/burxondv/new-services/api-gateway/pkg/logger"
+
+	"github.com/gin-gonic/gin"
+)
+
+// invalidate drops cached responses of changed resources, failed
+// invalidation is only logged, stale responses expire by TTL
+func (h *handlerV1) invalidate(ctx context.Context, resources ...string) {
+	if err := h.cache.Invalidate(ctx, resources...); err != nil {
+		l.WithContext(h.log, ctx).Error("failed to invalidate cache", l.Error(err))
+	}
+}
+
+// invalidateRelation drops responses the relation of the users changes:
+// what each of them sees of the other and their counters
+func (h *handlerV1) invalidateRelation(ctx context.Context, userId, targetId string) {
+	h.invalidate(ctx,
+		cache.RelationsResource(userId), cache.RelationsResource(targetId),
+		cache.UserResource(userId), cache.UserResource(targetId),
+	)
+}
+
+// Super-Admin | Admin
+// @Summary Cache stats
+// @Tags Cache
+// @Description Hits and misses of response cache of this gateway instance since its start
+// @Security ApiKeyAuth
+// @Produce json
+// @Success 200 {object} cache.Stats
+// @Router /v1/cache/stats [get]
+func (h *handlerV1) GetCacheStats(c *gin.Context) {
+	c.JSON(http.StatusOK, h.cache.Stats())
+}
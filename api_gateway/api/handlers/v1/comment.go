@@ -1,12 +1,12 @@
 package v1
 
 import (
-	"context"
 	"net/http"
 	"time"
 
 	"github.com/burxondv/new-services/api-gateway/api/handlers/models"
 	pc "github.com/burxondv/new-services/api-gateway/genproto/comment"
+	"github.com/burxondv/new-services/api-gateway/pkg/cache"
 	l "github.com/burxondv/new-services/api-gateway/pkg/logger"
 	"github.com/google/uuid"
 
@@ -22,8 +22,12 @@ import (
 // @Accept json
 // @Produce json
 // @Param CommentInfo body models.CommentRequest true "write comment"
+// @Param Idempotency-Key header string false "retries with the same key return the first response"
 // @Success 201 {object} models.Comment
 // @Failure 400 string Error models.Error
+// @Failure 404 string Error models.Error
+// @Failure 409 string Error models.Error
+// @Failure 422 string Error models.Error
 // @Failure 500 string Error models.Error
 // @Router /v1/comments [post]
 func (h *handlerV1) WriteComment(c *gin.Context) {
@@ -38,7 +42,7 @@ func (h *handlerV1) WriteComment(c *gin.Context) {
 		c.JSON(http.StatusBadRequest, gin.H{
 			"error": err.Error(),
 		})
-		h.log.Error("failed to bind JSON", l.Error(err))
+		h.reqLog(c).Error("failed to bind JSON", l.Error(err))
 		return
 	}
 
@@ -48,26 +52,28 @@ func (h *handlerV1) WriteComment(c *gin.Context) {
 		c.JSON(http.StatusBadRequest, gin.H{
 			"error": err.Error(),
 		})
-		h.log.Error("failed to generating uuid", l.Error(err))
+		h.reqLog(c).Error("failed to generating uuid", l.Error(err))
 		return
 	}
 
 	claims := GetClaims(h, c)
 	reqId := claims["sub"].(string)
 
-	response, err := h.serviceManager.CommentService().WriteComment(context.Background(), &pc.CommentRequest{
-		Id:     id.String(),
-		PostId: body.PostId,
-		UserId: reqId,
-		Text:   body.Text,
+	response, err := h.serviceManager.CommentService().WriteComment(c.Request.Context(), &pc.CommentRequest{
+		Id:       id.String(),
+		PostId:   body.PostId,
+		ParentId: body.ParentId,
+		UserId:   reqId,
+		Text:     body.Text,
 	})
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{
+		c.JSON(httpStatus(err), gin.H{
 			"error": err.Error(),
 		})
-		h.log.Error("failed to write comment", l.Error(err))
+		h.reqLog(c).Error("failed to write comment", l.Error(err))
 		return
 	}
+	h.invalidate(c.Request.Context(), cache.PostResource(response.PostId))
 
 	c.JSON(http.StatusCreated, models.Comment{
 		Id:           response.Id,
@@ -77,6 +83,7 @@ func (h *handlerV1) WriteComment(c *gin.Context) {
 		UserName:     response.UserName,
 		UserType:     response.UserType,
 		PostUserName: response.PostUserName,
+		ParentId:     response.ParentId,
 		Text:         response.Text,
 		CreatedAt:    response.CreatedAt,
 	})
@@ -92,6 +99,7 @@ func (h *handlerV1) WriteComment(c *gin.Context) {
 // @Param id path string true "Post ID"
 // @Success 200 string models.Comments
 // @Failure 400 string Error models.Error
+// @Failure 404 string Error models.Error
 // @Failure 500 string Error models.Error
 // @Router /v1/comments/{id} [get]
 func (h *handlerV1) GetComments(c *gin.Context) {
@@ -101,12 +109,15 @@ func (h *handlerV1) GetComments(c *gin.Context) {
 
 	id := c.Param("id")
 
-	response, err := h.serviceManager.CommentService().GetComments(context.Background(), &pc.Request{Str: id})
+	claims := GetClaims(h, c)
+	reqId := claims["sub"].(string)
+
+	response, err := h.serviceManager.CommentService().GetComments(c.Request.Context(), &pc.Request{Str: id, ViewerId: reqId})
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{
+		c.JSON(httpStatus(err), gin.H{
 			"error": err.Error(),
 		})
-		h.log.Error("failed to get comment by post id", l.Error(err))
+		h.reqLog(c).Error("failed to get comment by post id", l.Error(err))
 		return
 	}
 
@@ -119,8 +130,10 @@ func (h *handlerV1) GetComments(c *gin.Context) {
 		com.UserId = val.UserId
 		com.UserName = val.UserName
 		com.UserType = val.UserType
+		com.ParentId = val.ParentId
 		com.Text = val.Text
 		com.CreatedAt = val.CreatedAt
+		com.ModerationStatus = val.ModerationStatus
 
 		comments.Comments = append(comments.Comments, com)
 	}
@@ -138,6 +151,7 @@ func (h *handlerV1) GetComments(c *gin.Context) {
 // @Param id path string true "Comment Id"
 // @Success 200 {object} models.DeletedComment
 // @Failure 400 string Error models.Error
+// @Failure 403 string Error models.Error
 // @Failure 500 string Error models.Error
 // @Router /v1/comments/{id} [delete]
 func (h *handlerV1) DeleteComment(c *gin.Context) {
@@ -146,14 +160,15 @@ func (h *handlerV1) DeleteComment(c *gin.Context) {
 
 	id := c.Param("id")
 
-	response, err := h.serviceManager.CommentService().DeleteComment(context.Background(), &pc.Request{Str: id})
+	response, err := h.serviceManager.CommentService().DeleteComment(c.Request.Context(), &pc.Request{Str: id})
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{
+		c.JSON(httpStatus(err), gin.H{
 			"error": err.Error(),
 		})
-		h.log.Error("failed to delete comment", l.Error(err))
+		h.reqLog(c).Error("failed to delete comment", l.Error(err))
 		return
 	}
+	h.invalidate(c.Request.Context(), cache.PostResource(response.PostId))
 
 	c.JSON(http.StatusOK, models.DeletedComment{
 		Id:           response.Id,
@@ -0,0 +1,52 @@
+package v1
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// etag is the entity tag of a version of user or post
+func etag(version int64) string {
+	return `"` + strconv.FormatInt(version, 10) + `"`
+}
+
+// expectedVersion returns version of If-Match header or version of the body
+// when the header is not given. ifMatch reports whether the header is used,
+// "*" matches any version.
+func expectedVersion(c *gin.Context, bodyVersion int64) (version int64, ifMatch bool, err error) {
+	header := strings.TrimSpace(c.GetHeader("If-Match"))
+	if header == "" {
+		return bodyVersion, false, nil
+	}
+	if header == "*" {
+		return 0, true, nil
+	}
+
+	// weak tags never match and only one version can be expected
+	if !strings.HasPrefix(header, `"`) || !strings.HasSuffix(header, `"`) || len(header) < 2 {
+		return 0, true, errors.New("If-Match must be one strong entity tag")
+	}
+
+	version, err = strconv.ParseInt(header[1:len(header)-1], 10, 64)
+	if err != nil || version <= 0 {
+		return 0, true, errors.New("If-Match must be one strong entity tag")
+	}
+
+	return version, true, nil
+}
+
+// versionStatus answers a conflict of If-Match with 412, a conflict of the
+// version in body with 409
+func versionStatus(err error, ifMatch bool) int {
+	if ifMatch && status.Code(err) == codes.FailedPrecondition {
+		return http.StatusPreconditionFailed
+	}
+
+	return httpStatus(err)
+}
@@ -0,0 +1,114 @@
+package v1
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/burxondv/new-services/api-gateway/api/handlers/models"
+	pu "github.com/burxondv/new-services/api-gateway/genproto/user"
+	l "github.com/burxondv/new-services/api-gateway/pkg/logger"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Super-Admin | Admin | User
+// @Summary Request data export
+// @Tags User
+// @Description Request export of the profile, posts, comments and likes of the user from Claims, it is built in background
+// @Security ApiKeyAuth
+// @Produce json
+// @Success 202 {object} models.DataExport
+// @Failure 404 string Error models.Error
+// @Failure 500 string Error models.Error
+// @Router /v1/users/export [post]
+func (h *handlerV1) RequestDataExport(c *gin.Context) {
+	claims := GetClaims(h, c)
+	reqId := claims["sub"].(string)
+
+	response, err := h.serviceManager.UserService().RequestDataExport(c.Request.Context(), &pu.Request{Str: reqId})
+	if err != nil {
+		c.JSON(httpStatus(err), gin.H{
+			"error": err.Error(),
+		})
+		h.reqLog(c).Error("failed to request data export", l.Error(err))
+		return
+	}
+
+	c.JSON(http.StatusAccepted, dataExportModel(response))
+}
+
+// Super-Admin | Admin | User
+// @Summary Get data export
+// @Tags User
+// @Description Get status of data export of the user from Claims
+// @Security ApiKeyAuth
+// @Produce json
+// @Param id path string true "Export ID"
+// @Success 200 {object} models.DataExport
+// @Failure 404 string Error models.Error
+// @Failure 500 string Error models.Error
+// @Router /v1/users/export/{id} [get]
+func (h *handlerV1) GetDataExport(c *gin.Context) {
+	claims := GetClaims(h, c)
+	reqId := claims["sub"].(string)
+
+	response, err := h.serviceManager.UserService().GetDataExport(c.Request.Context(), &pu.DataExportRequest{
+		Id:     c.Param("id"),
+		UserId: reqId,
+	})
+	if err != nil {
+		c.JSON(httpStatus(err), gin.H{
+			"error": err.Error(),
+		})
+		h.reqLog(c).Error("failed to get data export", l.Error(err))
+		return
+	}
+
+	c.JSON(http.StatusOK, dataExportModel(response))
+}
+
+// Super-Admin | Admin | User
+// @Summary Download data export
+// @Tags User
+// @Description Download ready data export of the user from Claims as zip archive or json file
+// @Security ApiKeyAuth
+// @Produce octet-stream
+// @Param id path string true "Export ID"
+// @Param format query string false "zip (default) or json"
+// @Success 200 {file} file
+// @Failure 400 string Error models.Error
+// @Failure 404 string Error models.Error
+// @Failure 409 string Error models.Error
+// @Failure 500 string Error models.Error
+// @Router /v1/users/export/{id}/download [get]
+func (h *handlerV1) DownloadDataExport(c *gin.Context) {
+	claims := GetClaims(h, c)
+	reqId := claims["sub"].(string)
+
+	response, err := h.serviceManager.UserService().GetDataExportContent(c.Request.Context(), &pu.DataExportRequest{
+		Id:     c.Param("id"),
+		UserId: reqId,
+		Format: c.Query("format"),
+	})
+	if err != nil {
+		c.JSON(httpStatus(err), gin.H{
+			"error": err.Error(),
+		})
+		h.reqLog(c).Error("failed to download data export", l.Error(err))
+		return
+	}
+
+	c.Header("Content-Disposition", "attachment; filename="+strconv.Quote(response.FileName))
+	c.Header("X-Content-Type-Options", "nosniff")
+	c.Data(http.StatusOK, response.MimeType, response.Content)
+}
+
+func dataExportModel(res *pu.DataExportResponse) models.DataExport {
+	return models.DataExport{
+		Id:          res.Id,
+		Status:      res.Status,
+		Error:       res.Error,
+		CreatedAt:   res.CreatedAt,
+		CompletedAt: res.CompletedAt,
+	}
+}
@@ -0,0 +1,122 @@
+package v1
+
+import (
+	"net/http"
+
+	"github.com/burxondv/new-services/api-gateway/api/handlers/models"
+	pu "github.com/burxondv/new-services/api-gateway/genproto/user"
+	l "github.com/burxondv/new-services/api-gateway/pkg/logger"
+
+	"github.com/gin-gonic/gin"
+)
+
+// User
+// @Summary follow user
+// @Tags User
+// @Descrtiption Follow user by Id. Follower Id from Claims
+// @Security ApiKeyAuth
+// @Produce json
+// @Param id path string true "User Id"
+// @Success 200 {object} models.Follow
+// @Failure 400 string Error models.Error
+// @Failure 404 string Error models.Error
+// @Failure 500 string Error models.Error
+// @Router /v1/users/{id}/follow [post]
+func (h *handlerV1) FollowUser(c *gin.Context) {
+	claims := GetClaims(h, c)
+	reqId := claims["sub"].(string)
+
+	response, err := h.serviceManager.UserService().FollowUser(c.Request.Context(), &pu.FollowRequest{
+		FollowerId:  reqId,
+		FollowingId: c.Param("id"),
+	})
+	if err != nil {
+		c.JSON(httpStatus(err), gin.H{
+			"error": err.Error(),
+		})
+		h.reqLog(c).Error("failed to follow user", l.Error(err))
+		return
+	}
+
+	h.invalidateRelation(c.Request.Context(), reqId, c.Param("id"))
+
+	c.JSON(http.StatusOK, models.Follow{
+		FollowerId:  response.FollowerId,
+		FollowingId: response.FollowingId,
+		Following:   response.Following,
+	})
+}
+
+// User
+// @Summary unfollow user
+// @Tags User
+// @Descrtiption Unfollow user by Id. Follower Id from Claims
+// @Security ApiKeyAuth
+// @Produce json
+// @Param id path string true "User Id"
+// @Success 200 {object} models.Follow
+// @Failure 400 string Error models.Error
+// @Failure 500 string Error models.Error
+// @Router /v1/users/{id}/follow [delete]
+func (h *handlerV1) UnfollowUser(c *gin.Context) {
+	claims := GetClaims(h, c)
+	reqId := claims["sub"].(string)
+
+	response, err := h.serviceManager.UserService().UnfollowUser(c.Request.Context(), &pu.FollowRequest{
+		FollowerId:  reqId,
+		FollowingId: c.Param("id"),
+	})
+	if err != nil {
+		c.JSON(httpStatus(err), gin.H{
+			"error": err.Error(),
+		})
+		h.reqLog(c).Error("failed to unfollow user", l.Error(err))
+		return
+	}
+
+	h.invalidateRelation(c.Request.Context(), reqId, c.Param("id"))
+
+	c.JSON(http.StatusOK, models.Follow{
+		FollowerId:  response.FollowerId,
+		FollowingId: response.FollowingId,
+		Following:   response.Following,
+	})
+}
+
+// Super-Admin | Admin | User
+// @Summary get followers
+// @Tags User
+// @Descrtiption Get followers of user by Id
+// @Security ApiKeyAuth
+// @Produce json
+// @Param id path string true "User Id"
+// @Success 200 {object} models.Users
+// @Failure 400 string Error models.Error
+// @Failure 500 string Error models.Error
+// @Router /v1/users/{id}/followers [get]
+func (h *handlerV1) GetFollowers(c *gin.Context) {
+	response, err := h.serviceManager.UserService().GetFollowers(c.Request.Context(), &pu.Request{Str: c.Param("id")})
+	if err != nil {
+		c.JSON(httpStatus(err), gin.H{
+			"error": err.Error(),
+		})
+		h.reqLog(c).Error("failed to get followers", l.Error(err))
+		return
+	}
+
+	users := models.Users{}
+	for _, val := range response.Users {
+		users.Users = append(users.Users, models.User{
+			Id:        val.Id,
+			FirstName: val.FirstName,
+			LastName:  val.LastName,
+			UserType:  val.UserType,
+			Email:     val.Email,
+			Posts:     val.Posts,
+			CreatedAt: val.CreatedAt,
+			UpdatedAt: val.UpdatedAt,
+		})
+	}
+
+	c.JSON(http.StatusOK, users)
+}
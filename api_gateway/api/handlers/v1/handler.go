@@ -9,12 +9,16 @@ import (
 	"github.com/burxondv/new-services/api-gateway/api/handlers/models"
 	"github.com/burxondv/new-services/api-gateway/api/handlers/token"
 	"github.com/burxondv/new-services/api-gateway/config"
+	"github.com/burxondv/new-services/api-gateway/pkg/cache"
 	"github.com/burxondv/new-services/api-gateway/pkg/logger"
+	"github.com/burxondv/new-services/api-gateway/pkg/realtime"
 	"github.com/burxondv/new-services/api-gateway/services"
 	"github.com/burxondv/new-services/api-gateway/storage/repo"
 	"github.com/casbin/casbin/v2"
 	"github.com/gin-gonic/gin"
 	"github.com/golang-jwt/jwt"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 type handlerV1 struct {
@@ -23,7 +27,9 @@ type handlerV1 struct {
 	cfg            config.Config
 	redis          repo.RedisRepo
 	jwtHandler     token.JWTHandler
-	enforcer       casbin.Enforcer
+	enforcer       *casbin.Enforcer
+	broker         *realtime.Broker
+	cache          *cache.Cache
 }
 
 type HandlerV1Config struct {
@@ -32,7 +38,9 @@ type HandlerV1Config struct {
 	Cfg            config.Config
 	Redis          repo.RedisRepo
 	JWTHandler     token.JWTHandler
-	Enforcer       casbin.Enforcer
+	Enforcer       *casbin.Enforcer
+	Broker         *realtime.Broker
+	Cache          *cache.Cache
 }
 
 func New(c *HandlerV1Config) *handlerV1 {
@@ -43,6 +51,8 @@ func New(c *HandlerV1Config) *handlerV1 {
 		redis:          c.Redis,
 		jwtHandler:     c.JWTHandler,
 		enforcer:       c.Enforcer,
+		broker:         c.Broker,
+		cache:          c.Cache,
 	}
 }
 
@@ -59,7 +69,7 @@ func GetClaims(h *handlerV1, c *gin.Context) jwt.MapClaims {
 		c.JSON(http.StatusUnauthorized, models.StandardErrorModel{
 			Error: models.Error{Message: "error unauthorized in get header"},
 		})
-		h.log.Error("Unauthorized request: ", logger.Error(ErrUnauthorized))
+		h.reqLog(c).Error("Unauthorized request: ", logger.Error(ErrUnauthorized))
 		return nil
 	}
 
@@ -72,9 +82,32 @@ func GetClaims(h *handlerV1, c *gin.Context) jwt.MapClaims {
 		c.JSON(http.StatusUnauthorized, models.StandardErrorModel{
 			Error: models.Error{Message: "error unmarshalling in extract claims"},
 		})
-		h.log.Error("Unauthorized request: ", logger.Error(ErrUnauthorized))
+		h.reqLog(c).Error("Unauthorized request: ", logger.Error(ErrUnauthorized))
 		return nil
 	}
 
 	return claims
 }
+
+// reqLog returns logger with ids of the request and of its trace
+func (h *handlerV1) reqLog(c *gin.Context) logger.Logger {
+	return logger.WithContext(h.log, c.Request.Context())
+}
+
+// httpStatus maps gRPC error code from services to HTTP status code
+func httpStatus(err error) int {
+	switch status.Code(err) {
+	case codes.InvalidArgument:
+		return http.StatusBadRequest
+	case codes.NotFound:
+		return http.StatusNotFound
+	case codes.PermissionDenied:
+		return http.StatusForbidden
+	case codes.Unauthenticated:
+		return http.StatusUnauthorized
+	case codes.AlreadyExists, codes.FailedPrecondition:
+		return http.StatusConflict
+	default:
+		return http.StatusInternalServerError
+	}
+}
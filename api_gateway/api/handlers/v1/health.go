@@ -0,0 +1,51 @@
+package v1
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// readyTimeout limits checks of dependencies of one readiness probe
+const readyTimeout = 3 * time.Second
+
+// unauthorized
+// @Summary Liveness
+// @Tags Health
+// @Description Gateway process is up, dependencies are not checked
+// @Produce json
+// @Success 200 {object} map[string]string
+// @Router /healthz [get]
+func (h *handlerV1) Healthz(c *gin.Context) {
+	c.JSON(http.StatusOK, gin.H{"status": "ok"})
+}
+
+// unauthorized
+// @Summary Readiness
+// @Tags Health
+// @Description Gateway can serve requests: Redis and all services are reachable and services have their databases
+// @Produce json
+// @Success 200 {object} map[string]string
+// @Failure 503 {object} map[string]string
+// @Router /readyz [get]
+func (h *handlerV1) Readyz(c *gin.Context) {
+	ctx, cancel := context.WithTimeout(c.Request.Context(), readyTimeout)
+	defer cancel()
+
+	errs := h.serviceManager.Check(ctx)
+	errs["redis"] = h.redis.Ping(ctx)
+
+	code, checks := http.StatusOK, gin.H{}
+	for name, err := range errs {
+		if err != nil {
+			code = http.StatusServiceUnavailable
+			checks[name] = err.Error()
+			continue
+		}
+		checks[name] = "ok"
+	}
+
+	c.JSON(code, checks)
+}
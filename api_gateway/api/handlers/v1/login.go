@@ -1,13 +1,13 @@
 package v1
 
 import (
-	"context"
 	"net/http"
 
 	"github.com/burxondv/new-services/api-gateway/api/handlers/models"
 	"github.com/burxondv/new-services/api-gateway/api/handlers/token"
 	pu "github.com/burxondv/new-services/api-gateway/genproto/user"
 	l "github.com/burxondv/new-services/api-gateway/pkg/logger"
+	"github.com/burxondv/new-services/api-gateway/pkg/metrics"
 	"github.com/gin-gonic/gin"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -22,6 +22,7 @@ import (
 // @Param email path string true "email"
 // @Param password path string true "password"
 // @Success 200 {object} models.LoginResponseModel
+// @Failure 403 {object} models.StandardErrorModel
 // @Router /v1/login/{email}/{password} [get]
 func (h *handlerV1) Login(c *gin.Context) {
 	var (
@@ -33,7 +34,7 @@ func (h *handlerV1) Login(c *gin.Context) {
 	)
 
 	res, err := h.serviceManager.UserService().Login(
-		context.Background(), &pu.LoginRequest{
+		c.Request.Context(), &pu.LoginRequest{
 			Email:    email,
 			Password: password,
 		},
@@ -46,7 +47,17 @@ func (h *handlerV1) Login(c *gin.Context) {
 				Message: st.Message(),
 			},
 		})
-		h.log.Error("failed get client by email", l.Error(err))
+		h.reqLog(c).Error("failed get client by email", l.Error(err))
+		return
+	} else if err != nil {
+		// banned and suspended users get 403
+		c.JSON(httpStatus(err), models.StandardErrorModel{
+			Error: models.Error{
+				Message: st.Message(),
+			},
+		})
+		metrics.Logins.WithLabelValues("failure").Inc()
+		h.reqLog(c).Error("failed to login", l.Error(err))
 		return
 	}
 
@@ -66,7 +77,7 @@ func (h *handlerV1) Login(c *gin.Context) {
 		c.JSON(http.StatusBadRequest, gin.H{
 			"error": err.Error(),
 		})
-		h.log.Error("failed to access and refresh token", l.Error(err))
+		h.reqLog(c).Error("failed to access and refresh token", l.Error(err))
 		return
 	}
 
@@ -76,7 +87,7 @@ func (h *handlerV1) Login(c *gin.Context) {
 		RefreshToken: refreshToken,
 	}
 
-	newRes, err := h.serviceManager.UserService().UpdateUserTokens(context.Background(), &pu.UpdateUserTokensRequest{
+	newRes, err := h.serviceManager.UserService().UpdateUserTokens(c.Request.Context(), &pu.UpdateUserTokensRequest{
 		Id:           ucReq.Id,
 		RefreshToken: ucReq.RefreshToken,
 	})
@@ -84,7 +95,7 @@ func (h *handlerV1) Login(c *gin.Context) {
 		c.JSON(http.StatusBadRequest, gin.H{
 			"error": err.Error(),
 		})
-		h.log.Error("failed to update user tokens", l.Error(err))
+		h.reqLog(c).Error("failed to update user tokens", l.Error(err))
 		return
 	}
 
@@ -95,6 +106,7 @@ func (h *handlerV1) Login(c *gin.Context) {
 	loginResponse.Email = newRes.Email
 	loginResponse.AccessToken = accessToken
 	loginResponse.RefreshToken = refreshToken
+	metrics.Logins.WithLabelValues("success").Inc()
 
 	c.JSON(http.StatusOK, loginResponse)
 }
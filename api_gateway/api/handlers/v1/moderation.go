@@ -0,0 +1,256 @@
+package v1
+
+import (
+	"net/http"
+
+	"github.com/burxondv/new-services/api-gateway/api/handlers/models"
+	pm "github.com/burxondv/new-services/api-gateway/genproto/moderation"
+	"github.com/burxondv/new-services/api-gateway/pkg/cache"
+	l "github.com/burxondv/new-services/api-gateway/pkg/logger"
+	"github.com/burxondv/new-services/api-gateway/pkg/utils"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Super-Admin | Admin | Moderator | User
+// @Summary Report content
+// @Tags Moderation
+// @Description Report a post, comment or user to moderators, reports of the same target are collected in one case
+// @Security ApiKeyAuth
+// @Accept json
+// @Produce json
+// @Param Report body models.ReportRequest true "Report"
+// @Success 201 {object} models.Report
+// @Failure 400 string Error models.Error
+// @Failure 404 string Error models.Error
+// @Failure 409 string Error models.Error
+// @Failure 500 string Error models.Error
+// @Router /v1/reports [post]
+func (h *handlerV1) CreateReport(c *gin.Context) {
+	var body models.ReportRequest
+
+	err := c.ShouldBindJSON(&body)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": err.Error(),
+		})
+		h.reqLog(c).Error("failed to bind JSON", l.Error(err))
+		return
+	}
+
+	claims := GetClaims(h, c)
+	reqId := claims["sub"].(string)
+
+	response, err := h.serviceManager.ModerationService().CreateReport(c.Request.Context(), &pm.ReportRequest{
+		TargetType: body.TargetType,
+		TargetId:   body.TargetId,
+		ReporterId: reqId,
+		Reason:     body.Reason,
+		Details:    body.Details,
+	})
+	if err != nil {
+		c.JSON(httpStatus(err), gin.H{
+			"error": err.Error(),
+		})
+		h.reqLog(c).Error("failed to create report", l.Error(err))
+		return
+	}
+
+	c.JSON(http.StatusCreated, reportModel(response))
+}
+
+// Super-Admin | Admin | Moderator
+// @Summary Get moderation queue
+// @Tags Moderation
+// @Description Get cases of the queue, unresolved cases oldest first when status is empty
+// @Security ApiKeyAuth
+// @Produce json
+// @Param status query string false "open, assigned or resolved"
+// @Param target_type query string false "post, comment or user"
+// @Param assignee query string false "Assignee ID"
+// @Param limit query int false "Limit"
+// @Param page query int false "Page"
+// @Success 200 {object} models.Cases
+// @Failure 400 string Error models.Error
+// @Failure 500 string Error models.Error
+// @Router /v1/moderation/queue [get]
+func (h *handlerV1) GetModerationQueue(c *gin.Context) {
+	params, errStr := utils.ParseQueryParams(c.Request.URL.Query())
+	if errStr != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": errStr[0],
+		})
+		h.reqLog(c).Error("failed to parse query params to json: " + errStr[0])
+		return
+	}
+
+	offset := int64(0)
+	if params.Page > 1 {
+		offset = (params.Page - 1) * params.Limit
+	}
+
+	response, err := h.serviceManager.ModerationService().GetQueue(c.Request.Context(), &pm.QueueRequest{
+		Status:     params.Filters["status"],
+		TargetType: params.Filters["target_type"],
+		AssigneeId: params.Filters["assignee"],
+		Limit:      params.Limit,
+		Offset:     offset,
+	})
+	if err != nil {
+		c.JSON(httpStatus(err), gin.H{
+			"error": err.Error(),
+		})
+		h.reqLog(c).Error("failed to get moderation queue", l.Error(err))
+		return
+	}
+
+	cases := models.Cases{Cases: []models.Case{}}
+	for _, val := range response.Cases {
+		cases.Cases = append(cases.Cases, caseModel(val))
+	}
+
+	c.JSON(http.StatusOK, cases)
+}
+
+// Super-Admin | Admin | Moderator
+// @Summary Get moderation case
+// @Tags Moderation
+// @Description Get the case with its reports
+// @Security ApiKeyAuth
+// @Produce json
+// @Param id path string true "Case ID"
+// @Success 200 {object} models.Case
+// @Failure 404 string Error models.Error
+// @Failure 500 string Error models.Error
+// @Router /v1/moderation/cases/{id} [get]
+func (h *handlerV1) GetModerationCase(c *gin.Context) {
+	response, err := h.serviceManager.ModerationService().GetCase(c.Request.Context(), &pm.Request{Str: c.Param("id")})
+	if err != nil {
+		c.JSON(httpStatus(err), gin.H{
+			"error": err.Error(),
+		})
+		h.reqLog(c).Error("failed to get moderation case", l.Error(err))
+		return
+	}
+
+	c.JSON(http.StatusOK, caseModel(response))
+}
+
+// Super-Admin | Admin | Moderator
+// @Summary Assign moderation case
+// @Tags Moderation
+// @Description Assign unresolved case to the moderator from Claims
+// @Security ApiKeyAuth
+// @Produce json
+// @Param id path string true "Case ID"
+// @Success 200 {object} models.Case
+// @Failure 404 string Error models.Error
+// @Failure 409 string Error models.Error
+// @Failure 500 string Error models.Error
+// @Router /v1/moderation/cases/{id}/assign [put]
+func (h *handlerV1) AssignModerationCase(c *gin.Context) {
+	claims := GetClaims(h, c)
+	reqId := claims["sub"].(string)
+
+	response, err := h.serviceManager.ModerationService().AssignCase(c.Request.Context(), &pm.AssignRequest{
+		Id:          c.Param("id"),
+		ModeratorId: reqId,
+	})
+	if err != nil {
+		c.JSON(httpStatus(err), gin.H{
+			"error": err.Error(),
+		})
+		h.reqLog(c).Error("failed to assign moderation case", l.Error(err))
+		return
+	}
+
+	c.JSON(http.StatusOK, caseModel(response))
+}
+
+// Super-Admin | Admin | Moderator
+// @Summary Resolve moderation case
+// @Tags Moderation
+// @Description Apply the action to the target of the case and close it
+// @Security ApiKeyAuth
+// @Accept json
+// @Produce json
+// @Param id path string true "Case ID"
+// @Param Resolve body models.ResolveRequest true "Resolve"
+// @Success 200 {object} models.Case
+// @Failure 400 string Error models.Error
+// @Failure 404 string Error models.Error
+// @Failure 409 string Error models.Error
+// @Failure 500 string Error models.Error
+// @Router /v1/moderation/cases/{id}/resolve [put]
+func (h *handlerV1) ResolveModerationCase(c *gin.Context) {
+	var body models.ResolveRequest
+
+	err := c.ShouldBindJSON(&body)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": err.Error(),
+		})
+		h.reqLog(c).Error("failed to bind JSON", l.Error(err))
+		return
+	}
+
+	claims := GetClaims(h, c)
+	reqId := claims["sub"].(string)
+
+	response, err := h.serviceManager.ModerationService().ResolveCase(c.Request.Context(), &pm.ResolveRequest{
+		Id:           c.Param("id"),
+		ModeratorId:  reqId,
+		Action:       body.Action,
+		Note:         body.Note,
+		SuspendHours: body.SuspendHours,
+	})
+	if err != nil {
+		c.JSON(httpStatus(err), gin.H{
+			"error": err.Error(),
+		})
+		h.reqLog(c).Error("failed to resolve moderation case", l.Error(err))
+		return
+	}
+
+	// hidden or removed posts must not be served from cache
+	if response.TargetType == "post" {
+		h.invalidate(c.Request.Context(), cache.PostResource(response.TargetId), cache.UserResource(response.OwnerId))
+	}
+
+	c.JSON(http.StatusOK, caseModel(response))
+}
+
+func reportModel(report *pm.ReportResponse) models.Report {
+	return models.Report{
+		Id:         report.Id,
+		CaseId:     report.CaseId,
+		ReporterId: report.ReporterId,
+		Reason:     report.Reason,
+		Details:    report.Details,
+		CreatedAt:  report.CreatedAt,
+	}
+}
+
+func caseModel(res *pm.CaseResponse) models.Case {
+	caseModel := models.Case{
+		Id:         res.Id,
+		TargetType: res.TargetType,
+		TargetId:   res.TargetId,
+		OwnerId:    res.OwnerId,
+		Status:     res.Status,
+		Source:     res.Source,
+		Reasons:    res.Reasons,
+		Reports:    res.Reports,
+		AssigneeId: res.AssigneeId,
+		Action:     res.Action,
+		Note:       res.Note,
+		CreatedAt:  res.CreatedAt,
+		UpdatedAt:  res.UpdatedAt,
+		ResolvedAt: res.ResolvedAt,
+	}
+	for _, val := range res.ReportList {
+		caseModel.ReportList = append(caseModel.ReportList, reportModel(val))
+	}
+
+	return caseModel
+}
@@ -0,0 +1,202 @@
+package v1
+
+import (
+	"net/http"
+
+	"github.com/burxondv/new-services/api-gateway/api/handlers/models"
+	pn "github.com/burxondv/new-services/api-gateway/genproto/notification"
+	l "github.com/burxondv/new-services/api-gateway/pkg/logger"
+	"github.com/burxondv/new-services/api-gateway/pkg/utils"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Super-Admin | Admin | User
+// @Summary Get notifications
+// @Tags Notification
+// @Description Get notifications of the user from Claims, newest first
+// @Security ApiKeyAuth
+// @Produce json
+// @Param unread query bool false "Only unread"
+// @Param limit query int false "Limit"
+// @Param page query int false "Page"
+// @Success 200 {object} models.Notifications
+// @Failure 400 string Error models.Error
+// @Failure 500 string Error models.Error
+// @Router /v1/notifications [get]
+func (h *handlerV1) GetNotifications(c *gin.Context) {
+	params, errStr := utils.ParseQueryParams(c.Request.URL.Query())
+	if errStr != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": errStr[0],
+		})
+		h.reqLog(c).Error("failed to parse query params to json: " + errStr[0])
+		return
+	}
+
+	claims := GetClaims(h, c)
+	reqId := claims["sub"].(string)
+
+	response, err := h.serviceManager.NotificationService().GetNotifications(c.Request.Context(), &pn.GetNotificationsRequest{
+		UserId:     reqId,
+		UnreadOnly: c.Query("unread") == "true",
+		Limit:      params.Limit,
+		Page:       params.Page,
+	})
+	if err != nil {
+		c.JSON(httpStatus(err), gin.H{
+			"error": err.Error(),
+		})
+		h.reqLog(c).Error("failed to get notifications", l.Error(err))
+		return
+	}
+
+	notifications := models.Notifications{
+		Notifications: []models.Notification{},
+		Unread:        response.Unread,
+	}
+	for _, val := range response.Notifications {
+		notifications.Notifications = append(notifications.Notifications, models.Notification{
+			Id:        val.Id,
+			ActorId:   val.ActorId,
+			ActorName: val.ActorName,
+			Type:      val.Type,
+			PostId:    val.PostId,
+			CommentId: val.CommentId,
+			Text:      val.Text,
+			Read:      val.Read,
+			CreatedAt: val.CreatedAt,
+		})
+	}
+
+	c.JSON(http.StatusOK, notifications)
+}
+
+// Super-Admin | Admin | User
+// @Summary Mark notifications as read
+// @Tags Notification
+// @Description Mark notifications with given ids, or all notifications, as read
+// @Security ApiKeyAuth
+// @Accept json
+// @Produce json
+// @Param MarkRead body models.MarkReadRequest true "Mark read"
+// @Success 200 {object} models.MarkRead
+// @Failure 400 string Error models.Error
+// @Failure 500 string Error models.Error
+// @Router /v1/notifications/read [put]
+func (h *handlerV1) MarkNotificationsRead(c *gin.Context) {
+	var body models.MarkReadRequest
+
+	err := c.ShouldBindJSON(&body)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": err.Error(),
+		})
+		h.reqLog(c).Error("failed to bind JSON", l.Error(err))
+		return
+	}
+
+	claims := GetClaims(h, c)
+	reqId := claims["sub"].(string)
+
+	response, err := h.serviceManager.NotificationService().MarkRead(c.Request.Context(), &pn.MarkReadRequest{
+		UserId: reqId,
+		Ids:    body.Ids,
+		All:    body.All,
+	})
+	if err != nil {
+		c.JSON(httpStatus(err), gin.H{
+			"error": err.Error(),
+		})
+		h.reqLog(c).Error("failed to mark notifications as read", l.Error(err))
+		return
+	}
+
+	c.JSON(http.StatusOK, models.MarkRead{Updated: response.Updated})
+}
+
+// Super-Admin | Admin | User
+// @Summary Get notification preferences
+// @Tags Notification
+// @Description Get notification preferences of the user from Claims
+// @Security ApiKeyAuth
+// @Produce json
+// @Success 200 {object} models.Preferences
+// @Failure 500 string Error models.Error
+// @Router /v1/notifications/preferences [get]
+func (h *handlerV1) GetNotificationPreferences(c *gin.Context) {
+	claims := GetClaims(h, c)
+	reqId := claims["sub"].(string)
+
+	response, err := h.serviceManager.NotificationService().GetPreferences(c.Request.Context(), &pn.Request{Str: reqId})
+	if err != nil {
+		c.JSON(httpStatus(err), gin.H{
+			"error": err.Error(),
+		})
+		h.reqLog(c).Error("failed to get notification preferences", l.Error(err))
+		return
+	}
+
+	c.JSON(http.StatusOK, preferencesModel(response))
+}
+
+// Super-Admin | Admin | User
+// @Summary Update notification preferences
+// @Tags Notification
+// @Description Update notification preferences of the user from Claims, omitted types are not changed
+// @Security ApiKeyAuth
+// @Accept json
+// @Produce json
+// @Param Preferences body models.Preferences true "Preferences"
+// @Success 200 {object} models.Preferences
+// @Failure 400 string Error models.Error
+// @Failure 500 string Error models.Error
+// @Router /v1/notifications/preferences [put]
+func (h *handlerV1) UpdateNotificationPreferences(c *gin.Context) {
+	var body models.Preferences
+
+	err := c.ShouldBindJSON(&body)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": err.Error(),
+		})
+		h.reqLog(c).Error("failed to bind JSON", l.Error(err))
+		return
+	}
+
+	claims := GetClaims(h, c)
+	reqId := claims["sub"].(string)
+
+	req := &pn.PreferencesRequest{UserId: reqId}
+	for _, val := range body.Preferences {
+		req.Preferences = append(req.Preferences, &pn.Preference{
+			Type:  val.Type,
+			InApp: val.InApp,
+			Email: val.Email,
+		})
+	}
+
+	response, err := h.serviceManager.NotificationService().UpdatePreferences(c.Request.Context(), req)
+	if err != nil {
+		c.JSON(httpStatus(err), gin.H{
+			"error": err.Error(),
+		})
+		h.reqLog(c).Error("failed to update notification preferences", l.Error(err))
+		return
+	}
+
+	c.JSON(http.StatusOK, preferencesModel(response))
+}
+
+func preferencesModel(response *pn.PreferencesResponse) models.Preferences {
+	preferences := models.Preferences{Preferences: []models.Preference{}}
+	for _, val := range response.Preferences {
+		preferences.Preferences = append(preferences.Preferences, models.Preference{
+			Type:  val.Type,
+			InApp: val.InApp,
+			Email: val.Email,
+		})
+	}
+
+	return preferences
+}
@@ -7,6 +7,7 @@ import (
 
 	"github.com/burxondv/new-services/api-gateway/api/handlers/models"
 	pp "github.com/burxondv/new-services/api-gateway/genproto/post"
+	"github.com/burxondv/new-services/api-gateway/pkg/cache"
 	l "github.com/burxondv/new-services/api-gateway/pkg/logger"
 	"github.com/google/uuid"
 
@@ -22,8 +23,11 @@ import (
 // @Accept json
 // @Produce json
 // @Param PostInfo body models.PostRequest true "Create Post"
+// @Param Idempotency-Key header string false "retries with the same key return the first response"
 // @Success 201 {object} models.Post
 // @Failure 400 string Error models.Error
+// @Failure 409 string Error models.Error
+// @Failure 422 string Error models.Error
 // @Failure 500 string Error models.Error
 // @Router /v1/posts [post]
 func (h *handlerV1) CreatePost(c *gin.Context) {
@@ -38,7 +42,7 @@ func (h *handlerV1) CreatePost(c *gin.Context) {
 		c.JSON(http.StatusBadRequest, gin.H{
 			"error": err.Error(),
 		})
-		h.log.Error("failed to bind JSON", l.Error(err))
+		h.reqLog(c).Error("failed to bind JSON", l.Error(err))
 		return
 	}
 
@@ -48,37 +52,33 @@ func (h *handlerV1) CreatePost(c *gin.Context) {
 		c.JSON(http.StatusBadRequest, gin.H{
 			"error": err.Error(),
 		})
-		h.log.Error("failed to generating uuid", l.Error(err))
+		h.reqLog(c).Error("failed to generating uuid", l.Error(err))
 		return
 	}
 
 	claims := GetClaims(h, c)
 	reqId := claims["sub"].(string)
 
-	response, err := h.serviceManager.PostService().CreatePost(context.Background(), &pp.PostRequest{
+	response, err := h.serviceManager.PostService().CreatePost(c.Request.Context(), &pp.PostRequest{
 		Id:          id.String(),
 		Title:       body.Title,
 		Description: body.Description,
 		UserId:      reqId,
+		Status:      body.Status,
+		PublishAt:   body.PublishAt,
+		Visibility:  body.Visibility,
+		Tags:        body.Tags,
 	})
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{
+		c.JSON(httpStatus(err), gin.H{
 			"error": err.Error(),
 		})
-		h.log.Error("failed to create post", l.Error(err))
+		h.reqLog(c).Error("failed to create post", l.Error(err))
 		return
 	}
+	h.invalidate(c.Request.Context(), cache.UserResource(reqId))
 
-	c.JSON(http.StatusCreated, models.Post{
-		Id:          response.Id,
-		Title:       response.Title,
-		Description: response.Description,
-		Likes:       response.Likes,
-		UserId:      response.UserId,
-		UserName:    response.UserName,
-		CreatedAt:   response.CreatedAt,
-		UpdatedAt:   response.UpdatedAt,
-	})
+	c.JSON(http.StatusCreated, postModel(response))
 }
 
 // Super-Admin | Admin | User
@@ -98,27 +98,32 @@ func (h *handlerV1) GetPost(c *gin.Context) {
 	jspbMarshal.UseProtoNames = true
 
 	id := c.Param("id")
+	claims := GetClaims(h, c)
+	reqId := claims["sub"].(string)
+
+	// hidden posts are not found for some viewers, so the response is
+	// cached for every viewer and their relations, shadow bans hide posts
+	// of all viewers
+	variant := h.cache.Variant(c.Request.Context(), reqId, cache.RelationsResource(reqId), cache.PostsResource)
+	var post models.Post
+	err := h.cache.Fetch(c.Request.Context(), cache.PostResource(id), variant, h.cfg.CacheTTL, &post, func(ctx context.Context) (interface{}, error) {
+		response, err := h.serviceManager.PostService().GetPostById(ctx, &pp.Request{Str: id, ViewerId: reqId})
+		if err != nil {
+			return nil, err
+		}
 
-	response, err := h.serviceManager.PostService().GetPostById(context.Background(), &pp.Request{Str: id})
+		return postModel(response), nil
+	})
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{
+		c.JSON(httpStatus(err), gin.H{
 			"error": err.Error(),
 		})
-		h.log.Error("failed to get post by id", l.Error(err))
+		h.reqLog(c).Error("failed to get post by id", l.Error(err))
 		return
 	}
 
-	c.JSON(http.StatusOK, models.Post{
-		Id:          response.Id,
-		Title:       response.Title,
-		Description: response.Description,
-		Likes:       response.Likes,
-		UserId:      response.UserId,
-		UserName:    response.UserName,
-		Comments:    response.Comments,
-		CreatedAt:   response.CreatedAt,
-		UpdatedAt:   response.UpdatedAt,
-	})
+	c.Header("ETag", etag(post.Version))
+	c.JSON(http.StatusOK, post)
 }
 
 // User
@@ -135,29 +140,18 @@ func (h *handlerV1) GetProfilePosts(c *gin.Context) {
 	claims := GetClaims(h, c)
 	reqId := claims["sub"].(string)
 
-	response, err := h.serviceManager.PostService().GetPostByUserId(context.Background(), &pp.Request{Str: reqId})
+	response, err := h.serviceManager.PostService().GetPostByUserId(c.Request.Context(), &pp.Request{Str: reqId, ViewerId: reqId})
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{
 			"error": err.Error(),
 		})
-		h.log.Error("failed to get own posts", l.Error(err))
+		h.reqLog(c).Error("failed to get own posts", l.Error(err))
 		return
 	}
 
 	posts := models.Posts{}
 	for _, val := range response.Posts {
-		pt := models.Post{}
-		pt.Id = val.Id
-		pt.Title = val.Title
-		pt.Description = val.Description
-		pt.UserId = val.UserId
-		pt.UserName = val.UserName
-		pt.Likes = val.Likes
-		pt.CreatedAt = val.CreatedAt
-		pt.UpdatedAt = val.UpdatedAt
-		pt.Comments = val.Comments
-
-		posts.Posts = append(posts.Posts, pt)
+		posts.Posts = append(posts.Posts, postModel(val))
 	}
 
 	c.JSON(http.StatusOK, posts)
@@ -179,30 +173,21 @@ func (h *handlerV1) GetPostsUser(c *gin.Context) {
 	var jspbMarshal protojson.MarshalOptions
 	jspbMarshal.UseProtoNames = true
 	Id := c.Param("id")
+	claims := GetClaims(h, c)
+	reqId := claims["sub"].(string)
 
-	response, err := h.serviceManager.PostService().GetPostByUserId(context.Background(), &pp.Request{Str: Id})
+	response, err := h.serviceManager.PostService().GetPostByUserId(c.Request.Context(), &pp.Request{Str: Id, ViewerId: reqId})
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{
 			"error": err.Error(),
 		})
-		h.log.Error("failed to get posts by user id", l.Error(err))
+		h.reqLog(c).Error("failed to get posts by user id", l.Error(err))
 		return
 	}
 
 	posts := models.Posts{}
 	for _, val := range response.Posts {
-		pt := models.Post{}
-		pt.Id = val.Id
-		pt.Title = val.Title
-		pt.Description = val.Description
-		pt.UserId = val.UserId
-		pt.UserName = val.UserName
-		pt.Likes = val.Likes
-		pt.Comments = val.Comments
-		pt.CreatedAt = val.CreatedAt
-		pt.UpdatedAt = val.UpdatedAt
-
-		posts.Posts = append(posts.Posts, pt)
+		posts.Posts = append(posts.Posts, postModel(val))
 	}
 
 	c.JSON(http.StatusOK, posts)
@@ -216,8 +201,12 @@ func (h *handlerV1) GetPostsUser(c *gin.Context) {
 // @Accept json
 // @Produce json
 // @Param UpdatePost body models.UpdatePostRequest true "Update Post"
+// @Param If-Match header string false "ETag of the post from get post"
 // @Success 200 string Success models.Post
 // @Failure 400 string Error models.Error
+// @Failure 403 string Error models.Error
+// @Failure 409 string Error models.Error
+// @Failure 412 string Error models.Error
 // @Failure 500 string Error models.Error
 // @Router /v1/posts/{id} [put]
 func (h *handlerV1) UpdatePost(c *gin.Context) {
@@ -232,30 +221,36 @@ func (h *handlerV1) UpdatePost(c *gin.Context) {
 		c.JSON(http.StatusBadRequest, gin.H{
 			"error": err.Error(),
 		})
-		h.log.Error("failed to bind JSON", l.Error(err))
+		h.reqLog(c).Error("failed to bind JSON", l.Error(err))
 		return
 	}
 
-	response, err := h.serviceManager.PostService().UpdatePost(context.Background(), &body)
+	claims := GetClaims(h, c)
+	body.EditorId = claims["sub"].(string)
+	// tags are replaced only when they are sent, even empty
+	body.TagsSet = body.Tags != nil
+
+	var ifMatch bool
+	body.ExpectedVersion, ifMatch, err = expectedVersion(c, body.ExpectedVersion)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{
+		c.JSON(http.StatusBadRequest, gin.H{
 			"error": err.Error(),
 		})
-		h.log.Error("failed to update post", l.Error(err))
 		return
 	}
 
-	c.JSON(http.StatusOK, models.Post{
-		Id:          response.Id,
-		Title:       response.Title,
-		Description: response.Description,
-		Likes:       response.Likes,
-		UserId:      response.UserId,
-		UserName:    response.UserName,
-		Comments:    response.Comments,
-		CreatedAt:   response.CreatedAt,
-		UpdatedAt:   response.UpdatedAt,
-	})
+	response, err := h.serviceManager.PostService().UpdatePost(c.Request.Context(), &body)
+	if err != nil {
+		c.JSON(versionStatus(err, ifMatch), gin.H{
+			"error": err.Error(),
+		})
+		h.reqLog(c).Error("failed to update post", l.Error(err))
+		return
+	}
+	h.invalidate(c.Request.Context(), cache.PostResource(response.Id), cache.UserResource(response.UserId))
+
+	c.Header("ETag", etag(response.Version))
+	c.JSON(http.StatusOK, postModel(response))
 }
 
 // Super-Admin | Admin | User
@@ -268,6 +263,8 @@ func (h *handlerV1) UpdatePost(c *gin.Context) {
 // @Param id path string true "Id"
 // @Success 200 string models.DeletedPost
 // @Failure 400 string Error models.Error
+// @Failure 403 string Error models.Error
+// @Failure 404 string Error models.Error
 // @Failure 500 string Error models.Error
 // @Router /v1/posts/{id} [delete]
 func (h *handlerV1) DeletePost(c *gin.Context) {
@@ -276,14 +273,15 @@ func (h *handlerV1) DeletePost(c *gin.Context) {
 
 	id := c.Param("id")
 
-	response, err := h.serviceManager.PostService().DeletePost(context.Background(), &pp.Request{Str: id})
+	response, err := h.serviceManager.PostService().DeletePost(c.Request.Context(), &pp.Request{Str: id})
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{
+		c.JSON(httpStatus(err), gin.H{
 			"error": err.Error(),
 		})
-		h.log.Error("failed to delete post", l.Error(err))
+		h.reqLog(c).Error("failed to delete post", l.Error(err))
 		return
 	}
+	h.invalidate(c.Request.Context(), cache.PostResource(id), cache.UserResource(response.UserId))
 
 	c.JSON(http.StatusOK, models.DeletedPost{
 		Id:          response.Id,
@@ -298,3 +296,78 @@ func (h *handlerV1) DeletePost(c *gin.Context) {
 		DeletedAt:   time.Now().Format("2006-01-02 15:04:05"),
 	})
 }
+
+// Super-Admin | Admin | User
+// @Summary Like post
+// @Tags Post
+// @Description Like or unlike post, post owner is notified about likes
+// @Security ApiKeyAuth
+// @Accept json
+// @Produce json
+// @Param id path string true "Post ID"
+// @Param Like body models.LikeRequest true "Like"
+// @Success 200 {object} models.Post
+// @Failure 400 string Error models.Error
+// @Failure 404 string Error models.Error
+// @Failure 500 string Error models.Error
+// @Router /v1/posts/{id}/like [put]
+func (h *handlerV1) LikePost(c *gin.Context) {
+	var body models.LikeRequest
+
+	err := c.ShouldBindJSON(&body)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": err.Error(),
+		})
+		h.reqLog(c).Error("failed to bind JSON", l.Error(err))
+		return
+	}
+
+	claims := GetClaims(h, c)
+	reqId := claims["sub"].(string)
+
+	response, err := h.serviceManager.PostService().LikePost(c.Request.Context(), &pp.LikeRequest{
+		PostId:  c.Param("id"),
+		IsLiked: body.IsLiked,
+		UserId:  reqId,
+	})
+	if err != nil {
+		c.JSON(httpStatus(err), gin.H{
+			"error": err.Error(),
+		})
+		h.reqLog(c).Error("failed to like post", l.Error(err))
+		return
+	}
+	h.invalidate(c.Request.Context(), cache.PostResource(response.Id))
+
+	c.JSON(http.StatusOK, postModel(response))
+}
+
+func postModel(post *pp.PostResponse) models.Post {
+	attachments := []models.Attachment{}
+	for _, val := range post.Attachments {
+		attachments = append(attachments, attachmentModel(val))
+	}
+
+	return models.Post{
+		Id:          post.Id,
+		Title:       post.Title,
+		Description: post.Description,
+		Likes:       post.Likes,
+		UserId:      post.UserId,
+		UserName:    post.UserName,
+		Comments:    post.Comments,
+		Status:      post.Status,
+		PublishAt:   post.PublishAt,
+		Visibility:  post.Visibility,
+		Edited:      post.Edited,
+		EditedAt:    post.EditedAt,
+		Tags:        post.Tags,
+		CreatedAt:   post.CreatedAt,
+		UpdatedAt:   post.UpdatedAt,
+		Attachments: attachments,
+		Version:     post.Version,
+
+		ModerationStatus: post.ModerationStatus,
+	}
+}
@@ -1,9 +1,6 @@
 package v1
 
 import (
-	"context"
-	"fmt"
-	"log"
 	"net/http"
 
 	"github.com/burxondv/new-services/api-gateway/api/handlers/models"
@@ -32,17 +29,17 @@ func (h *handlerV1) AddPolicy(c *gin.Context) {
 		c.JSON(http.StatusBadRequest, gin.H{
 			"error": err.Error(),
 		})
-		h.log.Error("failed to bind json", l.Error(err))
+		h.reqLog(c).Error("failed to bind json", l.Error(err))
 		return
 	}
 
 	ok, err := h.enforcer.AddPolicy(body.User, body.Domain, body.Action)
 	if err != nil {
-		log.Println("failed to add policy: ", err)
+		h.reqLog(c).Error("failed to add policy", l.Error(err))
 	}
 
 	h.enforcer.SavePolicy()
-	fmt.Println(ok)
+	h.reqLog(c).Info("added policy", l.Bool("added", ok))
 
 	c.JSON(http.StatusOK, models.Success{
 		Message: "successfully added policy",
@@ -69,7 +66,7 @@ func (h *handlerV1) RemovePolicy(c *gin.Context) {
 		c.JSON(http.StatusBadRequest, gin.H{
 			"error": err.Error(),
 		})
-		h.log.Error("failed to bind json", l.Error(err))
+		h.reqLog(c).Error("failed to bind json", l.Error(err))
 		return
 	}
 
@@ -78,12 +75,12 @@ func (h *handlerV1) RemovePolicy(c *gin.Context) {
 		c.JSON(http.StatusBadRequest, gin.H{
 			"error": err.Error(),
 		})
-		h.log.Error("failed to remove policy", l.Error(err))
+		h.reqLog(c).Error("failed to remove policy", l.Error(err))
 		return
 	}
 
 	h.enforcer.SavePolicy()
-	fmt.Println(ok)
+	h.reqLog(c).Info("removed policy", l.Bool("removed", ok))
 
 	c.JSON(http.StatusOK, models.Success{
 		Message: "successfully removed policy",
@@ -110,7 +107,7 @@ func (h *handlerV1) AddRoleForUser(c *gin.Context) {
 		c.JSON(http.StatusBadRequest, gin.H{
 			"error": err.Error(),
 		})
-		h.log.Error("failed to bind json", l.Error(err))
+		h.reqLog(c).Error("failed to bind json", l.Error(err))
 		return
 	}
 
@@ -119,11 +116,11 @@ func (h *handlerV1) AddRoleForUser(c *gin.Context) {
 		c.JSON(http.StatusBadRequest, gin.H{
 			"error": err.Error(),
 		})
-		h.log.Error("failed to add role for user", l.Error(err))
+		h.reqLog(c).Error("failed to add role for user", l.Error(err))
 		return
 	}
 
-	fmt.Println(ok)
+	h.reqLog(c).Info("added role for user", l.Bool("added", ok))
 
 	c.JSON(http.StatusOK, models.Success{
 		Message: "successfully added role",
@@ -150,7 +147,7 @@ func (h *handlerV1) DeleteRoleForUser(c *gin.Context) {
 		c.JSON(http.StatusBadRequest, gin.H{
 			"error": err.Error(),
 		})
-		h.log.Error("failed to bind json", l.Error(err))
+		h.reqLog(c).Error("failed to bind json", l.Error(err))
 		return
 	}
 
@@ -159,11 +156,11 @@ func (h *handlerV1) DeleteRoleForUser(c *gin.Context) {
 		c.JSON(http.StatusBadRequest, gin.H{
 			"error": err.Error(),
 		})
-		h.log.Error("failed to delete role for user", l.Error(err))
+		h.reqLog(c).Error("failed to delete role for user", l.Error(err))
 		return
 	}
 
-	fmt.Println(ok)
+	h.reqLog(c).Info("deleted role for user", l.Bool("deleted", ok))
 
 	c.JSON(http.StatusOK, models.Success{
 		Message: "successfully deleted role",
@@ -202,11 +199,11 @@ func (h *handlerV1) ChangeRoleUser(c *gin.Context) {
 		c.JSON(http.StatusBadRequest, gin.H{
 			"error": err.Error(),
 		})
-		h.log.Error("failed to bind json", l.Error(err))
+		h.reqLog(c).Error("failed to bind json", l.Error(err))
 		return
 	}
 
-	response, err := h.serviceManager.UserService().ChangeRoleUser(context.Background(), &pu.ChangeRoleRequest{
+	response, err := h.serviceManager.UserService().ChangeRoleUser(c.Request.Context(), &pu.ChangeRoleRequest{
 		Id:   body.Id,
 		Role: body.Role,
 	})
@@ -214,7 +211,7 @@ func (h *handlerV1) ChangeRoleUser(c *gin.Context) {
 		c.JSON(http.StatusInternalServerError, gin.H{
 			"error": err.Error(),
 		})
-		h.log.Error("failed to add role user", l.Error(err))
+		h.reqLog(c).Error("failed to add role user", l.Error(err))
 		return
 	}
 
@@ -243,12 +240,12 @@ func (h *handlerV1) ChangeRoleUser(c *gin.Context) {
 // @Router /v1/rbac/same-role/{role} [get]
 func (h *handlerV1) GetSameRoleUsers(c *gin.Context) {
 	users := models.Users{}
-	res, err := h.serviceManager.UserService().GetSameRoleUsers(context.Background(), &pu.Request{Str: c.Param("role")})
+	res, err := h.serviceManager.UserService().GetSameRoleUsers(c.Request.Context(), &pu.Request{Str: c.Param("role")})
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{
 			"error": err.Error(),
 		})
-		h.log.Error("failed to get the same role users", l.Error(err))
+		h.reqLog(c).Error("failed to get the same role users", l.Error(err))
 		return
 	}
 
@@ -1,11 +1,10 @@
 package v1
 
 import (
-	"context"
 	"encoding/json"
-	"fmt"
 	"net/http"
 	"strings"
+	"time"
 
 	"github.com/burxondv/new-services/api-gateway/api/handlers/models"
 	"github.com/burxondv/new-services/api-gateway/api/handlers/token"
@@ -13,8 +12,8 @@ import (
 	"github.com/burxondv/new-services/api-gateway/pkg/email"
 	"github.com/burxondv/new-services/api-gateway/pkg/etc"
 	l "github.com/burxondv/new-services/api-gateway/pkg/logger"
+	"github.com/burxondv/new-services/api-gateway/storage/repo"
 	"github.com/gin-gonic/gin"
-	r "github.com/gomodule/redigo/redis"
 	"github.com/google/uuid"
 )
 
@@ -38,14 +37,14 @@ func (h *handlerV1) Register(c *gin.Context) {
 		c.JSON(http.StatusBadRequest, gin.H{
 			"error": err.Error(),
 		})
-		h.log.Error("failed to bind json", l.Error(err))
+		h.reqLog(c).Error("failed to bind json", l.Error(err))
 		return
 	}
 
 	body.Email = strings.TrimSpace(body.Email)
 	body.Email = strings.ToLower(body.Email)
 
-	existsFirstName, err := h.serviceManager.UserService().CheckField(context.Background(), &pu.CheckFieldRequest{
+	existsFirstName, err := h.serviceManager.UserService().CheckField(c.Request.Context(), &pu.CheckFieldRequest{
 		Field: "first_name",
 		Value: body.FirstName,
 	})
@@ -53,7 +52,7 @@ func (h *handlerV1) Register(c *gin.Context) {
 		c.JSON(http.StatusInternalServerError, gin.H{
 			"error": err.Error(),
 		})
-		h.log.Error("failed check first name uniques ", l.Error(err))
+		h.reqLog(c).Error("failed check first name uniques ", l.Error(err))
 	}
 
 	if existsFirstName.Exists {
@@ -61,10 +60,10 @@ func (h *handlerV1) Register(c *gin.Context) {
 			"error": err.Error(),
 			"info":  "please enter another first name",
 		})
-		h.log.Error("this first name already exists ", l.Error(err))
+		h.reqLog(c).Error("this first name already exists ", l.Error(err))
 	}
 
-	existsEmail, err := h.serviceManager.UserService().CheckField(context.Background(), &pu.CheckFieldRequest{
+	existsEmail, err := h.serviceManager.UserService().CheckField(c.Request.Context(), &pu.CheckFieldRequest{
 		Field: "email",
 		Value: body.Email,
 	})
@@ -72,7 +71,7 @@ func (h *handlerV1) Register(c *gin.Context) {
 		c.JSON(http.StatusInternalServerError, gin.H{
 			"error": err.Error(),
 		})
-		h.log.Error("failed check email uniques ", l.Error(err))
+		h.reqLog(c).Error("failed check email uniques ", l.Error(err))
 	}
 
 	if existsEmail.Exists {
@@ -80,7 +79,7 @@ func (h *handlerV1) Register(c *gin.Context) {
 			"error": err.Error(),
 			"info":  "please enter another email",
 		})
-		h.log.Error("this email already exists ", l.Error(err))
+		h.reqLog(c).Error("this email already exists ", l.Error(err))
 	}
 
 	code := etc.GenerateCode(6)
@@ -99,18 +98,17 @@ func (h *handlerV1) Register(c *gin.Context) {
 		c.JSON(http.StatusInternalServerError, gin.H{
 			"eroor": err.Error(),
 		})
-		h.log.Error("failed while marshal user body", l.Error(err))
+		h.reqLog(c).Error("failed while marshal user body", l.Error(err))
 		return
 	}
 
-	err = h.redis.SetWithTTL(string(body.Email), string(userBodyByte), 300)
+	err = h.redis.Set(c.Request.Context(), string(body.Email), string(userBodyByte), 300*time.Second)
 
 	if err != nil {
-		fmt.Println(err)
 		c.JSON(http.StatusInternalServerError, gin.H{
 			"error": err.Error(),
 		})
-		h.log.Error("error set to redis user body", l.Error(err))
+		h.reqLog(c).Error("error set to redis user body", l.Error(err))
 		return
 	}
 
@@ -134,30 +132,26 @@ func (h *handlerV1) Verify(c *gin.Context) {
 		body  models.RegisterModel
 	)
 
-	userBody, err := h.redis.Get(email)
-	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{
-			"error": err.Error(),
+	userBody, err := h.redis.Get(c.Request.Context(), email)
+	if err == repo.ErrNotFound {
+		c.JSON(http.StatusNotFound, gin.H{
+			"error": "verification code is expired or email is not registered",
 		})
-		h.log.Error("error get from redis by email", l.Error(err))
 		return
-	}
-
-	byteData, err := r.String(userBody, err)
-	if err != nil {
+	} else if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{
 			"error": err.Error(),
 		})
-		h.log.Error("error string redis", l.Error(err))
+		h.reqLog(c).Error("error get from redis by email", l.Error(err))
 		return
 	}
 
-	err = json.Unmarshal([]byte(byteData), &body)
+	err = json.Unmarshal([]byte(userBody), &body)
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{
 			"error": err.Error(),
 		})
-		h.log.Error("error while unmarshalling user data", l.Error(err))
+		h.reqLog(c).Error("error while unmarshalling user data", l.Error(err))
 		return
 	}
 
@@ -165,7 +159,7 @@ func (h *handlerV1) Verify(c *gin.Context) {
 		c.JSON(http.StatusBadRequest, gin.H{
 			"error": err.Error(),
 		})
-		h.log.Error("error while checking code ", l.Error(err))
+		h.reqLog(c).Error("error while checking code ", l.Error(err))
 		return
 	}
 
@@ -174,7 +168,7 @@ func (h *handlerV1) Verify(c *gin.Context) {
 		c.JSON(http.StatusBadRequest, gin.H{
 			"error": err.Error(),
 		})
-		h.log.Error("failed to generating uuid", l.Error(err))
+		h.reqLog(c).Error("failed to generating uuid", l.Error(err))
 		return
 	}
 
@@ -195,7 +189,7 @@ func (h *handlerV1) Verify(c *gin.Context) {
 		c.JSON(http.StatusBadRequest, gin.H{
 			"error": err.Error(),
 		})
-		h.log.Error("failed to generating access token", l.Error(err))
+		h.reqLog(c).Error("failed to generating access token", l.Error(err))
 		return
 	}
 
@@ -205,11 +199,11 @@ func (h *handlerV1) Verify(c *gin.Context) {
 		c.JSON(http.StatusBadRequest, gin.H{
 			"error": err.Error(),
 		})
-		h.log.Error("failed to generating hash password", l.Error(err))
+		h.reqLog(c).Error("failed to generating hash password", l.Error(err))
 		return
 	}
 
-	user, err := h.serviceManager.UserService().CreateUser(context.Background(), &pu.UserResponse{
+	user, err := h.serviceManager.UserService().CreateUser(c.Request.Context(), &pu.UserResponse{
 		Id:           id.String(),
 		FirstName:    body.FirstName,
 		LastName:     body.LastName,
@@ -222,7 +216,7 @@ func (h *handlerV1) Verify(c *gin.Context) {
 		c.JSON(http.StatusInternalServerError, gin.H{
 			"error": err.Error(),
 		})
-		h.log.Error("error while creating user to db", l.Error(err))
+		h.reqLog(c).Error("error while creating user to db", l.Error(err))
 		return
 	}
 
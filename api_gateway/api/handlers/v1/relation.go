@@ -0,0 +1,215 @@
+package v1
+
+import (
+	"net/http"
+
+	"github.com/burxondv/new-services/api-gateway/api/handlers/models"
+	pu "github.com/burxondv/new-services/api-gateway/genproto/user"
+	l "github.com/burxondv/new-services/api-gateway/pkg/logger"
+
+	"github.com/gin-gonic/gin"
+)
+
+// User
+// @Summary block user
+// @Tags User
+// @Descrtiption Block user by Id. Blocked user can not follow you, comment on your posts or find you in search. Follows between you are removed
+// @Security ApiKeyAuth
+// @Produce json
+// @Param id path string true "User Id"
+// @Success 200 {object} models.Relation
+// @Failure 400 string Error models.Error
+// @Failure 404 string Error models.Error
+// @Failure 500 string Error models.Error
+// @Router /v1/users/{id}/block [post]
+func (h *handlerV1) BlockUser(c *gin.Context) {
+	claims := GetClaims(h, c)
+	reqId := claims["sub"].(string)
+
+	response, err := h.serviceManager.UserService().BlockUser(c.Request.Context(), &pu.RelationRequest{
+		UserId:   reqId,
+		TargetId: c.Param("id"),
+	})
+	if err != nil {
+		c.JSON(httpStatus(err), gin.H{
+			"error": err.Error(),
+		})
+		h.reqLog(c).Error("failed to block user", l.Error(err))
+		return
+	}
+
+	h.invalidateRelation(c.Request.Context(), reqId, c.Param("id"))
+
+	c.JSON(http.StatusOK, relationModel(response))
+}
+
+// User
+// @Summary unblock user
+// @Tags User
+// @Descrtiption Unblock user by Id
+// @Security ApiKeyAuth
+// @Produce json
+// @Param id path string true "User Id"
+// @Success 200 {object} models.Relation
+// @Failure 400 string Error models.Error
+// @Failure 500 string Error models.Error
+// @Router /v1/users/{id}/block [delete]
+func (h *handlerV1) UnblockUser(c *gin.Context) {
+	claims := GetClaims(h, c)
+	reqId := claims["sub"].(string)
+
+	response, err := h.serviceManager.UserService().UnblockUser(c.Request.Context(), &pu.RelationRequest{
+		UserId:   reqId,
+		TargetId: c.Param("id"),
+	})
+	if err != nil {
+		c.JSON(httpStatus(err), gin.H{
+			"error": err.Error(),
+		})
+		h.reqLog(c).Error("failed to unblock user", l.Error(err))
+		return
+	}
+
+	h.invalidateRelation(c.Request.Context(), reqId, c.Param("id"))
+
+	c.JSON(http.StatusOK, relationModel(response))
+}
+
+// User
+// @Summary get blocked users
+// @Tags User
+// @Descrtiption Get users blocked by you
+// @Security ApiKeyAuth
+// @Produce json
+// @Success 200 {object} models.Users
+// @Failure 500 string Error models.Error
+// @Router /v1/users/blocked [get]
+func (h *handlerV1) GetBlockedUsers(c *gin.Context) {
+	claims := GetClaims(h, c)
+	reqId := claims["sub"].(string)
+
+	response, err := h.serviceManager.UserService().GetBlockedUsers(c.Request.Context(), &pu.Request{Str: reqId})
+	if err != nil {
+		c.JSON(httpStatus(err), gin.H{
+			"error": err.Error(),
+		})
+		h.reqLog(c).Error("failed to get blocked users", l.Error(err))
+		return
+	}
+
+	c.JSON(http.StatusOK, relationUsers(response))
+}
+
+// User
+// @Summary mute user
+// @Tags User
+// @Descrtiption Mute user by Id. Posts and comments of muted user are not shown in your listings, the user is not notified
+// @Security ApiKeyAuth
+// @Produce json
+// @Param id path string true "User Id"
+// @Success 200 {object} models.Relation
+// @Failure 400 string Error models.Error
+// @Failure 404 string Error models.Error
+// @Failure 500 string Error models.Error
+// @Router /v1/users/{id}/mute [post]
+func (h *handlerV1) MuteUser(c *gin.Context) {
+	claims := GetClaims(h, c)
+	reqId := claims["sub"].(string)
+
+	response, err := h.serviceManager.UserService().MuteUser(c.Request.Context(), &pu.RelationRequest{
+		UserId:   reqId,
+		TargetId: c.Param("id"),
+	})
+	if err != nil {
+		c.JSON(httpStatus(err), gin.H{
+			"error": err.Error(),
+		})
+		h.reqLog(c).Error("failed to mute user", l.Error(err))
+		return
+	}
+
+	h.invalidateRelation(c.Request.Context(), reqId, c.Param("id"))
+
+	c.JSON(http.StatusOK, relationModel(response))
+}
+
+// User
+// @Summary unmute user
+// @Tags User
+// @Descrtiption Unmute user by Id
+// @Security ApiKeyAuth
+// @Produce json
+// @Param id path string true "User Id"
+// @Success 200 {object} models.Relation
+// @Failure 400 string Error models.Error
+// @Failure 500 string Error models.Error
+// @Router /v1/users/{id}/mute [delete]
+func (h *handlerV1) UnmuteUser(c *gin.Context) {
+	claims := GetClaims(h, c)
+	reqId := claims["sub"].(string)
+
+	response, err := h.serviceManager.UserService().UnmuteUser(c.Request.Context(), &pu.RelationRequest{
+		UserId:   reqId,
+		TargetId: c.Param("id"),
+	})
+	if err != nil {
+		c.JSON(httpStatus(err), gin.H{
+			"error": err.Error(),
+		})
+		h.reqLog(c).Error("failed to unmute user", l.Error(err))
+		return
+	}
+
+	h.invalidateRelation(c.Request.Context(), reqId, c.Param("id"))
+
+	c.JSON(http.StatusOK, relationModel(response))
+}
+
+// User
+// @Summary get muted users
+// @Tags User
+// @Descrtiption Get users muted by you
+// @Security ApiKeyAuth
+// @Produce json
+// @Success 200 {object} models.Users
+// @Failure 500 string Error models.Error
+// @Router /v1/users/muted [get]
+func (h *handlerV1) GetMutedUsers(c *gin.Context) {
+	claims := GetClaims(h, c)
+	reqId := claims["sub"].(string)
+
+	response, err := h.serviceManager.UserService().GetMutedUsers(c.Request.Context(), &pu.Request{Str: reqId})
+	if err != nil {
+		c.JSON(httpStatus(err), gin.H{
+			"error": err.Error(),
+		})
+		h.reqLog(c).Error("failed to get muted users", l.Error(err))
+		return
+	}
+
+	c.JSON(http.StatusOK, relationUsers(response))
+}
+
+func relationModel(res *pu.RelationResponse) models.Relation {
+	return models.Relation{
+		UserId:   res.UserId,
+		TargetId: res.TargetId,
+		Active:   res.Active,
+	}
+}
+
+func relationUsers(res *pu.UsersResponse) models.Users {
+	users := models.Users{}
+	for _, val := range res.Users {
+		users.Users = append(users.Users, models.User{
+			Id:        val.Id,
+			FirstName: val.FirstName,
+			LastName:  val.LastName,
+			UserType:  val.UserType,
+			CreatedAt: val.CreatedAt,
+			UpdatedAt: val.UpdatedAt,
+		})
+	}
+
+	return users
+}
@@ -0,0 +1,174 @@
+package v1
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/burxondv/new-services/api-gateway/api/handlers/models"
+	pp "github.com/burxondv/new-services/api-gateway/genproto/post"
+	"github.com/burxondv/new-services/api-gateway/pkg/cache"
+	l "github.com/burxondv/new-services/api-gateway/pkg/logger"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Super-Admin | Admin | Moderator | User
+// @Summary Get post revisions
+// @Tags Post
+// @Description Get edit history of the post, newest first. User can see only own posts history
+// @Security ApiKeyAuth
+// @Produce json
+// @Param id path string true "Post ID"
+// @Success 200 {object} models.Revisions
+// @Failure 403 string Error models.Error
+// @Failure 404 string Error models.Error
+// @Failure 500 string Error models.Error
+// @Router /v1/posts/{id}/revisions [get]
+func (h *handlerV1) GetRevisions(c *gin.Context) {
+	response, err := h.serviceManager.PostService().GetRevisions(c.Request.Context(), &pp.RevisionsRequest{
+		PostId:   c.Param("id"),
+		ViewerId: revisionViewer(h, c),
+	})
+	if err != nil {
+		c.JSON(httpStatus(err), gin.H{
+			"error": err.Error(),
+		})
+		h.reqLog(c).Error("failed to get revisions", l.Error(err))
+		return
+	}
+
+	revisions := models.Revisions{}
+	for _, val := range response.Revisions {
+		revisions.Revisions = append(revisions.Revisions, models.Revision{
+			PostId:       val.PostId,
+			Revision:     val.Revision,
+			Title:        val.Title,
+			Description:  val.Description,
+			EditorId:     val.EditorId,
+			RestoredFrom: val.RestoredFrom,
+			CreatedAt:    val.CreatedAt,
+		})
+	}
+
+	c.JSON(http.StatusOK, revisions)
+}
+
+// Super-Admin | Admin | Moderator | User
+// @Summary Diff post revisions
+// @Tags Post
+// @Description Line by line difference of title and description between two revisions
+// @Security ApiKeyAuth
+// @Produce json
+// @Param id path string true "Post ID"
+// @Param from query int true "From revision"
+// @Param to query int true "To revision"
+// @Success 200 {object} models.RevisionDiff
+// @Failure 400 string Error models.Error
+// @Failure 403 string Error models.Error
+// @Failure 404 string Error models.Error
+// @Failure 500 string Error models.Error
+// @Router /v1/posts/{id}/revisions/diff [get]
+func (h *handlerV1) DiffRevisions(c *gin.Context) {
+	from, err := strconv.ParseInt(c.Query("from"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "from must be revision number",
+		})
+		h.reqLog(c).Error("failed to parse from revision", l.Error(err))
+		return
+	}
+
+	to, err := strconv.ParseInt(c.Query("to"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "to must be revision number",
+		})
+		h.reqLog(c).Error("failed to parse to revision", l.Error(err))
+		return
+	}
+
+	response, err := h.serviceManager.PostService().DiffRevisions(c.Request.Context(), &pp.DiffRevisionsRequest{
+		PostId:   c.Param("id"),
+		From:     from,
+		To:       to,
+		ViewerId: revisionViewer(h, c),
+	})
+	if err != nil {
+		c.JSON(httpStatus(err), gin.H{
+			"error": err.Error(),
+		})
+		h.reqLog(c).Error("failed to diff revisions", l.Error(err))
+		return
+	}
+
+	c.JSON(http.StatusOK, models.RevisionDiff{
+		From:        response.From,
+		To:          response.To,
+		Title:       diffLinesModel(response.Title),
+		Description: diffLinesModel(response.Description),
+	})
+}
+
+// User
+// @Summary Restore post revision
+// @Tags Post
+// @Description Restore title and description of the revision, it is saved as a new revision
+// @Security ApiKeyAuth
+// @Produce json
+// @Param id path string true "Post ID"
+// @Param revision path int true "Revision"
+// @Success 200 {object} models.Post
+// @Failure 400 string Error models.Error
+// @Failure 403 string Error models.Error
+// @Failure 404 string Error models.Error
+// @Failure 500 string Error models.Error
+// @Router /v1/posts/{id}/revisions/{revision}/restore [post]
+func (h *handlerV1) RestoreRevision(c *gin.Context) {
+	revision, err := strconv.ParseInt(c.Param("revision"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "revision must be number",
+		})
+		h.reqLog(c).Error("failed to parse revision", l.Error(err))
+		return
+	}
+
+	claims := GetClaims(h, c)
+	reqId := claims["sub"].(string)
+
+	response, err := h.serviceManager.PostService().RestoreRevision(c.Request.Context(), &pp.RestoreRevisionRequest{
+		PostId:   c.Param("id"),
+		Revision: revision,
+		EditorId: reqId,
+	})
+	if err != nil {
+		c.JSON(httpStatus(err), gin.H{
+			"error": err.Error(),
+		})
+		h.reqLog(c).Error("failed to restore revision", l.Error(err))
+		return
+	}
+	h.invalidate(c.Request.Context(), cache.PostResource(response.Id))
+
+	c.JSON(http.StatusOK, postModel(response))
+}
+
+// revisionViewer returns user id for users, they can see only own history.
+// Moderators and admins can see history of every post.
+func revisionViewer(h *handlerV1, c *gin.Context) string {
+	claims := GetClaims(h, c)
+	if claims["role"].(string) != "user" {
+		return ""
+	}
+
+	return claims["sub"].(string)
+}
+
+func diffLinesModel(lines []*pp.DiffLine) []models.DiffLine {
+	res := []models.DiffLine{}
+	for _, line := range lines {
+		res = append(res, models.DiffLine{Op: line.Op, Text: line.Text})
+	}
+
+	return res
+}
@@ -0,0 +1,203 @@
+package v1
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"time"
+
+	pp "github.com/burxondv/new-services/api-gateway/genproto/post"
+	l "github.com/burxondv/new-services/api-gateway/pkg/logger"
+	"github.com/burxondv/new-services/api-gateway/pkg/realtime"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gorilla/websocket"
+)
+
+const (
+	// keeps idle connections open through proxies
+	streamPing = 30 * time.Second
+
+	wsWriteWait = 10 * time.Second
+	wsPongWait  = 2 * streamPing
+)
+
+var upgrader = websocket.Upgrader{
+	ReadBufferSize:  1024,
+	WriteBufferSize: 1024,
+	// the connection is authorized with JWT, not with cookies
+	CheckOrigin: func(r *http.Request) bool { return true },
+}
+
+// Super-Admin | Admin | User
+// @Summary Stream events over Server-Sent Events
+// @Tags Stream
+// @Description Stream new comments and like counts of posts and own notifications. Every event is JSON with type (comment, like or notification) and data. Token can be passed in access_token query param
+// @Security ApiKeyAuth
+// @Produce text/event-stream
+// @Param comments query []string false "Post IDs to stream comments of" collectionFormat(multi)
+// @Param likes query []string false "Post IDs to stream likes of" collectionFormat(multi)
+// @Param notifications query bool false "Stream own notifications"
+// @Param access_token query string false "JWT"
+// @Success 200 {object} realtime.Event
+// @Failure 400 string Error models.Error
+// @Failure 404 string Error models.Error
+// @Failure 500 string Error models.Error
+// @Router /v1/stream/events [get]
+func (h *handlerV1) StreamEvents(c *gin.Context) {
+	events, ok := h.subscribe(c)
+	if !ok {
+		return
+	}
+
+	c.Header("Cache-Control", "no-cache")
+	c.Header("Connection", "keep-alive")
+	c.Header("X-Accel-Buffering", "no")
+
+	ping := time.NewTicker(streamPing)
+	defer ping.Stop()
+
+	c.Stream(func(w io.Writer) bool {
+		select {
+		case event, ok := <-events:
+			if !ok {
+				return false
+			}
+			c.SSEvent("message", string(event))
+		case <-ping.C:
+			_, err := io.WriteString(w, ": ping\n\n")
+			return err == nil
+		}
+
+		return true
+	})
+}
+
+// Super-Admin | Admin | User
+// @Summary Stream events over WebSocket
+// @Tags Stream
+// @Description Same events as in /v1/stream/events sent as WebSocket text messages. Token can be passed in access_token query param
+// @Security ApiKeyAuth
+// @Param comments query []string false "Post IDs to stream comments of" collectionFormat(multi)
+// @Param likes query []string false "Post IDs to stream likes of" collectionFormat(multi)
+// @Param notifications query bool false "Stream own notifications"
+// @Param access_token query string false "JWT"
+// @Success 101 {object} realtime.Event
+// @Failure 400 string Error models.Error
+// @Failure 404 string Error models.Error
+// @Failure 500 string Error models.Error
+// @Router /v1/stream/ws [get]
+func (h *handlerV1) StreamWebSocket(c *gin.Context) {
+	// request context is not canceled after the connection is hijacked
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	c.Request = c.Request.WithContext(ctx)
+
+	events, ok := h.subscribe(c)
+	if !ok {
+		return
+	}
+
+	conn, err := upgrader.Upgrade(c.Writer, c.Request, nil)
+	if err != nil {
+		// upgrader has already written the error response
+		h.reqLog(c).Error("failed to upgrade to websocket", l.Error(err))
+		return
+	}
+	defer conn.Close()
+
+	// messages from client are not expected, reading is needed to handle
+	// pongs and to notice closed connection
+	go func() {
+		defer cancel()
+
+		conn.SetReadDeadline(time.Now().Add(wsPongWait))
+		conn.SetPongHandler(func(string) error {
+			return conn.SetReadDeadline(time.Now().Add(wsPongWait))
+		})
+		for {
+			if _, _, err := conn.NextReader(); err != nil {
+				return
+			}
+		}
+	}()
+
+	ping := time.NewTicker(streamPing)
+	defer ping.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case event, ok := <-events:
+			conn.SetWriteDeadline(time.Now().Add(wsWriteWait))
+			if !ok {
+				conn.WriteMessage(websocket.CloseMessage, websocket.FormatCloseMessage(websocket.CloseGoingAway, ""))
+				return
+			}
+			if err := conn.WriteMessage(websocket.TextMessage, event); err != nil {
+				return
+			}
+		case <-ping.C:
+			conn.SetWriteDeadline(time.Now().Add(wsWriteWait))
+			if err := conn.WriteMessage(websocket.PingMessage, nil); err != nil {
+				return
+			}
+		}
+	}
+}
+
+// subscribe checks access to requested topics and subscribes to them until
+// the request context is done, error response is written if it fails
+func (h *handlerV1) subscribe(c *gin.Context) (<-chan []byte, bool) {
+	claims := GetClaims(h, c)
+	reqId := claims["sub"].(string)
+
+	channels := []string{}
+	for _, id := range c.QueryArray("comments") {
+		channels = append(channels, realtime.CommentsChannel(id))
+	}
+	for _, id := range c.QueryArray("likes") {
+		channels = append(channels, realtime.LikesChannel(id))
+	}
+	if c.Query("notifications") == "true" {
+		channels = append(channels, realtime.NotificationsChannel(reqId))
+	}
+	if len(channels) == 0 {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "nothing to stream, comments, likes or notifications param is required",
+		})
+		h.reqLog(c).Error("failed to subscribe to stream", l.Error(errors.New("no topics")))
+		return nil, false
+	}
+
+	// hidden posts are not found for the viewer
+	checked := map[string]bool{}
+	for _, id := range append(c.QueryArray("comments"), c.QueryArray("likes")...) {
+		if checked[id] {
+			continue
+		}
+		checked[id] = true
+
+		_, err := h.serviceManager.PostService().GetPostById(c.Request.Context(), &pp.Request{Str: id, ViewerId: reqId})
+		if err != nil {
+			c.JSON(httpStatus(err), gin.H{
+				"error": err.Error(),
+			})
+			h.reqLog(c).Error("failed to get post for stream", l.Error(err))
+			return nil, false
+		}
+	}
+
+	events, err := h.broker.Subscribe(c.Request.Context(), channels...)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error": err.Error(),
+		})
+		h.reqLog(c).Error("failed to subscribe to stream", l.Error(err))
+		return nil, false
+	}
+
+	return events, true
+}
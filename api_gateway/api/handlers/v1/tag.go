@@ -0,0 +1,163 @@
+package v1
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/burxondv/new-services/api-gateway/api/handlers/models"
+	pp "github.com/burxondv/new-services/api-gateway/genproto/post"
+	l "github.com/burxondv/new-services/api-gateway/pkg/logger"
+	"github.com/burxondv/new-services/api-gateway/pkg/utils"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Super-Admin | Admin | User
+// @Summary Get posts by tag
+// @Tags Tag
+// @Description Get published posts with the tag, newest first
+// @Security ApiKeyAuth
+// @Produce json
+// @Param tag path string true "Tag"
+// @Param limit query int false "Limit"
+// @Param page query int false "Page"
+// @Success 200 {object} models.Posts
+// @Failure 400 string Error models.Error
+// @Failure 500 string Error models.Error
+// @Router /v1/tags/{tag}/posts [get]
+func (h *handlerV1) GetPostsByTag(c *gin.Context) {
+	params, errStr := utils.ParseQueryParams(c.Request.URL.Query())
+	if errStr != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": errStr[0],
+		})
+		h.reqLog(c).Error("failed to parse query params to json: " + errStr[0])
+		return
+	}
+
+	claims := GetClaims(h, c)
+	reqId := claims["sub"].(string)
+
+	response, err := h.serviceManager.PostService().GetPostsByTag(c.Request.Context(), &pp.TagPostsRequest{
+		Tag:      c.Param("tag"),
+		ViewerId: reqId,
+		Limit:    params.Limit,
+		Page:     params.Page,
+	})
+	if err != nil {
+		c.JSON(httpStatus(err), gin.H{
+			"error": err.Error(),
+		})
+		h.reqLog(c).Error("failed to get posts by tag", l.Error(err))
+		return
+	}
+
+	posts := models.Posts{}
+	for _, val := range response.Posts {
+		posts.Posts = append(posts.Posts, postModel(val))
+	}
+
+	c.JSON(http.StatusOK, posts)
+}
+
+// Super-Admin | Admin | User
+// @Summary Autocomplete tags
+// @Tags Tag
+// @Description Get tags starting with prefix, most used first
+// @Security ApiKeyAuth
+// @Produce json
+// @Param prefix query string true "Prefix"
+// @Param limit query int false "Limit"
+// @Success 200 {object} models.Tags
+// @Failure 400 string Error models.Error
+// @Failure 500 string Error models.Error
+// @Router /v1/tags/autocomplete [get]
+func (h *handlerV1) AutocompleteTags(c *gin.Context) {
+	limit, err := queryInt(c, "limit")
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "Invalid `limit` param",
+		})
+		h.reqLog(c).Error("failed to parse limit", l.Error(err))
+		return
+	}
+
+	response, err := h.serviceManager.PostService().AutocompleteTags(c.Request.Context(), &pp.AutocompleteTagsRequest{
+		Prefix: c.Query("prefix"),
+		Limit:  limit,
+	})
+	if err != nil {
+		c.JSON(httpStatus(err), gin.H{
+			"error": err.Error(),
+		})
+		h.reqLog(c).Error("failed to autocomplete tags", l.Error(err))
+		return
+	}
+
+	c.JSON(http.StatusOK, tagsModel(response))
+}
+
+// Super-Admin | Admin | User
+// @Summary Trending tags
+// @Tags Tag
+// @Description Get most used tags of posts published in the last hours (24 by default)
+// @Security ApiKeyAuth
+// @Produce json
+// @Param hours query int false "Hours"
+// @Param limit query int false "Limit"
+// @Success 200 {object} models.Tags
+// @Failure 400 string Error models.Error
+// @Failure 500 string Error models.Error
+// @Router /v1/tags/trending [get]
+func (h *handlerV1) GetTrendingTags(c *gin.Context) {
+	hours, err := queryInt(c, "hours")
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "Invalid `hours` param",
+		})
+		h.reqLog(c).Error("failed to parse hours", l.Error(err))
+		return
+	}
+
+	limit, err := queryInt(c, "limit")
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "Invalid `limit` param",
+		})
+		h.reqLog(c).Error("failed to parse limit", l.Error(err))
+		return
+	}
+
+	response, err := h.serviceManager.PostService().GetTrendingTags(c.Request.Context(), &pp.TrendingTagsRequest{
+		Hours: hours,
+		Limit: limit,
+	})
+	if err != nil {
+		c.JSON(httpStatus(err), gin.H{
+			"error": err.Error(),
+		})
+		h.reqLog(c).Error("failed to get trending tags", l.Error(err))
+		return
+	}
+
+	c.JSON(http.StatusOK, tagsModel(response))
+}
+
+// queryInt returns 0 when the param is not given
+func queryInt(c *gin.Context, key string) (int64, error) {
+	val := c.Query(key)
+	if val == "" {
+		return 0, nil
+	}
+
+	return strconv.ParseInt(val, 10, 64)
+}
+
+func tagsModel(response *pp.TagsResponse) models.Tags {
+	tags := models.Tags{Tags: []models.Tag{}}
+	for _, val := range response.Tags {
+		tags.Tags = append(tags.Tags, models.Tag{Name: val.Name, Posts: val.Posts})
+	}
+
+	return tags
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/burxondv/new-services/api-gateway/api/handlers/models"
 	"github.com/burxondv/new-services/api-gateway/api/handlers/token"
 	pu "github.com/burxondv/new-services/api-gateway/genproto/user"
+	"github.com/burxondv/new-services/api-gateway/pkg/cache"
 	"github.com/burxondv/new-services/api-gateway/pkg/etc"
 	l "github.com/burxondv/new-services/api-gateway/pkg/logger"
 	"github.com/burxondv/new-services/api-gateway/pkg/utils"
@@ -41,7 +42,7 @@ func (h *handlerV1) CreateUser(c *gin.Context) {
 		c.JSON(http.StatusBadRequest, gin.H{
 			"error": err.Error(),
 		})
-		h.log.Error("failed to bind json", l.Error(err))
+		h.reqLog(c).Error("failed to bind json", l.Error(err))
 		return
 	}
 
@@ -51,7 +52,7 @@ func (h *handlerV1) CreateUser(c *gin.Context) {
 		c.JSON(http.StatusBadRequest, gin.H{
 			"error": err.Error(),
 		})
-		h.log.Error("failed to generating uuid", l.Error(err))
+		h.reqLog(c).Error("failed to generating uuid", l.Error(err))
 		return
 	}
 
@@ -61,7 +62,7 @@ func (h *handlerV1) CreateUser(c *gin.Context) {
 		c.JSON(http.StatusBadRequest, gin.H{
 			"error": err.Error(),
 		})
-		h.log.Error("failed to generating hash password", l.Error(err))
+		h.reqLog(c).Error("failed to generating hash password", l.Error(err))
 		return
 	}
 
@@ -82,11 +83,11 @@ func (h *handlerV1) CreateUser(c *gin.Context) {
 		c.JSON(http.StatusBadRequest, gin.H{
 			"error": err.Error(),
 		})
-		h.log.Error("failed to generating access token", l.Error(err))
+		h.reqLog(c).Error("failed to generating access token", l.Error(err))
 		return
 	}
 
-	response, err := h.serviceManager.UserService().CreateUser(context.Background(), &pu.UserResponse{
+	response, err := h.serviceManager.UserService().CreateUser(c.Request.Context(), &pu.UserResponse{
 		Id:           id.String(),
 		FirstName:    body.FirstName,
 		LastName:     body.LastName,
@@ -98,7 +99,7 @@ func (h *handlerV1) CreateUser(c *gin.Context) {
 		c.JSON(http.StatusInternalServerError, gin.H{
 			"error": err.Error(),
 		})
-		h.log.Error("failed to create user", l.Error(err))
+		h.reqLog(c).Error("failed to create user", l.Error(err))
 		return
 	}
 
@@ -124,32 +125,19 @@ func (h *handlerV1) CreateUser(c *gin.Context) {
 // @Failure 500 string Error models.Error
 // @Router /v1/users/get-profile [get]
 func (h *handlerV1) GetProfile(c *gin.Context) {
-	var jspbMarshal protojson.MarshalOptions
-	jspbMarshal.UseProtoNames = true
-
 	claims := GetClaims(h, c)
 	reqId := claims["sub"].(string)
 
-	response, err := h.serviceManager.UserService().GetUserById(context.Background(), &pu.Request{Str: reqId})
+	user, err := h.getUser(c.Request.Context(), reqId, reqId)
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{
 			"error": err.Error(),
 		})
-		h.log.Error("failed to get user by id", l.Error(err))
+		h.reqLog(c).Error("failed to get user by id", l.Error(err))
 		return
 	}
 
-	user := models.User{
-		Id:        response.Id,
-		FirstName: response.FirstName,
-		LastName:  response.LastName,
-		UserType:  response.UserType,
-		Email:     response.Email,
-		Posts:     response.Posts,
-		CreatedAt: response.CreatedAt,
-		UpdatedAt: response.UpdatedAt,
-	}
-
+	c.Header("ETag", etag(user.Version))
 	c.JSON(http.StatusOK, user)
 }
 
@@ -166,30 +154,47 @@ func (h *handlerV1) GetProfile(c *gin.Context) {
 // @Failure 500 string Error models.Error
 // @Router /v1/users/{id} [get]
 func (h *handlerV1) GetUserById(c *gin.Context) {
-	var (
-		jspbMarshal = protojson.MarshalOptions{}
-	)
-	jspbMarshal.UseProtoNames = true
+	claims := GetClaims(h, c)
+	reqId := claims["sub"].(string)
 
-	res, err := h.serviceManager.UserService().GetUserById(context.Background(), &pu.Request{Str: c.Param("id")})
+	user, err := h.getUser(c.Request.Context(), c.Param("id"), reqId)
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{
 			"error": err.Error(),
 		})
-		h.log.Error("failed to get user by id", l.Error(err))
+		h.reqLog(c).Error("failed to get user by id", l.Error(err))
 		return
 	}
 
-	c.JSON(http.StatusOK, models.User{
-		Id:        res.Id,
-		FirstName: res.FirstName,
-		LastName:  res.LastName,
-		UserType:  res.UserType,
-		Email:     res.Email,
-		Posts:     res.Posts,
-		CreatedAt: res.CreatedAt,
-		UpdatedAt: res.UpdatedAt,
+	c.Header("ETag", etag(user.Version))
+	c.JSON(http.StatusOK, user)
+}
+
+// getUser reads user through cache, posts count depends on the viewer and
+// their relations so the response is cached for every viewer
+func (h *handlerV1) getUser(ctx context.Context, id, viewerId string) (models.User, error) {
+	variant := h.cache.Variant(ctx, viewerId, cache.RelationsResource(viewerId))
+	var user models.User
+	err := h.cache.Fetch(ctx, cache.UserResource(id), variant, h.cfg.CacheTTL, &user, func(ctx context.Context) (interface{}, error) {
+		res, err := h.serviceManager.UserService().GetUserById(ctx, &pu.Request{Str: id, ViewerId: viewerId})
+		if err != nil {
+			return nil, err
+		}
+
+		return models.User{
+			Id:        res.Id,
+			FirstName: res.FirstName,
+			LastName:  res.LastName,
+			UserType:  res.UserType,
+			Email:     res.Email,
+			Posts:     res.Posts,
+			CreatedAt: res.CreatedAt,
+			UpdatedAt: res.UpdatedAt,
+			Version:   res.Version,
+		}, nil
 	})
+
+	return user, err
 }
 
 // Super-Admin | Admin | User
@@ -201,6 +206,7 @@ func (h *handlerV1) GetUserById(c *gin.Context) {
 // @Produce json
 // @Param limit query int true "Limit"
 // @Param page query int true "Page"
+// @Param search query string false "Search by name, users who blocked you are not found"
 // @Success 200 {object} models.Users
 // @Failure 400 string Error models.Error
 // @Failure 500 string Error models.Error
@@ -214,19 +220,26 @@ func (h *handlerV1) GetAllUsers(c *gin.Context) {
 		c.JSON(http.StatusBadRequest, gin.H{
 			"error": errStr[0],
 		})
-		h.log.Error("failed to parse query params to json: " + errStr[0])
+		h.reqLog(c).Error("failed to parse query params to json: " + errStr[0])
 		return
 	}
 
 	var jspbMarshal protojson.MarshalOptions
 	jspbMarshal.UseProtoNames = true
 
-	response, err := h.serviceManager.UserService().GetAllUsers(context.Background(), &pu.GetUsersRequest{Limit: params.Limit, Page: params.Page})
+	var response *pu.UsersResponse
+	var err error
+	claims := GetClaims(h, c)
+	if params.Search != "" {
+		response, err = h.serviceManager.UserService().SearchUsers(c.Request.Context(), &pu.Request{Str: params.Search, ViewerId: claims["sub"].(string)})
+	} else {
+		response, err = h.serviceManager.UserService().GetAllUsers(c.Request.Context(), &pu.GetUsersRequest{Limit: params.Limit, Page: params.Page, ViewerId: claims["sub"].(string)})
+	}
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{
 			"error": err.Error(),
 		})
-		h.log.Error("failed to get all users", l.Error(err))
+		h.reqLog(c).Error("failed to get all users", l.Error(err))
 		return
 	}
 
@@ -255,8 +268,11 @@ func (h *handlerV1) GetAllUsers(c *gin.Context) {
 // @Accept json
 // @Produce json
 // @Param NewUser body models.UpdateUserRequest true "Update User"
+// @Param If-Match header string false "ETag of the user from get profile"
 // @Success 200 string Success models.Success
 // @Failure 400 string Error models.Error
+// @Failure 409 string Error models.Error
+// @Failure 412 string Error models.Error
 // @Failure 500 string Error models.Error
 // @Router /v1/users [put]
 func (h *handlerV1) UpdateUser(c *gin.Context) {
@@ -271,27 +287,38 @@ func (h *handlerV1) UpdateUser(c *gin.Context) {
 		c.JSON(http.StatusBadRequest, gin.H{
 			"error": err.Error(),
 		})
-		h.log.Error("failed to bind JSON", l.Error(err))
+		h.reqLog(c).Error("failed to bind JSON", l.Error(err))
 		return
 	}
 
 	claims := GetClaims(h, c)
 	reqId := claims["sub"].(string)
 
-	res, err := h.serviceManager.UserService().UpdateUser(context.Background(), &pu.UpdateUserRequest{
-		Id:        reqId,
-		FirstName: body.FirstName,
-		LastName:  body.LastName,
-		Email:     body.Email,
+	version, ifMatch, err := expectedVersion(c, body.ExpectedVersion)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	res, err := h.serviceManager.UserService().UpdateUser(c.Request.Context(), &pu.UpdateUserRequest{
+		Id:              reqId,
+		FirstName:       body.FirstName,
+		LastName:        body.LastName,
+		Email:           body.Email,
+		ExpectedVersion: version,
 	})
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{
+		c.JSON(versionStatus(err, ifMatch), gin.H{
 			"error": err.Error(),
 		})
-		h.log.Error("failed to update user", l.Error(err))
+		h.reqLog(c).Error("failed to update user", l.Error(err))
 		return
 	}
+	h.invalidate(c.Request.Context(), cache.UserResource(reqId))
 
+	c.Header("ETag", etag(res.Version))
 	c.JSON(http.StatusOK, models.User{
 		Id:        res.Id,
 		FirstName: res.FirstName,
@@ -301,6 +328,7 @@ func (h *handlerV1) UpdateUser(c *gin.Context) {
 		Posts:     res.Posts,
 		CreatedAt: res.CreatedAt,
 		UpdatedAt: res.UpdatedAt,
+		Version:   res.Version,
 	})
 }
 
@@ -317,29 +345,50 @@ func (h *handlerV1) UpdateUser(c *gin.Context) {
 // @Failure 500 string Error models.Error
 // @Router /v1/users/{id} [delete]
 func (h *handlerV1) DeleteUser(c *gin.Context) {
-	jspbMarshal := protojson.MarshalOptions{}
-	jspbMarshal.UseProtoNames = true
+	h.deleteUser(c, c.Param("id"))
+}
 
-	response, err := h.serviceManager.UserService().DeleteUser(context.Background(), &pu.Request{Str: c.Param("id")})
+// Super-Admin | Admin | User
+// @Summary delete account
+// @Tags User
+// @Descrtiption this method for delete account of the user from Claims, posts and comments are deleted at once and erased after grace period
+// @Security ApiKeyAuth
+// @Accept json
+// @Produce json
+// @Success 200 string models.DeletedUser
+// @Failure 404 string Error models.Error
+// @Failure 500 string Error models.Error
+// @Router /v1/users [delete]
+func (h *handlerV1) DeleteAccount(c *gin.Context) {
+	claims := GetClaims(h, c)
+	reqId := claims["sub"].(string)
+
+	h.deleteUser(c, reqId)
+}
+
+func (h *handlerV1) deleteUser(c *gin.Context, id string) {
+	response, err := h.serviceManager.UserService().DeleteUser(c.Request.Context(), &pu.Request{Str: id})
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{
+		c.JSON(httpStatus(err), gin.H{
 			"error": err.Error(),
 		})
-		h.log.Error("failed to delete user", l.Error(err))
+		h.reqLog(c).Error("failed to delete user", l.Error(err))
 		return
 	}
+	h.invalidate(c.Request.Context(), cache.UserResource(id))
 
 	now := time.Now()
 	user := models.DeletedUser{
-		Id:        response.Id,
-		FirstName: response.FirstName,
-		LastName:  response.LastName,
-		UserType:  response.UserType,
-		Email:     response.Email,
-		Posts:     response.Posts,
-		CreatedAt: response.CreatedAt,
-		UpdatedAt: response.UpdatedAt,
-		DeletedAt: now.Format("2006-01-02 15:04:05"),
+		Id:         response.Id,
+		FirstName:  response.FirstName,
+		LastName:   response.LastName,
+		UserType:   response.UserType,
+		Email:      response.Email,
+		Posts:      response.Posts,
+		CreatedAt:  response.CreatedAt,
+		UpdatedAt:  response.UpdatedAt,
+		DeletedAt:  now.Format("2006-01-02 15:04:05"),
+		PurgeAfter: response.PurgeAfter,
 	}
 
 	c.JSON(http.StatusOK, user)
@@ -0,0 +1,67 @@
+package v2
+
+import (
+	"context"
+
+	pc "github.com/burxondv/new-services/api-gateway/genproto/comment"
+	pp "github.com/burxondv/new-services/api-gateway/genproto/post"
+	"github.com/burxondv/new-services/api-gateway/pkg/cache"
+	"github.com/burxondv/new-services/api-gateway/pkg/logger"
+
+	"google.golang.org/grpc"
+)
+
+// invalidations return resources of cached v1 responses changed by methods
+// of the generated API, they are the same as invalidations of v1 handlers.
+// Only these methods change data and are allowed by casbin, a method allowed
+// later needs its entry here.
+var invalidations = map[string]func(res interface{}) []string{
+	"/post.PostService/UpdatePost": func(res interface{}) []string {
+		post := res.(*pp.PostResponse)
+		return []string{cache.PostResource(post.Id), cache.UserResource(post.UserId)}
+	},
+	"/comment.CommentService/DeleteComment": func(res interface{}) []string {
+		return []string{cache.PostResource(res.(*pc.CommentResponse).PostId)}
+	},
+}
+
+// invalidate drops cached responses changed by the successful call of the
+// method, failed invalidation is only logged, stale responses expire by TTL
+func (h *handlerV2) invalidate(ctx context.Context, method string, res interface{}) {
+	changed, ok := invalidations[method]
+	if !ok {
+		return
+	}
+
+	if err := h.cache.Invalidate(ctx, changed(res)...); err != nil {
+		logger.WithContext(h.log, ctx).Error("failed to invalidate cache", logger.String("method", method), logger.Error(err))
+	}
+}
+
+// postClient and commentClient invalidate the cache after REST calls, REST
+// handlers of grpc-gateway call services through them
+type postClient struct {
+	pp.PostServiceClient
+	h *handlerV2
+}
+
+func (c postClient) UpdatePost(ctx context.Context, in *pp.UpdatePostRequest, opts ...grpc.CallOption) (*pp.PostResponse, error) {
+	res, err := c.PostServiceClient.UpdatePost(ctx, in, opts...)
+	if err == nil {
+		c.h.invalidate(ctx, "/post.PostService/UpdatePost", res)
+	}
+	return res, err
+}
+
+type commentClient struct {
+	pc.CommentServiceClient
+	h *handlerV2
+}
+
+func (c commentClient) DeleteComment(ctx context.Context, in *pc.Request, opts ...grpc.CallOption) (*pc.CommentResponse, error) {
+	res, err := c.CommentServiceClient.DeleteComment(ctx, in, opts...)
+	if err == nil {
+		c.h.invalidate(ctx, "/comment.CommentService/DeleteComment", res)
+	}
+	return res, err
+}
@@ -0,0 +1,130 @@
+package v2
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/burxondv/new-services/api-gateway/pkg/logger"
+	"github.com/burxondv/new-services/api-gateway/services"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/improbable-eng/grpc-web/go/grpcweb"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+)
+
+// grpcServices are services of the generated API and their connections
+var grpcServices = map[string]string{
+	"post.PostService":       "post_service",
+	"comment.CommentService": "comment_service",
+	"user.UserService":       "user_service",
+}
+
+type grpcMethod struct {
+	conn   *grpc.ClientConn
+	input  reflect.Type
+	output reflect.Type
+}
+
+// grpcWeb forwards gRPC-Web calls to services, methods are taken from
+// descriptors of genproto, so new RPCs are served without changes here.
+// Responses of successful calls are passed to done.
+type grpcWeb struct {
+	log      logger.Logger
+	services map[string]bool
+	methods  map[string]grpcMethod
+	wrapped  *grpcweb.WrappedGrpcServer
+	done     func(ctx context.Context, method string, res interface{})
+}
+
+func newGrpcWeb(serviceManager services.IServiceManager, allowedOrigins []string, done func(ctx context.Context, method string, res interface{}), log logger.Logger) (*grpcWeb, error) {
+	g := &grpcWeb{
+		log:      log,
+		services: map[string]bool{},
+		methods:  map[string]grpcMethod{},
+		done:     done,
+	}
+
+	for name, conn := range grpcServices {
+		d, err := protoregistry.GlobalFiles.FindDescriptorByName(protoreflect.FullName(name))
+		if err != nil {
+			return nil, fmt.Errorf("service %s: %w", name, err)
+		}
+		service, ok := d.(protoreflect.ServiceDescriptor)
+		if !ok {
+			return nil, fmt.Errorf("%s is not a service", name)
+		}
+
+		methods := service.Methods()
+		for i := 0; i < methods.Len(); i++ {
+			m := methods.Get(i)
+			// streams are served by v1 handlers only
+			if m.IsStreamingClient() || m.IsStreamingServer() {
+				continue
+			}
+
+			input := proto.MessageType(string(m.Input().FullName()))
+			output := proto.MessageType(string(m.Output().FullName()))
+			if input == nil || output == nil {
+				return nil, fmt.Errorf("types of %s/%s are not registered", name, m.Name())
+			}
+
+			g.methods["/"+name+"/"+string(m.Name())] = grpcMethod{
+				conn:   serviceManager.Conn(conn),
+				input:  input.Elem(),
+				output: output.Elem(),
+			}
+		}
+		g.services[name] = true
+	}
+
+	server := grpc.NewServer(grpc.UnknownServiceHandler(g.forward))
+	g.wrapped = grpcweb.WrapServer(server, grpcweb.WithOriginFunc(originAllowed(allowedOrigins)))
+
+	return g, nil
+}
+
+// originAllowed checks origins of cross-origin calls, same-origin calls are
+// not checked
+func originAllowed(allowedOrigins []string) func(origin string) bool {
+	allowed := map[string]bool{}
+	for _, origin := range allowedOrigins {
+		allowed[strings.TrimSuffix(origin, "/")] = true
+	}
+
+	return func(origin string) bool {
+		return allowed["*"] || allowed[origin]
+	}
+}
+
+// forward calls the method of the stream on its service, the context of the
+// stream is the request context, so it has the identity of the user
+func (g *grpcWeb) forward(srv interface{}, stream grpc.ServerStream) error {
+	name, ok := grpc.MethodFromServerStream(stream)
+	if !ok {
+		return status.Error(codes.Internal, "method is not known")
+	}
+	method, ok := g.methods[name]
+	if !ok {
+		return status.Errorf(codes.Unimplemented, "method %s is not served", name)
+	}
+
+	req := reflect.New(method.input).Interface()
+	if err := stream.RecvMsg(req); err != nil {
+		return err
+	}
+
+	res := reflect.New(method.output).Interface()
+	if err := method.conn.Invoke(stream.Context(), name, req, res); err != nil {
+		g.log.Error("gRPC-Web call failed", logger.String("method", name), logger.Error(err))
+		return err
+	}
+	g.done(stream.Context(), name, res)
+
+	return stream.SendMsg(res)
+}
@@ -0,0 +1,89 @@
+package v2
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/burxondv/new-services/api-gateway/config"
+	pc "github.com/burxondv/new-services/api-gateway/genproto/comment"
+	pp "github.com/burxondv/new-services/api-gateway/genproto/post"
+	pu "github.com/burxondv/new-services/api-gateway/genproto/user"
+	"github.com/burxondv/new-services/api-gateway/pkg/cache"
+	"github.com/burxondv/new-services/api-gateway/pkg/logger"
+	"github.com/burxondv/new-services/api-gateway/pkg/publicapi"
+	"github.com/burxondv/new-services/api-gateway/services"
+
+	"github.com/gin-gonic/gin"
+	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+)
+
+// handlerV2 serves the API generated from protos: REST of methods with
+// google.api.http options and gRPC-Web of all unary methods. Requests come
+// after auth and casbin, so v2 paths and gRPC methods need policies like v1.
+// Successful calls of mutating methods invalidate cached v1 responses.
+type handlerV2 struct {
+	log   logger.Logger
+	cache *cache.Cache
+	mux   *runtime.ServeMux
+	grpc  *grpcWeb
+}
+
+func New(serviceManager services.IServiceManager, responseCache *cache.Cache, cfg config.Config, log logger.Logger) (*handlerV2, error) {
+	h := &handlerV2{
+		log:   log,
+		cache: responseCache,
+	}
+
+	h.mux = runtime.NewServeMux(
+		runtime.WithMarshalerOption(runtime.MIMEWildcard, &runtime.JSONPb{OrigName: true, EmitDefaults: true}),
+		// headers of clients are not sent to services, the identity is
+		// signed by the gateway
+		runtime.WithIncomingHeaderMatcher(func(string) (string, bool) { return "", false }),
+	)
+
+	ctx := context.Background()
+	if err := pp.RegisterPostServiceHandlerClient(ctx, h.mux, postClient{serviceManager.PostService(), h}); err != nil {
+		return nil, err
+	}
+	if err := pc.RegisterCommentServiceHandlerClient(ctx, h.mux, commentClient{serviceManager.CommentService(), h}); err != nil {
+		return nil, err
+	}
+	if err := pu.RegisterUserServiceHandlerClient(ctx, h.mux, serviceManager.UserService()); err != nil {
+		return nil, err
+	}
+
+	grpc, err := newGrpcWeb(serviceManager, cfg.APIV2AllowedOrigins, h.invalidate, log)
+	if err != nil {
+		return nil, err
+	}
+	h.grpc = grpc
+
+	return h, nil
+}
+
+// REST serves /v2 paths of google.api.http options
+func (h *handlerV2) REST(c *gin.Context) {
+	h.mux.ServeHTTP(c.Writer, c.Request.WithContext(publicapi.With(c.Request.Context())))
+}
+
+// GRPCWeb serves gRPC-Web calls, their paths are full method names like
+// /post.PostService/GetPostById
+func (h *handlerV2) GRPCWeb(c *gin.Context) {
+	if !h.grpc.wrapped.IsGrpcWebRequest(c.Request) {
+		c.JSON(http.StatusUnsupportedMediaType, gin.H{
+			"error": "only gRPC-Web requests are served",
+		})
+		return
+	}
+
+	h.grpc.wrapped.ServeHTTP(c.Writer, c.Request.WithContext(publicapi.With(c.Request.Context())))
+}
+
+// Services are full names of services served with gRPC-Web
+func (h *handlerV2) Services() []string {
+	names := []string{}
+	for name := range h.grpc.services {
+		names = append(names, name)
+	}
+	return names
+}
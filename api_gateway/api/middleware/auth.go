@@ -1,12 +1,17 @@
 package middleware
 
 import (
+	"context"
 	"net/http"
 	"strings"
 
 	"github.com/burxondv/new-services/api-gateway/api/handlers/models"
 	"github.com/burxondv/new-services/api-gateway/api/handlers/token"
 	"github.com/burxondv/new-services/api-gateway/config"
+	pu "github.com/burxondv/new-services/api-gateway/genproto/user"
+	"github.com/burxondv/new-services/api-gateway/pkg/cache"
+	"github.com/burxondv/new-services/api-gateway/pkg/identity"
+	"github.com/burxondv/new-services/api-gateway/pkg/logger"
 	"github.com/casbin/casbin/v2"
 	"github.com/gin-gonic/gin"
 	"github.com/golang-jwt/jwt"
@@ -16,73 +21,86 @@ type JWTRoleAuthorizer struct {
 	enforcer   *casbin.Enforcer
 	cfg        config.Config
 	jwtHandler token.JWTHandler
+	users      pu.UserServiceClient
+	cache      *cache.Cache
+	log        logger.Logger
 }
 
-// NewAuthorizer is a middleware for gin to get role and allow or deny access to endpoints
-func NewAuthorizer(e *casbin.Enforcer, jwtHandler token.JWTHandler, cfg config.Config) gin.HandlerFunc {
+// NewAuthorizer is a middleware for gin to get role and allow or deny access to endpoints,
+// tokens of banned and suspended users are rejected
+func NewAuthorizer(e *casbin.Enforcer, jwtHandler token.JWTHandler, cfg config.Config, users pu.UserServiceClient, cache *cache.Cache, log logger.Logger) gin.HandlerFunc {
 	a := &JWTRoleAuthorizer{
 		enforcer:   e,
 		cfg:        cfg,
 		jwtHandler: jwtHandler,
+		users:      users,
+		cache:      cache,
+		log:        log,
 	}
 
 	return func(c *gin.Context) {
-		allow, err := a.CheckPermission(c.Request)
+		// the authorizer is shared by all requests, so claims of the request
+		// are parsed once here and passed to every step
+		claims, err := a.RequestClaims(c.Request)
 		if err != nil {
-			v, _ := err.(*jwt.ValidationError)
-			if v.Errors == jwt.ValidationErrorExpired {
+			if v, ok := err.(*jwt.ValidationError); ok && v.Errors == jwt.ValidationErrorExpired {
 				a.RequireRefresh(c)
 			} else {
 				a.REquirePermission(c)
 			}
-		} else if !allow {
+			return
+		}
+
+		allow, err := a.CheckPermission(c.Request, claims)
+		if err != nil || !allow {
 			a.REquirePermission(c)
+			return
+		}
+
+		a.CheckAccount(c, claims)
+		if !c.IsAborted() {
+			a.SetIdentity(c, claims)
 		}
 	}
 }
 
-// unauthorized
-func (a *JWTRoleAuthorizer) GetRole(r *http.Request) (string, error) {
-	var (
-		role   string
-		claims jwt.MapClaims
-		err    error
-	)
-
+// RequestClaims parses the token of the request, requests without a token
+// have no claims
+func (a *JWTRoleAuthorizer) RequestClaims(r *http.Request) (jwt.MapClaims, error) {
 	jwtToken := r.Header.Get("Authorization")
-	if jwtToken == "" {
-		return "unauthorized", nil
-	} else if strings.Contains(jwtToken, "Basic") {
-		return "unauthorized", nil
+	if jwtToken == "" || strings.Contains(jwtToken, "Basic") {
+		return nil, nil
 	}
 
-	a.jwtHandler.Token = jwtToken
-	claims, err = a.jwtHandler.ExtractClaims()
-	if err != nil {
-		return "", err
+	return token.ExtractClaim(jwtToken, []byte(a.jwtHandler.SigninKey))
+}
+
+// unauthorized
+func (a *JWTRoleAuthorizer) GetRole(claims jwt.MapClaims) string {
+	var role string
+
+	if claims == nil {
+		return "unauthorized"
 	}
 
-	if claims["role"].(string) == "user" {
+	if claims["role"] == "user" {
 		role = "user"
-	} else if claims["role"].(string) == "admin" {
+	} else if claims["role"] == "admin" {
 		role = "admin"
-	} else if claims["role"].(string) == "super_admin" {
+	} else if claims["role"] == "super_admin" {
 		role = "super_admin"
-	} else if claims["role"].(string) == "moderator" {
+	} else if claims["role"] == "moderator" {
 		role = "moderator"
 	} else {
 		role = "unknown"
 	}
 
-	return role, nil
+	return role
 }
 
 // CheckPermission checks whether user is allowed to use certain endpoint
-func (a *JWTRoleAuthorizer) CheckPermission(r *http.Request) (bool, error) {
-	user, err := a.GetRole(r)
-	if err != nil {
-		return false, err
-	}
+func (a *JWTRoleAuthorizer) CheckPermission(r *http.Request, claims jwt.MapClaims) (bool, error) {
+	user := a.GetRole(claims)
 
 	method := r.Method
 	path := r.URL.Path
@@ -95,6 +113,58 @@ func (a *JWTRoleAuthorizer) CheckPermission(r *http.Request) (bool, error) {
 	return allowed, nil
 }
 
+// CheckAccount rejects requests of banned and suspended users, tokens issued
+// before the restriction are rejected too. State of the account is cached for
+// AccountCacheTTL seconds, requests are allowed when user service is not
+// available.
+func (a *JWTRoleAuthorizer) CheckAccount(c *gin.Context, claims jwt.MapClaims) {
+	sub, _ := claims["sub"].(string)
+	if sub == "" {
+		return
+	}
+
+	var account models.AccountStatus
+	err := a.cache.Fetch(c.Request.Context(), cache.AccountResource(sub), "status", a.cfg.AccountCacheTTL, &account, func(ctx context.Context) (interface{}, error) {
+		res, err := a.users.GetAccountStatus(ctx, &pu.Request{Str: sub})
+		if err != nil {
+			return nil, err
+		}
+
+		return models.AccountStatus{
+			Id:     res.Id,
+			State:  res.State,
+			Until:  res.Until,
+			Reason: res.Reason,
+		}, nil
+	})
+	if err != nil {
+		logger.WithContext(a.log, c.Request.Context()).Error("failed to get account status", logger.String("user_id", sub), logger.Error(err))
+		return
+	}
+
+	switch account.State {
+	case "banned", "suspended":
+		c.AbortWithStatusJSON(http.StatusForbidden, models.StandardErrorModel{
+			Error: models.Error{
+				Message: "account is " + account.State,
+			},
+		})
+	}
+}
+
+// SetIdentity puts the user of the token into the request context, it is
+// signed and sent to services with every call made for the request
+func (a *JWTRoleAuthorizer) SetIdentity(c *gin.Context, claims jwt.MapClaims) {
+	sub, _ := claims["sub"].(string)
+	role, _ := claims["role"].(string)
+	if sub == "" {
+		return
+	}
+
+	ctx := identity.With(c.Request.Context(), identity.Identity{Subject: sub, Role: role})
+	c.Request = c.Request.WithContext(ctx)
+}
+
 func (a *JWTRoleAuthorizer) REquirePermission(c *gin.Context) {
 	c.AbortWithStatus(403)
 }
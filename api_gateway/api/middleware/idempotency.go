@@ -0,0 +1,212 @@
+package middleware
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/burxondv/new-services/api-gateway/api/handlers/token"
+	"github.com/burxondv/new-services/api-gateway/config"
+	"github.com/burxondv/new-services/api-gateway/pkg/logger"
+	"github.com/burxondv/new-services/api-gateway/storage/repo"
+
+	"github.com/gin-gonic/gin"
+)
+
+const (
+	IdempotencyKeyHeader = "Idempotency-Key"
+	// IdempotentReplayedHeader is set on responses replayed from the store
+	IdempotentReplayedHeader = "Idempotent-Replayed"
+
+	maxIdempotencyKeyLen = 255
+)
+
+// idempotentResponse is stored for the key. It is in progress until the
+// first request completes.
+type idempotentResponse struct {
+	Hash        string `json:"hash"`
+	Done        bool   `json:"done"`
+	Status      int    `json:"status,omitempty"`
+	ContentType string `json:"content_type,omitempty"`
+	Body        []byte `json:"body,omitempty"`
+}
+
+// Idempotency makes POST, PUT and DELETE requests with Idempotency-Key
+// header safe to retry. The first response is stored for the user and the
+// key and it is replayed to retries with the same method, path and body.
+// Requests with the same key and other content are rejected with 422, and
+// duplicates sent while the first request is in progress with 409. Server
+// errors are not stored, so the request can be retried. When Redis is not
+// available requests are handled without the guarantee.
+func Idempotency(store repo.RedisRepo, jwtHandler token.JWTHandler, cfg config.Config, log logger.Logger) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		switch c.Request.Method {
+		case http.MethodPost, http.MethodPut, http.MethodDelete:
+		default:
+			return
+		}
+
+		key := c.GetHeader(IdempotencyKeyHeader)
+		if key == "" {
+			return
+		}
+		if len(key) > maxIdempotencyKeyLen {
+			c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{
+				"error": "idempotency key is too long",
+			})
+			return
+		}
+
+		// bodies are read to be hashed, the largest allowed is an attachment
+		// upload with the multipart overhead
+		body, err := io.ReadAll(http.MaxBytesReader(c.Writer, c.Request.Body, cfg.MaxAttachmentSize+1<<20))
+		var tooLarge *http.MaxBytesError
+		if errors.As(err, &tooLarge) {
+			c.AbortWithStatusJSON(http.StatusRequestEntityTooLarge, gin.H{
+				"error": "request body is too large",
+			})
+			return
+		} else if err != nil {
+			c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{
+				"error": err.Error(),
+			})
+			return
+		}
+		c.Request.Body = io.NopCloser(bytes.NewReader(body))
+
+		storeKey := "idempotency:" + requestUser(c.Request, jwtHandler) + ":" + key
+		hash := requestHash(c.Request, body)
+
+		locked, err := json.Marshal(idempotentResponse{Hash: hash})
+		if err != nil {
+			return
+		}
+
+		ok, err := store.SetNX(c.Request.Context(), storeKey, string(locked), time.Duration(cfg.IdempotencyLockTTL)*time.Second)
+		if err != nil {
+			log.Error("failed to lock idempotency key", logger.Error(err))
+			return
+		}
+		if !ok {
+			replay(c, store, storeKey, hash, log)
+			return
+		}
+
+		w := &responseRecorder{ResponseWriter: c.Writer}
+		c.Writer = w
+		c.Next()
+
+		// the response is saved even if the client is gone
+		ctx := context.Background()
+		if w.Status() >= http.StatusInternalServerError {
+			if _, err := store.Del(ctx, storeKey); err != nil {
+				log.Error("failed to release idempotency key", logger.Error(err))
+			}
+			return
+		}
+
+		done, err := json.Marshal(idempotentResponse{
+			Hash:        hash,
+			Done:        true,
+			Status:      w.Status(),
+			ContentType: w.Header().Get("Content-Type"),
+			Body:        w.body.Bytes(),
+		})
+		if err == nil {
+			err = store.Set(ctx, storeKey, string(done), time.Duration(cfg.IdempotencyTTL)*time.Second)
+		}
+		if err != nil {
+			log.Error("failed to save idempotent response", logger.Error(err))
+		}
+	}
+}
+
+// replay writes the stored response of the key
+func replay(c *gin.Context, store repo.RedisRepo, storeKey, hash string, log logger.Logger) {
+	data, err := store.Get(c.Request.Context(), storeKey)
+	if err == repo.ErrNotFound {
+		// the first request failed or the key expired in the meantime
+		c.AbortWithStatusJSON(http.StatusConflict, gin.H{
+			"error": "request with the same idempotency key is in progress",
+		})
+		return
+	} else if err != nil {
+		log.Error("failed to get idempotent response", logger.Error(err))
+		c.AbortWithStatusJSON(http.StatusServiceUnavailable, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	var res idempotentResponse
+	if err := json.Unmarshal([]byte(data), &res); err != nil {
+		log.Error("failed to decode idempotent response", logger.Error(err))
+		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	switch {
+	case res.Hash != hash:
+		c.AbortWithStatusJSON(http.StatusUnprocessableEntity, gin.H{
+			"error": "idempotency key is already used for another request",
+		})
+	case !res.Done:
+		c.Header("Retry-After", "1")
+		c.AbortWithStatusJSON(http.StatusConflict, gin.H{
+			"error": "request with the same idempotency key is in progress",
+		})
+	default:
+		c.Header(IdempotentReplayedHeader, "true")
+		c.Data(res.Status, res.ContentType, res.Body)
+		c.Abort()
+	}
+}
+
+// requestUser returns subject of the JWT, keys of anonymous requests are
+// shared by all of them
+func requestUser(r *http.Request, jwtHandler token.JWTHandler) string {
+	jwtHandler.Token = r.Header.Get("Authorization")
+	if jwtHandler.Token == "" {
+		return "anonymous"
+	}
+
+	claims, err := jwtHandler.ExtractClaims()
+	if err != nil {
+		return "anonymous"
+	}
+
+	sub, _ := claims["sub"].(string)
+	return sub
+}
+
+func requestHash(r *http.Request, body []byte) string {
+	h := sha256.New()
+	h.Write([]byte(r.Method + " " + r.URL.RequestURI() + "\n"))
+	h.Write(body)
+
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// responseRecorder keeps a copy of the response body
+type responseRecorder struct {
+	gin.ResponseWriter
+	body bytes.Buffer
+}
+
+func (w *responseRecorder) Write(b []byte) (int, error) {
+	w.body.Write(b)
+	return w.ResponseWriter.Write(b)
+}
+
+func (w *responseRecorder) WriteString(s string) (int, error) {
+	w.body.WriteString(s)
+	return w.ResponseWriter.WriteString(s)
+}
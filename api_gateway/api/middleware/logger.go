@@ -0,0 +1,61 @@
+package middleware
+
+import (
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/burxondv/new-services/api-gateway/pkg/logger"
+
+	"github.com/gin-gonic/gin"
+)
+
+// unmatchedRoute is logged for paths without a route
+const unmatchedRoute = "unmatched"
+
+// Logger writes one structured line for every request. Path parameters
+// with sensitive names, like the password of login, are redacted, query
+// strings are not logged because they carry stream tokens.
+func Logger(log logger.Logger) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		start := time.Now()
+		c.Next()
+
+		route := c.FullPath()
+		if route == "" {
+			route = unmatchedRoute
+		}
+		fields := []logger.Field{
+			logger.String("method", c.Request.Method),
+			logger.String("route", route),
+			logger.String("path", redactPath(c)),
+			logger.Int("status", c.Writer.Status()),
+			logger.Duration("duration", time.Since(start)),
+			logger.String("client_ip", c.ClientIP()),
+			logger.Int("size", c.Writer.Size()),
+		}
+		if len(c.Errors) > 0 {
+			fields = append(fields, logger.String("errors", c.Errors.String()))
+		}
+
+		l := logger.WithContext(log, c.Request.Context())
+		switch status := c.Writer.Status(); {
+		case status >= http.StatusInternalServerError:
+			l.Error("http request", fields...)
+		case status >= http.StatusBadRequest:
+			l.Warn("http request", fields...)
+		default:
+			l.Info("http request", fields...)
+		}
+	}
+}
+
+func redactPath(c *gin.Context) string {
+	path := c.Request.URL.Path
+	for _, param := range c.Params {
+		if logger.Sensitive(param.Key) && param.Value != "" {
+			path = strings.Replace(path, "/"+param.Value, "/"+logger.Redacted, 1)
+		}
+	}
+	return path
+}
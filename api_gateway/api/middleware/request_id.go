@@ -0,0 +1,22 @@
+package middleware
+
+import (
+	"github.com/burxondv/new-services/api-gateway/pkg/requestid"
+
+	"github.com/gin-gonic/gin"
+)
+
+// RequestID accepts X-Request-ID of the client or generates a new one. The
+// id is returned in the response and sent to the services with every call.
+func RequestID() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		id := c.GetHeader(requestid.Header)
+		if !requestid.Valid(id) {
+			id = requestid.New()
+		}
+
+		c.Header(requestid.Header, id)
+		c.Request = c.Request.WithContext(requestid.With(c.Request.Context(), id))
+		c.Next()
+	}
+}
@@ -0,0 +1,23 @@
+package middleware
+
+import (
+	"strings"
+
+	"github.com/gin-gonic/gin"
+)
+
+// StreamToken lets clients of stream endpoints pass the JWT in access_token
+// query param, because browsers can not set headers for WebSocket and
+// EventSource requests
+func StreamToken() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if !strings.HasPrefix(c.Request.URL.Path, "/v1/stream/") {
+			return
+		}
+
+		token := c.Query("access_token")
+		if token != "" && c.GetHeader("Authorization") == "" {
+			c.Request.Header.Set("Authorization", token)
+		}
+	}
+}
@@ -4,9 +4,13 @@ import (
 	_ "github.com/burxondv/new-services/api-gateway/api/docs" // swag
 	"github.com/burxondv/new-services/api-gateway/api/handlers/token"
 	v1 "github.com/burxondv/new-services/api-gateway/api/handlers/v1"
+	v2 "github.com/burxondv/new-services/api-gateway/api/handlers/v2"
 	"github.com/burxondv/new-services/api-gateway/api/middleware"
 	"github.com/burxondv/new-services/api-gateway/config"
+	"github.com/burxondv/new-services/api-gateway/pkg/cache"
 	"github.com/burxondv/new-services/api-gateway/pkg/logger"
+	"github.com/burxondv/new-services/api-gateway/pkg/metrics"
+	"github.com/burxondv/new-services/api-gateway/pkg/realtime"
 	"github.com/burxondv/new-services/api-gateway/services"
 	"github.com/burxondv/new-services/api-gateway/storage/repo"
 	"github.com/casbin/casbin/v2"
@@ -14,6 +18,7 @@ import (
 	ginSwagger "github.com/swaggo/gin-swagger"
 
 	"github.com/gin-gonic/gin"
+	"go.opentelemetry.io/contrib/instrumentation/github.com/gin-gonic/gin/otelgin"
 )
 
 // Option ...
@@ -23,6 +28,8 @@ type Option struct {
 	ServiceManager  services.IServiceManager
 	InMemoryStorage repo.RedisRepo
 	CasbinEnforcer  *casbin.Enforcer
+	Broker          *realtime.Broker
+	Cache           *cache.Cache
 }
 
 // Swagger...
@@ -36,7 +43,10 @@ type Option struct {
 func New(option Option) *gin.Engine {
 	router := gin.New()
 
-	router.Use(gin.Logger())
+	router.Use(otelgin.Middleware("api_gateway"))
+	router.Use(middleware.RequestID())
+	router.Use(middleware.Logger(option.Logger))
+	router.Use(metrics.Middleware())
 	router.Use(gin.Recovery())
 
 	jwtHandler := token.JWTHandler{
@@ -50,11 +60,15 @@ func New(option Option) *gin.Engine {
 		Cfg:            option.Conf,
 		Redis:          option.InMemoryStorage,
 		JWTHandler:     jwtHandler,
-		Enforcer:       *option.CasbinEnforcer,
+		Enforcer:       option.CasbinEnforcer,
+		Broker:         option.Broker,
+		Cache:          option.Cache,
 	})
 
 	router.Use(gin.Recovery())
-	router.Use(middleware.NewAuthorizer(option.CasbinEnforcer, jwtHandler, option.Conf))
+	router.Use(middleware.StreamToken())
+	router.Use(middleware.NewAuthorizer(option.CasbinEnforcer, jwtHandler, option.Conf, option.ServiceManager.UserService(), option.Cache, option.Logger))
+	router.Use(middleware.Idempotency(option.InMemoryStorage, jwtHandler, option.Conf, option.Logger))
 
 	api := router.Group("/v1")
 
@@ -79,6 +93,27 @@ func New(option Option) *gin.Engine {
 	api.GET("/users", handlerV1.GetAllUsers)
 	api.PUT("/users", handlerV1.UpdateUser)
 	api.DELETE("/users/:id", handlerV1.DeleteUser)
+	api.DELETE("/users", handlerV1.DeleteAccount)
+	api.POST("/users/export", handlerV1.RequestDataExport)
+	api.GET("/users/export/:id", handlerV1.GetDataExport)
+	api.GET("/users/export/:id/download", handlerV1.DownloadDataExport)
+	api.POST("/users/:id/follow", handlerV1.FollowUser)
+	api.DELETE("/users/:id/follow", handlerV1.UnfollowUser)
+	api.GET("/users/:id/followers", handlerV1.GetFollowers)
+
+	// blocks and mutes ...
+	api.POST("/users/:id/block", handlerV1.BlockUser)
+	api.DELETE("/users/:id/block", handlerV1.UnblockUser)
+	api.GET("/users/blocked", handlerV1.GetBlockedUsers)
+	api.POST("/users/:id/mute", handlerV1.MuteUser)
+	api.DELETE("/users/:id/mute", handlerV1.UnmuteUser)
+	api.GET("/users/muted", handlerV1.GetMutedUsers)
+
+	// bans ...
+	api.GET("/users/:id/account", handlerV1.GetAccountStatus)
+	api.PUT("/users/:id/suspend", handlerV1.SuspendUser)
+	api.PUT("/users/:id/ban", handlerV1.BanUser)
+	api.PUT("/users/:id/shadow-ban", handlerV1.ShadowBanUser)
 
 	// posts ...
 	api.POST("/posts", handlerV1.CreatePost)
@@ -87,15 +122,70 @@ func New(option Option) *gin.Engine {
 	api.GET("/posts/users/:id", handlerV1.GetPostsUser)
 	api.PUT("/posts/:id", handlerV1.UpdatePost)
 	api.DELETE("/posts/:id", handlerV1.DeletePost)
+	api.PUT("/posts/:id/like", handlerV1.LikePost)
+
+	// revisions ...
+	api.GET("/posts/:id/revisions", handlerV1.GetRevisions)
+	api.GET("/posts/:id/revisions/diff", handlerV1.DiffRevisions)
+	api.POST("/posts/:id/revisions/:revision/restore", handlerV1.RestoreRevision)
+
+	// tags ...
+	api.GET("/tags/:tag/posts", handlerV1.GetPostsByTag)
+	api.GET("/tags/autocomplete", handlerV1.AutocompleteTags)
+	api.GET("/tags/trending", handlerV1.GetTrendingTags)
+
+	// attachments ...
+	api.POST("/posts/:id/attachments", handlerV1.UploadAttachment)
+	api.GET("/posts/:id/attachments", handlerV1.GetAttachments)
+	api.GET("/attachments/:id", handlerV1.DownloadAttachment)
+	api.DELETE("/attachments/:id", handlerV1.DeleteAttachment)
 
 	// comment ...
 	api.POST("/comments", handlerV1.WriteComment)
 	api.GET("/comments/:id", handlerV1.GetComments)
 	api.DELETE("/comments/:id", handlerV1.DeleteComment)
 
+	// moderation ...
+	api.POST("/reports", handlerV1.CreateReport)
+	api.GET("/moderation/queue", handlerV1.GetModerationQueue)
+	api.GET("/moderation/cases/:id", handlerV1.GetModerationCase)
+	api.PUT("/moderation/cases/:id/assign", handlerV1.AssignModerationCase)
+	api.PUT("/moderation/cases/:id/resolve", handlerV1.ResolveModerationCase)
+
+	// cache ...
+	api.GET("/cache/stats", handlerV1.GetCacheStats)
+
+	// notifications ...
+	api.GET("/notifications", handlerV1.GetNotifications)
+	api.PUT("/notifications/read", handlerV1.MarkNotificationsRead)
+	api.GET("/notifications/preferences", handlerV1.GetNotificationPreferences)
+	api.PUT("/notifications/preferences", handlerV1.UpdateNotificationPreferences)
+
+	// stream ...
+	api.GET("/stream/events", handlerV1.StreamEvents)
+	api.GET("/stream/ws", handlerV1.StreamWebSocket)
+
+	// metrics and health
+	router.GET("/metrics", gin.WrapH(metrics.Handler()))
+	router.GET("/healthz", handlerV1.Healthz)
+	router.GET("/readyz", handlerV1.Readyz)
+
 	// swagger
 	url := ginSwagger.URL("swagger/doc.json")
 	api.GET("swagger/*any", ginSwagger.WrapHandler(swaggerFiles.Handler, url))
 
+	// api v2 generated from protos, it goes through the same middlewares
+	if option.Conf.APIV2Enabled {
+		handlerV2, err := v2.New(option.ServiceManager, option.Cache, option.Conf, option.Logger)
+		if err != nil {
+			option.Logger.Fatal("failed to create api v2", logger.Error(err))
+		}
+
+		router.Any("/v2/*any", handlerV2.REST)
+		for _, service := range handlerV2.Services() {
+			router.POST("/"+service+"/:method", handlerV2.GRPCWeb)
+		}
+	}
+
 	return router
 }
@@ -0,0 +1,163 @@
+// devcerts makes a local CA and a certificate for every service, it is for
+// development only. The CA is reused when it is already in the directory, so
+// certificates of new services can be added later.
+//
+//	go run ./cmd/devcerts -out ../certs
+package main
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"flag"
+	"fmt"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+func main() {
+	out := flag.String("out", "../certs", "directory of certificates")
+	names := flag.String("services", "api_gateway,user_service,post_service,comment_service,notification_service,moderation_service", "comma separated service names")
+	hosts := flag.String("hosts", "localhost,127.0.0.1", "comma separated hosts added to every certificate")
+	flag.Parse()
+
+	if err := run(*out, strings.Split(*names, ","), strings.Split(*hosts, ",")); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
+
+func run(out string, names, hosts []string) error {
+	if err := os.MkdirAll(out, 0o700); err != nil {
+		return err
+	}
+
+	ca, caKey, err := loadCA(out)
+	if os.IsNotExist(err) {
+		ca, caKey, err = createCA(out)
+	}
+	if err != nil {
+		return fmt.Errorf("ca: %w", err)
+	}
+
+	for _, name := range names {
+		name = strings.TrimSpace(name)
+		if name == "" {
+			continue
+		}
+		if err := createCert(out, name, hosts, ca, caKey); err != nil {
+			return fmt.Errorf("%s: %w", name, err)
+		}
+		fmt.Println("created", filepath.Join(out, name+".crt"))
+	}
+
+	return nil
+}
+
+func loadCA(out string) (*x509.Certificate, *ecdsa.PrivateKey, error) {
+	pair, err := tls.LoadX509KeyPair(filepath.Join(out, "ca.crt"), filepath.Join(out, "ca.key"))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	ca, err := x509.ParseCertificate(pair.Certificate[0])
+	if err != nil {
+		return nil, nil, err
+	}
+	key, ok := pair.PrivateKey.(*ecdsa.PrivateKey)
+	if !ok {
+		return nil, nil, fmt.Errorf("ca key is not ECDSA")
+	}
+
+	return ca, key, nil
+}
+
+func createCA(out string) (*x509.Certificate, *ecdsa.PrivateKey, error) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	template := &x509.Certificate{
+		SerialNumber:          serial(),
+		Subject:               pkix.Name{CommonName: "new-services dev CA"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().AddDate(10, 0, 0),
+		KeyUsage:              x509.KeyUsageCertSign | x509.KeyUsageCRLSign,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		return nil, nil, err
+	}
+	if err := write(out, "ca", der, key); err != nil {
+		return nil, nil, err
+	}
+
+	ca, err := x509.ParseCertificate(der)
+	return ca, key, err
+}
+
+// createCert makes the certificate of a service, its common name is the
+// service name which other services check
+func createCert(out, name string, hosts []string, ca *x509.Certificate, caKey *ecdsa.PrivateKey) error {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return err
+	}
+
+	template := &x509.Certificate{
+		SerialNumber: serial(),
+		Subject:      pkix.Name{CommonName: name},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().AddDate(1, 0, 0),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+		DNSNames:     []string{name, strings.ReplaceAll(name, "_", "-")},
+	}
+	for _, host := range hosts {
+		host = strings.TrimSpace(host)
+		if ip := net.ParseIP(host); ip != nil {
+			template.IPAddresses = append(template.IPAddresses, ip)
+		} else if host != "" {
+			template.DNSNames = append(template.DNSNames, host)
+		}
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, ca, &key.PublicKey, caKey)
+	if err != nil {
+		return err
+	}
+	return write(out, name, der, key)
+}
+
+func write(out, name string, der []byte, key *ecdsa.PrivateKey) error {
+	keyDer, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		return err
+	}
+
+	crt := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+	if err := os.WriteFile(filepath.Join(out, name+".crt"), crt, 0o644); err != nil {
+		return err
+	}
+	pemKey := pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDer})
+	return os.WriteFile(filepath.Join(out, name+".key"), pemKey, 0o600)
+}
+
+func serial() *big.Int {
+	n, err := rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), 128))
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
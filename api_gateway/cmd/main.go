@@ -1,19 +1,31 @@
 package main
 
 import (
+	"context"
 	"fmt"
+	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
 
 	"github.com/burxondv/new-services/api-gateway/api"
 	"github.com/burxondv/new-services/api-gateway/config"
+	"github.com/burxondv/new-services/api-gateway/pkg/backoff"
+	"github.com/burxondv/new-services/api-gateway/pkg/cache"
+	"github.com/burxondv/new-services/api-gateway/pkg/events"
+	"github.com/burxondv/new-services/api-gateway/pkg/identity"
 	"github.com/burxondv/new-services/api-gateway/pkg/logger"
+	"github.com/burxondv/new-services/api-gateway/pkg/metrics"
+	"github.com/burxondv/new-services/api-gateway/pkg/mtls"
+	"github.com/burxondv/new-services/api-gateway/pkg/realtime"
+	"github.com/burxondv/new-services/api-gateway/pkg/tracing"
 	"github.com/burxondv/new-services/api-gateway/services"
 	"github.com/burxondv/new-services/api-gateway/storage/redis"
 	"github.com/casbin/casbin/v2"
 	defaultrolemanager "github.com/casbin/casbin/v2/rbac/default-role-manager"
 	"github.com/casbin/casbin/v2/util"
 
-	r "github.com/gomodule/redigo/redis"
-
 	// gormadapter "github.com/casbin/gorm-adapter/v2"
 )
 
@@ -21,6 +33,22 @@ func main() {
 	cfg := config.Load()
 	log := logger.New(cfg.LogLevel, "api_gateway")
 
+	shutdownTracing, err := tracing.Init(context.Background(), tracing.Config{
+		ServiceName:  "api_gateway",
+		Exporter:     cfg.TraceExporter,
+		OTLPEndpoint: cfg.OTLPEndpoint,
+		File:         cfg.TraceFile,
+		SampleRatio:  cfg.TraceSampleRatio,
+	})
+	if err != nil {
+		log.Fatal("failed to init tracing", logger.Error(err))
+	}
+	defer shutdownTracing(context.Background())
+
+	// SIGINT and SIGTERM start graceful shutdown
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+
 	log.Info("main: sqlxConfig",
 		logger.String("host", cfg.PostgresHost),
 		logger.String("port", cfg.PostgresPort),
@@ -50,34 +78,73 @@ func main() {
 		log.Error("casbin load policy error", logger.Error(err))
 	}
 
-	serviceManager, err := services.NewServiceManager(&cfg)
+	// certs is nil when TLS is disabled
+	var certs *mtls.Certs
+	if cfg.TLSEnabled {
+		certs, err = mtls.Load(mtls.Config{CertFile: cfg.TLSCertFile, KeyFile: cfg.TLSKeyFile, CAFile: cfg.TLSCAFile}, log)
+		if err != nil {
+			log.Fatal("failed to load certificates", logger.Error(err))
+		}
+		go certs.Run(ctx, time.Duration(cfg.TLSReloadInterval)*time.Second)
+	}
+
+	signer := identity.NewSigner(cfg.IdentitySecret, time.Duration(cfg.IdentityMaxAge)*time.Second)
+	serviceManager, err := services.NewServiceManager(&cfg, certs, signer)
 	if err != nil {
-		log.Error("gRPC dial error: ", logger.Error(err))
+		log.Fatal("gRPC dial error: ", logger.Error(err))
 	}
+	defer serviceManager.Close()
 
-	pool := r.Pool{
-		MaxIdle:   80,
-		MaxActive: 12000,
-		Dial: func() (r.Conn, error) {
-			c, err := r.Dial("tcp", fmt.Sprintf("%s:%s", cfg.RedisHost, cfg.RedisPort))
-			if err != nil {
-				panic(err.Error())
-			}
-			return c, err
-		},
+	pool := redis.NewPool(fmt.Sprintf("%s:%s", cfg.RedisHost, cfg.RedisPort))
+	redisRepo := redis.NewRedisRepo(pool)
+	defer pool.Close()
+	metrics.RegisterRedisPool(pool)
+
+	// gateway starts without Redis after StartupTimeout, requests which need
+	// it fail until it is up
+	err = backoff.Wait(ctx, time.Duration(cfg.StartupTimeout)*time.Second, redisRepo.Ping, func(err error, delay time.Duration) {
+		log.Warn("redis is not ready", logger.Duration("retry_in", delay), logger.Error(err))
+	})
+	if err != nil {
+		log.Error("redis ping error: ", logger.Error(err))
 	}
 
+	broker := realtime.NewBroker(redisRepo)
+	realtime.NewRelay(broker, serviceManager, log).Run(ctx)
+
+	// responses changed by services themselves are invalidated on their events
+	responseCache := cache.New(redisRepo)
+	hostname, _ := os.Hostname()
+	go responseCache.RunConsumer(ctx, events.NewRedisBus(pool, log), hostname, log)
+
 	server := api.New(api.Option{
 		Conf:            cfg,
 		ServiceManager:  serviceManager,
 		Logger:          log,
-		InMemoryStorage: redis.NewRedisRepo(&pool),
+		InMemoryStorage: redisRepo,
 		CasbinEnforcer:  casbinEnForcer,
+		Broker:          broker,
+		Cache:           responseCache,
 	})
 
-	if err := server.Run(cfg.HTTPPort); err != nil {
-		log.Fatal("failed to run HTTP server: ", logger.Error(err))
-		panic(err)
+	httpServer := &http.Server{
+		Addr:    cfg.HTTPPort,
+		Handler: server,
+	}
+	go func() {
+		if err := httpServer.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+			log.Fatal("failed to run HTTP server: ", logger.Error(err))
+		}
+	}()
+
+	// in-flight requests are drained, open streams are cut after ShutdownTimeout
+	<-ctx.Done()
+	log.Info("main: shutting down")
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), time.Duration(cfg.ShutdownTimeout)*time.Second)
+	defer cancel()
+	if err := httpServer.Shutdown(shutdownCtx); err != nil {
+		log.Error("failed to shut down HTTP server gracefully", logger.Error(err))
+		httpServer.Close()
 	}
 
 	casbinEnForcer.GetRoleManager().(*defaultrolemanager.RoleManager).AddDomainMatchingFunc("keyMatch", util.KeyMatch)
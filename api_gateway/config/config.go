@@ -2,6 +2,7 @@ package config
 
 import (
 	"os"
+	"strings"
 
 	"github.com/spf13/cast"
 )
@@ -30,6 +31,12 @@ type Config struct {
 	CommentServiceHost string
 	CommentServicePort string
 
+	NotificationServiceHost string
+	NotificationServicePort string
+
+	ModerationServiceHost string
+	ModerationServicePort string
+
 	// redis...
 	RedisHost string
 	RedisPort string
@@ -39,6 +46,43 @@ type Config struct {
 	CasbinPolicyPath string
 
 	SigningKey string
+
+	// attachments...
+	MaxAttachmentSize int64 // in bytes
+
+	// cache of profile and post responses...
+	CacheTTL int // in seconds
+	// bans of users are applied to their tokens within this time
+	AccountCacheTTL int // in seconds
+
+	// responses of requests with Idempotency-Key...
+	IdempotencyTTL     int // in seconds
+	IdempotencyLockTTL int // in seconds, how long a key is held by a request in progress
+
+	// tracing...
+	TraceExporter    string // none, otlp, stdout or file
+	OTLPEndpoint     string
+	TraceFile        string
+	TraceSampleRatio float64
+
+	// startup and shutdown...
+	StartupTimeout  int // in seconds, how long Redis is waited for
+	ShutdownTimeout int // in seconds, requests still running after it are canceled
+
+	// tls...
+	TLSEnabled        bool // services are called with mutual TLS, certificates are made by `make dev-certs` in api_gateway
+	TLSCertFile       string
+	TLSKeyFile        string
+	TLSCAFile         string
+	TLSReloadInterval int // in seconds, how often certificate files are checked for changes
+
+	// identity...
+	IdentitySecret string // signs the end user of gRPC calls, the same in the gateway and all services
+	IdentityMaxAge int    // in seconds, how long a signed identity is accepted
+
+	// api v2...
+	APIV2Enabled        bool     // REST under /v2 and gRPC-Web generated from protos are served
+	APIV2AllowedOrigins []string // origins of browsers allowed to call gRPC-Web cross-origin, * allows all
 }
 
 func Load() Config {
@@ -65,6 +109,12 @@ func Load() Config {
 	c.CommentServiceHost = cast.ToString(getOrReturnDefault("COMMENT_SERVICE_HOST", "localhost"))
 	c.CommentServicePort = cast.ToString(getOrReturnDefault("COMMENT_SERVICE_PORT", "8020"))
 
+	c.NotificationServiceHost = cast.ToString(getOrReturnDefault("NOTIFICATION_SERVICE_HOST", "localhost"))
+	c.NotificationServicePort = cast.ToString(getOrReturnDefault("NOTIFICATION_SERVICE_PORT", "8030"))
+
+	c.ModerationServiceHost = cast.ToString(getOrReturnDefault("MODERATION_SERVICE_HOST", "localhost"))
+	c.ModerationServicePort = cast.ToString(getOrReturnDefault("MODERATION_SERVICE_PORT", "8040"))
+
 	// redis...
 	c.RedisHost = cast.ToString(getOrReturnDefault("REDIS_HOST", "localhost"))
 	c.RedisPort = cast.ToString(getOrReturnDefault("REDIS_PORT", "6379"))
@@ -75,9 +125,54 @@ func Load() Config {
 
 	c.SigningKey = cast.ToString(getOrReturnDefault("SIGNING_KEY", "$2a$12$p.TOcxMvceEAyd3WdWp.OORMbpv1nfHBHJ.XambWjk0Un7TWTBm66"))
 
+	c.MaxAttachmentSize = cast.ToInt64(getOrReturnDefault("MAX_ATTACHMENT_SIZE", 10<<20))
+
+	c.CacheTTL = cast.ToInt(getOrReturnDefault("CACHE_TTL", 60))
+	c.AccountCacheTTL = cast.ToInt(getOrReturnDefault("ACCOUNT_CACHE_TTL", 30))
+
+	c.IdempotencyTTL = cast.ToInt(getOrReturnDefault("IDEMPOTENCY_TTL", 24*60*60))
+	c.IdempotencyLockTTL = cast.ToInt(getOrReturnDefault("IDEMPOTENCY_LOCK_TTL", 60))
+
+	// tracing...
+	c.TraceExporter = cast.ToString(getOrReturnDefault("TRACE_EXPORTER", "none"))
+	c.OTLPEndpoint = cast.ToString(getOrReturnDefault("OTLP_ENDPOINT", "localhost:4317"))
+	c.TraceFile = cast.ToString(getOrReturnDefault("TRACE_FILE", "traces.json"))
+	c.TraceSampleRatio = cast.ToFloat64(getOrReturnDefault("TRACE_SAMPLE_RATIO", 1.0))
+
+	// startup and shutdown...
+	c.StartupTimeout = cast.ToInt(getOrReturnDefault("STARTUP_TIMEOUT", 10))
+	c.ShutdownTimeout = cast.ToInt(getOrReturnDefault("SHUTDOWN_TIMEOUT", 15))
+
+	// tls...
+	c.TLSEnabled = cast.ToBool(getOrReturnDefault("TLS_ENABLED", false))
+	c.TLSCertFile = cast.ToString(getOrReturnDefault("TLS_CERT_FILE", "../certs/api_gateway.crt"))
+	c.TLSKeyFile = cast.ToString(getOrReturnDefault("TLS_KEY_FILE", "../certs/api_gateway.key"))
+	c.TLSCAFile = cast.ToString(getOrReturnDefault("TLS_CA_FILE", "../certs/ca.crt"))
+	c.TLSReloadInterval = cast.ToInt(getOrReturnDefault("TLS_RELOAD_INTERVAL", 30))
+
+	// identity...
+	c.IdentitySecret = cast.ToString(getOrReturnDefault("IDENTITY_SECRET", "dev-identity-secret"))
+	c.IdentityMaxAge = cast.ToInt(getOrReturnDefault("IDENTITY_MAX_AGE", 60))
+
+	// api v2...
+	c.APIV2Enabled = cast.ToBool(getOrReturnDefault("API_V2_ENABLED", false))
+	c.APIV2AllowedOrigins = splitList(cast.ToString(getOrReturnDefault("API_V2_ALLOWED_ORIGINS", "")))
+
 	return c
 }
 
+// splitList splits comma separated values, empty values are skipped
+func splitList(s string) []string {
+	list := []string{}
+	for _, v := range strings.Split(s, ",") {
+		if v = strings.TrimSpace(v); v != "" {
+			list = append(list, v)
+		}
+	}
+
+	return list
+}
+
 func getOrReturnDefault(key string, defaultValue interface{}) interface{} {
 	_, exists := os.LookupEnv(key)
 	if exists {
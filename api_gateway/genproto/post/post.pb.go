@@ -7,6 +7,7 @@ import (
 	context "context"
 	fmt "fmt"
 	proto "github.com/golang/protobuf/proto"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
@@ -28,6 +29,7 @@ const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
 
 type Request struct {
 	Str                  string   `protobuf:"bytes,1,opt,name=str,proto3" json:"str"`
+	ViewerId             string   `protobuf:"bytes,2,opt,name=viewer_id,json=viewerId,proto3" json:"viewer_id"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -73,26 +75,33 @@ func (m *Request) GetStr() string {
 	return ""
 }
 
-type LikeRequest struct {
-	PostId               string   `protobuf:"bytes,1,opt,name=post_id,json=postId,proto3" json:"post_id"`
-	IsLiked              bool     `protobuf:"varint,2,opt,name=is_liked,json=isLiked,proto3" json:"is_liked"`
+func (m *Request) GetViewerId() string {
+	if m != nil {
+		return m.ViewerId
+	}
+	return ""
+}
+
+type ModerateRequest struct {
+	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id"`
+	Action               string   `protobuf:"bytes,2,opt,name=action,proto3" json:"action"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *LikeRequest) Reset()         { *m = LikeRequest{} }
-func (m *LikeRequest) String() string { return proto.CompactTextString(m) }
-func (*LikeRequest) ProtoMessage()    {}
-func (*LikeRequest) Descriptor() ([]byte, []int) {
+func (m *ModerateRequest) Reset()         { *m = ModerateRequest{} }
+func (m *ModerateRequest) String() string { return proto.CompactTextString(m) }
+func (*ModerateRequest) ProtoMessage()    {}
+func (*ModerateRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_e9e4a9952ba66d64, []int{1}
 }
-func (m *LikeRequest) XXX_Unmarshal(b []byte) error {
+func (m *ModerateRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *LikeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ModerateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_LikeRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ModerateRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -102,54 +111,52 @@ func (m *LikeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error)
 		return b[:n], nil
 	}
 }
-func (m *LikeRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_LikeRequest.Merge(m, src)
+func (m *ModerateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ModerateRequest.Merge(m, src)
 }
-func (m *LikeRequest) XXX_Size() int {
+func (m *ModerateRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *LikeRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_LikeRequest.DiscardUnknown(m)
+func (m *ModerateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ModerateRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_LikeRequest proto.InternalMessageInfo
+var xxx_messageInfo_ModerateRequest proto.InternalMessageInfo
 
-func (m *LikeRequest) GetPostId() string {
+func (m *ModerateRequest) GetId() string {
 	if m != nil {
-		return m.PostId
+		return m.Id
 	}
 	return ""
 }
 
-func (m *LikeRequest) GetIsLiked() bool {
+func (m *ModerateRequest) GetAction() string {
 	if m != nil {
-		return m.IsLiked
+		return m.Action
 	}
-	return false
+	return ""
 }
 
-type PostRequest struct {
-	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id"`
-	Title                string   `protobuf:"bytes,2,opt,name=title,proto3" json:"title"`
-	Description          string   `protobuf:"bytes,3,opt,name=description,proto3" json:"description"`
-	UserId               string   `protobuf:"bytes,4,opt,name=user_id,json=userId,proto3" json:"user_id"`
+type IdsRequest struct {
+	Ids                  []string `protobuf:"bytes,1,rep,name=ids,proto3" json:"ids"`
+	ViewerId             string   `protobuf:"bytes,2,opt,name=viewer_id,json=viewerId,proto3" json:"viewer_id"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *PostRequest) Reset()         { *m = PostRequest{} }
-func (m *PostRequest) String() string { return proto.CompactTextString(m) }
-func (*PostRequest) ProtoMessage()    {}
-func (*PostRequest) Descriptor() ([]byte, []int) {
+func (m *IdsRequest) Reset()         { *m = IdsRequest{} }
+func (m *IdsRequest) String() string { return proto.CompactTextString(m) }
+func (*IdsRequest) ProtoMessage()    {}
+func (*IdsRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_e9e4a9952ba66d64, []int{2}
 }
-func (m *PostRequest) XXX_Unmarshal(b []byte) error {
+func (m *IdsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *PostRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *IdsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_PostRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_IdsRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -159,67 +166,163 @@ func (m *PostRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error)
 		return b[:n], nil
 	}
 }
-func (m *PostRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_PostRequest.Merge(m, src)
+func (m *IdsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IdsRequest.Merge(m, src)
 }
-func (m *PostRequest) XXX_Size() int {
+func (m *IdsRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *PostRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_PostRequest.DiscardUnknown(m)
+func (m *IdsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_IdsRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_PostRequest proto.InternalMessageInfo
+var xxx_messageInfo_IdsRequest proto.InternalMessageInfo
 
-func (m *PostRequest) GetId() string {
+func (m *IdsRequest) GetIds() []string {
 	if m != nil {
-		return m.Id
+		return m.Ids
 	}
-	return ""
+	return nil
 }
 
-func (m *PostRequest) GetTitle() string {
+func (m *IdsRequest) GetViewerId() string {
 	if m != nil {
-		return m.Title
+		return m.ViewerId
 	}
 	return ""
 }
 
-func (m *PostRequest) GetDescription() string {
+type PostCountsResponse struct {
+	Counts               map[string]int64 `protobuf:"bytes,1,rep,name=counts,proto3" json:"counts" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *PostCountsResponse) Reset()         { *m = PostCountsResponse{} }
+func (m *PostCountsResponse) String() string { return proto.CompactTextString(m) }
+func (*PostCountsResponse) ProtoMessage()    {}
+func (*PostCountsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e9e4a9952ba66d64, []int{3}
+}
+func (m *PostCountsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PostCountsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PostCountsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PostCountsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PostCountsResponse.Merge(m, src)
+}
+func (m *PostCountsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *PostCountsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_PostCountsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PostCountsResponse proto.InternalMessageInfo
+
+func (m *PostCountsResponse) GetCounts() map[string]int64 {
 	if m != nil {
-		return m.Description
+		return m.Counts
+	}
+	return nil
+}
+
+type LikeRequest struct {
+	PostId               string   `protobuf:"bytes,1,opt,name=post_id,json=postId,proto3" json:"post_id"`
+	IsLiked              bool     `protobuf:"varint,2,opt,name=is_liked,json=isLiked,proto3" json:"is_liked"`
+	UserId               string   `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *LikeRequest) Reset()         { *m = LikeRequest{} }
+func (m *LikeRequest) String() string { return proto.CompactTextString(m) }
+func (*LikeRequest) ProtoMessage()    {}
+func (*LikeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e9e4a9952ba66d64, []int{4}
+}
+func (m *LikeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LikeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LikeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LikeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LikeRequest.Merge(m, src)
+}
+func (m *LikeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *LikeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_LikeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LikeRequest proto.InternalMessageInfo
+
+func (m *LikeRequest) GetPostId() string {
+	if m != nil {
+		return m.PostId
 	}
 	return ""
 }
 
-func (m *PostRequest) GetUserId() string {
+func (m *LikeRequest) GetIsLiked() bool {
+	if m != nil {
+		return m.IsLiked
+	}
+	return false
+}
+
+func (m *LikeRequest) GetUserId() string {
 	if m != nil {
 		return m.UserId
 	}
 	return ""
 }
 
-type UpdatePostRequest struct {
-	Title                string   `protobuf:"bytes,1,opt,name=title,proto3" json:"title"`
-	Description          string   `protobuf:"bytes,2,opt,name=description,proto3" json:"description"`
-	Id                   string   `protobuf:"bytes,3,opt,name=id,proto3" json:"id"`
+type LikeResponse struct {
+	PostId               string   `protobuf:"bytes,1,opt,name=post_id,json=postId,proto3" json:"post_id"`
+	PostTitle            string   `protobuf:"bytes,2,opt,name=post_title,json=postTitle,proto3" json:"post_title"`
+	CreatedAt            string   `protobuf:"bytes,3,opt,name=created_at,json=createdAt,proto3" json:"created_at"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *UpdatePostRequest) Reset()         { *m = UpdatePostRequest{} }
-func (m *UpdatePostRequest) String() string { return proto.CompactTextString(m) }
-func (*UpdatePostRequest) ProtoMessage()    {}
-func (*UpdatePostRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e9e4a9952ba66d64, []int{3}
+func (m *LikeResponse) Reset()         { *m = LikeResponse{} }
+func (m *LikeResponse) String() string { return proto.CompactTextString(m) }
+func (*LikeResponse) ProtoMessage()    {}
+func (*LikeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e9e4a9952ba66d64, []int{5}
 }
-func (m *UpdatePostRequest) XXX_Unmarshal(b []byte) error {
+func (m *LikeResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *UpdatePostRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *LikeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_UpdatePostRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_LikeResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -229,58 +332,58 @@ func (m *UpdatePostRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, e
 		return b[:n], nil
 	}
 }
-func (m *UpdatePostRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_UpdatePostRequest.Merge(m, src)
+func (m *LikeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LikeResponse.Merge(m, src)
 }
-func (m *UpdatePostRequest) XXX_Size() int {
+func (m *LikeResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *UpdatePostRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_UpdatePostRequest.DiscardUnknown(m)
+func (m *LikeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_LikeResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_UpdatePostRequest proto.InternalMessageInfo
+var xxx_messageInfo_LikeResponse proto.InternalMessageInfo
 
-func (m *UpdatePostRequest) GetTitle() string {
+func (m *LikeResponse) GetPostId() string {
 	if m != nil {
-		return m.Title
+		return m.PostId
 	}
 	return ""
 }
 
-func (m *UpdatePostRequest) GetDescription() string {
+func (m *LikeResponse) GetPostTitle() string {
 	if m != nil {
-		return m.Description
+		return m.PostTitle
 	}
 	return ""
 }
 
-func (m *UpdatePostRequest) GetId() string {
+func (m *LikeResponse) GetCreatedAt() string {
 	if m != nil {
-		return m.Id
+		return m.CreatedAt
 	}
 	return ""
 }
 
-type PostsResponse struct {
-	Posts                []*PostResponse `protobuf:"bytes,1,rep,name=posts,proto3" json:"posts"`
+type LikesResponse struct {
+	Likes                []*LikeResponse `protobuf:"bytes,1,rep,name=likes,proto3" json:"likes"`
 	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
 	XXX_unrecognized     []byte          `json:"-"`
 	XXX_sizecache        int32           `json:"-"`
 }
 
-func (m *PostsResponse) Reset()         { *m = PostsResponse{} }
-func (m *PostsResponse) String() string { return proto.CompactTextString(m) }
-func (*PostsResponse) ProtoMessage()    {}
-func (*PostsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e9e4a9952ba66d64, []int{4}
+func (m *LikesResponse) Reset()         { *m = LikesResponse{} }
+func (m *LikesResponse) String() string { return proto.CompactTextString(m) }
+func (*LikesResponse) ProtoMessage()    {}
+func (*LikesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e9e4a9952ba66d64, []int{6}
 }
-func (m *PostsResponse) XXX_Unmarshal(b []byte) error {
+func (m *LikesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *PostsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *LikesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_PostsResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_LikesResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -290,52 +393,44 @@ func (m *PostsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error
 		return b[:n], nil
 	}
 }
-func (m *PostsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_PostsResponse.Merge(m, src)
+func (m *LikesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LikesResponse.Merge(m, src)
 }
-func (m *PostsResponse) XXX_Size() int {
+func (m *LikesResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *PostsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_PostsResponse.DiscardUnknown(m)
+func (m *LikesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_LikesResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_PostsResponse proto.InternalMessageInfo
+var xxx_messageInfo_LikesResponse proto.InternalMessageInfo
 
-func (m *PostsResponse) GetPosts() []*PostResponse {
+func (m *LikesResponse) GetLikes() []*LikeResponse {
 	if m != nil {
-		return m.Posts
+		return m.Likes
 	}
 	return nil
 }
 
-type PostResponse struct {
-	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id"`
-	Title                string   `protobuf:"bytes,2,opt,name=title,proto3" json:"title"`
-	Description          string   `protobuf:"bytes,3,opt,name=description,proto3" json:"description"`
-	Likes                int64    `protobuf:"varint,4,opt,name=likes,proto3" json:"likes"`
-	Comments             int64    `protobuf:"varint,5,opt,name=comments,proto3" json:"comments"`
-	UserId               string   `protobuf:"bytes,6,opt,name=user_id,json=userId,proto3" json:"user_id"`
-	UserName             string   `protobuf:"bytes,7,opt,name=user_name,json=userName,proto3" json:"user_name"`
-	CreatedAt            string   `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at"`
-	UpdatedAt            string   `protobuf:"bytes,9,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at"`
+type StreamRequest struct {
+	PostId               string   `protobuf:"bytes,1,opt,name=post_id,json=postId,proto3" json:"post_id"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *PostResponse) Reset()         { *m = PostResponse{} }
-func (m *PostResponse) String() string { return proto.CompactTextString(m) }
-func (*PostResponse) ProtoMessage()    {}
-func (*PostResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e9e4a9952ba66d64, []int{5}
+func (m *StreamRequest) Reset()         { *m = StreamRequest{} }
+func (m *StreamRequest) String() string { return proto.CompactTextString(m) }
+func (*StreamRequest) ProtoMessage()    {}
+func (*StreamRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e9e4a9952ba66d64, []int{7}
 }
-func (m *PostResponse) XXX_Unmarshal(b []byte) error {
+func (m *StreamRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *PostResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *StreamRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_PostResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_StreamRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -345,976 +440,8896 @@ func (m *PostResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error)
 		return b[:n], nil
 	}
 }
-func (m *PostResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_PostResponse.Merge(m, src)
+func (m *StreamRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StreamRequest.Merge(m, src)
 }
-func (m *PostResponse) XXX_Size() int {
+func (m *StreamRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *PostResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_PostResponse.DiscardUnknown(m)
+func (m *StreamRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_StreamRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_PostResponse proto.InternalMessageInfo
+var xxx_messageInfo_StreamRequest proto.InternalMessageInfo
 
-func (m *PostResponse) GetId() string {
+func (m *StreamRequest) GetPostId() string {
 	if m != nil {
-		return m.Id
+		return m.PostId
 	}
 	return ""
 }
 
-func (m *PostResponse) GetTitle() string {
-	if m != nil {
-		return m.Title
-	}
-	return ""
+type LikeEvent struct {
+	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id"`
+	PostId               string   `protobuf:"bytes,2,opt,name=post_id,json=postId,proto3" json:"post_id"`
+	UserId               string   `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id"`
+	IsLiked              bool     `protobuf:"varint,4,opt,name=is_liked,json=isLiked,proto3" json:"is_liked"`
+	Likes                int64    `protobuf:"varint,5,opt,name=likes,proto3" json:"likes"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *PostResponse) GetDescription() string {
-	if m != nil {
-		return m.Description
+func (m *LikeEvent) Reset()         { *m = LikeEvent{} }
+func (m *LikeEvent) String() string { return proto.CompactTextString(m) }
+func (*LikeEvent) ProtoMessage()    {}
+func (*LikeEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e9e4a9952ba66d64, []int{8}
+}
+func (m *LikeEvent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LikeEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LikeEvent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return ""
+}
+func (m *LikeEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LikeEvent.Merge(m, src)
+}
+func (m *LikeEvent) XXX_Size() int {
+	return m.Size()
+}
+func (m *LikeEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_LikeEvent.DiscardUnknown(m)
 }
 
-func (m *PostResponse) GetLikes() int64 {
+var xxx_messageInfo_LikeEvent proto.InternalMessageInfo
+
+func (m *LikeEvent) GetId() string {
 	if m != nil {
-		return m.Likes
+		return m.Id
 	}
-	return 0
+	return ""
 }
 
-func (m *PostResponse) GetComments() int64 {
+func (m *LikeEvent) GetPostId() string {
 	if m != nil {
-		return m.Comments
+		return m.PostId
 	}
-	return 0
+	return ""
 }
 
-func (m *PostResponse) GetUserId() string {
+func (m *LikeEvent) GetUserId() string {
 	if m != nil {
 		return m.UserId
 	}
 	return ""
 }
 
-func (m *PostResponse) GetUserName() string {
+func (m *LikeEvent) GetIsLiked() bool {
 	if m != nil {
-		return m.UserName
+		return m.IsLiked
 	}
-	return ""
+	return false
 }
 
-func (m *PostResponse) GetCreatedAt() string {
+func (m *LikeEvent) GetLikes() int64 {
 	if m != nil {
-		return m.CreatedAt
+		return m.Likes
 	}
-	return ""
+	return 0
 }
 
-func (m *PostResponse) GetUpdatedAt() string {
-	if m != nil {
-		return m.UpdatedAt
-	}
-	return ""
+type PostRequest struct {
+	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id"`
+	Title                string   `protobuf:"bytes,2,opt,name=title,proto3" json:"title"`
+	Description          string   `protobuf:"bytes,3,opt,name=description,proto3" json:"description"`
+	UserId               string   `protobuf:"bytes,4,opt,name=user_id,json=userId,proto3" json:"user_id"`
+	Status               string   `protobuf:"bytes,5,opt,name=status,proto3" json:"status"`
+	PublishAt            string   `protobuf:"bytes,6,opt,name=publish_at,json=publishAt,proto3" json:"publish_at"`
+	Visibility           string   `protobuf:"bytes,7,opt,name=visibility,proto3" json:"visibility"`
+	Tags                 []string `protobuf:"bytes,8,rep,name=tags,proto3" json:"tags"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func init() {
-	proto.RegisterType((*Request)(nil), "post.Request")
-	proto.RegisterType((*LikeRequest)(nil), "post.LikeRequest")
-	proto.RegisterType((*PostRequest)(nil), "post.PostRequest")
-	proto.RegisterType((*UpdatePostRequest)(nil), "post.UpdatePostRequest")
-	proto.RegisterType((*PostsResponse)(nil), "post.PostsResponse")
-	proto.RegisterType((*PostResponse)(nil), "post.PostResponse")
+func (m *PostRequest) Reset()         { *m = PostRequest{} }
+func (m *PostRequest) String() string { return proto.CompactTextString(m) }
+func (*PostRequest) ProtoMessage()    {}
+func (*PostRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e9e4a9952ba66d64, []int{9}
 }
-
-func init() { proto.RegisterFile("post/post.proto", fileDescriptor_e9e4a9952ba66d64) }
-
-var fileDescriptor_e9e4a9952ba66d64 = []byte{
-	// 496 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x94, 0xcd, 0x6e, 0xd3, 0x40,
-	0x10, 0xc7, 0xbb, 0x71, 0x93, 0xd8, 0x63, 0xfa, 0x91, 0xa5, 0x52, 0x4d, 0x2b, 0xa2, 0xc8, 0xa7,
-	0x9c, 0x8a, 0x48, 0xc5, 0x97, 0x38, 0xa5, 0x45, 0xa0, 0x48, 0x08, 0x21, 0x57, 0x3d, 0x71, 0x88,
-	0x8c, 0x77, 0x24, 0x56, 0x4d, 0x6c, 0xe3, 0xdd, 0x20, 0xf1, 0x26, 0x3c, 0x12, 0x47, 0x1e, 0x01,
-	0x85, 0xd7, 0x00, 0x09, 0xcd, 0xae, 0x93, 0xac, 0x28, 0xad, 0x7c, 0xe8, 0x25, 0xda, 0x99, 0xff,
-	0xfc, 0x76, 0x66, 0xff, 0x99, 0x04, 0xf6, 0xca, 0x42, 0xe9, 0x47, 0xf4, 0x71, 0x52, 0x56, 0x85,
-	0x2e, 0xf8, 0x36, 0x9d, 0xe3, 0x63, 0xe8, 0x26, 0xf8, 0x79, 0x81, 0x4a, 0xf3, 0x7d, 0xf0, 0x94,
-	0xae, 0x22, 0x36, 0x60, 0xc3, 0x20, 0xa1, 0x63, 0x3c, 0x86, 0xf0, 0xad, 0xbc, 0xc2, 0x55, 0xc1,
-	0x21, 0x74, 0x89, 0x99, 0x4a, 0x51, 0x17, 0x75, 0x28, 0x9c, 0x08, 0xfe, 0x00, 0x7c, 0xa9, 0xa6,
-	0x33, 0x79, 0x85, 0x22, 0x6a, 0x0d, 0xd8, 0xd0, 0x4f, 0xba, 0x52, 0x11, 0x29, 0xe2, 0x1c, 0xc2,
-	0xf7, 0x85, 0xd2, 0xab, 0x2b, 0x76, 0xa1, 0xb5, 0xa6, 0x5b, 0x52, 0xf0, 0x03, 0x68, 0x6b, 0xa9,
-	0x67, 0x68, 0xb0, 0x20, 0xb1, 0x01, 0x1f, 0x40, 0x28, 0x50, 0x65, 0x95, 0x2c, 0xb5, 0x2c, 0xf2,
-	0xc8, 0x33, 0x9a, 0x9b, 0xa2, 0x51, 0x16, 0x0a, 0x2b, 0x1a, 0x65, 0xdb, 0x8e, 0x42, 0xe1, 0x44,
-	0xc4, 0x1f, 0xa0, 0x77, 0x59, 0x8a, 0x54, 0xa3, 0xdb, 0x75, 0xdd, 0x85, 0xdd, 0xd2, 0xa5, 0x75,
-	0xbd, 0x8b, 0x9d, 0xd6, 0x5b, 0x4d, 0x1b, 0xbf, 0x80, 0x1d, 0xba, 0x56, 0x25, 0xa8, 0xca, 0x22,
-	0x57, 0xc8, 0x87, 0xd0, 0x26, 0x0b, 0x54, 0xc4, 0x06, 0xde, 0x30, 0x1c, 0xf1, 0x13, 0xe3, 0xaf,
-	0x6d, 0x6d, 0x4b, 0x12, 0x5b, 0x10, 0xff, 0x66, 0x70, 0xcf, 0xcd, 0xdf, 0x99, 0x13, 0x07, 0xd0,
-	0x26, 0xe3, 0x95, 0xf1, 0xc1, 0x4b, 0x6c, 0xc0, 0x8f, 0xc0, 0xcf, 0x8a, 0xf9, 0x1c, 0x73, 0xad,
-	0xa2, 0xb6, 0x11, 0xd6, 0xb1, 0xeb, 0x5d, 0xc7, 0xf5, 0x8e, 0x1f, 0x43, 0x60, 0x84, 0x3c, 0x9d,
-	0x63, 0xd4, 0x35, 0x92, 0x4f, 0x89, 0x77, 0xe9, 0x1c, 0xf9, 0x43, 0x80, 0xac, 0xc2, 0x54, 0xa3,
-	0x98, 0xa6, 0x3a, 0xf2, 0x8d, 0x1a, 0xd4, 0x99, 0xb1, 0x26, 0x79, 0x61, 0x7c, 0x37, 0x72, 0x60,
-	0xe5, 0x3a, 0x33, 0xd6, 0xa3, 0x3f, 0x9e, 0xdd, 0x83, 0x0b, 0xac, 0xbe, 0xc8, 0x0c, 0xf9, 0x13,
-	0x80, 0x73, 0xc3, 0x52, 0x92, 0xf7, 0x5c, 0xdf, 0xcc, 0x57, 0x76, 0xf4, 0x1f, 0x2b, 0xe3, 0x2d,
-	0x3e, 0x82, 0xf0, 0x0d, 0x6a, 0x4a, 0x9e, 0x7d, 0x9d, 0x08, 0xbe, 0x63, 0x8b, 0x6e, 0x67, 0x9e,
-	0xc1, 0xde, 0x9a, 0xb9, 0xb4, 0x0f, 0xfd, 0x87, 0xbb, 0xbf, 0xe1, 0x94, 0x03, 0x9e, 0x42, 0x78,
-	0x81, 0x69, 0x95, 0x7d, 0x32, 0x42, 0x63, 0xc8, 0xa7, 0xc5, 0x77, 0x9f, 0xe5, 0xfc, 0x84, 0x6e,
-	0x18, 0xf1, 0x25, 0xc0, 0x66, 0x69, 0xf9, 0xa1, 0xad, 0xb9, 0xb6, 0xc6, 0x37, 0xc0, 0x8f, 0x01,
-	0x5e, 0xe1, 0x0c, 0x6b, 0xb8, 0x91, 0x25, 0x4f, 0x61, 0xb7, 0xb6, 0xe4, 0x75, 0x51, 0x91, 0x27,
-	0x0d, 0x1f, 0xf7, 0x1c, 0x7a, 0x1b, 0xee, 0xdc, 0xee, 0x53, 0xa3, 0x8e, 0x67, 0xfb, 0xdf, 0x97,
-	0x7d, 0xf6, 0x63, 0xd9, 0x67, 0x3f, 0x97, 0x7d, 0xf6, 0xed, 0x57, 0x7f, 0xeb, 0x63, 0xc7, 0xfc,
-	0x0b, 0x9d, 0xfe, 0x0d, 0x00, 0x00, 0xff, 0xff, 0x1e, 0xc6, 0x54, 0xd1, 0x98, 0x04, 0x00, 0x00,
+func (m *PostRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// PostServiceClient is the client API for PostService service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type PostServiceClient interface {
-	// methods...
-	CreatePost(ctx context.Context, in *PostRequest, opts ...grpc.CallOption) (*PostResponse, error)
-	GetPostById(ctx context.Context, in *Request, opts ...grpc.CallOption) (*PostResponse, error)
-	GetPostByUserId(ctx context.Context, in *Request, opts ...grpc.CallOption) (*PostsResponse, error)
-	SearchPosts(ctx context.Context, in *Request, opts ...grpc.CallOption) (*PostsResponse, error)
-	LikePost(ctx context.Context, in *LikeRequest, opts ...grpc.CallOption) (*PostResponse, error)
-	UpdatePost(ctx context.Context, in *UpdatePostRequest, opts ...grpc.CallOption) (*PostResponse, error)
-	DeletePost(ctx context.Context, in *Request, opts ...grpc.CallOption) (*PostResponse, error)
-	// for Clients...
-	GetPostForUser(ctx context.Context, in *Request, opts ...grpc.CallOption) (*PostsResponse, error)
-	GetPostForComment(ctx context.Context, in *Request, opts ...grpc.CallOption) (*PostResponse, error)
+func (m *PostRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PostRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-
-type postServiceClient struct {
-	cc *grpc.ClientConn
+func (m *PostRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PostRequest.Merge(m, src)
 }
-
-func NewPostServiceClient(cc *grpc.ClientConn) PostServiceClient {
-	return &postServiceClient{cc}
+func (m *PostRequest) XXX_Size() int {
+	return m.Size()
 }
-
-func (c *postServiceClient) CreatePost(ctx context.Context, in *PostRequest, opts ...grpc.CallOption) (*PostResponse, error) {
-	out := new(PostResponse)
-	err := c.cc.Invoke(ctx, "/post.PostService/CreatePost", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *PostRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_PostRequest.DiscardUnknown(m)
 }
 
-func (c *postServiceClient) GetPostById(ctx context.Context, in *Request, opts ...grpc.CallOption) (*PostResponse, error) {
-	out := new(PostResponse)
-	err := c.cc.Invoke(ctx, "/post.PostService/GetPostById", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
-}
+var xxx_messageInfo_PostRequest proto.InternalMessageInfo
 
-func (c *postServiceClient) GetPostByUserId(ctx context.Context, in *Request, opts ...grpc.CallOption) (*PostsResponse, error) {
-	out := new(PostsResponse)
-	err := c.cc.Invoke(ctx, "/post.PostService/GetPostByUserId", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *PostRequest) GetId() string {
+	if m != nil {
+		return m.Id
 	}
-	return out, nil
+	return ""
 }
 
-func (c *postServiceClient) SearchPosts(ctx context.Context, in *Request, opts ...grpc.CallOption) (*PostsResponse, error) {
-	out := new(PostsResponse)
-	err := c.cc.Invoke(ctx, "/post.PostService/SearchPosts", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *PostRequest) GetTitle() string {
+	if m != nil {
+		return m.Title
 	}
-	return out, nil
+	return ""
 }
 
-func (c *postServiceClient) LikePost(ctx context.Context, in *LikeRequest, opts ...grpc.CallOption) (*PostResponse, error) {
-	out := new(PostResponse)
-	err := c.cc.Invoke(ctx, "/post.PostService/LikePost", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *PostRequest) GetDescription() string {
+	if m != nil {
+		return m.Description
 	}
-	return out, nil
+	return ""
 }
 
-func (c *postServiceClient) UpdatePost(ctx context.Context, in *UpdatePostRequest, opts ...grpc.CallOption) (*PostResponse, error) {
-	out := new(PostResponse)
-	err := c.cc.Invoke(ctx, "/post.PostService/UpdatePost", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *PostRequest) GetUserId() string {
+	if m != nil {
+		return m.UserId
 	}
-	return out, nil
+	return ""
 }
 
-func (c *postServiceClient) DeletePost(ctx context.Context, in *Request, opts ...grpc.CallOption) (*PostResponse, error) {
-	out := new(PostResponse)
-	err := c.cc.Invoke(ctx, "/post.PostService/DeletePost", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *PostRequest) GetStatus() string {
+	if m != nil {
+		return m.Status
 	}
-	return out, nil
+	return ""
 }
 
-func (c *postServiceClient) GetPostForUser(ctx context.Context, in *Request, opts ...grpc.CallOption) (*PostsResponse, error) {
-	out := new(PostsResponse)
-	err := c.cc.Invoke(ctx, "/post.PostService/GetPostForUser", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *PostRequest) GetPublishAt() string {
+	if m != nil {
+		return m.PublishAt
 	}
-	return out, nil
+	return ""
 }
 
-func (c *postServiceClient) GetPostForComment(ctx context.Context, in *Request, opts ...grpc.CallOption) (*PostResponse, error) {
-	out := new(PostResponse)
-	err := c.cc.Invoke(ctx, "/post.PostService/GetPostForComment", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *PostRequest) GetVisibility() string {
+	if m != nil {
+		return m.Visibility
 	}
-	return out, nil
+	return ""
 }
 
-// PostServiceServer is the server API for PostService service.
-type PostServiceServer interface {
-	// methods...
-	CreatePost(context.Context, *PostRequest) (*PostResponse, error)
-	GetPostById(context.Context, *Request) (*PostResponse, error)
-	GetPostByUserId(context.Context, *Request) (*PostsResponse, error)
-	SearchPosts(context.Context, *Request) (*PostsResponse, error)
-	LikePost(context.Context, *LikeRequest) (*PostResponse, error)
-	UpdatePost(context.Context, *UpdatePostRequest) (*PostResponse, error)
-	DeletePost(context.Context, *Request) (*PostResponse, error)
-	// for Clients...
-	GetPostForUser(context.Context, *Request) (*PostsResponse, error)
-	GetPostForComment(context.Context, *Request) (*PostResponse, error)
+func (m *PostRequest) GetTags() []string {
+	if m != nil {
+		return m.Tags
+	}
+	return nil
 }
 
-// UnimplementedPostServiceServer can be embedded to have forward compatible implementations.
-type UnimplementedPostServiceServer struct {
+type UpdatePostRequest struct {
+	Title                string   `protobuf:"bytes,1,opt,name=title,proto3" json:"title"`
+	Description          string   `protobuf:"bytes,2,opt,name=description,proto3" json:"description"`
+	Id                   string   `protobuf:"bytes,3,opt,name=id,proto3" json:"id"`
+	Status               string   `protobuf:"bytes,4,opt,name=status,proto3" json:"status"`
+	PublishAt            string   `protobuf:"bytes,5,opt,name=publish_at,json=publishAt,proto3" json:"publish_at"`
+	Visibility           string   `protobuf:"bytes,6,opt,name=visibility,proto3" json:"visibility"`
+	EditorId             string   `protobuf:"bytes,7,opt,name=editor_id,json=editorId,proto3" json:"editor_id"`
+	Tags                 []string `protobuf:"bytes,8,rep,name=tags,proto3" json:"tags"`
+	ExpectedVersion      int64    `protobuf:"varint,9,opt,name=expected_version,json=expectedVersion,proto3" json:"expected_version"`
+	TagsSet              bool     `protobuf:"varint,10,opt,name=tags_set,json=tagsSet,proto3" json:"tags_set"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (*UnimplementedPostServiceServer) CreatePost(ctx context.Context, req *PostRequest) (*PostResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreatePost not implemented")
+func (m *UpdatePostRequest) Reset()         { *m = UpdatePostRequest{} }
+func (m *UpdatePostRequest) String() string { return proto.CompactTextString(m) }
+func (*UpdatePostRequest) ProtoMessage()    {}
+func (*UpdatePostRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e9e4a9952ba66d64, []int{10}
 }
-func (*UnimplementedPostServiceServer) GetPostById(ctx context.Context, req *Request) (*PostResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetPostById not implemented")
+func (m *UpdatePostRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedPostServiceServer) GetPostByUserId(ctx context.Context, req *Request) (*PostsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetPostByUserId not implemented")
+func (m *UpdatePostRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UpdatePostRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedPostServiceServer) SearchPosts(ctx context.Context, req *Request) (*PostsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SearchPosts not implemented")
+func (m *UpdatePostRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdatePostRequest.Merge(m, src)
 }
-func (*UnimplementedPostServiceServer) LikePost(ctx context.Context, req *LikeRequest) (*PostResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method LikePost not implemented")
+func (m *UpdatePostRequest) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedPostServiceServer) UpdatePost(ctx context.Context, req *UpdatePostRequest) (*PostResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdatePost not implemented")
+func (m *UpdatePostRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdatePostRequest.DiscardUnknown(m)
 }
-func (*UnimplementedPostServiceServer) DeletePost(ctx context.Context, req *Request) (*PostResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeletePost not implemented")
+
+var xxx_messageInfo_UpdatePostRequest proto.InternalMessageInfo
+
+func (m *UpdatePostRequest) GetTitle() string {
+	if m != nil {
+		return m.Title
+	}
+	return ""
 }
-func (*UnimplementedPostServiceServer) GetPostForUser(ctx context.Context, req *Request) (*PostsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetPostForUser not implemented")
+
+func (m *UpdatePostRequest) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
 }
-func (*UnimplementedPostServiceServer) GetPostForComment(ctx context.Context, req *Request) (*PostResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetPostForComment not implemented")
+
+func (m *UpdatePostRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
 }
 
-func RegisterPostServiceServer(s *grpc.Server, srv PostServiceServer) {
-	s.RegisterService(&_PostService_serviceDesc, srv)
+func (m *UpdatePostRequest) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
 }
 
-func _PostService_CreatePost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(PostRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *UpdatePostRequest) GetPublishAt() string {
+	if m != nil {
+		return m.PublishAt
 	}
-	if interceptor == nil {
-		return srv.(PostServiceServer).CreatePost(ctx, in)
+	return ""
+}
+
+func (m *UpdatePostRequest) GetVisibility() string {
+	if m != nil {
+		return m.Visibility
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/post.PostService/CreatePost",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PostServiceServer).CreatePost(ctx, req.(*PostRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _PostService_GetPostById_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Request)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *UpdatePostRequest) GetEditorId() string {
+	if m != nil {
+		return m.EditorId
 	}
-	if interceptor == nil {
-		return srv.(PostServiceServer).GetPostById(ctx, in)
+	return ""
+}
+
+func (m *UpdatePostRequest) GetTags() []string {
+	if m != nil {
+		return m.Tags
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/post.PostService/GetPostById",
+	return nil
+}
+
+func (m *UpdatePostRequest) GetExpectedVersion() int64 {
+	if m != nil {
+		return m.ExpectedVersion
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PostServiceServer).GetPostById(ctx, req.(*Request))
+	return 0
+}
+
+func (m *UpdatePostRequest) GetTagsSet() bool {
+	if m != nil {
+		return m.TagsSet
 	}
-	return interceptor(ctx, in, info, handler)
+	return false
 }
 
-func _PostService_GetPostByUserId_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Request)
-	if err := dec(in); err != nil {
-		return nil, err
+type PostsResponse struct {
+	Posts                []*PostResponse `protobuf:"bytes,1,rep,name=posts,proto3" json:"posts"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *PostsResponse) Reset()         { *m = PostsResponse{} }
+func (m *PostsResponse) String() string { return proto.CompactTextString(m) }
+func (*PostsResponse) ProtoMessage()    {}
+func (*PostsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e9e4a9952ba66d64, []int{11}
+}
+func (m *PostsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PostsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PostsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if interceptor == nil {
-		return srv.(PostServiceServer).GetPostByUserId(ctx, in)
+}
+func (m *PostsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PostsResponse.Merge(m, src)
+}
+func (m *PostsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *PostsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_PostsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PostsResponse proto.InternalMessageInfo
+
+func (m *PostsResponse) GetPosts() []*PostResponse {
+	if m != nil {
+		return m.Posts
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/post.PostService/GetPostByUserId",
+	return nil
+}
+
+type PostResponse struct {
+	Id                   string                `protobuf:"bytes,1,opt,name=id,proto3" json:"id"`
+	Title                string                `protobuf:"bytes,2,opt,name=title,proto3" json:"title"`
+	Description          string                `protobuf:"bytes,3,opt,name=description,proto3" json:"description"`
+	Likes                int64                 `protobuf:"varint,4,opt,name=likes,proto3" json:"likes"`
+	Comments             int64                 `protobuf:"varint,5,opt,name=comments,proto3" json:"comments"`
+	UserId               string                `protobuf:"bytes,6,opt,name=user_id,json=userId,proto3" json:"user_id"`
+	UserName             string                `protobuf:"bytes,7,opt,name=user_name,json=userName,proto3" json:"user_name"`
+	CreatedAt            string                `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at"`
+	UpdatedAt            string                `protobuf:"bytes,9,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at"`
+	Attachments          []*AttachmentResponse `protobuf:"bytes,10,rep,name=attachments,proto3" json:"attachments"`
+	Status               string                `protobuf:"bytes,11,opt,name=status,proto3" json:"status"`
+	PublishAt            string                `protobuf:"bytes,12,opt,name=publish_at,json=publishAt,proto3" json:"publish_at"`
+	Visibility           string                `protobuf:"bytes,13,opt,name=visibility,proto3" json:"visibility"`
+	Edited               bool                  `protobuf:"varint,14,opt,name=edited,proto3" json:"edited"`
+	EditedAt             string                `protobuf:"bytes,15,opt,name=edited_at,json=editedAt,proto3" json:"edited_at"`
+	Tags                 []string              `protobuf:"bytes,16,rep,name=tags,proto3" json:"tags"`
+	Version              int64                 `protobuf:"varint,17,opt,name=version,proto3" json:"version"`
+	ModerationStatus     string                `protobuf:"bytes,18,opt,name=moderation_status,json=moderationStatus,proto3" json:"moderation_status"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *PostResponse) Reset()         { *m = PostResponse{} }
+func (m *PostResponse) String() string { return proto.CompactTextString(m) }
+func (*PostResponse) ProtoMessage()    {}
+func (*PostResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e9e4a9952ba66d64, []int{12}
+}
+func (m *PostResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PostResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PostResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PostServiceServer).GetPostByUserId(ctx, req.(*Request))
+}
+func (m *PostResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PostResponse.Merge(m, src)
+}
+func (m *PostResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *PostResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_PostResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PostResponse proto.InternalMessageInfo
+
+func (m *PostResponse) GetId() string {
+	if m != nil {
+		return m.Id
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _PostService_SearchPosts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Request)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *PostResponse) GetTitle() string {
+	if m != nil {
+		return m.Title
 	}
-	if interceptor == nil {
-		return srv.(PostServiceServer).SearchPosts(ctx, in)
+	return ""
+}
+
+func (m *PostResponse) GetDescription() string {
+	if m != nil {
+		return m.Description
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/post.PostService/SearchPosts",
+	return ""
+}
+
+func (m *PostResponse) GetLikes() int64 {
+	if m != nil {
+		return m.Likes
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PostServiceServer).SearchPosts(ctx, req.(*Request))
+	return 0
+}
+
+func (m *PostResponse) GetComments() int64 {
+	if m != nil {
+		return m.Comments
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _PostService_LikePost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(LikeRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *PostResponse) GetUserId() string {
+	if m != nil {
+		return m.UserId
 	}
-	if interceptor == nil {
-		return srv.(PostServiceServer).LikePost(ctx, in)
+	return ""
+}
+
+func (m *PostResponse) GetUserName() string {
+	if m != nil {
+		return m.UserName
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/post.PostService/LikePost",
+	return ""
+}
+
+func (m *PostResponse) GetCreatedAt() string {
+	if m != nil {
+		return m.CreatedAt
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PostServiceServer).LikePost(ctx, req.(*LikeRequest))
+	return ""
+}
+
+func (m *PostResponse) GetUpdatedAt() string {
+	if m != nil {
+		return m.UpdatedAt
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _PostService_UpdatePost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdatePostRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *PostResponse) GetAttachments() []*AttachmentResponse {
+	if m != nil {
+		return m.Attachments
 	}
-	if interceptor == nil {
-		return srv.(PostServiceServer).UpdatePost(ctx, in)
+	return nil
+}
+
+func (m *PostResponse) GetStatus() string {
+	if m != nil {
+		return m.Status
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/post.PostService/UpdatePost",
+	return ""
+}
+
+func (m *PostResponse) GetPublishAt() string {
+	if m != nil {
+		return m.PublishAt
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PostServiceServer).UpdatePost(ctx, req.(*UpdatePostRequest))
+	return ""
+}
+
+func (m *PostResponse) GetVisibility() string {
+	if m != nil {
+		return m.Visibility
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _PostService_DeletePost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Request)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *PostResponse) GetEdited() bool {
+	if m != nil {
+		return m.Edited
 	}
-	if interceptor == nil {
-		return srv.(PostServiceServer).DeletePost(ctx, in)
+	return false
+}
+
+func (m *PostResponse) GetEditedAt() string {
+	if m != nil {
+		return m.EditedAt
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/post.PostService/DeletePost",
+	return ""
+}
+
+func (m *PostResponse) GetTags() []string {
+	if m != nil {
+		return m.Tags
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PostServiceServer).DeletePost(ctx, req.(*Request))
+	return nil
+}
+
+func (m *PostResponse) GetVersion() int64 {
+	if m != nil {
+		return m.Version
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _PostService_GetPostForUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Request)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *PostResponse) GetModerationStatus() string {
+	if m != nil {
+		return m.ModerationStatus
 	}
-	if interceptor == nil {
-		return srv.(PostServiceServer).GetPostForUser(ctx, in)
+	return ""
+}
+
+type AttachmentRequest struct {
+	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id"`
+	PostId               string   `protobuf:"bytes,2,opt,name=post_id,json=postId,proto3" json:"post_id"`
+	UserId               string   `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id"`
+	FileName             string   `protobuf:"bytes,4,opt,name=file_name,json=fileName,proto3" json:"file_name"`
+	Data                 []byte   `protobuf:"bytes,5,opt,name=data,proto3" json:"data"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AttachmentRequest) Reset()         { *m = AttachmentRequest{} }
+func (m *AttachmentRequest) String() string { return proto.CompactTextString(m) }
+func (*AttachmentRequest) ProtoMessage()    {}
+func (*AttachmentRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e9e4a9952ba66d64, []int{13}
+}
+func (m *AttachmentRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AttachmentRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AttachmentRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/post.PostService/GetPostForUser",
+}
+func (m *AttachmentRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AttachmentRequest.Merge(m, src)
+}
+func (m *AttachmentRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *AttachmentRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AttachmentRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AttachmentRequest proto.InternalMessageInfo
+
+func (m *AttachmentRequest) GetId() string {
+	if m != nil {
+		return m.Id
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PostServiceServer).GetPostForUser(ctx, req.(*Request))
+	return ""
+}
+
+func (m *AttachmentRequest) GetPostId() string {
+	if m != nil {
+		return m.PostId
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _PostService_GetPostForComment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Request)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *AttachmentRequest) GetUserId() string {
+	if m != nil {
+		return m.UserId
 	}
-	if interceptor == nil {
-		return srv.(PostServiceServer).GetPostForComment(ctx, in)
+	return ""
+}
+
+func (m *AttachmentRequest) GetFileName() string {
+	if m != nil {
+		return m.FileName
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/post.PostService/GetPostForComment",
+	return ""
+}
+
+func (m *AttachmentRequest) GetData() []byte {
+	if m != nil {
+		return m.Data
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PostServiceServer).GetPostForComment(ctx, req.(*Request))
+	return nil
+}
+
+type AttachmentContentRequest struct {
+	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id"`
+	Thumbnail            bool     `protobuf:"varint,2,opt,name=thumbnail,proto3" json:"thumbnail"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AttachmentContentRequest) Reset()         { *m = AttachmentContentRequest{} }
+func (m *AttachmentContentRequest) String() string { return proto.CompactTextString(m) }
+func (*AttachmentContentRequest) ProtoMessage()    {}
+func (*AttachmentContentRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e9e4a9952ba66d64, []int{14}
+}
+func (m *AttachmentContentRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AttachmentContentRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AttachmentContentRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *AttachmentContentRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AttachmentContentRequest.Merge(m, src)
+}
+func (m *AttachmentContentRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *AttachmentContentRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AttachmentContentRequest.DiscardUnknown(m)
 }
 
-var _PostService_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "post.PostService",
-	HandlerType: (*PostServiceServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "CreatePost",
-			Handler:    _PostService_CreatePost_Handler,
-		},
-		{
-			MethodName: "GetPostById",
-			Handler:    _PostService_GetPostById_Handler,
-		},
-		{
-			MethodName: "GetPostByUserId",
-			Handler:    _PostService_GetPostByUserId_Handler,
-		},
-		{
-			MethodName: "SearchPosts",
-			Handler:    _PostService_SearchPosts_Handler,
-		},
-		{
-			MethodName: "LikePost",
-			Handler:    _PostService_LikePost_Handler,
-		},
-		{
-			MethodName: "UpdatePost",
-			Handler:    _PostService_UpdatePost_Handler,
-		},
-		{
-			MethodName: "DeletePost",
-			Handler:    _PostService_DeletePost_Handler,
-		},
-		{
-			MethodName: "GetPostForUser",
-			Handler:    _PostService_GetPostForUser_Handler,
-		},
-		{
-			MethodName: "GetPostForComment",
-			Handler:    _PostService_GetPostForComment_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "post/post.proto",
+var xxx_messageInfo_AttachmentContentRequest proto.InternalMessageInfo
+
+func (m *AttachmentContentRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
 }
 
-func (m *Request) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *AttachmentContentRequest) GetThumbnail() bool {
+	if m != nil {
+		return m.Thumbnail
 	}
-	return dAtA[:n], nil
+	return false
 }
 
-func (m *Request) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type AttachmentResponse struct {
+	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id"`
+	PostId               string   `protobuf:"bytes,2,opt,name=post_id,json=postId,proto3" json:"post_id"`
+	FileName             string   `protobuf:"bytes,3,opt,name=file_name,json=fileName,proto3" json:"file_name"`
+	MimeType             string   `protobuf:"bytes,4,opt,name=mime_type,json=mimeType,proto3" json:"mime_type"`
+	FileSize             int64    `protobuf:"varint,5,opt,name=file_size,json=fileSize,proto3" json:"file_size"`
+	HasThumbnail         bool     `protobuf:"varint,6,opt,name=has_thumbnail,json=hasThumbnail,proto3" json:"has_thumbnail"`
+	CreatedAt            string   `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *Request) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+func (m *AttachmentResponse) Reset()         { *m = AttachmentResponse{} }
+func (m *AttachmentResponse) String() string { return proto.CompactTextString(m) }
+func (*AttachmentResponse) ProtoMessage()    {}
+func (*AttachmentResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e9e4a9952ba66d64, []int{15}
+}
+func (m *AttachmentResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AttachmentResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AttachmentResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.Str) > 0 {
-		i -= len(m.Str)
-		copy(dAtA[i:], m.Str)
-		i = encodeVarintPost(dAtA, i, uint64(len(m.Str)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *AttachmentResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AttachmentResponse.Merge(m, src)
+}
+func (m *AttachmentResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *AttachmentResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_AttachmentResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AttachmentResponse proto.InternalMessageInfo
+
+func (m *AttachmentResponse) GetId() string {
+	if m != nil {
+		return m.Id
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *LikeRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *AttachmentResponse) GetPostId() string {
+	if m != nil {
+		return m.PostId
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *LikeRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *AttachmentResponse) GetFileName() string {
+	if m != nil {
+		return m.FileName
+	}
+	return ""
 }
 
-func (m *LikeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+func (m *AttachmentResponse) GetMimeType() string {
+	if m != nil {
+		return m.MimeType
 	}
-	if m.IsLiked {
-		i--
-		if m.IsLiked {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x10
+	return ""
+}
+
+func (m *AttachmentResponse) GetFileSize() int64 {
+	if m != nil {
+		return m.FileSize
 	}
-	if len(m.PostId) > 0 {
-		i -= len(m.PostId)
-		copy(dAtA[i:], m.PostId)
-		i = encodeVarintPost(dAtA, i, uint64(len(m.PostId)))
-		i--
-		dAtA[i] = 0xa
+	return 0
+}
+
+func (m *AttachmentResponse) GetHasThumbnail() bool {
+	if m != nil {
+		return m.HasThumbnail
 	}
-	return len(dAtA) - i, nil
+	return false
 }
 
-func (m *PostRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *AttachmentResponse) GetCreatedAt() string {
+	if m != nil {
+		return m.CreatedAt
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *PostRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type AttachmentsResponse struct {
+	Attachments          []*AttachmentResponse `protobuf:"bytes,1,rep,name=attachments,proto3" json:"attachments"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
 }
 
-func (m *PostRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+func (m *AttachmentsResponse) Reset()         { *m = AttachmentsResponse{} }
+func (m *AttachmentsResponse) String() string { return proto.CompactTextString(m) }
+func (*AttachmentsResponse) ProtoMessage()    {}
+func (*AttachmentsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e9e4a9952ba66d64, []int{16}
+}
+func (m *AttachmentsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AttachmentsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AttachmentsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.UserId) > 0 {
-		i -= len(m.UserId)
-		copy(dAtA[i:], m.UserId)
-		i = encodeVarintPost(dAtA, i, uint64(len(m.UserId)))
-		i--
-		dAtA[i] = 0x22
+}
+func (m *AttachmentsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AttachmentsResponse.Merge(m, src)
+}
+func (m *AttachmentsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *AttachmentsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_AttachmentsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AttachmentsResponse proto.InternalMessageInfo
+
+func (m *AttachmentsResponse) GetAttachments() []*AttachmentResponse {
+	if m != nil {
+		return m.Attachments
 	}
-	if len(m.Description) > 0 {
-		i -= len(m.Description)
-		copy(dAtA[i:], m.Description)
-		i = encodeVarintPost(dAtA, i, uint64(len(m.Description)))
-		i--
-		dAtA[i] = 0x1a
+	return nil
+}
+
+type AttachmentContent struct {
+	FileName             string   `protobuf:"bytes,1,opt,name=file_name,json=fileName,proto3" json:"file_name"`
+	MimeType             string   `protobuf:"bytes,2,opt,name=mime_type,json=mimeType,proto3" json:"mime_type"`
+	Data                 []byte   `protobuf:"bytes,3,opt,name=data,proto3" json:"data"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AttachmentContent) Reset()         { *m = AttachmentContent{} }
+func (m *AttachmentContent) String() string { return proto.CompactTextString(m) }
+func (*AttachmentContent) ProtoMessage()    {}
+func (*AttachmentContent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e9e4a9952ba66d64, []int{17}
+}
+func (m *AttachmentContent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AttachmentContent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AttachmentContent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.Title) > 0 {
-		i -= len(m.Title)
-		copy(dAtA[i:], m.Title)
-		i = encodeVarintPost(dAtA, i, uint64(len(m.Title)))
-		i--
-		dAtA[i] = 0x12
+}
+func (m *AttachmentContent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AttachmentContent.Merge(m, src)
+}
+func (m *AttachmentContent) XXX_Size() int {
+	return m.Size()
+}
+func (m *AttachmentContent) XXX_DiscardUnknown() {
+	xxx_messageInfo_AttachmentContent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AttachmentContent proto.InternalMessageInfo
+
+func (m *AttachmentContent) GetFileName() string {
+	if m != nil {
+		return m.FileName
 	}
-	if len(m.Id) > 0 {
-		i -= len(m.Id)
-		copy(dAtA[i:], m.Id)
-		i = encodeVarintPost(dAtA, i, uint64(len(m.Id)))
-		i--
-		dAtA[i] = 0xa
+	return ""
+}
+
+func (m *AttachmentContent) GetMimeType() string {
+	if m != nil {
+		return m.MimeType
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *UpdatePostRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *AttachmentContent) GetData() []byte {
+	if m != nil {
+		return m.Data
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *UpdatePostRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type RevisionsRequest struct {
+	PostId               string   `protobuf:"bytes,1,opt,name=post_id,json=postId,proto3" json:"post_id"`
+	ViewerId             string   `protobuf:"bytes,2,opt,name=viewer_id,json=viewerId,proto3" json:"viewer_id"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *UpdatePostRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.Id) > 0 {
-		i -= len(m.Id)
-		copy(dAtA[i:], m.Id)
-		i = encodeVarintPost(dAtA, i, uint64(len(m.Id)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Description) > 0 {
-		i -= len(m.Description)
-		copy(dAtA[i:], m.Description)
-		i = encodeVarintPost(dAtA, i, uint64(len(m.Description)))
-		i--
-		dAtA[i] = 0x12
+func (m *RevisionsRequest) Reset()         { *m = RevisionsRequest{} }
+func (m *RevisionsRequest) String() string { return proto.CompactTextString(m) }
+func (*RevisionsRequest) ProtoMessage()    {}
+func (*RevisionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e9e4a9952ba66d64, []int{18}
+}
+func (m *RevisionsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RevisionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RevisionsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.Title) > 0 {
-		i -= len(m.Title)
-		copy(dAtA[i:], m.Title)
-		i = encodeVarintPost(dAtA, i, uint64(len(m.Title)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *RevisionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RevisionsRequest.Merge(m, src)
+}
+func (m *RevisionsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *RevisionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RevisionsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RevisionsRequest proto.InternalMessageInfo
+
+func (m *RevisionsRequest) GetPostId() string {
+	if m != nil {
+		return m.PostId
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *PostsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *RevisionsRequest) GetViewerId() string {
+	if m != nil {
+		return m.ViewerId
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *PostsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type RevisionResponse struct {
+	PostId               string   `protobuf:"bytes,1,opt,name=post_id,json=postId,proto3" json:"post_id"`
+	Revision             int64    `protobuf:"varint,2,opt,name=revision,proto3" json:"revision"`
+	Title                string   `protobuf:"bytes,3,opt,name=title,proto3" json:"title"`
+	Description          string   `protobuf:"bytes,4,opt,name=description,proto3" json:"description"`
+	EditorId             string   `protobuf:"bytes,5,opt,name=editor_id,json=editorId,proto3" json:"editor_id"`
+	RestoredFrom         int64    `protobuf:"varint,6,opt,name=restored_from,json=restoredFrom,proto3" json:"restored_from"`
+	CreatedAt            string   `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *PostsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.Posts) > 0 {
-		for iNdEx := len(m.Posts) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Posts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintPost(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *RevisionResponse) Reset()         { *m = RevisionResponse{} }
+func (m *RevisionResponse) String() string { return proto.CompactTextString(m) }
+func (*RevisionResponse) ProtoMessage()    {}
+func (*RevisionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e9e4a9952ba66d64, []int{19}
+}
+func (m *RevisionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RevisionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RevisionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *RevisionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RevisionResponse.Merge(m, src)
+}
+func (m *RevisionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *RevisionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RevisionResponse.DiscardUnknown(m)
 }
 
-func (m *PostResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_RevisionResponse proto.InternalMessageInfo
+
+func (m *RevisionResponse) GetPostId() string {
+	if m != nil {
+		return m.PostId
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *PostResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *RevisionResponse) GetRevision() int64 {
+	if m != nil {
+		return m.Revision
+	}
+	return 0
 }
 
-func (m *PostResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+func (m *RevisionResponse) GetTitle() string {
+	if m != nil {
+		return m.Title
 	}
-	if len(m.UpdatedAt) > 0 {
-		i -= len(m.UpdatedAt)
-		copy(dAtA[i:], m.UpdatedAt)
-		i = encodeVarintPost(dAtA, i, uint64(len(m.UpdatedAt)))
-		i--
-		dAtA[i] = 0x4a
+	return ""
+}
+
+func (m *RevisionResponse) GetDescription() string {
+	if m != nil {
+		return m.Description
 	}
-	if len(m.CreatedAt) > 0 {
-		i -= len(m.CreatedAt)
-		copy(dAtA[i:], m.CreatedAt)
-		i = encodeVarintPost(dAtA, i, uint64(len(m.CreatedAt)))
-		i--
-		dAtA[i] = 0x42
+	return ""
+}
+
+func (m *RevisionResponse) GetEditorId() string {
+	if m != nil {
+		return m.EditorId
 	}
-	if len(m.UserName) > 0 {
-		i -= len(m.UserName)
-		copy(dAtA[i:], m.UserName)
-		i = encodeVarintPost(dAtA, i, uint64(len(m.UserName)))
-		i--
-		dAtA[i] = 0x3a
+	return ""
+}
+
+func (m *RevisionResponse) GetRestoredFrom() int64 {
+	if m != nil {
+		return m.RestoredFrom
 	}
-	if len(m.UserId) > 0 {
-		i -= len(m.UserId)
-		copy(dAtA[i:], m.UserId)
-		i = encodeVarintPost(dAtA, i, uint64(len(m.UserId)))
-		i--
-		dAtA[i] = 0x32
-	}
-	if m.Comments != 0 {
-		i = encodeVarintPost(dAtA, i, uint64(m.Comments))
-		i--
-		dAtA[i] = 0x28
-	}
-	if m.Likes != 0 {
-		i = encodeVarintPost(dAtA, i, uint64(m.Likes))
-		i--
-		dAtA[i] = 0x20
-	}
-	if len(m.Description) > 0 {
-		i -= len(m.Description)
-		copy(dAtA[i:], m.Description)
-		i = encodeVarintPost(dAtA, i, uint64(len(m.Description)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Title) > 0 {
-		i -= len(m.Title)
-		copy(dAtA[i:], m.Title)
-		i = encodeVarintPost(dAtA, i, uint64(len(m.Title)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Id) > 0 {
-		i -= len(m.Id)
-		copy(dAtA[i:], m.Id)
-		i = encodeVarintPost(dAtA, i, uint64(len(m.Id)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func encodeVarintPost(dAtA []byte, offset int, v uint64) int {
-	offset -= sovPost(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *RevisionResponse) GetCreatedAt() string {
+	if m != nil {
+		return m.CreatedAt
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return ""
 }
-func (m *Request) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Str)
-	if l > 0 {
-		n += 1 + l + sovPost(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
+
+type RevisionsResponse struct {
+	Revisions            []*RevisionResponse `protobuf:"bytes,1,rep,name=revisions,proto3" json:"revisions"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
 }
 
-func (m *LikeRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.PostId)
-	if l > 0 {
-		n += 1 + l + sovPost(uint64(l))
-	}
-	if m.IsLiked {
-		n += 2
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+func (m *RevisionsResponse) Reset()         { *m = RevisionsResponse{} }
+func (m *RevisionsResponse) String() string { return proto.CompactTextString(m) }
+func (*RevisionsResponse) ProtoMessage()    {}
+func (*RevisionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e9e4a9952ba66d64, []int{20}
+}
+func (m *RevisionsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RevisionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RevisionsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *RevisionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RevisionsResponse.Merge(m, src)
+}
+func (m *RevisionsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *RevisionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RevisionsResponse.DiscardUnknown(m)
 }
 
-func (m *PostRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Id)
-	if l > 0 {
-		n += 1 + l + sovPost(uint64(l))
-	}
-	l = len(m.Title)
-	if l > 0 {
-		n += 1 + l + sovPost(uint64(l))
-	}
-	l = len(m.Description)
-	if l > 0 {
-		n += 1 + l + sovPost(uint64(l))
+var xxx_messageInfo_RevisionsResponse proto.InternalMessageInfo
+
+func (m *RevisionsResponse) GetRevisions() []*RevisionResponse {
+	if m != nil {
+		return m.Revisions
 	}
-	l = len(m.UserId)
-	if l > 0 {
-		n += 1 + l + sovPost(uint64(l))
+	return nil
+}
+
+type DiffRevisionsRequest struct {
+	PostId               string   `protobuf:"bytes,1,opt,name=post_id,json=postId,proto3" json:"post_id"`
+	From                 int64    `protobuf:"varint,2,opt,name=from,proto3" json:"from"`
+	To                   int64    `protobuf:"varint,3,opt,name=to,proto3" json:"to"`
+	ViewerId             string   `protobuf:"bytes,4,opt,name=viewer_id,json=viewerId,proto3" json:"viewer_id"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DiffRevisionsRequest) Reset()         { *m = DiffRevisionsRequest{} }
+func (m *DiffRevisionsRequest) String() string { return proto.CompactTextString(m) }
+func (*DiffRevisionsRequest) ProtoMessage()    {}
+func (*DiffRevisionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e9e4a9952ba66d64, []int{21}
+}
+func (m *DiffRevisionsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DiffRevisionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DiffRevisionsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+}
+func (m *DiffRevisionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DiffRevisionsRequest.Merge(m, src)
+}
+func (m *DiffRevisionsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *DiffRevisionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DiffRevisionsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DiffRevisionsRequest proto.InternalMessageInfo
+
+func (m *DiffRevisionsRequest) GetPostId() string {
+	if m != nil {
+		return m.PostId
 	}
-	return n
+	return ""
 }
 
-func (m *UpdatePostRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *DiffRevisionsRequest) GetFrom() int64 {
+	if m != nil {
+		return m.From
 	}
-	var l int
-	_ = l
-	l = len(m.Title)
-	if l > 0 {
-		n += 1 + l + sovPost(uint64(l))
+	return 0
+}
+
+func (m *DiffRevisionsRequest) GetTo() int64 {
+	if m != nil {
+		return m.To
 	}
-	l = len(m.Description)
-	if l > 0 {
-		n += 1 + l + sovPost(uint64(l))
+	return 0
+}
+
+func (m *DiffRevisionsRequest) GetViewerId() string {
+	if m != nil {
+		return m.ViewerId
 	}
-	l = len(m.Id)
-	if l > 0 {
-		n += 1 + l + sovPost(uint64(l))
+	return ""
+}
+
+type DiffLine struct {
+	Op                   string   `protobuf:"bytes,1,opt,name=op,proto3" json:"op"`
+	Text                 string   `protobuf:"bytes,2,opt,name=text,proto3" json:"text"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DiffLine) Reset()         { *m = DiffLine{} }
+func (m *DiffLine) String() string { return proto.CompactTextString(m) }
+func (*DiffLine) ProtoMessage()    {}
+func (*DiffLine) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e9e4a9952ba66d64, []int{22}
+}
+func (m *DiffLine) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DiffLine) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DiffLine.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+}
+func (m *DiffLine) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DiffLine.Merge(m, src)
+}
+func (m *DiffLine) XXX_Size() int {
+	return m.Size()
+}
+func (m *DiffLine) XXX_DiscardUnknown() {
+	xxx_messageInfo_DiffLine.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DiffLine proto.InternalMessageInfo
+
+func (m *DiffLine) GetOp() string {
+	if m != nil {
+		return m.Op
 	}
-	return n
+	return ""
 }
 
-func (m *PostsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *DiffLine) GetText() string {
+	if m != nil {
+		return m.Text
 	}
-	var l int
-	_ = l
-	if len(m.Posts) > 0 {
-		for _, e := range m.Posts {
-			l = e.Size()
-			n += 1 + l + sovPost(uint64(l))
+	return ""
+}
+
+type DiffRevisionsResponse struct {
+	From                 int64       `protobuf:"varint,1,opt,name=from,proto3" json:"from"`
+	To                   int64       `protobuf:"varint,2,opt,name=to,proto3" json:"to"`
+	Title                []*DiffLine `protobuf:"bytes,3,rep,name=title,proto3" json:"title"`
+	Description          []*DiffLine `protobuf:"bytes,4,rep,name=description,proto3" json:"description"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
+}
+
+func (m *DiffRevisionsResponse) Reset()         { *m = DiffRevisionsResponse{} }
+func (m *DiffRevisionsResponse) String() string { return proto.CompactTextString(m) }
+func (*DiffRevisionsResponse) ProtoMessage()    {}
+func (*DiffRevisionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e9e4a9952ba66d64, []int{23}
+}
+func (m *DiffRevisionsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DiffRevisionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DiffRevisionsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+}
+func (m *DiffRevisionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DiffRevisionsResponse.Merge(m, src)
+}
+func (m *DiffRevisionsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *DiffRevisionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DiffRevisionsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DiffRevisionsResponse proto.InternalMessageInfo
+
+func (m *DiffRevisionsResponse) GetFrom() int64 {
+	if m != nil {
+		return m.From
 	}
-	return n
+	return 0
 }
 
-func (m *PostResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *DiffRevisionsResponse) GetTo() int64 {
+	if m != nil {
+		return m.To
 	}
-	var l int
-	_ = l
-	l = len(m.Id)
-	if l > 0 {
-		n += 1 + l + sovPost(uint64(l))
+	return 0
+}
+
+func (m *DiffRevisionsResponse) GetTitle() []*DiffLine {
+	if m != nil {
+		return m.Title
 	}
-	l = len(m.Title)
-	if l > 0 {
-		n += 1 + l + sovPost(uint64(l))
+	return nil
+}
+
+func (m *DiffRevisionsResponse) GetDescription() []*DiffLine {
+	if m != nil {
+		return m.Description
 	}
-	l = len(m.Description)
-	if l > 0 {
-		n += 1 + l + sovPost(uint64(l))
+	return nil
+}
+
+type RestoreRevisionRequest struct {
+	PostId               string   `protobuf:"bytes,1,opt,name=post_id,json=postId,proto3" json:"post_id"`
+	Revision             int64    `protobuf:"varint,2,opt,name=revision,proto3" json:"revision"`
+	EditorId             string   `protobuf:"bytes,3,opt,name=editor_id,json=editorId,proto3" json:"editor_id"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RestoreRevisionRequest) Reset()         { *m = RestoreRevisionRequest{} }
+func (m *RestoreRevisionRequest) String() string { return proto.CompactTextString(m) }
+func (*RestoreRevisionRequest) ProtoMessage()    {}
+func (*RestoreRevisionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e9e4a9952ba66d64, []int{24}
+}
+func (m *RestoreRevisionRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RestoreRevisionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RestoreRevisionRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if m.Likes != 0 {
-		n += 1 + sovPost(uint64(m.Likes))
+}
+func (m *RestoreRevisionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RestoreRevisionRequest.Merge(m, src)
+}
+func (m *RestoreRevisionRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *RestoreRevisionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RestoreRevisionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RestoreRevisionRequest proto.InternalMessageInfo
+
+func (m *RestoreRevisionRequest) GetPostId() string {
+	if m != nil {
+		return m.PostId
 	}
-	if m.Comments != 0 {
-		n += 1 + sovPost(uint64(m.Comments))
+	return ""
+}
+
+func (m *RestoreRevisionRequest) GetRevision() int64 {
+	if m != nil {
+		return m.Revision
 	}
-	l = len(m.UserId)
-	if l > 0 {
-		n += 1 + l + sovPost(uint64(l))
+	return 0
+}
+
+func (m *RestoreRevisionRequest) GetEditorId() string {
+	if m != nil {
+		return m.EditorId
 	}
-	l = len(m.UserName)
-	if l > 0 {
-		n += 1 + l + sovPost(uint64(l))
+	return ""
+}
+
+type TagPostsRequest struct {
+	Tag                  string   `protobuf:"bytes,1,opt,name=tag,proto3" json:"tag"`
+	ViewerId             string   `protobuf:"bytes,2,opt,name=viewer_id,json=viewerId,proto3" json:"viewer_id"`
+	Limit                int64    `protobuf:"varint,3,opt,name=limit,proto3" json:"limit"`
+	Page                 int64    `protobuf:"varint,4,opt,name=page,proto3" json:"page"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TagPostsRequest) Reset()         { *m = TagPostsRequest{} }
+func (m *TagPostsRequest) String() string { return proto.CompactTextString(m) }
+func (*TagPostsRequest) ProtoMessage()    {}
+func (*TagPostsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e9e4a9952ba66d64, []int{25}
+}
+func (m *TagPostsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TagPostsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TagPostsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	l = len(m.CreatedAt)
-	if l > 0 {
-		n += 1 + l + sovPost(uint64(l))
+}
+func (m *TagPostsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TagPostsRequest.Merge(m, src)
+}
+func (m *TagPostsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *TagPostsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_TagPostsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TagPostsRequest proto.InternalMessageInfo
+
+func (m *TagPostsRequest) GetTag() string {
+	if m != nil {
+		return m.Tag
 	}
-	l = len(m.UpdatedAt)
-	if l > 0 {
-		n += 1 + l + sovPost(uint64(l))
+	return ""
+}
+
+func (m *TagPostsRequest) GetViewerId() string {
+	if m != nil {
+		return m.ViewerId
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	return ""
+}
+
+func (m *TagPostsRequest) GetLimit() int64 {
+	if m != nil {
+		return m.Limit
 	}
-	return n
+	return 0
 }
 
-func sovPost(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *TagPostsRequest) GetPage() int64 {
+	if m != nil {
+		return m.Page
+	}
+	return 0
 }
-func sozPost(x uint64) (n int) {
-	return sovPost(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+type AutocompleteTagsRequest struct {
+	Prefix               string   `protobuf:"bytes,1,opt,name=prefix,proto3" json:"prefix"`
+	Limit                int64    `protobuf:"varint,2,opt,name=limit,proto3" json:"limit"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AutocompleteTagsRequest) Reset()         { *m = AutocompleteTagsRequest{} }
+func (m *AutocompleteTagsRequest) String() string { return proto.CompactTextString(m) }
+func (*AutocompleteTagsRequest) ProtoMessage()    {}
+func (*AutocompleteTagsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e9e4a9952ba66d64, []int{26}
+}
+func (m *AutocompleteTagsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AutocompleteTagsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AutocompleteTagsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AutocompleteTagsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AutocompleteTagsRequest.Merge(m, src)
+}
+func (m *AutocompleteTagsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *AutocompleteTagsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AutocompleteTagsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AutocompleteTagsRequest proto.InternalMessageInfo
+
+func (m *AutocompleteTagsRequest) GetPrefix() string {
+	if m != nil {
+		return m.Prefix
+	}
+	return ""
+}
+
+func (m *AutocompleteTagsRequest) GetLimit() int64 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+type TrendingTagsRequest struct {
+	Hours                int64    `protobuf:"varint,1,opt,name=hours,proto3" json:"hours"`
+	Limit                int64    `protobuf:"varint,2,opt,name=limit,proto3" json:"limit"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TrendingTagsRequest) Reset()         { *m = TrendingTagsRequest{} }
+func (m *TrendingTagsRequest) String() string { return proto.CompactTextString(m) }
+func (*TrendingTagsRequest) ProtoMessage()    {}
+func (*TrendingTagsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e9e4a9952ba66d64, []int{27}
+}
+func (m *TrendingTagsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TrendingTagsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TrendingTagsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TrendingTagsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TrendingTagsRequest.Merge(m, src)
+}
+func (m *TrendingTagsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *TrendingTagsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_TrendingTagsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TrendingTagsRequest proto.InternalMessageInfo
+
+func (m *TrendingTagsRequest) GetHours() int64 {
+	if m != nil {
+		return m.Hours
+	}
+	return 0
+}
+
+func (m *TrendingTagsRequest) GetLimit() int64 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+type TagResponse struct {
+	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name"`
+	Posts                int64    `protobuf:"varint,2,opt,name=posts,proto3" json:"posts"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TagResponse) Reset()         { *m = TagResponse{} }
+func (m *TagResponse) String() string { return proto.CompactTextString(m) }
+func (*TagResponse) ProtoMessage()    {}
+func (*TagResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e9e4a9952ba66d64, []int{28}
+}
+func (m *TagResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TagResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TagResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TagResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TagResponse.Merge(m, src)
+}
+func (m *TagResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *TagResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_TagResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TagResponse proto.InternalMessageInfo
+
+func (m *TagResponse) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *TagResponse) GetPosts() int64 {
+	if m != nil {
+		return m.Posts
+	}
+	return 0
+}
+
+type TagsResponse struct {
+	Tags                 []*TagResponse `protobuf:"bytes,1,rep,name=tags,proto3" json:"tags"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *TagsResponse) Reset()         { *m = TagsResponse{} }
+func (m *TagsResponse) String() string { return proto.CompactTextString(m) }
+func (*TagsResponse) ProtoMessage()    {}
+func (*TagsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e9e4a9952ba66d64, []int{29}
+}
+func (m *TagsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TagsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TagsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TagsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TagsResponse.Merge(m, src)
+}
+func (m *TagsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *TagsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_TagsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TagsResponse proto.InternalMessageInfo
+
+func (m *TagsResponse) GetTags() []*TagResponse {
+	if m != nil {
+		return m.Tags
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*Request)(nil), "post.Request")
+	proto.RegisterType((*ModerateRequest)(nil), "post.ModerateRequest")
+	proto.RegisterType((*IdsRequest)(nil), "post.IdsRequest")
+	proto.RegisterType((*PostCountsResponse)(nil), "post.PostCountsResponse")
+	proto.RegisterMapType((map[string]int64)(nil), "post.PostCountsResponse.CountsEntry")
+	proto.RegisterType((*LikeRequest)(nil), "post.LikeRequest")
+	proto.RegisterType((*LikeResponse)(nil), "post.LikeResponse")
+	proto.RegisterType((*LikesResponse)(nil), "post.LikesResponse")
+	proto.RegisterType((*StreamRequest)(nil), "post.StreamRequest")
+	proto.RegisterType((*LikeEvent)(nil), "post.LikeEvent")
+	proto.RegisterType((*PostRequest)(nil), "post.PostRequest")
+	proto.RegisterType((*UpdatePostRequest)(nil), "post.UpdatePostRequest")
+	proto.RegisterType((*PostsResponse)(nil), "post.PostsResponse")
+	proto.RegisterType((*PostResponse)(nil), "post.PostResponse")
+	proto.RegisterType((*AttachmentRequest)(nil), "post.AttachmentRequest")
+	proto.RegisterType((*AttachmentContentRequest)(nil), "post.AttachmentContentRequest")
+	proto.RegisterType((*AttachmentResponse)(nil), "post.AttachmentResponse")
+	proto.RegisterType((*AttachmentsResponse)(nil), "post.AttachmentsResponse")
+	proto.RegisterType((*AttachmentContent)(nil), "post.AttachmentContent")
+	proto.RegisterType((*RevisionsRequest)(nil), "post.RevisionsRequest")
+	proto.RegisterType((*RevisionResponse)(nil), "post.RevisionResponse")
+	proto.RegisterType((*RevisionsResponse)(nil), "post.RevisionsResponse")
+	proto.RegisterType((*DiffRevisionsRequest)(nil), "post.DiffRevisionsRequest")
+	proto.RegisterType((*DiffLine)(nil), "post.DiffLine")
+	proto.RegisterType((*DiffRevisionsResponse)(nil), "post.DiffRevisionsResponse")
+	proto.RegisterType((*RestoreRevisionRequest)(nil), "post.RestoreRevisionRequest")
+	proto.RegisterType((*TagPostsRequest)(nil), "post.TagPostsRequest")
+	proto.RegisterType((*AutocompleteTagsRequest)(nil), "post.AutocompleteTagsRequest")
+	proto.RegisterType((*TrendingTagsRequest)(nil), "post.TrendingTagsRequest")
+	proto.RegisterType((*TagResponse)(nil), "post.TagResponse")
+	proto.RegisterType((*TagsResponse)(nil), "post.TagsResponse")
+}
+
+func init() { proto.RegisterFile("post/post.proto", fileDescriptor_e9e4a9952ba66d64) }
+
+var fileDescriptor_e9e4a9952ba66d64 = []byte{
+	// 1761 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x58, 0xcd, 0x6f, 0x1b, 0xc7,
+	0x15, 0xe7, 0x92, 0x14, 0x45, 0x3e, 0x92, 0x22, 0x39, 0x92, 0x25, 0x9a, 0x92, 0x05, 0x63, 0xed,
+	0x02, 0x6e, 0x0b, 0x48, 0xae, 0x5d, 0xd7, 0x9f, 0x3d, 0x50, 0xb2, 0xad, 0xb2, 0x70, 0x8b, 0x9a,
+	0xa2, 0x0b, 0xb4, 0x39, 0x10, 0x23, 0xee, 0x88, 0x1a, 0x8b, 0xe4, 0x6e, 0x76, 0x86, 0x8a, 0x69,
+	0xc3, 0x39, 0x24, 0x40, 0x6e, 0x39, 0xf9, 0x92, 0x5b, 0xce, 0xf9, 0x4f, 0x72, 0x0c, 0x90, 0x4b,
+	0x72, 0x33, 0x9c, 0xfc, 0x21, 0xc1, 0x7c, 0xec, 0xee, 0xec, 0xf2, 0x43, 0x32, 0x90, 0x0b, 0xb1,
+	0xf3, 0x66, 0xde, 0x9b, 0xf7, 0x7e, 0xef, 0xf7, 0xde, 0x0c, 0x07, 0x2a, 0x9e, 0xcb, 0xf8, 0xae,
+	0xf8, 0xd9, 0xf1, 0x7c, 0x97, 0xbb, 0x28, 0x2b, 0xbe, 0x1b, 0x5b, 0x7d, 0xd7, 0xed, 0x0f, 0xc8,
+	0x2e, 0xf6, 0xe8, 0x2e, 0x1e, 0x8d, 0x5c, 0x8e, 0x39, 0x75, 0x47, 0x4c, 0xad, 0xb1, 0xef, 0xc1,
+	0x72, 0x9b, 0x7c, 0x3a, 0x26, 0x8c, 0xa3, 0x2a, 0x64, 0x18, 0xf7, 0xeb, 0xd6, 0x55, 0xeb, 0x46,
+	0xa1, 0x2d, 0x3e, 0xd1, 0x26, 0x14, 0xce, 0x28, 0xf9, 0x8c, 0xf8, 0x5d, 0xea, 0xd4, 0xd3, 0x52,
+	0x9e, 0x57, 0x82, 0x96, 0x63, 0xdf, 0x87, 0xca, 0xbf, 0x5c, 0x87, 0xf8, 0x98, 0x93, 0xc0, 0xc2,
+	0x0a, 0xa4, 0xa9, 0xa3, 0x0d, 0xa4, 0xa9, 0x83, 0xd6, 0x21, 0x87, 0x7b, 0x62, 0x37, 0xad, 0xac,
+	0x47, 0xf6, 0x43, 0x80, 0x96, 0xc3, 0x8c, 0x7d, 0xa9, 0xc3, 0xea, 0xd6, 0xd5, 0x8c, 0xd8, 0x97,
+	0x3a, 0x6c, 0xf1, 0xbe, 0x5f, 0x5b, 0x80, 0xfe, 0xe3, 0x32, 0xbe, 0xef, 0x8e, 0x47, 0x9c, 0xb5,
+	0x09, 0xf3, 0xdc, 0x11, 0x23, 0xe8, 0x11, 0xe4, 0x7a, 0x52, 0x22, 0x0d, 0x15, 0x6f, 0x5d, 0xdf,
+	0x91, 0x48, 0x4c, 0xaf, 0xdc, 0x51, 0xc3, 0x27, 0x23, 0xee, 0x4f, 0xda, 0x5a, 0xa7, 0x71, 0x1f,
+	0x8a, 0x86, 0x58, 0xb8, 0x74, 0x4a, 0x26, 0x01, 0x14, 0xa7, 0x64, 0x82, 0xd6, 0x60, 0xe9, 0x0c,
+	0x0f, 0xc6, 0x44, 0xba, 0x93, 0x69, 0xab, 0xc1, 0x83, 0xf4, 0x3d, 0xcb, 0xfe, 0x3f, 0x14, 0x9f,
+	0xd1, 0xd3, 0x10, 0x83, 0x0d, 0x58, 0x16, 0x1b, 0x77, 0x43, 0x20, 0x72, 0x62, 0xd8, 0x72, 0xd0,
+	0x65, 0xc8, 0x53, 0xd6, 0x1d, 0xd0, 0x53, 0xa2, 0x62, 0xca, 0xb7, 0x97, 0x29, 0x13, 0x9a, 0x8e,
+	0xd0, 0x19, 0x33, 0x15, 0x6d, 0x46, 0xe9, 0x88, 0x61, 0xcb, 0xb1, 0x09, 0x94, 0x94, 0x6d, 0x1d,
+	0xe4, 0x5c, 0xe3, 0x57, 0x00, 0xe4, 0x04, 0xa7, 0x7c, 0x40, 0x34, 0x64, 0x05, 0x21, 0xe9, 0x08,
+	0x81, 0x98, 0xee, 0xf9, 0x04, 0x73, 0xe2, 0x74, 0x31, 0xd7, 0x7b, 0x14, 0xb4, 0xa4, 0xc9, 0xed,
+	0xfb, 0x50, 0x16, 0xdb, 0x44, 0x60, 0xde, 0x80, 0x25, 0xe1, 0x68, 0x80, 0x25, 0x52, 0x58, 0x9a,
+	0xae, 0xb4, 0xd5, 0x02, 0xfb, 0x06, 0x94, 0x0f, 0xb9, 0x4f, 0xf0, 0xf0, 0xbc, 0xf8, 0xed, 0xcf,
+	0xa1, 0x20, 0x0c, 0x3c, 0x39, 0x23, 0xa3, 0x69, 0xa6, 0x18, 0x5a, 0xe9, 0x58, 0x60, 0xf3, 0xa0,
+	0x89, 0xc1, 0x99, 0x8d, 0xc3, 0xb9, 0x16, 0x78, 0xbf, 0xa4, 0x72, 0xa5, 0x3c, 0xfd, 0xd9, 0x82,
+	0xa2, 0x60, 0xc3, 0x3c, 0xb2, 0xae, 0xc1, 0x92, 0x89, 0x9e, 0x1a, 0xa0, 0xab, 0x50, 0x74, 0x08,
+	0xeb, 0xf9, 0xd4, 0x93, 0x3c, 0x56, 0x3e, 0x98, 0x22, 0xd3, 0xc3, 0x6c, 0xcc, 0xc3, 0x75, 0xc8,
+	0x31, 0x8e, 0xf9, 0x58, 0xf9, 0x51, 0x68, 0xeb, 0x91, 0xcc, 0xd5, 0xf8, 0x68, 0x40, 0xd9, 0x89,
+	0x48, 0x46, 0x4e, 0xe7, 0x4a, 0x49, 0x9a, 0x1c, 0x6d, 0x03, 0x9c, 0x51, 0x46, 0x8f, 0xe8, 0x80,
+	0xf2, 0x49, 0x7d, 0x59, 0x4e, 0x1b, 0x12, 0x84, 0x20, 0xcb, 0x71, 0x9f, 0xd5, 0xf3, 0xb2, 0x5e,
+	0xe4, 0xb7, 0xfd, 0x5d, 0x1a, 0x6a, 0x2f, 0x3c, 0x07, 0x73, 0x62, 0x46, 0x18, 0x46, 0x64, 0x2d,
+	0x88, 0x28, 0x3d, 0x1d, 0x91, 0x42, 0x26, 0x63, 0x96, 0xb1, 0x0e, 0x24, 0xbb, 0x20, 0x90, 0xa5,
+	0xc5, 0x81, 0xe4, 0xa6, 0x02, 0xd9, 0x84, 0x02, 0x71, 0x28, 0x77, 0x25, 0x74, 0x2a, 0xce, 0xbc,
+	0x12, 0xb4, 0x9c, 0x59, 0x51, 0xa2, 0x3f, 0x42, 0x95, 0xbc, 0xf2, 0x48, 0x4f, 0xd0, 0xf8, 0x8c,
+	0xf8, 0x4c, 0xb8, 0x5f, 0x90, 0x29, 0xae, 0x04, 0xf2, 0xff, 0x2a, 0xb1, 0x60, 0x87, 0x50, 0xe9,
+	0x32, 0xc2, 0xeb, 0xa0, 0xd8, 0x21, 0xc6, 0x87, 0x44, 0x92, 0x5d, 0x80, 0x14, 0x23, 0xbb, 0x20,
+	0x5b, 0x82, 0xec, 0x0a, 0xc8, 0x80, 0xec, 0x72, 0x81, 0xfd, 0x6d, 0x16, 0x4a, 0xa6, 0xfc, 0x77,
+	0xe3, 0x50, 0xc8, 0xd8, 0xac, 0xc1, 0x58, 0xd4, 0x80, 0x7c, 0xcf, 0x1d, 0x0e, 0x89, 0x68, 0x6a,
+	0x8a, 0xca, 0xe1, 0xd8, 0x64, 0x5d, 0x2e, 0xc6, 0xba, 0x4d, 0x28, 0xc8, 0x89, 0x11, 0x1e, 0x92,
+	0x00, 0x55, 0x21, 0xf8, 0x37, 0x1e, 0x26, 0xfb, 0x40, 0x3e, 0xd1, 0x07, 0xc4, 0xf4, 0xd8, 0x73,
+	0x82, 0xe9, 0x82, 0x9a, 0xd6, 0x92, 0x26, 0x47, 0x0f, 0xa0, 0x88, 0x39, 0xc7, 0xbd, 0x13, 0xe5,
+	0x12, 0x48, 0xb8, 0xea, 0x0a, 0xae, 0x66, 0x38, 0x11, 0x82, 0x66, 0x2e, 0x36, 0x38, 0x54, 0x5c,
+	0xc0, 0xa1, 0xd2, 0x62, 0x0e, 0x95, 0xa7, 0x38, 0xb4, 0x0e, 0x39, 0x41, 0x19, 0xe2, 0xd4, 0x57,
+	0x64, 0x96, 0xf5, 0x28, 0xe0, 0x96, 0x0a, 0xa4, 0x12, 0x71, 0x4b, 0xc6, 0x11, 0x70, 0xab, 0x6a,
+	0x70, 0xab, 0x0e, 0xcb, 0x01, 0xa5, 0x6a, 0x12, 0xea, 0x60, 0x88, 0xfe, 0x0c, 0xb5, 0xa1, 0x3a,
+	0xe7, 0xa8, 0x3b, 0xea, 0xea, 0x20, 0x90, 0x34, 0x59, 0x8d, 0x26, 0x0e, 0xa5, 0xdc, 0xfe, 0xca,
+	0x82, 0x9a, 0x09, 0xc5, 0xec, 0x56, 0xf3, 0xf1, 0xdd, 0x6e, 0x13, 0x0a, 0xc7, 0x74, 0x40, 0x54,
+	0x56, 0x55, 0x15, 0xe6, 0x85, 0x40, 0x66, 0x15, 0x41, 0xd6, 0xc1, 0x1c, 0x4b, 0x8e, 0x94, 0xda,
+	0xf2, 0xdb, 0xfe, 0x07, 0xd4, 0x23, 0x3f, 0xf6, 0xdd, 0x11, 0x5f, 0xe0, 0xce, 0x16, 0x14, 0xf8,
+	0xc9, 0x78, 0x78, 0x34, 0xc2, 0x74, 0xa0, 0x8f, 0xa6, 0x48, 0x60, 0xff, 0x64, 0x01, 0x9a, 0xce,
+	0xee, 0xc5, 0x63, 0x8a, 0xb9, 0x9e, 0x49, 0xb8, 0xbe, 0x09, 0x85, 0x21, 0x1d, 0x92, 0x2e, 0x9f,
+	0x78, 0x61, 0x5c, 0x42, 0xd0, 0x99, 0x78, 0x24, 0xd4, 0x64, 0xf4, 0x35, 0x09, 0x0a, 0x40, 0x08,
+	0x0e, 0xe9, 0x6b, 0x82, 0xae, 0x41, 0xf9, 0x04, 0xb3, 0x6e, 0xe4, 0x78, 0x4e, 0x3a, 0x5e, 0x3a,
+	0xc1, 0xac, 0x13, 0xc8, 0x12, 0x7c, 0x5f, 0x4e, 0x9e, 0x7b, 0xcf, 0x61, 0x35, 0x8a, 0x2c, 0x6a,
+	0x08, 0x09, 0x9e, 0x5b, 0x1f, 0xc1, 0x73, 0x1b, 0x43, 0x6d, 0x0a, 0xf7, 0x38, 0x04, 0xd6, 0x22,
+	0x08, 0xd2, 0x09, 0x08, 0x82, 0xd4, 0x66, 0x62, 0xa9, 0xad, 0xb6, 0x89, 0xa8, 0x01, 0x77, 0xc4,
+	0xce, 0xbd, 0x75, 0x2c, 0xbc, 0x4a, 0xbd, 0xb7, 0x22, 0x53, 0xe7, 0xdf, 0x31, 0x1a, 0x90, 0xf7,
+	0xf5, 0x62, 0x7d, 0x0b, 0x0a, 0xc7, 0x51, 0xe3, 0xcb, 0x2c, 0x68, 0x7c, 0xd9, 0xe9, 0xc6, 0x17,
+	0x3b, 0x03, 0x96, 0x12, 0x67, 0xc0, 0x35, 0x28, 0xfb, 0x84, 0x71, 0xd7, 0x27, 0x4e, 0xf7, 0xd8,
+	0x77, 0x87, 0x32, 0xc5, 0x99, 0x76, 0x29, 0x10, 0x3e, 0xf5, 0xdd, 0xe1, 0x79, 0x29, 0x6e, 0x41,
+	0xcd, 0x00, 0x4b, 0x87, 0xf8, 0x57, 0x28, 0x04, 0x9e, 0x07, 0xe9, 0x5d, 0x57, 0xe9, 0x4d, 0xa2,
+	0xd1, 0x8e, 0x16, 0xda, 0x1e, 0xac, 0x3d, 0xa6, 0xc7, 0xc7, 0x17, 0xc7, 0x1e, 0x41, 0x56, 0xba,
+	0xad, 0xc0, 0x92, 0xdf, 0xa2, 0x6c, 0xb8, 0x2b, 0x51, 0xca, 0xb4, 0xd3, 0xdc, 0x8d, 0xe7, 0x27,
+	0x9b, 0xc8, 0xcf, 0x0e, 0xe4, 0xc5, 0x8e, 0xcf, 0xe8, 0x48, 0xd6, 0x9b, 0xeb, 0x05, 0xf5, 0xe6,
+	0x7a, 0xc2, 0x38, 0x27, 0xaf, 0xb8, 0xce, 0xa9, 0xfc, 0xb6, 0xdf, 0x59, 0x70, 0x29, 0xe1, 0xa2,
+	0x8e, 0x38, 0x70, 0xc5, 0x9a, 0x72, 0x25, 0x1d, 0xba, 0x72, 0x3d, 0xca, 0xa1, 0x40, 0x64, 0x45,
+	0x21, 0x12, 0x38, 0x10, 0xe4, 0xf4, 0x66, 0x32, 0xa7, 0xb3, 0xd6, 0x9a, 0x4b, 0xec, 0x97, 0xb0,
+	0xde, 0x56, 0x19, 0x8b, 0xd0, 0x3d, 0x07, 0xb9, 0x45, 0x54, 0x8b, 0x51, 0x26, 0x13, 0xa7, 0x8c,
+	0xfd, 0x12, 0x2a, 0x1d, 0xdc, 0xd7, 0xe7, 0x7b, 0xf8, 0xf7, 0x82, 0xe3, 0x7e, 0x70, 0x97, 0xe7,
+	0xb8, 0xbf, 0xb0, 0x26, 0xd4, 0x51, 0x3c, 0xa4, 0x5c, 0xe7, 0x48, 0x0d, 0x04, 0x7e, 0x1e, 0xee,
+	0x13, 0x7d, 0x3e, 0xcb, 0x6f, 0xfb, 0x00, 0x36, 0x9a, 0x63, 0xee, 0xf6, 0xdc, 0xa1, 0x37, 0x20,
+	0x9c, 0x74, 0x70, 0x3f, 0xdc, 0x73, 0x1d, 0x72, 0x9e, 0x4f, 0x8e, 0xe9, 0xab, 0x30, 0x2e, 0x39,
+	0x8a, 0x8c, 0xa7, 0x0d, 0xe3, 0x76, 0x13, 0x56, 0x3b, 0x3e, 0x19, 0x39, 0x74, 0xd4, 0x37, 0x8d,
+	0xac, 0xc1, 0xd2, 0x89, 0x3b, 0xf6, 0x99, 0x4e, 0x9a, 0x1a, 0xcc, 0x31, 0x71, 0x17, 0x8a, 0x1d,
+	0xdc, 0x37, 0xd3, 0x6d, 0xf4, 0x1a, 0xf9, 0x2d, 0x14, 0xd5, 0x35, 0x47, 0x2b, 0xca, 0x81, 0x7d,
+	0x07, 0x4a, 0x6a, 0x4f, 0xad, 0xf9, 0x07, 0x7d, 0x36, 0xaa, 0xaa, 0xa8, 0xa9, 0xbc, 0x1a, 0xa6,
+	0xd5, 0x71, 0x79, 0xeb, 0xcb, 0xb2, 0xba, 0x4c, 0x1f, 0x12, 0xff, 0x8c, 0xf6, 0x08, 0xba, 0x03,
+	0xb0, 0x2f, 0x6b, 0x4e, 0x08, 0x51, 0xcd, 0xbc, 0x42, 0xc9, 0x60, 0x1a, 0x33, 0x6e, 0x55, 0x76,
+	0x0a, 0xb5, 0xa0, 0x78, 0x40, 0xb8, 0x10, 0xee, 0x4d, 0x5a, 0x0e, 0x2a, 0x07, 0x45, 0x38, 0x5f,
+	0x67, 0xe3, 0x8b, 0x1f, 0x7f, 0x7d, 0x97, 0xae, 0xa1, 0xca, 0xee, 0xd9, 0x2d, 0xf9, 0x5f, 0x97,
+	0xed, 0xbe, 0x61, 0xdc, 0x7f, 0x8b, 0x3a, 0x50, 0x09, 0x4d, 0xbd, 0x50, 0x87, 0x66, 0xc2, 0xdc,
+	0x6a, 0x64, 0x2e, 0x8c, 0xd7, 0xbe, 0x22, 0xed, 0x6d, 0xa0, 0x4b, 0xc2, 0x9e, 0x38, 0x6c, 0xb5,
+	0x3d, 0x65, 0x1b, 0xdd, 0x86, 0xe2, 0x21, 0xc1, 0x7e, 0xef, 0x44, 0x6a, 0x5d, 0xc8, 0x62, 0x0a,
+	0xdd, 0x86, 0xbc, 0xf8, 0x23, 0x62, 0x42, 0x61, 0xfc, 0x43, 0x9c, 0x03, 0x45, 0x07, 0x20, 0xba,
+	0xc1, 0xa3, 0x0d, 0xb5, 0x66, 0xea, 0x4e, 0x3f, 0x53, 0xf9, 0xb2, 0x8c, 0x61, 0xb5, 0xb1, 0x62,
+	0x60, 0x42, 0x9d, 0xb7, 0x0f, 0xac, 0x3f, 0xa1, 0xbf, 0x00, 0x3c, 0x26, 0x82, 0x9d, 0xd2, 0xea,
+	0x05, 0xf0, 0x4d, 0xa1, 0x03, 0xa8, 0xbe, 0xf0, 0x06, 0x2e, 0x76, 0xa2, 0x73, 0x2c, 0x70, 0x67,
+	0xea, 0x66, 0xd3, 0x98, 0x7b, 0x2a, 0xda, 0x29, 0xf4, 0x08, 0x56, 0x0e, 0x08, 0x6f, 0x1a, 0x97,
+	0xc0, 0xc4, 0xfe, 0x97, 0x93, 0xca, 0x26, 0x88, 0xcf, 0x61, 0x2d, 0xa6, 0x1d, 0x9c, 0xa5, 0xdb,
+	0x49, 0xa5, 0xf8, 0xe5, 0xa6, 0xb1, 0x31, 0x67, 0xde, 0x4e, 0xa1, 0xbf, 0x43, 0x55, 0x81, 0x61,
+	0x44, 0x96, 0x70, 0x69, 0x51, 0x3c, 0x4d, 0x28, 0x1d, 0x10, 0x1e, 0xf6, 0x56, 0x94, 0x38, 0x32,
+	0x58, 0xc2, 0x83, 0xa9, 0x26, 0x6c, 0xa7, 0xd0, 0x3f, 0xa1, 0x1c, 0xeb, 0xcf, 0xa8, 0x11, 0x35,
+	0xce, 0x29, 0x3b, 0x9b, 0x33, 0xe7, 0x42, 0x5b, 0x4f, 0xa0, 0x92, 0x68, 0xab, 0x68, 0x2b, 0xd8,
+	0x79, 0x56, 0xb7, 0x9d, 0x93, 0xee, 0xff, 0x41, 0x59, 0xd7, 0x0d, 0xdb, 0x9b, 0x74, 0x70, 0x1f,
+	0x5d, 0x0a, 0x6b, 0xde, 0x6c, 0xa3, 0xb3, 0xb9, 0xbe, 0x25, 0x99, 0xb7, 0x8e, 0xd6, 0x04, 0xf3,
+	0x44, 0x63, 0xd8, 0x7d, 0xc3, 0x71, 0x3f, 0x28, 0x1e, 0x07, 0xaa, 0xc9, 0x06, 0x89, 0xae, 0x68,
+	0x80, 0x67, 0x37, 0xce, 0xc0, 0x47, 0xb3, 0x25, 0xc5, 0x4b, 0x54, 0x6e, 0x82, 0x0d, 0x6d, 0xf4,
+	0x89, 0x2c, 0x7c, 0xb3, 0x81, 0x22, 0x4d, 0xac, 0x19, 0x4d, 0x75, 0xe6, 0x06, 0xba, 0x7e, 0x50,
+	0x2d, 0xdc, 0x80, 0x6b, 0x4d, 0x74, 0x17, 0x8a, 0xea, 0x79, 0x43, 0xbe, 0x8f, 0x20, 0x0d, 0x42,
+	0xec, 0xc5, 0xa3, 0x51, 0x89, 0x4a, 0x5c, 0x3e, 0x6e, 0xd8, 0xa9, 0x9b, 0x16, 0xfa, 0x9b, 0x24,
+	0xbf, 0x40, 0xeb, 0xa9, 0xeb, 0x8b, 0x7e, 0x74, 0xc1, 0xde, 0x71, 0x0f, 0x6a, 0x91, 0xde, 0xbe,
+	0xfa, 0xb7, 0x77, 0xb1, 0xba, 0x55, 0x3b, 0x4a, 0x3f, 0x55, 0x07, 0x9c, 0xb3, 0x63, 0xec, 0xa5,
+	0x47, 0xee, 0x68, 0x10, 0xa0, 0xe5, 0x30, 0x54, 0x55, 0xeb, 0xa2, 0x17, 0xba, 0x79, 0xbe, 0xee,
+	0x01, 0x92, 0x8f, 0x66, 0x52, 0xae, 0xc3, 0x9c, 0xa5, 0x5e, 0x9f, 0xf7, 0x14, 0x67, 0xa7, 0xd0,
+	0x43, 0x28, 0x05, 0xaf, 0x88, 0x62, 0x3e, 0x60, 0x5f, 0xe2, 0x65, 0x71, 0x76, 0xc8, 0x7b, 0xd5,
+	0xef, 0x3f, 0x6c, 0x5b, 0x3f, 0x7c, 0xd8, 0xb6, 0xde, 0x7f, 0xd8, 0xb6, 0xbe, 0xf9, 0x65, 0x3b,
+	0x75, 0x94, 0x93, 0xaf, 0x9a, 0xb7, 0x7f, 0x1b, 0x00, 0x17, 0x4d, 0x66, 0xf7, 0x0c, 0x15, 0x00,
+	0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// PostServiceClient is the client API for PostService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type PostServiceClient interface {
+	// methods...
+	CreatePost(ctx context.Context, in *PostRequest, opts ...grpc.CallOption) (*PostResponse, error)
+	GetPostById(ctx context.Context, in *Request, opts ...grpc.CallOption) (*PostResponse, error)
+	GetPostByUserId(ctx context.Context, in *Request, opts ...grpc.CallOption) (*PostsResponse, error)
+	SearchPosts(ctx context.Context, in *Request, opts ...grpc.CallOption) (*PostsResponse, error)
+	LikePost(ctx context.Context, in *LikeRequest, opts ...grpc.CallOption) (*PostResponse, error)
+	UpdatePost(ctx context.Context, in *UpdatePostRequest, opts ...grpc.CallOption) (*PostResponse, error)
+	DeletePost(ctx context.Context, in *Request, opts ...grpc.CallOption) (*PostResponse, error)
+	// attachments...
+	UploadAttachment(ctx context.Context, in *AttachmentRequest, opts ...grpc.CallOption) (*AttachmentResponse, error)
+	GetAttachments(ctx context.Context, in *Request, opts ...grpc.CallOption) (*AttachmentsResponse, error)
+	GetAttachmentContent(ctx context.Context, in *AttachmentContentRequest, opts ...grpc.CallOption) (*AttachmentContent, error)
+	DeleteAttachment(ctx context.Context, in *Request, opts ...grpc.CallOption) (*AttachmentResponse, error)
+	// revisions...
+	GetRevisions(ctx context.Context, in *RevisionsRequest, opts ...grpc.CallOption) (*RevisionsResponse, error)
+	DiffRevisions(ctx context.Context, in *DiffRevisionsRequest, opts ...grpc.CallOption) (*DiffRevisionsResponse, error)
+	RestoreRevision(ctx context.Context, in *RestoreRevisionRequest, opts ...grpc.CallOption) (*PostResponse, error)
+	// tags...
+	GetPostsByTag(ctx context.Context, in *TagPostsRequest, opts ...grpc.CallOption) (*PostsResponse, error)
+	AutocompleteTags(ctx context.Context, in *AutocompleteTagsRequest, opts ...grpc.CallOption) (*TagsResponse, error)
+	GetTrendingTags(ctx context.Context, in *TrendingTagsRequest, opts ...grpc.CallOption) (*TagsResponse, error)
+	// streams...
+	StreamLikes(ctx context.Context, in *StreamRequest, opts ...grpc.CallOption) (PostService_StreamLikesClient, error)
+	// for Clients...
+	GetPostForUser(ctx context.Context, in *Request, opts ...grpc.CallOption) (*PostsResponse, error)
+	GetPostForComment(ctx context.Context, in *Request, opts ...grpc.CallOption) (*PostResponse, error)
+	GetLikesByUser(ctx context.Context, in *Request, opts ...grpc.CallOption) (*LikesResponse, error)
+	GetPostsByIds(ctx context.Context, in *IdsRequest, opts ...grpc.CallOption) (*PostsResponse, error)
+	CountPostsForUsers(ctx context.Context, in *IdsRequest, opts ...grpc.CallOption) (*PostCountsResponse, error)
+	// moderation...
+	ModeratePost(ctx context.Context, in *ModerateRequest, opts ...grpc.CallOption) (*PostResponse, error)
+}
+
+type postServiceClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewPostServiceClient(cc *grpc.ClientConn) PostServiceClient {
+	return &postServiceClient{cc}
+}
+
+func (c *postServiceClient) CreatePost(ctx context.Context, in *PostRequest, opts ...grpc.CallOption) (*PostResponse, error) {
+	out := new(PostResponse)
+	err := c.cc.Invoke(ctx, "/post.PostService/CreatePost", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *postServiceClient) GetPostById(ctx context.Context, in *Request, opts ...grpc.CallOption) (*PostResponse, error) {
+	out := new(PostResponse)
+	err := c.cc.Invoke(ctx, "/post.PostService/GetPostById", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *postServiceClient) GetPostByUserId(ctx context.Context, in *Request, opts ...grpc.CallOption) (*PostsResponse, error) {
+	out := new(PostsResponse)
+	err := c.cc.Invoke(ctx, "/post.PostService/GetPostByUserId", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *postServiceClient) SearchPosts(ctx context.Context, in *Request, opts ...grpc.CallOption) (*PostsResponse, error) {
+	out := new(PostsResponse)
+	err := c.cc.Invoke(ctx, "/post.PostService/SearchPosts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *postServiceClient) LikePost(ctx context.Context, in *LikeRequest, opts ...grpc.CallOption) (*PostResponse, error) {
+	out := new(PostResponse)
+	err := c.cc.Invoke(ctx, "/post.PostService/LikePost", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *postServiceClient) UpdatePost(ctx context.Context, in *UpdatePostRequest, opts ...grpc.CallOption) (*PostResponse, error) {
+	out := new(PostResponse)
+	err := c.cc.Invoke(ctx, "/post.PostService/UpdatePost", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *postServiceClient) DeletePost(ctx context.Context, in *Request, opts ...grpc.CallOption) (*PostResponse, error) {
+	out := new(PostResponse)
+	err := c.cc.Invoke(ctx, "/post.PostService/DeletePost", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *postServiceClient) UploadAttachment(ctx context.Context, in *AttachmentRequest, opts ...grpc.CallOption) (*AttachmentResponse, error) {
+	out := new(AttachmentResponse)
+	err := c.cc.Invoke(ctx, "/post.PostService/UploadAttachment", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *postServiceClient) GetAttachments(ctx context.Context, in *Request, opts ...grpc.CallOption) (*AttachmentsResponse, error) {
+	out := new(AttachmentsResponse)
+	err := c.cc.Invoke(ctx, "/post.PostService/GetAttachments", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *postServiceClient) GetAttachmentContent(ctx context.Context, in *AttachmentContentRequest, opts ...grpc.CallOption) (*AttachmentContent, error) {
+	out := new(AttachmentContent)
+	err := c.cc.Invoke(ctx, "/post.PostService/GetAttachmentContent", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *postServiceClient) DeleteAttachment(ctx context.Context, in *Request, opts ...grpc.CallOption) (*AttachmentResponse, error) {
+	out := new(AttachmentResponse)
+	err := c.cc.Invoke(ctx, "/post.PostService/DeleteAttachment", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *postServiceClient) GetRevisions(ctx context.Context, in *RevisionsRequest, opts ...grpc.CallOption) (*RevisionsResponse, error) {
+	out := new(RevisionsResponse)
+	err := c.cc.Invoke(ctx, "/post.PostService/GetRevisions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *postServiceClient) DiffRevisions(ctx context.Context, in *DiffRevisionsRequest, opts ...grpc.CallOption) (*DiffRevisionsResponse, error) {
+	out := new(DiffRevisionsResponse)
+	err := c.cc.Invoke(ctx, "/post.PostService/DiffRevisions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *postServiceClient) RestoreRevision(ctx context.Context, in *RestoreRevisionRequest, opts ...grpc.CallOption) (*PostResponse, error) {
+	out := new(PostResponse)
+	err := c.cc.Invoke(ctx, "/post.PostService/RestoreRevision", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *postServiceClient) GetPostsByTag(ctx context.Context, in *TagPostsRequest, opts ...grpc.CallOption) (*PostsResponse, error) {
+	out := new(PostsResponse)
+	err := c.cc.Invoke(ctx, "/post.PostService/GetPostsByTag", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *postServiceClient) AutocompleteTags(ctx context.Context, in *AutocompleteTagsRequest, opts ...grpc.CallOption) (*TagsResponse, error) {
+	out := new(TagsResponse)
+	err := c.cc.Invoke(ctx, "/post.PostService/AutocompleteTags", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *postServiceClient) GetTrendingTags(ctx context.Context, in *TrendingTagsRequest, opts ...grpc.CallOption) (*TagsResponse, error) {
+	out := new(TagsResponse)
+	err := c.cc.Invoke(ctx, "/post.PostService/GetTrendingTags", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *postServiceClient) StreamLikes(ctx context.Context, in *StreamRequest, opts ...grpc.CallOption) (PostService_StreamLikesClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_PostService_serviceDesc.Streams[0], "/post.PostService/StreamLikes", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &postServiceStreamLikesClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type PostService_StreamLikesClient interface {
+	Recv() (*LikeEvent, error)
+	grpc.ClientStream
+}
+
+type postServiceStreamLikesClient struct {
+	grpc.ClientStream
+}
+
+func (x *postServiceStreamLikesClient) Recv() (*LikeEvent, error) {
+	m := new(LikeEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *postServiceClient) GetPostForUser(ctx context.Context, in *Request, opts ...grpc.CallOption) (*PostsResponse, error) {
+	out := new(PostsResponse)
+	err := c.cc.Invoke(ctx, "/post.PostService/GetPostForUser", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *postServiceClient) GetPostForComment(ctx context.Context, in *Request, opts ...grpc.CallOption) (*PostResponse, error) {
+	out := new(PostResponse)
+	err := c.cc.Invoke(ctx, "/post.PostService/GetPostForComment", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *postServiceClient) GetLikesByUser(ctx context.Context, in *Request, opts ...grpc.CallOption) (*LikesResponse, error) {
+	out := new(LikesResponse)
+	err := c.cc.Invoke(ctx, "/post.PostService/GetLikesByUser", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *postServiceClient) GetPostsByIds(ctx context.Context, in *IdsRequest, opts ...grpc.CallOption) (*PostsResponse, error) {
+	out := new(PostsResponse)
+	err := c.cc.Invoke(ctx, "/post.PostService/GetPostsByIds", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *postServiceClient) CountPostsForUsers(ctx context.Context, in *IdsRequest, opts ...grpc.CallOption) (*PostCountsResponse, error) {
+	out := new(PostCountsResponse)
+	err := c.cc.Invoke(ctx, "/post.PostService/CountPostsForUsers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *postServiceClient) ModeratePost(ctx context.Context, in *ModerateRequest, opts ...grpc.CallOption) (*PostResponse, error) {
+	out := new(PostResponse)
+	err := c.cc.Invoke(ctx, "/post.PostService/ModeratePost", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// PostServiceServer is the server API for PostService service.
+type PostServiceServer interface {
+	// methods...
+	CreatePost(context.Context, *PostRequest) (*PostResponse, error)
+	GetPostById(context.Context, *Request) (*PostResponse, error)
+	GetPostByUserId(context.Context, *Request) (*PostsResponse, error)
+	SearchPosts(context.Context, *Request) (*PostsResponse, error)
+	LikePost(context.Context, *LikeRequest) (*PostResponse, error)
+	UpdatePost(context.Context, *UpdatePostRequest) (*PostResponse, error)
+	DeletePost(context.Context, *Request) (*PostResponse, error)
+	// attachments...
+	UploadAttachment(context.Context, *AttachmentRequest) (*AttachmentResponse, error)
+	GetAttachments(context.Context, *Request) (*AttachmentsResponse, error)
+	GetAttachmentContent(context.Context, *AttachmentContentRequest) (*AttachmentContent, error)
+	DeleteAttachment(context.Context, *Request) (*AttachmentResponse, error)
+	// revisions...
+	GetRevisions(context.Context, *RevisionsRequest) (*RevisionsResponse, error)
+	DiffRevisions(context.Context, *DiffRevisionsRequest) (*DiffRevisionsResponse, error)
+	RestoreRevision(context.Context, *RestoreRevisionRequest) (*PostResponse, error)
+	// tags...
+	GetPostsByTag(context.Context, *TagPostsRequest) (*PostsResponse, error)
+	AutocompleteTags(context.Context, *AutocompleteTagsRequest) (*TagsResponse, error)
+	GetTrendingTags(context.Context, *TrendingTagsRequest) (*TagsResponse, error)
+	// streams...
+	StreamLikes(*StreamRequest, PostService_StreamLikesServer) error
+	// for Clients...
+	GetPostForUser(context.Context, *Request) (*PostsResponse, error)
+	GetPostForComment(context.Context, *Request) (*PostResponse, error)
+	GetLikesByUser(context.Context, *Request) (*LikesResponse, error)
+	GetPostsByIds(context.Context, *IdsRequest) (*PostsResponse, error)
+	CountPostsForUsers(context.Context, *IdsRequest) (*PostCountsResponse, error)
+	// moderation...
+	ModeratePost(context.Context, *ModerateRequest) (*PostResponse, error)
+}
+
+// UnimplementedPostServiceServer can be embedded to have forward compatible implementations.
+type UnimplementedPostServiceServer struct {
+}
+
+func (*UnimplementedPostServiceServer) CreatePost(ctx context.Context, req *PostRequest) (*PostResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreatePost not implemented")
+}
+func (*UnimplementedPostServiceServer) GetPostById(ctx context.Context, req *Request) (*PostResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPostById not implemented")
+}
+func (*UnimplementedPostServiceServer) GetPostByUserId(ctx context.Context, req *Request) (*PostsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPostByUserId not implemented")
+}
+func (*UnimplementedPostServiceServer) SearchPosts(ctx context.Context, req *Request) (*PostsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchPosts not implemented")
+}
+func (*UnimplementedPostServiceServer) LikePost(ctx context.Context, req *LikeRequest) (*PostResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LikePost not implemented")
+}
+func (*UnimplementedPostServiceServer) UpdatePost(ctx context.Context, req *UpdatePostRequest) (*PostResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdatePost not implemented")
+}
+func (*UnimplementedPostServiceServer) DeletePost(ctx context.Context, req *Request) (*PostResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeletePost not implemented")
+}
+func (*UnimplementedPostServiceServer) UploadAttachment(ctx context.Context, req *AttachmentRequest) (*AttachmentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UploadAttachment not implemented")
+}
+func (*UnimplementedPostServiceServer) GetAttachments(ctx context.Context, req *Request) (*AttachmentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAttachments not implemented")
+}
+func (*UnimplementedPostServiceServer) GetAttachmentContent(ctx context.Context, req *AttachmentContentRequest) (*AttachmentContent, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAttachmentContent not implemented")
+}
+func (*UnimplementedPostServiceServer) DeleteAttachment(ctx context.Context, req *Request) (*AttachmentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteAttachment not implemented")
+}
+func (*UnimplementedPostServiceServer) GetRevisions(ctx context.Context, req *RevisionsRequest) (*RevisionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRevisions not implemented")
+}
+func (*UnimplementedPostServiceServer) DiffRevisions(ctx context.Context, req *DiffRevisionsRequest) (*DiffRevisionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DiffRevisions not implemented")
+}
+func (*UnimplementedPostServiceServer) RestoreRevision(ctx context.Context, req *RestoreRevisionRequest) (*PostResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreRevision not implemented")
+}
+func (*UnimplementedPostServiceServer) GetPostsByTag(ctx context.Context, req *TagPostsRequest) (*PostsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPostsByTag not implemented")
+}
+func (*UnimplementedPostServiceServer) AutocompleteTags(ctx context.Context, req *AutocompleteTagsRequest) (*TagsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AutocompleteTags not implemented")
+}
+func (*UnimplementedPostServiceServer) GetTrendingTags(ctx context.Context, req *TrendingTagsRequest) (*TagsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTrendingTags not implemented")
+}
+func (*UnimplementedPostServiceServer) StreamLikes(req *StreamRequest, srv PostService_StreamLikesServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamLikes not implemented")
+}
+func (*UnimplementedPostServiceServer) GetPostForUser(ctx context.Context, req *Request) (*PostsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPostForUser not implemented")
+}
+func (*UnimplementedPostServiceServer) GetPostForComment(ctx context.Context, req *Request) (*PostResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPostForComment not implemented")
+}
+func (*UnimplementedPostServiceServer) GetLikesByUser(ctx context.Context, req *Request) (*LikesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLikesByUser not implemented")
+}
+func (*UnimplementedPostServiceServer) GetPostsByIds(ctx context.Context, req *IdsRequest) (*PostsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPostsByIds not implemented")
+}
+func (*UnimplementedPostServiceServer) CountPostsForUsers(ctx context.Context, req *IdsRequest) (*PostCountsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CountPostsForUsers not implemented")
+}
+func (*UnimplementedPostServiceServer) ModeratePost(ctx context.Context, req *ModerateRequest) (*PostResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ModeratePost not implemented")
+}
+
+func RegisterPostServiceServer(s *grpc.Server, srv PostServiceServer) {
+	s.RegisterService(&_PostService_serviceDesc, srv)
+}
+
+func _PostService_CreatePost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PostRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PostServiceServer).CreatePost(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/post.PostService/CreatePost",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PostServiceServer).CreatePost(ctx, req.(*PostRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PostService_GetPostById_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PostServiceServer).GetPostById(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/post.PostService/GetPostById",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PostServiceServer).GetPostById(ctx, req.(*Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PostService_GetPostByUserId_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PostServiceServer).GetPostByUserId(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/post.PostService/GetPostByUserId",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PostServiceServer).GetPostByUserId(ctx, req.(*Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PostService_SearchPosts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PostServiceServer).SearchPosts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/post.PostService/SearchPosts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PostServiceServer).SearchPosts(ctx, req.(*Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PostService_LikePost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LikeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PostServiceServer).LikePost(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/post.PostService/LikePost",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PostServiceServer).LikePost(ctx, req.(*LikeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PostService_UpdatePost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdatePostRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PostServiceServer).UpdatePost(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/post.PostService/UpdatePost",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PostServiceServer).UpdatePost(ctx, req.(*UpdatePostRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PostService_DeletePost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PostServiceServer).DeletePost(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/post.PostService/DeletePost",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PostServiceServer).DeletePost(ctx, req.(*Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PostService_UploadAttachment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AttachmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PostServiceServer).UploadAttachment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/post.PostService/UploadAttachment",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PostServiceServer).UploadAttachment(ctx, req.(*AttachmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PostService_GetAttachments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PostServiceServer).GetAttachments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/post.PostService/GetAttachments",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PostServiceServer).GetAttachments(ctx, req.(*Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PostService_GetAttachmentContent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AttachmentContentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PostServiceServer).GetAttachmentContent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/post.PostService/GetAttachmentContent",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PostServiceServer).GetAttachmentContent(ctx, req.(*AttachmentContentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PostService_DeleteAttachment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PostServiceServer).DeleteAttachment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/post.PostService/DeleteAttachment",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PostServiceServer).DeleteAttachment(ctx, req.(*Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PostService_GetRevisions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevisionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PostServiceServer).GetRevisions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/post.PostService/GetRevisions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PostServiceServer).GetRevisions(ctx, req.(*RevisionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PostService_DiffRevisions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DiffRevisionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PostServiceServer).DiffRevisions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/post.PostService/DiffRevisions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PostServiceServer).DiffRevisions(ctx, req.(*DiffRevisionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PostService_RestoreRevision_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreRevisionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PostServiceServer).RestoreRevision(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/post.PostService/RestoreRevision",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PostServiceServer).RestoreRevision(ctx, req.(*RestoreRevisionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PostService_GetPostsByTag_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TagPostsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PostServiceServer).GetPostsByTag(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/post.PostService/GetPostsByTag",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PostServiceServer).GetPostsByTag(ctx, req.(*TagPostsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PostService_AutocompleteTags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AutocompleteTagsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PostServiceServer).AutocompleteTags(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/post.PostService/AutocompleteTags",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PostServiceServer).AutocompleteTags(ctx, req.(*AutocompleteTagsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PostService_GetTrendingTags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TrendingTagsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PostServiceServer).GetTrendingTags(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/post.PostService/GetTrendingTags",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PostServiceServer).GetTrendingTags(ctx, req.(*TrendingTagsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PostService_StreamLikes_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(PostServiceServer).StreamLikes(m, &postServiceStreamLikesServer{stream})
+}
+
+type PostService_StreamLikesServer interface {
+	Send(*LikeEvent) error
+	grpc.ServerStream
+}
+
+type postServiceStreamLikesServer struct {
+	grpc.ServerStream
+}
+
+func (x *postServiceStreamLikesServer) Send(m *LikeEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _PostService_GetPostForUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PostServiceServer).GetPostForUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/post.PostService/GetPostForUser",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PostServiceServer).GetPostForUser(ctx, req.(*Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PostService_GetPostForComment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PostServiceServer).GetPostForComment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/post.PostService/GetPostForComment",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PostServiceServer).GetPostForComment(ctx, req.(*Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PostService_GetLikesByUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PostServiceServer).GetLikesByUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/post.PostService/GetLikesByUser",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PostServiceServer).GetLikesByUser(ctx, req.(*Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PostService_GetPostsByIds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IdsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PostServiceServer).GetPostsByIds(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/post.PostService/GetPostsByIds",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PostServiceServer).GetPostsByIds(ctx, req.(*IdsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PostService_CountPostsForUsers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IdsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PostServiceServer).CountPostsForUsers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/post.PostService/CountPostsForUsers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PostServiceServer).CountPostsForUsers(ctx, req.(*IdsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PostService_ModeratePost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ModerateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PostServiceServer).ModeratePost(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/post.PostService/ModeratePost",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PostServiceServer).ModeratePost(ctx, req.(*ModerateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _PostService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "post.PostService",
+	HandlerType: (*PostServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreatePost",
+			Handler:    _PostService_CreatePost_Handler,
+		},
+		{
+			MethodName: "GetPostById",
+			Handler:    _PostService_GetPostById_Handler,
+		},
+		{
+			MethodName: "GetPostByUserId",
+			Handler:    _PostService_GetPostByUserId_Handler,
+		},
+		{
+			MethodName: "SearchPosts",
+			Handler:    _PostService_SearchPosts_Handler,
+		},
+		{
+			MethodName: "LikePost",
+			Handler:    _PostService_LikePost_Handler,
+		},
+		{
+			MethodName: "UpdatePost",
+			Handler:    _PostService_UpdatePost_Handler,
+		},
+		{
+			MethodName: "DeletePost",
+			Handler:    _PostService_DeletePost_Handler,
+		},
+		{
+			MethodName: "UploadAttachment",
+			Handler:    _PostService_UploadAttachment_Handler,
+		},
+		{
+			MethodName: "GetAttachments",
+			Handler:    _PostService_GetAttachments_Handler,
+		},
+		{
+			MethodName: "GetAttachmentContent",
+			Handler:    _PostService_GetAttachmentContent_Handler,
+		},
+		{
+			MethodName: "DeleteAttachment",
+			Handler:    _PostService_DeleteAttachment_Handler,
+		},
+		{
+			MethodName: "GetRevisions",
+			Handler:    _PostService_GetRevisions_Handler,
+		},
+		{
+			MethodName: "DiffRevisions",
+			Handler:    _PostService_DiffRevisions_Handler,
+		},
+		{
+			MethodName: "RestoreRevision",
+			Handler:    _PostService_RestoreRevision_Handler,
+		},
+		{
+			MethodName: "GetPostsByTag",
+			Handler:    _PostService_GetPostsByTag_Handler,
+		},
+		{
+			MethodName: "AutocompleteTags",
+			Handler:    _PostService_AutocompleteTags_Handler,
+		},
+		{
+			MethodName: "GetTrendingTags",
+			Handler:    _PostService_GetTrendingTags_Handler,
+		},
+		{
+			MethodName: "GetPostForUser",
+			Handler:    _PostService_GetPostForUser_Handler,
+		},
+		{
+			MethodName: "GetPostForComment",
+			Handler:    _PostService_GetPostForComment_Handler,
+		},
+		{
+			MethodName: "GetLikesByUser",
+			Handler:    _PostService_GetLikesByUser_Handler,
+		},
+		{
+			MethodName: "GetPostsByIds",
+			Handler:    _PostService_GetPostsByIds_Handler,
+		},
+		{
+			MethodName: "CountPostsForUsers",
+			Handler:    _PostService_CountPostsForUsers_Handler,
+		},
+		{
+			MethodName: "ModeratePost",
+			Handler:    _PostService_ModeratePost_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamLikes",
+			Handler:       _PostService_StreamLikes_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "post/post.proto",
+}
+
+func (m *Request) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Request) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Request) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.ViewerId) > 0 {
+		i -= len(m.ViewerId)
+		copy(dAtA[i:], m.ViewerId)
+		i = encodeVarintPost(dAtA, i, uint64(len(m.ViewerId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Str) > 0 {
+		i -= len(m.Str)
+		copy(dAtA[i:], m.Str)
+		i = encodeVarintPost(dAtA, i, uint64(len(m.Str)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ModerateRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ModerateRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ModerateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Action) > 0 {
+		i -= len(m.Action)
+		copy(dAtA[i:], m.Action)
+		i = encodeVarintPost(dAtA, i, uint64(len(m.Action)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintPost(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *IdsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *IdsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *IdsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.ViewerId) > 0 {
+		i -= len(m.ViewerId)
+		copy(dAtA[i:], m.ViewerId)
+		i = encodeVarintPost(dAtA, i, uint64(len(m.ViewerId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Ids) > 0 {
+		for iNdEx := len(m.Ids) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Ids[iNdEx])
+			copy(dAtA[i:], m.Ids[iNdEx])
+			i = encodeVarintPost(dAtA, i, uint64(len(m.Ids[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PostCountsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PostCountsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PostCountsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Counts) > 0 {
+		for k := range m.Counts {
+			v := m.Counts[k]
+			baseI := i
+			i = encodeVarintPost(dAtA, i, uint64(v))
+			i--
+			dAtA[i] = 0x10
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintPost(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintPost(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *LikeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LikeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LikeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.UserId) > 0 {
+		i -= len(m.UserId)
+		copy(dAtA[i:], m.UserId)
+		i = encodeVarintPost(dAtA, i, uint64(len(m.UserId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.IsLiked {
+		i--
+		if m.IsLiked {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.PostId) > 0 {
+		i -= len(m.PostId)
+		copy(dAtA[i:], m.PostId)
+		i = encodeVarintPost(dAtA, i, uint64(len(m.PostId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *LikeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LikeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LikeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.CreatedAt) > 0 {
+		i -= len(m.CreatedAt)
+		copy(dAtA[i:], m.CreatedAt)
+		i = encodeVarintPost(dAtA, i, uint64(len(m.CreatedAt)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.PostTitle) > 0 {
+		i -= len(m.PostTitle)
+		copy(dAtA[i:], m.PostTitle)
+		i = encodeVarintPost(dAtA, i, uint64(len(m.PostTitle)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PostId) > 0 {
+		i -= len(m.PostId)
+		copy(dAtA[i:], m.PostId)
+		i = encodeVarintPost(dAtA, i, uint64(len(m.PostId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *LikesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LikesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LikesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Likes) > 0 {
+		for iNdEx := len(m.Likes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Likes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPost(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *StreamRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StreamRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StreamRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.PostId) > 0 {
+		i -= len(m.PostId)
+		copy(dAtA[i:], m.PostId)
+		i = encodeVarintPost(dAtA, i, uint64(len(m.PostId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *LikeEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LikeEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LikeEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Likes != 0 {
+		i = encodeVarintPost(dAtA, i, uint64(m.Likes))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.IsLiked {
+		i--
+		if m.IsLiked {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.UserId) > 0 {
+		i -= len(m.UserId)
+		copy(dAtA[i:], m.UserId)
+		i = encodeVarintPost(dAtA, i, uint64(len(m.UserId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.PostId) > 0 {
+		i -= len(m.PostId)
+		copy(dAtA[i:], m.PostId)
+		i = encodeVarintPost(dAtA, i, uint64(len(m.PostId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintPost(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PostRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PostRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PostRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Tags) > 0 {
+		for iNdEx := len(m.Tags) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Tags[iNdEx])
+			copy(dAtA[i:], m.Tags[iNdEx])
+			i = encodeVarintPost(dAtA, i, uint64(len(m.Tags[iNdEx])))
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.Visibility) > 0 {
+		i -= len(m.Visibility)
+		copy(dAtA[i:], m.Visibility)
+		i = encodeVarintPost(dAtA, i, uint64(len(m.Visibility)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.PublishAt) > 0 {
+		i -= len(m.PublishAt)
+		copy(dAtA[i:], m.PublishAt)
+		i = encodeVarintPost(dAtA, i, uint64(len(m.PublishAt)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Status) > 0 {
+		i -= len(m.Status)
+		copy(dAtA[i:], m.Status)
+		i = encodeVarintPost(dAtA, i, uint64(len(m.Status)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.UserId) > 0 {
+		i -= len(m.UserId)
+		copy(dAtA[i:], m.UserId)
+		i = encodeVarintPost(dAtA, i, uint64(len(m.UserId)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintPost(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintPost(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintPost(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UpdatePostRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UpdatePostRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UpdatePostRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.TagsSet {
+		i--
+		if m.TagsSet {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.ExpectedVersion != 0 {
+		i = encodeVarintPost(dAtA, i, uint64(m.ExpectedVersion))
+		i--
+		dAtA[i] = 0x48
+	}
+	if len(m.Tags) > 0 {
+		for iNdEx := len(m.Tags) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Tags[iNdEx])
+			copy(dAtA[i:], m.Tags[iNdEx])
+			i = encodeVarintPost(dAtA, i, uint64(len(m.Tags[iNdEx])))
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.EditorId) > 0 {
+		i -= len(m.EditorId)
+		copy(dAtA[i:], m.EditorId)
+		i = encodeVarintPost(dAtA, i, uint64(len(m.EditorId)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.Visibility) > 0 {
+		i -= len(m.Visibility)
+		copy(dAtA[i:], m.Visibility)
+		i = encodeVarintPost(dAtA, i, uint64(len(m.Visibility)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.PublishAt) > 0 {
+		i -= len(m.PublishAt)
+		copy(dAtA[i:], m.PublishAt)
+		i = encodeVarintPost(dAtA, i, uint64(len(m.PublishAt)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Status) > 0 {
+		i -= len(m.Status)
+		copy(dAtA[i:], m.Status)
+		i = encodeVarintPost(dAtA, i, uint64(len(m.Status)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintPost(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintPost(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintPost(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PostsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PostsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PostsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Posts) > 0 {
+		for iNdEx := len(m.Posts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Posts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPost(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PostResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PostResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PostResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.ModerationStatus) > 0 {
+		i -= len(m.ModerationStatus)
+		copy(dAtA[i:], m.ModerationStatus)
+		i = encodeVarintPost(dAtA, i, uint64(len(m.ModerationStatus)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x92
+	}
+	if m.Version != 0 {
+		i = encodeVarintPost(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x88
+	}
+	if len(m.Tags) > 0 {
+		for iNdEx := len(m.Tags) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Tags[iNdEx])
+			copy(dAtA[i:], m.Tags[iNdEx])
+			i = encodeVarintPost(dAtA, i, uint64(len(m.Tags[iNdEx])))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x82
+		}
+	}
+	if len(m.EditedAt) > 0 {
+		i -= len(m.EditedAt)
+		copy(dAtA[i:], m.EditedAt)
+		i = encodeVarintPost(dAtA, i, uint64(len(m.EditedAt)))
+		i--
+		dAtA[i] = 0x7a
+	}
+	if m.Edited {
+		i--
+		if m.Edited {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x70
+	}
+	if len(m.Visibility) > 0 {
+		i -= len(m.Visibility)
+		copy(dAtA[i:], m.Visibility)
+		i = encodeVarintPost(dAtA, i, uint64(len(m.Visibility)))
+		i--
+		dAtA[i] = 0x6a
+	}
+	if len(m.PublishAt) > 0 {
+		i -= len(m.PublishAt)
+		copy(dAtA[i:], m.PublishAt)
+		i = encodeVarintPost(dAtA, i, uint64(len(m.PublishAt)))
+		i--
+		dAtA[i] = 0x62
+	}
+	if len(m.Status) > 0 {
+		i -= len(m.Status)
+		copy(dAtA[i:], m.Status)
+		i = encodeVarintPost(dAtA, i, uint64(len(m.Status)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if len(m.Attachments) > 0 {
+		for iNdEx := len(m.Attachments) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Attachments[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPost(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.UpdatedAt) > 0 {
+		i -= len(m.UpdatedAt)
+		copy(dAtA[i:], m.UpdatedAt)
+		i = encodeVarintPost(dAtA, i, uint64(len(m.UpdatedAt)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.CreatedAt) > 0 {
+		i -= len(m.CreatedAt)
+		copy(dAtA[i:], m.CreatedAt)
+		i = encodeVarintPost(dAtA, i, uint64(len(m.CreatedAt)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.UserName) > 0 {
+		i -= len(m.UserName)
+		copy(dAtA[i:], m.UserName)
+		i = encodeVarintPost(dAtA, i, uint64(len(m.UserName)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.UserId) > 0 {
+		i -= len(m.UserId)
+		copy(dAtA[i:], m.UserId)
+		i = encodeVarintPost(dAtA, i, uint64(len(m.UserId)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Comments != 0 {
+		i = encodeVarintPost(dAtA, i, uint64(m.Comments))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Likes != 0 {
+		i = encodeVarintPost(dAtA, i, uint64(m.Likes))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintPost(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintPost(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintPost(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AttachmentRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AttachmentRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AttachmentRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintPost(dAtA, i, uint64(len(m.Data)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.FileName) > 0 {
+		i -= len(m.FileName)
+		copy(dAtA[i:], m.FileName)
+		i = encodeVarintPost(dAtA, i, uint64(len(m.FileName)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.UserId) > 0 {
+		i -= len(m.UserId)
+		copy(dAtA[i:], m.UserId)
+		i = encodeVarintPost(dAtA, i, uint64(len(m.UserId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.PostId) > 0 {
+		i -= len(m.PostId)
+		copy(dAtA[i:], m.PostId)
+		i = encodeVarintPost(dAtA, i, uint64(len(m.PostId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintPost(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AttachmentContentRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AttachmentContentRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AttachmentContentRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Thumbnail {
+		i--
+		if m.Thumbnail {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintPost(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AttachmentResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AttachmentResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AttachmentResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.CreatedAt) > 0 {
+		i -= len(m.CreatedAt)
+		copy(dAtA[i:], m.CreatedAt)
+		i = encodeVarintPost(dAtA, i, uint64(len(m.CreatedAt)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.HasThumbnail {
+		i--
+		if m.HasThumbnail {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.FileSize != 0 {
+		i = encodeVarintPost(dAtA, i, uint64(m.FileSize))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.MimeType) > 0 {
+		i -= len(m.MimeType)
+		copy(dAtA[i:], m.MimeType)
+		i = encodeVarintPost(dAtA, i, uint64(len(m.MimeType)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.FileName) > 0 {
+		i -= len(m.FileName)
+		copy(dAtA[i:], m.FileName)
+		i = encodeVarintPost(dAtA, i, uint64(len(m.FileName)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.PostId) > 0 {
+		i -= len(m.PostId)
+		copy(dAtA[i:], m.PostId)
+		i = encodeVarintPost(dAtA, i, uint64(len(m.PostId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintPost(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AttachmentsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AttachmentsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AttachmentsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Attachments) > 0 {
+		for iNdEx := len(m.Attachments) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Attachments[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPost(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AttachmentContent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AttachmentContent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AttachmentContent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintPost(dAtA, i, uint64(len(m.Data)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.MimeType) > 0 {
+		i -= len(m.MimeType)
+		copy(dAtA[i:], m.MimeType)
+		i = encodeVarintPost(dAtA, i, uint64(len(m.MimeType)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.FileName) > 0 {
+		i -= len(m.FileName)
+		copy(dAtA[i:], m.FileName)
+		i = encodeVarintPost(dAtA, i, uint64(len(m.FileName)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RevisionsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RevisionsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RevisionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.ViewerId) > 0 {
+		i -= len(m.ViewerId)
+		copy(dAtA[i:], m.ViewerId)
+		i = encodeVarintPost(dAtA, i, uint64(len(m.ViewerId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PostId) > 0 {
+		i -= len(m.PostId)
+		copy(dAtA[i:], m.PostId)
+		i = encodeVarintPost(dAtA, i, uint64(len(m.PostId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RevisionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RevisionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RevisionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.CreatedAt) > 0 {
+		i -= len(m.CreatedAt)
+		copy(dAtA[i:], m.CreatedAt)
+		i = encodeVarintPost(dAtA, i, uint64(len(m.CreatedAt)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.RestoredFrom != 0 {
+		i = encodeVarintPost(dAtA, i, uint64(m.RestoredFrom))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.EditorId) > 0 {
+		i -= len(m.EditorId)
+		copy(dAtA[i:], m.EditorId)
+		i = encodeVarintPost(dAtA, i, uint64(len(m.EditorId)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintPost(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintPost(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Revision != 0 {
+		i = encodeVarintPost(dAtA, i, uint64(m.Revision))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.PostId) > 0 {
+		i -= len(m.PostId)
+		copy(dAtA[i:], m.PostId)
+		i = encodeVarintPost(dAtA, i, uint64(len(m.PostId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RevisionsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RevisionsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RevisionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Revisions) > 0 {
+		for iNdEx := len(m.Revisions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Revisions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPost(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DiffRevisionsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DiffRevisionsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DiffRevisionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.ViewerId) > 0 {
+		i -= len(m.ViewerId)
+		copy(dAtA[i:], m.ViewerId)
+		i = encodeVarintPost(dAtA, i, uint64(len(m.ViewerId)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.To != 0 {
+		i = encodeVarintPost(dAtA, i, uint64(m.To))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.From != 0 {
+		i = encodeVarintPost(dAtA, i, uint64(m.From))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.PostId) > 0 {
+		i -= len(m.PostId)
+		copy(dAtA[i:], m.PostId)
+		i = encodeVarintPost(dAtA, i, uint64(len(m.PostId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DiffLine) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DiffLine) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DiffLine) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Text) > 0 {
+		i -= len(m.Text)
+		copy(dAtA[i:], m.Text)
+		i = encodeVarintPost(dAtA, i, uint64(len(m.Text)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Op) > 0 {
+		i -= len(m.Op)
+		copy(dAtA[i:], m.Op)
+		i = encodeVarintPost(dAtA, i, uint64(len(m.Op)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DiffRevisionsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DiffRevisionsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DiffRevisionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Description) > 0 {
+		for iNdEx := len(m.Description) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Description[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPost(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Title) > 0 {
+		for iNdEx := len(m.Title) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Title[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPost(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.To != 0 {
+		i = encodeVarintPost(dAtA, i, uint64(m.To))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.From != 0 {
+		i = encodeVarintPost(dAtA, i, uint64(m.From))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RestoreRevisionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RestoreRevisionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RestoreRevisionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.EditorId) > 0 {
+		i -= len(m.EditorId)
+		copy(dAtA[i:], m.EditorId)
+		i = encodeVarintPost(dAtA, i, uint64(len(m.EditorId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Revision != 0 {
+		i = encodeVarintPost(dAtA, i, uint64(m.Revision))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.PostId) > 0 {
+		i -= len(m.PostId)
+		copy(dAtA[i:], m.PostId)
+		i = encodeVarintPost(dAtA, i, uint64(len(m.PostId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TagPostsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TagPostsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TagPostsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Page != 0 {
+		i = encodeVarintPost(dAtA, i, uint64(m.Page))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Limit != 0 {
+		i = encodeVarintPost(dAtA, i, uint64(m.Limit))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ViewerId) > 0 {
+		i -= len(m.ViewerId)
+		copy(dAtA[i:], m.ViewerId)
+		i = encodeVarintPost(dAtA, i, uint64(len(m.ViewerId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Tag) > 0 {
+		i -= len(m.Tag)
+		copy(dAtA[i:], m.Tag)
+		i = encodeVarintPost(dAtA, i, uint64(len(m.Tag)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AutocompleteTagsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AutocompleteTagsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AutocompleteTagsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Limit != 0 {
+		i = encodeVarintPost(dAtA, i, uint64(m.Limit))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Prefix) > 0 {
+		i -= len(m.Prefix)
+		copy(dAtA[i:], m.Prefix)
+		i = encodeVarintPost(dAtA, i, uint64(len(m.Prefix)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TrendingTagsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TrendingTagsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TrendingTagsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Limit != 0 {
+		i = encodeVarintPost(dAtA, i, uint64(m.Limit))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Hours != 0 {
+		i = encodeVarintPost(dAtA, i, uint64(m.Hours))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TagResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TagResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TagResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Posts != 0 {
+		i = encodeVarintPost(dAtA, i, uint64(m.Posts))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintPost(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TagsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TagsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TagsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Tags) > 0 {
+		for iNdEx := len(m.Tags) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Tags[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPost(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintPost(dAtA []byte, offset int, v uint64) int {
+	offset -= sovPost(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Request) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Str)
+	if l > 0 {
+		n += 1 + l + sovPost(uint64(l))
+	}
+	l = len(m.ViewerId)
+	if l > 0 {
+		n += 1 + l + sovPost(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ModerateRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovPost(uint64(l))
+	}
+	l = len(m.Action)
+	if l > 0 {
+		n += 1 + l + sovPost(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *IdsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Ids) > 0 {
+		for _, s := range m.Ids {
+			l = len(s)
+			n += 1 + l + sovPost(uint64(l))
+		}
+	}
+	l = len(m.ViewerId)
+	if l > 0 {
+		n += 1 + l + sovPost(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *PostCountsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Counts) > 0 {
+		for k, v := range m.Counts {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovPost(uint64(len(k))) + 1 + sovPost(uint64(v))
+			n += mapEntrySize + 1 + sovPost(uint64(mapEntrySize))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *LikeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PostId)
+	if l > 0 {
+		n += 1 + l + sovPost(uint64(l))
+	}
+	if m.IsLiked {
+		n += 2
+	}
+	l = len(m.UserId)
+	if l > 0 {
+		n += 1 + l + sovPost(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *LikeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PostId)
+	if l > 0 {
+		n += 1 + l + sovPost(uint64(l))
+	}
+	l = len(m.PostTitle)
+	if l > 0 {
+		n += 1 + l + sovPost(uint64(l))
+	}
+	l = len(m.CreatedAt)
+	if l > 0 {
+		n += 1 + l + sovPost(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *LikesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Likes) > 0 {
+		for _, e := range m.Likes {
+			l = e.Size()
+			n += 1 + l + sovPost(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *StreamRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PostId)
+	if l > 0 {
+		n += 1 + l + sovPost(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *LikeEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovPost(uint64(l))
+	}
+	l = len(m.PostId)
+	if l > 0 {
+		n += 1 + l + sovPost(uint64(l))
+	}
+	l = len(m.UserId)
+	if l > 0 {
+		n += 1 + l + sovPost(uint64(l))
+	}
+	if m.IsLiked {
+		n += 2
+	}
+	if m.Likes != 0 {
+		n += 1 + sovPost(uint64(m.Likes))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *PostRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovPost(uint64(l))
+	}
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovPost(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovPost(uint64(l))
+	}
+	l = len(m.UserId)
+	if l > 0 {
+		n += 1 + l + sovPost(uint64(l))
+	}
+	l = len(m.Status)
+	if l > 0 {
+		n += 1 + l + sovPost(uint64(l))
+	}
+	l = len(m.PublishAt)
+	if l > 0 {
+		n += 1 + l + sovPost(uint64(l))
+	}
+	l = len(m.Visibility)
+	if l > 0 {
+		n += 1 + l + sovPost(uint64(l))
+	}
+	if len(m.Tags) > 0 {
+		for _, s := range m.Tags {
+			l = len(s)
+			n += 1 + l + sovPost(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *UpdatePostRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovPost(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovPost(uint64(l))
+	}
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovPost(uint64(l))
+	}
+	l = len(m.Status)
+	if l > 0 {
+		n += 1 + l + sovPost(uint64(l))
+	}
+	l = len(m.PublishAt)
+	if l > 0 {
+		n += 1 + l + sovPost(uint64(l))
+	}
+	l = len(m.Visibility)
+	if l > 0 {
+		n += 1 + l + sovPost(uint64(l))
+	}
+	l = len(m.EditorId)
+	if l > 0 {
+		n += 1 + l + sovPost(uint64(l))
+	}
+	if len(m.Tags) > 0 {
+		for _, s := range m.Tags {
+			l = len(s)
+			n += 1 + l + sovPost(uint64(l))
+		}
+	}
+	if m.ExpectedVersion != 0 {
+		n += 1 + sovPost(uint64(m.ExpectedVersion))
+	}
+	if m.TagsSet {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *PostsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Posts) > 0 {
+		for _, e := range m.Posts {
+			l = e.Size()
+			n += 1 + l + sovPost(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *PostResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovPost(uint64(l))
+	}
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovPost(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovPost(uint64(l))
+	}
+	if m.Likes != 0 {
+		n += 1 + sovPost(uint64(m.Likes))
+	}
+	if m.Comments != 0 {
+		n += 1 + sovPost(uint64(m.Comments))
+	}
+	l = len(m.UserId)
+	if l > 0 {
+		n += 1 + l + sovPost(uint64(l))
+	}
+	l = len(m.UserName)
+	if l > 0 {
+		n += 1 + l + sovPost(uint64(l))
+	}
+	l = len(m.CreatedAt)
+	if l > 0 {
+		n += 1 + l + sovPost(uint64(l))
+	}
+	l = len(m.UpdatedAt)
+	if l > 0 {
+		n += 1 + l + sovPost(uint64(l))
+	}
+	if len(m.Attachments) > 0 {
+		for _, e := range m.Attachments {
+			l = e.Size()
+			n += 1 + l + sovPost(uint64(l))
+		}
+	}
+	l = len(m.Status)
+	if l > 0 {
+		n += 1 + l + sovPost(uint64(l))
+	}
+	l = len(m.PublishAt)
+	if l > 0 {
+		n += 1 + l + sovPost(uint64(l))
+	}
+	l = len(m.Visibility)
+	if l > 0 {
+		n += 1 + l + sovPost(uint64(l))
+	}
+	if m.Edited {
+		n += 2
+	}
+	l = len(m.EditedAt)
+	if l > 0 {
+		n += 1 + l + sovPost(uint64(l))
+	}
+	if len(m.Tags) > 0 {
+		for _, s := range m.Tags {
+			l = len(s)
+			n += 2 + l + sovPost(uint64(l))
+		}
+	}
+	if m.Version != 0 {
+		n += 2 + sovPost(uint64(m.Version))
+	}
+	l = len(m.ModerationStatus)
+	if l > 0 {
+		n += 2 + l + sovPost(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *AttachmentRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovPost(uint64(l))
+	}
+	l = len(m.PostId)
+	if l > 0 {
+		n += 1 + l + sovPost(uint64(l))
+	}
+	l = len(m.UserId)
+	if l > 0 {
+		n += 1 + l + sovPost(uint64(l))
+	}
+	l = len(m.FileName)
+	if l > 0 {
+		n += 1 + l + sovPost(uint64(l))
+	}
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovPost(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *AttachmentContentRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovPost(uint64(l))
+	}
+	if m.Thumbnail {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *AttachmentResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovPost(uint64(l))
+	}
+	l = len(m.PostId)
+	if l > 0 {
+		n += 1 + l + sovPost(uint64(l))
+	}
+	l = len(m.FileName)
+	if l > 0 {
+		n += 1 + l + sovPost(uint64(l))
+	}
+	l = len(m.MimeType)
+	if l > 0 {
+		n += 1 + l + sovPost(uint64(l))
+	}
+	if m.FileSize != 0 {
+		n += 1 + sovPost(uint64(m.FileSize))
+	}
+	if m.HasThumbnail {
+		n += 2
+	}
+	l = len(m.CreatedAt)
+	if l > 0 {
+		n += 1 + l + sovPost(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *AttachmentsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Attachments) > 0 {
+		for _, e := range m.Attachments {
+			l = e.Size()
+			n += 1 + l + sovPost(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *AttachmentContent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FileName)
+	if l > 0 {
+		n += 1 + l + sovPost(uint64(l))
+	}
+	l = len(m.MimeType)
+	if l > 0 {
+		n += 1 + l + sovPost(uint64(l))
+	}
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovPost(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RevisionsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PostId)
+	if l > 0 {
+		n += 1 + l + sovPost(uint64(l))
+	}
+	l = len(m.ViewerId)
+	if l > 0 {
+		n += 1 + l + sovPost(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RevisionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PostId)
+	if l > 0 {
+		n += 1 + l + sovPost(uint64(l))
+	}
+	if m.Revision != 0 {
+		n += 1 + sovPost(uint64(m.Revision))
+	}
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovPost(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovPost(uint64(l))
+	}
+	l = len(m.EditorId)
+	if l > 0 {
+		n += 1 + l + sovPost(uint64(l))
+	}
+	if m.RestoredFrom != 0 {
+		n += 1 + sovPost(uint64(m.RestoredFrom))
+	}
+	l = len(m.CreatedAt)
+	if l > 0 {
+		n += 1 + l + sovPost(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RevisionsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Revisions) > 0 {
+		for _, e := range m.Revisions {
+			l = e.Size()
+			n += 1 + l + sovPost(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DiffRevisionsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PostId)
+	if l > 0 {
+		n += 1 + l + sovPost(uint64(l))
+	}
+	if m.From != 0 {
+		n += 1 + sovPost(uint64(m.From))
+	}
+	if m.To != 0 {
+		n += 1 + sovPost(uint64(m.To))
+	}
+	l = len(m.ViewerId)
+	if l > 0 {
+		n += 1 + l + sovPost(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DiffLine) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Op)
+	if l > 0 {
+		n += 1 + l + sovPost(uint64(l))
+	}
+	l = len(m.Text)
+	if l > 0 {
+		n += 1 + l + sovPost(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DiffRevisionsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.From != 0 {
+		n += 1 + sovPost(uint64(m.From))
+	}
+	if m.To != 0 {
+		n += 1 + sovPost(uint64(m.To))
+	}
+	if len(m.Title) > 0 {
+		for _, e := range m.Title {
+			l = e.Size()
+			n += 1 + l + sovPost(uint64(l))
+		}
+	}
+	if len(m.Description) > 0 {
+		for _, e := range m.Description {
+			l = e.Size()
+			n += 1 + l + sovPost(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RestoreRevisionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PostId)
+	if l > 0 {
+		n += 1 + l + sovPost(uint64(l))
+	}
+	if m.Revision != 0 {
+		n += 1 + sovPost(uint64(m.Revision))
+	}
+	l = len(m.EditorId)
+	if l > 0 {
+		n += 1 + l + sovPost(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TagPostsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Tag)
+	if l > 0 {
+		n += 1 + l + sovPost(uint64(l))
+	}
+	l = len(m.ViewerId)
+	if l > 0 {
+		n += 1 + l + sovPost(uint64(l))
+	}
+	if m.Limit != 0 {
+		n += 1 + sovPost(uint64(m.Limit))
+	}
+	if m.Page != 0 {
+		n += 1 + sovPost(uint64(m.Page))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *AutocompleteTagsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Prefix)
+	if l > 0 {
+		n += 1 + l + sovPost(uint64(l))
+	}
+	if m.Limit != 0 {
+		n += 1 + sovPost(uint64(m.Limit))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TrendingTagsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Hours != 0 {
+		n += 1 + sovPost(uint64(m.Hours))
+	}
+	if m.Limit != 0 {
+		n += 1 + sovPost(uint64(m.Limit))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TagResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovPost(uint64(l))
+	}
+	if m.Posts != 0 {
+		n += 1 + sovPost(uint64(m.Posts))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TagsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Tags) > 0 {
+		for _, e := range m.Tags {
+			l = e.Size()
+			n += 1 + l + sovPost(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func sovPost(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozPost(x uint64) (n int) {
+	return sovPost(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Request) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPost
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Request: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Request: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Str", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPost
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Str = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ViewerId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPost
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ViewerId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPost(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPost
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ModerateRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPost
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ModerateRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ModerateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPost
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Action", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPost
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Action = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPost(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPost
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *IdsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPost
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: IdsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: IdsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ids", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPost
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Ids = append(m.Ids, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ViewerId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPost
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ViewerId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPost(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPost
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PostCountsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPost
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PostCountsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PostCountsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Counts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPost
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Counts == nil {
+				m.Counts = make(map[string]int64)
+			}
+			var mapkey string
+			var mapvalue int64
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPost
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowPost
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthPost
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthPost
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowPost
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapvalue |= int64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipPost(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthPost
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Counts[mapkey] = mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPost(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPost
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LikeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPost
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LikeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LikeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PostId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPost
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PostId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsLiked", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsLiked = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UserId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPost
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UserId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPost(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPost
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LikeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPost
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LikeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LikeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PostId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPost
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PostId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PostTitle", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPost
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PostTitle = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPost
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CreatedAt = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPost(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPost
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LikesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPost
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LikesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LikesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Likes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPost
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Likes = append(m.Likes, &LikeResponse{})
+			if err := m.Likes[len(m.Likes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPost(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPost
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StreamRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPost
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StreamRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StreamRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PostId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPost
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PostId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPost(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPost
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LikeEvent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPost
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LikeEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LikeEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPost
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PostId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPost
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PostId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UserId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPost
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UserId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsLiked", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsLiked = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Likes", wireType)
+			}
+			m.Likes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Likes |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPost(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPost
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PostRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPost
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PostRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PostRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPost
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPost
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPost
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UserId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPost
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UserId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPost
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Status = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PublishAt", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPost
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PublishAt = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Visibility", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPost
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Visibility = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tags", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPost
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tags = append(m.Tags, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPost(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPost
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UpdatePostRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPost
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UpdatePostRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UpdatePostRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPost
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPost
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPost
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPost
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Status = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PublishAt", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPost
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PublishAt = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Visibility", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPost
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Visibility = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EditorId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPost
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EditorId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tags", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPost
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tags = append(m.Tags, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpectedVersion", wireType)
+			}
+			m.ExpectedVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpectedVersion |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TagsSet", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.TagsSet = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPost(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPost
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PostsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPost
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PostsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PostsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Posts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPost
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Posts = append(m.Posts, &PostResponse{})
+			if err := m.Posts[len(m.Posts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPost(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPost
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PostResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPost
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PostResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PostResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPost
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPost
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPost
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Likes", wireType)
+			}
+			m.Likes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Likes |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Comments", wireType)
+			}
+			m.Comments = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Comments |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UserId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPost
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UserId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UserName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPost
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UserName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPost
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CreatedAt = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedAt", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPost
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UpdatedAt = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attachments", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPost
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Attachments = append(m.Attachments, &AttachmentResponse{})
+			if err := m.Attachments[len(m.Attachments)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPost
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Status = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PublishAt", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPost
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PublishAt = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Visibility", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPost
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Visibility = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Edited", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Edited = bool(v != 0)
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EditedAt", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPost
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EditedAt = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tags", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPost
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tags = append(m.Tags, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 17:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ModerationStatus", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPost
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ModerationStatus = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPost(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPost
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AttachmentRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPost
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AttachmentRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AttachmentRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPost
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PostId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPost
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PostId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UserId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPost
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UserId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FileName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPost
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FileName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthPost
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPost(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPost
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AttachmentContentRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPost
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AttachmentContentRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AttachmentContentRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPost
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Thumbnail", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Thumbnail = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPost(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPost
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AttachmentResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPost
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AttachmentResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AttachmentResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPost
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PostId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPost
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PostId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FileName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPost
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FileName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MimeType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPost
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MimeType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FileSize", wireType)
+			}
+			m.FileSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FileSize |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HasThumbnail", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.HasThumbnail = bool(v != 0)
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPost
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CreatedAt = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPost(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPost
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AttachmentsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPost
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AttachmentsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AttachmentsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attachments", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPost
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Attachments = append(m.Attachments, &AttachmentResponse{})
+			if err := m.Attachments[len(m.Attachments)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPost(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPost
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AttachmentContent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPost
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AttachmentContent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AttachmentContent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FileName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPost
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FileName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MimeType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPost
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MimeType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthPost
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPost(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPost
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RevisionsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPost
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RevisionsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RevisionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PostId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPost
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PostId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ViewerId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPost
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ViewerId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPost(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPost
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RevisionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPost
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RevisionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RevisionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PostId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPost
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PostId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Revision", wireType)
+			}
+			m.Revision = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Revision |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPost
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPost
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EditorId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPost
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EditorId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RestoredFrom", wireType)
+			}
+			m.RestoredFrom = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RestoredFrom |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPost
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CreatedAt = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPost(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPost
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RevisionsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPost
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RevisionsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RevisionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Revisions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPost
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Revisions = append(m.Revisions, &RevisionResponse{})
+			if err := m.Revisions[len(m.Revisions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPost(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPost
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *Request) Unmarshal(dAtA []byte) error {
+func (m *DiffRevisionsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1337,15 +9352,15 @@ func (m *Request) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Request: wiretype end group for non-group")
+			return fmt.Errorf("proto: DiffRevisionsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Request: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DiffRevisionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Str", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PostId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1373,7 +9388,77 @@ func (m *Request) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Str = string(dAtA[iNdEx:postIndex])
+			m.PostId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
+			}
+			m.From = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.From |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
+			}
+			m.To = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.To |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ViewerId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPost
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ViewerId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1397,7 +9482,7 @@ func (m *Request) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *LikeRequest) Unmarshal(dAtA []byte) error {
+func (m *DiffLine) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1420,15 +9505,15 @@ func (m *LikeRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: LikeRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: DiffLine: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LikeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DiffLine: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PostId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Op", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1456,13 +9541,13 @@ func (m *LikeRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PostId = string(dAtA[iNdEx:postIndex])
+			m.Op = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IsLiked", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Text", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPost
@@ -1472,12 +9557,24 @@ func (m *LikeRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.IsLiked = bool(v != 0)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPost
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Text = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPost(dAtA[iNdEx:])
@@ -1500,7 +9597,7 @@ func (m *LikeRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PostRequest) Unmarshal(dAtA []byte) error {
+func (m *DiffRevisionsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1523,17 +9620,17 @@ func (m *PostRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PostRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: DiffRevisionsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PostRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DiffRevisionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
 			}
-			var stringLen uint64
+			m.From = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPost
@@ -1543,29 +9640,16 @@ func (m *PostRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.From |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPost
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthPost
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Id = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
 			}
-			var stringLen uint64
+			m.To = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPost
@@ -1575,29 +9659,16 @@ func (m *PostRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.To |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPost
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthPost
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Title = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPost
@@ -1607,29 +9678,31 @@ func (m *PostRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPost
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPost
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Description = string(dAtA[iNdEx:postIndex])
+			m.Title = append(m.Title, &DiffLine{})
+			if err := m.Title[len(m.Title)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UserId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPost
@@ -1639,23 +9712,25 @@ func (m *PostRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPost
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPost
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.UserId = string(dAtA[iNdEx:postIndex])
+			m.Description = append(m.Description, &DiffLine{})
+			if err := m.Description[len(m.Description)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1679,7 +9754,7 @@ func (m *PostRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UpdatePostRequest) Unmarshal(dAtA []byte) error {
+func (m *RestoreRevisionRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1702,15 +9777,15 @@ func (m *UpdatePostRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UpdatePostRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: RestoreRevisionRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UpdatePostRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RestoreRevisionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PostId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1738,13 +9813,13 @@ func (m *UpdatePostRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Title = string(dAtA[iNdEx:postIndex])
+			m.PostId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Revision", wireType)
 			}
-			var stringLen uint64
+			m.Revision = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPost
@@ -1754,27 +9829,14 @@ func (m *UpdatePostRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Revision |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPost
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthPost
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Description = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EditorId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1802,7 +9864,7 @@ func (m *UpdatePostRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Id = string(dAtA[iNdEx:postIndex])
+			m.EditorId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1826,7 +9888,7 @@ func (m *UpdatePostRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PostsResponse) Unmarshal(dAtA []byte) error {
+func (m *TagPostsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1849,17 +9911,17 @@ func (m *PostsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PostsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: TagPostsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PostsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TagPostsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Posts", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Tag", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPost
@@ -1869,26 +9931,94 @@ func (m *PostsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPost
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPost
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Posts = append(m.Posts, &PostResponse{})
-			if err := m.Posts[len(m.Posts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Tag = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ViewerId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPost
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ViewerId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			m.Limit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Limit |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Page", wireType)
+			}
+			m.Page = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Page |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPost(dAtA[iNdEx:])
@@ -1911,7 +10041,7 @@ func (m *PostsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PostResponse) Unmarshal(dAtA []byte) error {
+func (m *AutocompleteTagsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1934,15 +10064,15 @@ func (m *PostResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PostResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: AutocompleteTagsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PostResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AutocompleteTagsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Prefix", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1970,13 +10100,13 @@ func (m *PostResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Id = string(dAtA[iNdEx:postIndex])
+			m.Prefix = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
 			}
-			var stringLen uint64
+			m.Limit = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPost
@@ -1986,61 +10116,67 @@ func (m *PostResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Limit |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPost
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPost(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthPost
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Title = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPost
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPost
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthPost
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TrendingTagsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPost
 			}
-			if postIndex > l {
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Description = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TrendingTagsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TrendingTagsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Likes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Hours", wireType)
 			}
-			m.Likes = 0
+			m.Hours = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPost
@@ -2050,16 +10186,16 @@ func (m *PostResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Likes |= int64(b&0x7F) << shift
+				m.Hours |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Comments", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
 			}
-			m.Comments = 0
+			m.Limit = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPost
@@ -2069,46 +10205,65 @@ func (m *PostResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Comments |= int64(b&0x7F) << shift
+				m.Limit |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UserId", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPost
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPost(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthPost
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthPost
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TagResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPost
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.UserId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 7:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TagResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TagResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UserName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2136,13 +10291,13 @@ func (m *PostResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.UserName = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Posts", wireType)
 			}
-			var stringLen uint64
+			m.Posts = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPost
@@ -2152,29 +10307,67 @@ func (m *PostResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Posts |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPost
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPost(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthPost
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CreatedAt = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 9:
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TagsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPost
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TagsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TagsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Tags", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPost
@@ -2184,23 +10377,25 @@ func (m *PostResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPost
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPost
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.UpdatedAt = string(dAtA[iNdEx:postIndex])
+			m.Tags = append(m.Tags, &TagResponse{})
+			if err := m.Tags[len(m.Tags)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -0,0 +1,164 @@
+package cache
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync/atomic"
+	"time"
+
+	"github.com/burxondv/new-services/api-gateway/storage/repo"
+
+	"golang.org/x/sync/singleflight"
+)
+
+// Cache is a read-through cache of serialized responses. Every resource,
+// like a user or a post, has a version in Redis which is a part of keys of
+// its entries, so Invalidate drops all variants of the resource, for example
+// responses for different viewers, with one INCR. Stale entries expire by
+// their TTL.
+type Cache struct {
+	store repo.RedisRepo
+	group singleflight.Group
+
+	hits   atomic.Int64
+	misses atomic.Int64
+}
+
+// Stats are counted since start of the process
+type Stats struct {
+	Hits   int64 `json:"hits"`
+	Misses int64 `json:"misses"`
+}
+
+func New(store repo.RedisRepo) *Cache {
+	return &Cache{store: store}
+}
+
+// Resource names of cached responses...
+func UserResource(id string) string { return "user:" + id }
+func PostResource(id string) string { return "post:" + id }
+
+// AccountResource is the state of the account checked by the authorizer
+func AccountResource(id string) string { return "account:" + id }
+
+// RelationsResource are follows, blocks and mutes of the user, they change
+// what the user sees of others, so they are part of variants of the viewer
+func RelationsResource(id string) string { return "relations:" + id }
+
+// PostsResource is visibility of all posts, it changes with shadow bans
+const PostsResource = "posts"
+
+// loadTimeout limits a load shared by concurrent requests, it is not
+// cancelled with the request which started it
+const loadTimeout = 10 * time.Second
+
+// Fetch decodes cached variant of the resource into dest. On miss load is
+// called once for all concurrent requests of the same entry, its result is
+// cached for ttl seconds. Errors of load are not cached, when Redis is not
+// available load is called every time. Load gets values of ctx but not its
+// cancellation, so a cancelled request doesn't fail the others waiting for it.
+func (c *Cache) Fetch(ctx context.Context, resource, variant string, ttl int, dest interface{}, load func(ctx context.Context) (interface{}, error)) error {
+	key, err := c.key(ctx, resource, variant)
+	if err == nil {
+		data, err := c.store.Get(ctx, key)
+		if err == nil && json.Unmarshal([]byte(data), dest) == nil {
+			c.hits.Add(1)
+			return nil
+		}
+	}
+	c.misses.Add(1)
+
+	flight := key
+	if flight == "" {
+		flight = resource + ":" + variant
+	}
+
+	data, err, _ := c.group.Do(flight, func() (interface{}, error) {
+		ctx, cancel := context.WithTimeout(withoutCancel{ctx}, loadTimeout)
+		defer cancel()
+
+		val, err := load(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		data, err := json.Marshal(val)
+		if err != nil {
+			return nil, err
+		}
+
+		if key != "" {
+			// response is returned even if it is not cached
+			_ = c.store.Set(ctx, key, string(data), time.Duration(ttl)*time.Second)
+		}
+
+		return data, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(data.([]byte), dest)
+}
+
+// Variant appends current versions of resources the response depends on to
+// the variant, so invalidating any of them makes the entry stale. Without
+// Redis the variant is returned as is, Fetch doesn't cache then anyway.
+func (c *Cache) Variant(ctx context.Context, variant string, resources ...string) string {
+	for _, resource := range resources {
+		version, err := c.store.Get(ctx, versionKey(resource))
+		if err == repo.ErrNotFound {
+			version = "0"
+		} else if err != nil {
+			return variant
+		}
+		variant += ":" + version
+	}
+
+	return variant
+}
+
+// Invalidate makes cached variants of the resources stale
+func (c *Cache) Invalidate(ctx context.Context, resources ...string) error {
+	for _, resource := range resources {
+		if _, err := c.store.Incr(ctx, versionKey(resource)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (c *Cache) Stats() Stats {
+	return Stats{
+		Hits:   c.hits.Load(),
+		Misses: c.misses.Load(),
+	}
+}
+
+// key returns key of the variant in the current version of the resource
+func (c *Cache) key(ctx context.Context, resource, variant string) (string, error) {
+	version, err := c.store.Get(ctx, versionKey(resource))
+	if err == repo.ErrNotFound {
+		version = "0"
+	} else if err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("cache:%s:%s:%s", resource, version, variant), nil
+}
+
+// withoutCancel keeps values of the parent, like request id and identity,
+// but is never cancelled. It is context.WithoutCancel of newer Go.
+type withoutCancel struct {
+	context.Context
+}
+
+func (withoutCancel) Deadline() (time.Time, bool) { return time.Time{}, false }
+func (withoutCancel) Done() <-chan struct{}       { return nil }
+func (withoutCancel) Err() error                  { return nil }
+
+func versionKey(resource string) string {
+	return "cache:" + resource + ":version"
+}
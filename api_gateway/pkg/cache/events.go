@@ -0,0 +1,82 @@
+package cache
+
+import (
+	"context"
+	"time"
+
+	"github.com/burxondv/new-services/api-gateway/pkg/events"
+	"github.com/burxondv/new-services/api-gateway/pkg/logger"
+)
+
+// consumerGroup is the group of all gateway replicas in the event bus, the
+// cache is shared, so every event is handled by one of them
+const consumerGroup = "api_gateway"
+
+// RunConsumer invalidates responses changed by services themselves, e.g. by
+// the scheduler, until ctx is done. Consumer must be unique among replicas.
+func (c *Cache) RunConsumer(ctx context.Context, bus events.Bus, consumer string, log logger.Logger) {
+	handler := func(ctx context.Context, event events.Event) error {
+		return c.HandleEvent(ctx, event, log)
+	}
+
+	for ctx.Err() == nil {
+		err := bus.Subscribe(ctx, consumerGroup, consumer, handler)
+		if err != nil && ctx.Err() == nil {
+			log.Error("cache consumer: subscription is broken", logger.Error(err))
+			time.Sleep(time.Second)
+		}
+	}
+}
+
+// HandleEvent invalidates resources changed by the event, changes made
+// through the gateway are invalidated by its handlers
+func (c *Cache) HandleEvent(ctx context.Context, event events.Event, log logger.Logger) error {
+	switch event.Type {
+	case events.PostPublished:
+		var post events.Post
+		if err := event.Decode(&post); err != nil {
+			log.Error("cache consumer: failed to decode event", logger.String("event_id", event.Id), logger.Error(err))
+			return nil
+		}
+
+		return c.Invalidate(ctx, PostResource(post.Id), UserResource(post.UserId))
+	case events.PostDeleted:
+		var post events.Post
+		if err := event.Decode(&post); err != nil {
+			log.Error("cache consumer: failed to decode event", logger.String("event_id", event.Id), logger.Error(err))
+			return nil
+		}
+
+		// posts of deleted users are deleted by post_service
+		return c.Invalidate(ctx, PostResource(post.Id), UserResource(post.UserId))
+	case events.UserDeleted:
+		var user events.User
+		if err := event.Decode(&user); err != nil {
+			log.Error("cache consumer: failed to decode event", logger.String("event_id", event.Id), logger.Error(err))
+			return nil
+		}
+
+		// comments of the user on posts of others are deleted too, the posts
+		// are not known here
+		return c.Invalidate(ctx, PostsResource, UserResource(user.Id), AccountResource(user.Id), RelationsResource(user.Id))
+	case events.UserRestricted:
+		var restriction events.Restriction
+		if err := event.Decode(&restriction); err != nil {
+			log.Error("cache consumer: failed to decode event", logger.String("event_id", event.Id), logger.Error(err))
+			return nil
+		}
+
+		return c.Invalidate(ctx, AccountResource(restriction.Id), UserResource(restriction.Id))
+	case events.PostsRestricted:
+		var user events.User
+		if err := event.Decode(&user); err != nil {
+			log.Error("cache consumer: failed to decode event", logger.String("event_id", event.Id), logger.Error(err))
+			return nil
+		}
+
+		// posts of the user are not known here, shadow bans are rare
+		return c.Invalidate(ctx, PostsResource, UserResource(user.Id))
+	}
+
+	return nil
+}
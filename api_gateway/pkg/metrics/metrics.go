@@ -0,0 +1,83 @@
+package metrics
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gomodule/redigo/redis"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+// unmatchedRoute labels requests to paths without a route, so scans of
+// random paths do not create new series
+const unmatchedRoute = "unmatched"
+
+var (
+	httpRequests = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "http_requests_total",
+		Help: "HTTP requests by method, route template and status code.",
+	}, []string{"method", "route", "code"})
+
+	httpDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "http_request_duration_seconds",
+		Help:    "Duration of HTTP requests by method, route template and status code.",
+		Buckets: prometheus.DefBuckets,
+	}, []string{"method", "route", "code"})
+
+	// business...
+	Logins = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "logins_total",
+		Help: "Login attempts by result, success or failure.",
+	}, []string{"result"})
+)
+
+func init() {
+	prometheus.MustRegister(httpRequests, httpDuration, Logins)
+}
+
+// Middleware records rate, latency and errors of requests. Routes are
+// labeled by their template, e.g. /v1/posts/:id
+func Middleware() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		start := time.Now()
+		c.Next()
+
+		route := c.FullPath()
+		if route == "" {
+			route = unmatchedRoute
+		}
+		code := strconv.Itoa(c.Writer.Status())
+		httpRequests.WithLabelValues(c.Request.Method, route, code).Inc()
+		httpDuration.WithLabelValues(c.Request.Method, route, code).Observe(time.Since(start).Seconds())
+	}
+}
+
+// RegisterRedisPool exports stats of connections of pool
+func RegisterRedisPool(pool *redis.Pool) {
+	prometheus.MustRegister(
+		prometheus.NewGaugeFunc(prometheus.GaugeOpts{
+			Name: "redis_pool_active_connections",
+			Help: "Connections of the pool, both idle and in use.",
+		}, func() float64 { return float64(pool.Stats().ActiveCount) }),
+		prometheus.NewGaugeFunc(prometheus.GaugeOpts{
+			Name: "redis_pool_idle_connections",
+			Help: "Idle connections of the pool.",
+		}, func() float64 { return float64(pool.Stats().IdleCount) }),
+		prometheus.NewCounterFunc(prometheus.CounterOpts{
+			Name: "redis_pool_wait_total",
+			Help: "Connections waited for because the pool was at MaxActive.",
+		}, func() float64 { return float64(pool.Stats().WaitCount) }),
+		prometheus.NewCounterFunc(prometheus.CounterOpts{
+			Name: "redis_pool_wait_seconds_total",
+			Help: "Time spent waiting for connections of the pool.",
+		}, func() float64 { return pool.Stats().WaitDuration.Seconds() }),
+	)
+}
+
+// Handler serves metrics in Prometheus format
+func Handler() http.Handler {
+	return promhttp.Handler()
+}
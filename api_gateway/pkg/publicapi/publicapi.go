@@ -0,0 +1,55 @@
+package publicapi
+
+import (
+	"context"
+	"reflect"
+
+	"github.com/burxondv/new-services/api-gateway/pkg/identity"
+
+	"google.golang.org/grpc"
+)
+
+// callerFields name the user a request is made for, clients of the API
+// generated from protos can't set them, they are taken from the identity
+var callerFields = []string{"ViewerId", "EditorId"}
+
+type ctxKey struct{}
+
+// With marks calls made with ctx as calls of the generated API
+func With(ctx context.Context) context.Context {
+	return context.WithValue(ctx, ctxKey{}, true)
+}
+
+func Is(ctx context.Context) bool {
+	marked, _ := ctx.Value(ctxKey{}).(bool)
+	return marked
+}
+
+// SetCaller sets callerFields of req to the subject of the identity in ctx,
+// they are cleared when the caller is not logged in
+func SetCaller(ctx context.Context, req interface{}) {
+	v := reflect.ValueOf(req)
+	if v.Kind() != reflect.Ptr || v.Elem().Kind() != reflect.Struct {
+		return
+	}
+
+	id, _ := identity.FromContext(ctx)
+	for _, name := range callerFields {
+		field := v.Elem().FieldByName(name)
+		if field.IsValid() && field.CanSet() && field.Kind() == reflect.String {
+			field.SetString(id.Subject)
+		}
+	}
+}
+
+// UnaryClientInterceptor sets callers of requests of the generated API, other
+// calls are not changed
+func UnaryClientInterceptor() grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		if Is(ctx) {
+			SetCaller(ctx, req)
+		}
+
+		return invoker(ctx, method, req, reply, cc, opts...)
+	}
+}
@@ -0,0 +1,81 @@
+package realtime
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/burxondv/new-services/api-gateway/storage/repo"
+)
+
+// Event is sent to clients as JSON, Data is comment, like or notification
+type Event struct {
+	Type string      `json:"type"`
+	Data interface{} `json:"data"`
+}
+
+func CommentsChannel(postId string) string {
+	return "stream:comments:" + postId
+}
+
+func LikesChannel(postId string) string {
+	return "stream:likes:" + postId
+}
+
+func NotificationsChannel(userId string) string {
+	return "stream:notifications:" + userId
+}
+
+// Broker fans out events through Redis pub/sub, so clients connected to any
+// gateway replica receive them
+type Broker struct {
+	store repo.RedisRepo
+}
+
+func NewBroker(store repo.RedisRepo) *Broker {
+	return &Broker{store: store}
+}
+
+func (b *Broker) Publish(ctx context.Context, channel string, event Event) error {
+	data, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+
+	return b.store.Publish(ctx, channel, data)
+}
+
+// PublishOnce publishes event only if it was not published with the same key
+// during ttl seconds, so replicas relaying the same stream do not duplicate it
+func (b *Broker) PublishOnce(ctx context.Context, key, channel string, event Event, ttl int) error {
+	ok, err := b.store.SetNX(ctx, "stream:published:"+key, "1", time.Duration(ttl)*time.Second)
+	if err != nil || !ok {
+		return err
+	}
+
+	return b.Publish(ctx, channel, event)
+}
+
+// Subscribe returns JSON encoded events of the channels, returned channel is
+// closed when ctx is done or connection to Redis is lost
+func (b *Broker) Subscribe(ctx context.Context, channels ...string) (<-chan []byte, error) {
+	messages, err := b.store.Subscribe(ctx, channels...)
+	if err != nil {
+		return nil, err
+	}
+
+	events := make(chan []byte)
+	go func() {
+		defer close(events)
+
+		for msg := range messages {
+			select {
+			case events <- msg.Data:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return events, nil
+}
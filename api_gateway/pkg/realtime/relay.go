@@ -0,0 +1,166 @@
+package realtime
+
+import (
+	"context"
+	"time"
+
+	pc "github.com/burxondv/new-services/api-gateway/genproto/comment"
+	pn "github.com/burxondv/new-services/api-gateway/genproto/notification"
+	pp "github.com/burxondv/new-services/api-gateway/genproto/post"
+	"github.com/burxondv/new-services/api-gateway/pkg/logger"
+	"github.com/burxondv/new-services/api-gateway/services"
+
+	"google.golang.org/grpc"
+)
+
+const (
+	// every replica relays the same events, only the first one publishes
+	publishedTTL = 60 // seconds
+
+	minBackoff = time.Second
+	maxBackoff = 30 * time.Second
+
+	// replicas of services are resolved again this often, streams of new
+	// replicas are opened and streams of gone ones are closed
+	resolveInterval = 30 * time.Second
+)
+
+// Relay reads server-streaming RPCs of every replica of services and
+// publishes received events to Redis. Replicas stream only events of their
+// own calls, so one stream per service would miss events of the others.
+type Relay struct {
+	broker   *Broker
+	services services.IServiceManager
+	log      logger.Logger
+}
+
+func NewRelay(broker *Broker, services services.IServiceManager, log logger.Logger) *Relay {
+	return &Relay{
+		broker:   broker,
+		services: services,
+		log:      log,
+	}
+}
+
+// Run relays streams until ctx is done, broken streams are reopened
+func (r *Relay) Run(ctx context.Context) {
+	go r.watch(ctx, "comment_service", "comments", r.comments)
+	go r.watch(ctx, "post_service", "likes", r.likes)
+	go r.watch(ctx, "notification_service", "notifications", r.notifications)
+}
+
+// watch keeps a stream open on every replica of service
+func (r *Relay) watch(ctx context.Context, service, name string, relay func(context.Context, *grpc.ClientConn) error) {
+	streams := map[string]context.CancelFunc{}
+	for {
+		conns, err := r.services.Backends(ctx, service)
+		if err != nil {
+			r.log.Warn("failed to resolve replicas", logger.String("service", service), logger.Error(err))
+		} else {
+			for addr, conn := range conns {
+				if _, ok := streams[addr]; ok {
+					continue
+				}
+
+				streamCtx, cancel := context.WithCancel(ctx)
+				streams[addr] = cancel
+				conn := conn
+				go r.run(streamCtx, name, addr, func(ctx context.Context) error {
+					return relay(ctx, conn)
+				})
+			}
+			for addr, cancel := range streams {
+				if _, ok := conns[addr]; !ok {
+					cancel()
+					delete(streams, addr)
+				}
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(resolveInterval):
+		}
+	}
+}
+
+func (r *Relay) run(ctx context.Context, name, addr string, relay func(context.Context) error) {
+	backoff := minBackoff
+	for ctx.Err() == nil {
+		started := time.Now()
+		err := relay(ctx)
+		if ctx.Err() != nil {
+			return
+		}
+		if time.Since(started) > maxBackoff {
+			backoff = minBackoff
+		}
+
+		r.log.Warn("stream is broken, reopening", logger.String("stream", name), logger.String("addr", addr), logger.Error(err))
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(backoff):
+		}
+
+		backoff *= 2
+		if backoff > maxBackoff {
+			backoff = maxBackoff
+		}
+	}
+}
+
+func (r *Relay) comments(ctx context.Context, conn *grpc.ClientConn) error {
+	stream, err := pc.NewCommentServiceClient(conn).StreamComments(ctx, &pc.StreamRequest{})
+	if err != nil {
+		return err
+	}
+
+	for {
+		comment, err := stream.Recv()
+		if err != nil {
+			return err
+		}
+
+		r.publish(ctx, "comment:"+comment.Id, CommentsChannel(comment.PostId), Event{Type: "comment", Data: comment})
+	}
+}
+
+func (r *Relay) likes(ctx context.Context, conn *grpc.ClientConn) error {
+	stream, err := pp.NewPostServiceClient(conn).StreamLikes(ctx, &pp.StreamRequest{})
+	if err != nil {
+		return err
+	}
+
+	for {
+		like, err := stream.Recv()
+		if err != nil {
+			return err
+		}
+
+		r.publish(ctx, "like:"+like.Id, LikesChannel(like.PostId), Event{Type: "like", Data: like})
+	}
+}
+
+func (r *Relay) notifications(ctx context.Context, conn *grpc.ClientConn) error {
+	stream, err := pn.NewNotificationServiceClient(conn).StreamNotifications(ctx, &pn.StreamRequest{})
+	if err != nil {
+		return err
+	}
+
+	for {
+		notif, err := stream.Recv()
+		if err != nil {
+			return err
+		}
+
+		r.publish(ctx, "notification:"+notif.Id, NotificationsChannel(notif.UserId), Event{Type: "notification", Data: notif})
+	}
+}
+
+func (r *Relay) publish(ctx context.Context, key, channel string, event Event) {
+	if err := r.broker.PublishOnce(ctx, key, channel, event, publishedTTL); err != nil {
+		r.log.Error("failed to publish stream event", logger.String("channel", channel), logger.Error(err))
+	}
+}
@@ -4,8 +4,10 @@ CURRENT_DIR=$(pwd)
 for module in $(find $CURRENT_DIR/protos/* -type d); do
     protoc -I /usr/local/include \
            -I $GOPATH/pkg/mod/github.com/gogo/protobuf@v1.3.2 \
+           -I $GOPATH/pkg/mod/github.com/grpc-ecosystem/grpc-gateway@v1.16.0/third_party/googleapis \
            -I $CURRENT_DIR/protos/ \
             --gofast_out=plugins=grpc:$CURRENT_DIR/genproto/ \
+            --grpc-gateway_out=logtostderr=true:$CURRENT_DIR/genproto/ \
             $module/*.proto;
 done;
 
@@ -1,58 +1,138 @@
 package services
 
 import (
+	"context"
 	"fmt"
+	"net"
+	"sync"
 
 	"github.com/burxondv/new-services/api-gateway/config"
 	pc "github.com/burxondv/new-services/api-gateway/genproto/comment"
+	pm "github.com/burxondv/new-services/api-gateway/genproto/moderation"
+	pn "github.com/burxondv/new-services/api-gateway/genproto/notification"
 	pp "github.com/burxondv/new-services/api-gateway/genproto/post"
 	pu "github.com/burxondv/new-services/api-gateway/genproto/user"
+	"github.com/burxondv/new-services/api-gateway/pkg/identity"
+	"github.com/burxondv/new-services/api-gateway/pkg/mtls"
+	"github.com/burxondv/new-services/api-gateway/pkg/publicapi"
+	"github.com/burxondv/new-services/api-gateway/pkg/rpcclient"
 
 	"google.golang.org/grpc"
-	"google.golang.org/grpc/credentials/insecure"
-	"google.golang.org/grpc/resolver"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
 )
 
 type IServiceManager interface {
 	UserService() pu.UserServiceClient
 	PostService() pp.PostServiceClient
 	CommentService() pc.CommentServiceClient
+	NotificationService() pn.NotificationServiceClient
+	ModerationService() pm.ModerationServiceClient
+
+	// Conn returns the connection to a service, e.g. post_service, calls of
+	// the API generated from protos are made with it
+	Conn(name string) *grpc.ClientConn
+	// Backends returns connections to every replica of a service by their
+	// addresses. A server stream is served only by the replica it's opened
+	// on, so streams of all events are opened on every replica. Connections
+	// to replicas which are gone are closed.
+	Backends(ctx context.Context, name string) (map[string]*grpc.ClientConn, error)
+
+	// Check asks every service for its health, services which are not
+	// serving or can't be reached have errors
+	Check(ctx context.Context) map[string]error
+	// Close closes connections to all services
+	Close() error
 }
 
 type serviceManager struct {
-	userService    pu.UserServiceClient
-	postService    pp.PostServiceClient
-	commentService pc.CommentServiceClient
+	userService         pu.UserServiceClient
+	postService         pp.PostServiceClient
+	commentService      pc.CommentServiceClient
+	notificationService pn.NotificationServiceClient
+	moderationService   pm.ModerationServiceClient
+	conns               map[string]*grpc.ClientConn
+
+	mu       sync.Mutex
+	backends map[string]*backends
 }
 
-func NewServiceManager(conf *config.Config) (IServiceManager, error) {
-	resolver.SetDefaultScheme("dns")
+// backends are connections to replicas of one service
+type backends struct {
+	host, port string
+	dial       func(addr string) (*grpc.ClientConn, error)
+	conns      map[string]*grpc.ClientConn
+}
+
+func NewServiceManager(conf *config.Config, certs *mtls.Certs, signer *identity.Signer) (IServiceManager, error) {
+	// the end user of a call is sent to the called service
+	opts := []grpc.DialOption{
+		grpc.WithChainUnaryInterceptor(publicapi.UnaryClientInterceptor(), signer.UnaryClientInterceptor()),
+		grpc.WithChainStreamInterceptor(signer.StreamClientInterceptor()),
+	}
+
+	connUser, err := rpcclient.Dial("api_gateway", "user_service", conf.UserServiceHost, conf.UserServicePort, certs, opts...)
+	if err != nil {
+		return nil, err
+	}
 
-	connUser, err := grpc.Dial(
-		fmt.Sprintf("%s:%s", conf.UserServiceHost, conf.UserServicePort),
-		grpc.WithTransportCredentials(insecure.NewCredentials()))
+	// attachments are sent in one message, 1MB is left for other fields
+	maxMsgSize := int(conf.MaxAttachmentSize) + 1<<20
+	connPost, err := rpcclient.Dial("api_gateway", "post_service", conf.PostServiceHost, conf.PostServicePort, certs,
+		append(opts, grpc.WithDefaultCallOptions(grpc.MaxCallSendMsgSize(maxMsgSize), grpc.MaxCallRecvMsgSize(maxMsgSize)))...)
 	if err != nil {
 		return nil, err
 	}
 
-	connPost, err := grpc.Dial(
-		fmt.Sprintf("%s:%s", conf.PostServiceHost, conf.PostServicePort),
-		grpc.WithTransportCredentials(insecure.NewCredentials()))
+	connComment, err := rpcclient.Dial("api_gateway", "comment_service", conf.CommentServiceHost, conf.CommentServicePort, certs, opts...)
 	if err != nil {
 		return nil, err
 	}
 
-	connComment, err := grpc.Dial(
-		fmt.Sprintf("%s:%s", conf.CommentServiceHost, conf.CommentServicePort),
-		grpc.WithTransportCredentials(insecure.NewCredentials()))
+	connNotification, err := rpcclient.Dial("api_gateway", "notification_service", conf.NotificationServiceHost, conf.NotificationServicePort, certs, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	connModeration, err := rpcclient.Dial("api_gateway", "moderation_service", conf.ModerationServiceHost, conf.ModerationServicePort, certs, opts...)
 	if err != nil {
 		return nil, err
 	}
 
 	serviceManager := &serviceManager{
-		userService:    pu.NewUserServiceClient(connUser),
-		postService:    pp.NewPostServiceClient(connPost),
-		commentService: pc.NewCommentServiceClient(connComment),
+		userService:         pu.NewUserServiceClient(connUser),
+		postService:         pp.NewPostServiceClient(connPost),
+		commentService:      pc.NewCommentServiceClient(connComment),
+		notificationService: pn.NewNotificationServiceClient(connNotification),
+		moderationService:   pm.NewModerationServiceClient(connModeration),
+		conns: map[string]*grpc.ClientConn{
+			"user_service":         connUser,
+			"post_service":         connPost,
+			"comment_service":      connComment,
+			"notification_service": connNotification,
+			"moderation_service":   connModeration,
+		},
+		backends: map[string]*backends{},
+	}
+
+	// replicas of services with server streams
+	for name, host := range map[string][2]string{
+		"post_service":         {conf.PostServiceHost, conf.PostServicePort},
+		"comment_service":      {conf.CommentServiceHost, conf.CommentServicePort},
+		"notification_service": {conf.NotificationServiceHost, conf.NotificationServicePort},
+	} {
+		name := name
+		serviceManager.backends[name] = &backends{
+			host: host[0],
+			port: host[1],
+			dial: func(addr string) (*grpc.ClientConn, error) {
+				ip, port, err := net.SplitHostPort(addr)
+				if err != nil {
+					return nil, err
+				}
+				return rpcclient.Dial("api_gateway", name, ip, port, certs, opts...)
+			},
+			conns: map[string]*grpc.ClientConn{},
+		}
 	}
 
 	return serviceManager, nil
@@ -69,3 +149,105 @@ func (s *serviceManager) PostService() pp.PostServiceClient {
 func (s *serviceManager) CommentService() pc.CommentServiceClient {
 	return s.commentService
 }
+
+func (s *serviceManager) NotificationService() pn.NotificationServiceClient {
+	return s.notificationService
+}
+
+func (s *serviceManager) ModerationService() pm.ModerationServiceClient {
+	return s.moderationService
+}
+
+func (s *serviceManager) Conn(name string) *grpc.ClientConn {
+	return s.conns[name]
+}
+
+func (s *serviceManager) Backends(ctx context.Context, name string) (map[string]*grpc.ClientConn, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	b, ok := s.backends[name]
+	if !ok {
+		return nil, fmt.Errorf("%s has no streams", name)
+	}
+
+	addrs, err := rpcclient.Resolve(ctx, b.host, b.port)
+	if err != nil {
+		return nil, err
+	}
+
+	conns := map[string]*grpc.ClientConn{}
+	for _, addr := range addrs {
+		conn, ok := b.conns[addr]
+		if !ok {
+			conn, err = b.dial(addr)
+			if err != nil {
+				for addr, conn := range conns {
+					if _, ok := b.conns[addr]; !ok {
+						conn.Close()
+					}
+				}
+				return nil, err
+			}
+		}
+		conns[addr] = conn
+	}
+	for addr, conn := range b.conns {
+		if _, ok := conns[addr]; !ok {
+			conn.Close()
+		}
+	}
+	b.conns = conns
+
+	res := make(map[string]*grpc.ClientConn, len(conns))
+	for addr, conn := range conns {
+		res[addr] = conn
+	}
+	return res, nil
+}
+
+func (s *serviceManager) Check(ctx context.Context) map[string]error {
+	var (
+		mu  sync.Mutex
+		wg  sync.WaitGroup
+		res = map[string]error{}
+	)
+	for name, conn := range s.conns {
+		wg.Add(1)
+		go func(name string, conn *grpc.ClientConn) {
+			defer wg.Done()
+
+			resp, err := healthpb.NewHealthClient(conn).Check(ctx, &healthpb.HealthCheckRequest{})
+			if err == nil && resp.Status != healthpb.HealthCheckResponse_SERVING {
+				err = fmt.Errorf("status %s", resp.Status)
+			}
+
+			mu.Lock()
+			res[name] = err
+			mu.Unlock()
+		}(name, conn)
+	}
+	wg.Wait()
+
+	return res
+}
+
+func (s *serviceManager) Close() error {
+	var res error
+	for _, conn := range s.conns {
+		if err := conn.Close(); err != nil {
+			res = err
+		}
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, b := range s.backends {
+		for _, conn := range b.conns {
+			if err := conn.Close(); err != nil {
+				res = err
+			}
+		}
+	}
+	return res
+}
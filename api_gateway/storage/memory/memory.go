@@ -0,0 +1,620 @@
+package memory
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/burxondv/new-services/api-gateway/storage/repo"
+)
+
+// messages are dropped for a subscriber which falls behind by more than
+// subscriberBuffer
+const subscriberBuffer = 64
+
+var errWrongType = errors.New("WRONGTYPE Operation against a key holding the wrong kind of value")
+
+// ScriptFunc emulates a Lua script, it may call methods of the store
+type ScriptFunc func(ctx context.Context, keys []string, args []interface{}) (interface{}, error)
+
+type entry struct {
+	str     *string
+	hash    map[string]string
+	zset    map[string]float64
+	expires time.Time
+}
+
+// Store is an in-memory repo.RedisRepo for unit tests. Lua is not
+// interpreted, scripts are emulated by functions registered with
+// RegisterScript.
+type Store struct {
+	mu      sync.Mutex
+	data    map[string]*entry
+	scripts map[string]ScriptFunc
+	subs    map[string]map[chan repo.Message]struct{}
+
+	// Now returns the current time, tests may replace it to expire keys
+	Now func() time.Time
+}
+
+func New() *Store {
+	return &Store{
+		data:    make(map[string]*entry),
+		scripts: make(map[string]ScriptFunc),
+		subs:    make(map[string]map[chan repo.Message]struct{}),
+		Now:     time.Now,
+	}
+}
+
+// RegisterScript sets the function which runs instead of the script
+func (s *Store) RegisterScript(script *repo.Script, fn ScriptFunc) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.scripts[script.Src] = fn
+}
+
+// get returns the live entry of the key, expired entries are removed
+func (s *Store) get(key string) *entry {
+	e, ok := s.data[key]
+	if !ok {
+		return nil
+	}
+	if !e.expires.IsZero() && !s.Now().Before(e.expires) {
+		delete(s.data, key)
+		return nil
+	}
+
+	return e
+}
+
+func (s *Store) deadline(ttl time.Duration) time.Time {
+	if ttl <= 0 {
+		return time.Time{}
+	}
+
+	return s.Now().Add(ttl)
+}
+
+func (s *Store) Ping(ctx context.Context) error {
+	return ctx.Err()
+}
+
+func (s *Store) Exists(ctx context.Context, key string) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.get(key) != nil, nil
+}
+
+func (s *Store) Get(ctx context.Context, key string) (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	e := s.get(key)
+	if e == nil {
+		return "", repo.ErrNotFound
+	}
+	if e.str == nil {
+		return "", errWrongType
+	}
+
+	return *e.str, nil
+}
+
+func (s *Store) Set(ctx context.Context, key, value string, ttl time.Duration) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.set(key, value, ttl)
+	return nil
+}
+
+func (s *Store) set(key, value string, ttl time.Duration) {
+	s.data[key] = &entry{str: &value, expires: s.deadline(ttl)}
+}
+
+func (s *Store) SetNX(ctx context.Context, key, value string, ttl time.Duration) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.get(key) != nil {
+		return false, nil
+	}
+	s.set(key, value, ttl)
+
+	return true, nil
+}
+
+func (s *Store) Del(ctx context.Context, keys ...string) (int64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.del(keys...), nil
+}
+
+func (s *Store) del(keys ...string) int64 {
+	var n int64
+	for _, key := range keys {
+		if s.get(key) != nil {
+			delete(s.data, key)
+			n++
+		}
+	}
+
+	return n
+}
+
+func (s *Store) Expire(ctx context.Context, key string, ttl time.Duration) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.expire(key, ttl), nil
+}
+
+func (s *Store) expire(key string, ttl time.Duration) bool {
+	e := s.get(key)
+	if e == nil {
+		return false
+	}
+	e.expires = s.deadline(ttl)
+
+	return true
+}
+
+func (s *Store) TTL(ctx context.Context, key string) (time.Duration, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	e := s.get(key)
+	if e == nil {
+		return 0, repo.ErrNotFound
+	}
+	if e.expires.IsZero() {
+		return 0, nil
+	}
+
+	return e.expires.Sub(s.Now()), nil
+}
+
+func (s *Store) Incr(ctx context.Context, key string) (int64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.incr(key)
+}
+
+func (s *Store) incr(key string) (int64, error) {
+	var n int64
+	e := s.get(key)
+	if e != nil {
+		if e.str == nil {
+			return 0, errWrongType
+		}
+		var err error
+		n, err = strconv.ParseInt(*e.str, 10, 64)
+		if err != nil {
+			return 0, errors.New("ERR value is not an integer or out of range")
+		}
+	} else {
+		e = &entry{}
+		s.data[key] = e
+	}
+
+	n++
+	value := strconv.FormatInt(n, 10)
+	e.str = &value
+
+	return n, nil
+}
+
+func (s *Store) IncrWithExpiry(ctx context.Context, key string, ttl time.Duration) (int64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	n, err := s.incr(key)
+	if err != nil {
+		return 0, err
+	}
+	if n == 1 {
+		s.expire(key, ttl)
+	}
+
+	return n, nil
+}
+
+// hash returns hash of the key, it is created if create is true
+func (s *Store) hash(key string, create bool) (map[string]string, error) {
+	e := s.get(key)
+	if e == nil {
+		if !create {
+			return nil, nil
+		}
+		e = &entry{hash: make(map[string]string)}
+		s.data[key] = e
+	}
+	if e.hash == nil {
+		return nil, errWrongType
+	}
+
+	return e.hash, nil
+}
+
+func (s *Store) HSet(ctx context.Context, key string, fields map[string]string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.hset(key, fields)
+}
+
+func (s *Store) hset(key string, fields map[string]string) error {
+	if len(fields) == 0 {
+		return nil
+	}
+
+	hash, err := s.hash(key, true)
+	if err != nil {
+		return err
+	}
+	for field, value := range fields {
+		hash[field] = value
+	}
+
+	return nil
+}
+
+func (s *Store) HGet(ctx context.Context, key, field string) (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	hash, err := s.hash(key, false)
+	if err != nil {
+		return "", err
+	}
+	value, ok := hash[field]
+	if !ok {
+		return "", repo.ErrNotFound
+	}
+
+	return value, nil
+}
+
+func (s *Store) HGetAll(ctx context.Context, key string) (map[string]string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	hash, err := s.hash(key, false)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make(map[string]string, len(hash))
+	for field, value := range hash {
+		res[field] = value
+	}
+
+	return res, nil
+}
+
+func (s *Store) HDel(ctx context.Context, key string, fields ...string) (int64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	hash, err := s.hash(key, false)
+	if err != nil {
+		return 0, err
+	}
+
+	var n int64
+	for _, field := range fields {
+		if _, ok := hash[field]; ok {
+			delete(hash, field)
+			n++
+		}
+	}
+	if hash != nil && len(hash) == 0 {
+		delete(s.data, key)
+	}
+
+	return n, nil
+}
+
+func (s *Store) HIncrBy(ctx context.Context, key, field string, n int64) (int64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	hash, err := s.hash(key, true)
+	if err != nil {
+		return 0, err
+	}
+
+	var value int64
+	if v, ok := hash[field]; ok {
+		value, err = strconv.ParseInt(v, 10, 64)
+		if err != nil {
+			return 0, errors.New("ERR hash value is not an integer")
+		}
+	}
+	value += n
+	hash[field] = strconv.FormatInt(value, 10)
+
+	return value, nil
+}
+
+// zset returns sorted set of the key, it is created if create is true
+func (s *Store) zset(key string, create bool) (map[string]float64, error) {
+	e := s.get(key)
+	if e == nil {
+		if !create {
+			return nil, nil
+		}
+		e = &entry{zset: make(map[string]float64)}
+		s.data[key] = e
+	}
+	if e.zset == nil {
+		return nil, errWrongType
+	}
+
+	return e.zset, nil
+}
+
+func (s *Store) ZAdd(ctx context.Context, key string, members ...repo.Z) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.zadd(key, members...)
+}
+
+func (s *Store) zadd(key string, members ...repo.Z) error {
+	if len(members) == 0 {
+		return nil
+	}
+
+	zset, err := s.zset(key, true)
+	if err != nil {
+		return err
+	}
+	for _, m := range members {
+		zset[m.Member] = m.Score
+	}
+
+	return nil
+}
+
+func (s *Store) ZRem(ctx context.Context, key string, members ...string) (int64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.zrem(key, members...)
+}
+
+func (s *Store) zrem(key string, members ...string) (int64, error) {
+	zset, err := s.zset(key, false)
+	if err != nil {
+		return 0, err
+	}
+
+	var n int64
+	for _, member := range members {
+		if _, ok := zset[member]; ok {
+			delete(zset, member)
+			n++
+		}
+	}
+	if zset != nil && len(zset) == 0 {
+		delete(s.data, key)
+	}
+
+	return n, nil
+}
+
+func (s *Store) ZCard(ctx context.Context, key string) (int64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	zset, err := s.zset(key, false)
+	return int64(len(zset)), err
+}
+
+func (s *Store) ZScore(ctx context.Context, key, member string) (float64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	zset, err := s.zset(key, false)
+	if err != nil {
+		return 0, err
+	}
+	score, ok := zset[member]
+	if !ok {
+		return 0, repo.ErrNotFound
+	}
+
+	return score, nil
+}
+
+func (s *Store) ZRangeByScore(ctx context.Context, key string, min, max float64, limit int) ([]repo.Z, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	members, err := s.zrange(key, min, max)
+	if err != nil {
+		return nil, err
+	}
+	if limit > 0 && len(members) > limit {
+		members = members[:limit]
+	}
+
+	return members, nil
+}
+
+// zrange returns members in the score range ordered like in Redis, by score
+// and then by member
+func (s *Store) zrange(key string, min, max float64) ([]repo.Z, error) {
+	zset, err := s.zset(key, false)
+	if err != nil {
+		return nil, err
+	}
+
+	members := []repo.Z{}
+	for member, score := range zset {
+		if score >= min && score <= max {
+			members = append(members, repo.Z{Member: member, Score: score})
+		}
+	}
+	sort.Slice(members, func(i, j int) bool {
+		if members[i].Score != members[j].Score {
+			return members[i].Score < members[j].Score
+		}
+		return members[i].Member < members[j].Member
+	})
+
+	return members, nil
+}
+
+func (s *Store) ZRemRangeByScore(ctx context.Context, key string, min, max float64) (int64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	members, err := s.zrange(key, min, max)
+	if err != nil {
+		return 0, err
+	}
+
+	names := make([]string, 0, len(members))
+	for _, m := range members {
+		names = append(names, m.Member)
+	}
+
+	return s.zrem(key, names...)
+}
+
+func (s *Store) Eval(ctx context.Context, script *repo.Script, keys []string, args ...interface{}) (interface{}, error) {
+	s.mu.Lock()
+	fn, ok := s.scripts[script.Src]
+	s.mu.Unlock()
+	if !ok {
+		return nil, fmt.Errorf("memory: script is not registered: %q", script.Src)
+	}
+
+	return fn(ctx, keys, args)
+}
+
+// Pipeline applies queued commands under one lock, so like MULTI/EXEC other
+// clients do not see a part of them
+func (s *Store) Pipeline(ctx context.Context, fn func(repo.Pipe)) error {
+	p := &pipe{}
+	fn(p)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, cmd := range p.cmds {
+		if err := cmd(s); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (s *Store) Publish(ctx context.Context, channel string, message []byte) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for sub := range s.subs[channel] {
+		select {
+		case sub <- repo.Message{Channel: channel, Data: message}:
+		default:
+		}
+	}
+
+	return nil
+}
+
+func (s *Store) Subscribe(ctx context.Context, channels ...string) (<-chan repo.Message, error) {
+	sub := make(chan repo.Message, subscriberBuffer)
+
+	s.mu.Lock()
+	for _, channel := range channels {
+		if s.subs[channel] == nil {
+			s.subs[channel] = make(map[chan repo.Message]struct{})
+		}
+		s.subs[channel][sub] = struct{}{}
+	}
+	s.mu.Unlock()
+
+	go func() {
+		<-ctx.Done()
+
+		s.mu.Lock()
+		defer s.mu.Unlock()
+
+		for _, channel := range channels {
+			delete(s.subs[channel], sub)
+			if len(s.subs[channel]) == 0 {
+				delete(s.subs, channel)
+			}
+		}
+		close(sub)
+	}()
+
+	return sub, nil
+}
+
+// pipe queues commands which are applied with the lock of the store held
+type pipe struct {
+	cmds []func(s *Store) error
+}
+
+func (p *pipe) Set(key, value string, ttl time.Duration) {
+	p.cmds = append(p.cmds, func(s *Store) error {
+		s.set(key, value, ttl)
+		return nil
+	})
+}
+
+func (p *pipe) Del(keys ...string) {
+	p.cmds = append(p.cmds, func(s *Store) error {
+		s.del(keys...)
+		return nil
+	})
+}
+
+func (p *pipe) Expire(key string, ttl time.Duration) {
+	p.cmds = append(p.cmds, func(s *Store) error {
+		s.expire(key, ttl)
+		return nil
+	})
+}
+
+func (p *pipe) Incr(key string) {
+	p.cmds = append(p.cmds, func(s *Store) error {
+		_, err := s.incr(key)
+		return err
+	})
+}
+
+func (p *pipe) HSet(key string, fields map[string]string) {
+	p.cmds = append(p.cmds, func(s *Store) error {
+		return s.hset(key, fields)
+	})
+}
+
+func (p *pipe) ZAdd(key string, members ...repo.Z) {
+	p.cmds = append(p.cmds, func(s *Store) error {
+		return s.zadd(key, members...)
+	})
+}
+
+func (p *pipe) ZRem(key string, members ...string) {
+	p.cmds = append(p.cmds, func(s *Store) error {
+		_, err := s.zrem(key, members...)
+		return err
+	})
+}
+
+var _ repo.RedisRepo = (*Store)(nil)
@@ -1,15 +1,60 @@
 package redis
 
 import (
-	"fmt"
+	"context"
+	"math"
+	"strconv"
+	"sync"
+	"time"
 
 	"github.com/burxondv/new-services/api-gateway/storage/repo"
 
 	"github.com/gomodule/redigo/redis"
 )
 
+// messages are dropped for a subscriber which falls behind by more than
+// subscriberBuffer
+const subscriberBuffer = 64
+
+// connections idle for longer than healthCheckPeriod are pinged before use
+const healthCheckPeriod = time.Minute
+
+var incrWithExpiry = repo.NewScript(1, `
+local n = redis.call("INCR", KEYS[1])
+if n == 1 then
+	redis.call("PEXPIRE", KEYS[1], ARGV[1])
+end
+return n
+`)
+
+// NewPool returns pool of connections to addr. Dial errors are returned to
+// callers of the pool, so the server keeps running while Redis is down.
+func NewPool(addr string) *redis.Pool {
+	return &redis.Pool{
+		MaxIdle:     80,
+		MaxActive:   12000,
+		IdleTimeout: 4 * time.Minute,
+		DialContext: func(ctx context.Context) (redis.Conn, error) {
+			return redis.DialContext(ctx, "tcp", addr,
+				redis.DialConnectTimeout(5*time.Second),
+				redis.DialReadTimeout(5*time.Second),
+				redis.DialWriteTimeout(5*time.Second),
+			)
+		},
+		TestOnBorrow: func(c redis.Conn, t time.Time) error {
+			if time.Since(t) < healthCheckPeriod {
+				return nil
+			}
+			_, err := c.Do("PING")
+			return err
+		},
+	}
+}
+
 type RedisRepo struct {
 	Rds *redis.Pool
+
+	scripts sync.Map // *repo.Script -> *redis.Script
 }
 
 func NewRedisRepo(rds *redis.Pool) repo.RedisRepo {
@@ -18,34 +63,351 @@ func NewRedisRepo(rds *redis.Pool) repo.RedisRepo {
 	}
 }
 
-func (r *RedisRepo) Exists(key string) (interface{}, error) {
-	conn := r.Rds.Get()
+// do runs the command on a connection of the pool
+func (r *RedisRepo) do(ctx context.Context, cmd string, args ...interface{}) (interface{}, error) {
+	conn, err := r.Rds.GetContext(ctx)
+	if err != nil {
+		return nil, err
+	}
 	defer conn.Close()
-	fmt.Println(key)
-	return conn.Do("EXISTS", key)
+
+	return redis.DoContext(conn, ctx, cmd, args...)
 }
 
-func (r *RedisRepo) Set(key, value string) error {
-	conn := r.Rds.Get()
-	defer conn.Close()
+func (r *RedisRepo) Ping(ctx context.Context) error {
+	_, err := r.do(ctx, "PING")
+	return err
+}
+
+func (r *RedisRepo) Exists(ctx context.Context, key string) (bool, error) {
+	return redis.Bool(r.do(ctx, "EXISTS", key))
+}
 
-	_, err := conn.Do("SET", key, value)
+func (r *RedisRepo) Get(ctx context.Context, key string) (string, error) {
+	return notFound(redis.String(r.do(ctx, "GET", key)))
+}
+
+func (r *RedisRepo) Set(ctx context.Context, key, value string, ttl time.Duration) error {
+	_, err := r.do(ctx, "SET", append(redis.Args{key, value}, expiry(ttl)...)...)
 	return err
 }
 
-func (r *RedisRepo) SetWithTTL(key, value string, seconds int) error {
-	conn := r.Rds.Get()
-	fmt.Println(key)
-	defer conn.Close()
+func (r *RedisRepo) SetNX(ctx context.Context, key, value string, ttl time.Duration) (bool, error) {
+	_, err := redis.String(r.do(ctx, "SET", append(redis.Args{key, value, "NX"}, expiry(ttl)...)...))
+	if err == redis.ErrNil {
+		return false, nil
+	} else if err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+func (r *RedisRepo) Del(ctx context.Context, keys ...string) (int64, error) {
+	if len(keys) == 0 {
+		return 0, nil
+	}
+
+	return redis.Int64(r.do(ctx, "DEL", redis.Args{}.AddFlat(keys)...))
+}
+
+func (r *RedisRepo) Expire(ctx context.Context, key string, ttl time.Duration) (bool, error) {
+	if ttl <= 0 {
+		return redis.Bool(r.do(ctx, "PERSIST", key))
+	}
+
+	return redis.Bool(r.do(ctx, "PEXPIRE", key, ttl.Milliseconds()))
+}
+
+func (r *RedisRepo) TTL(ctx context.Context, key string) (time.Duration, error) {
+	ms, err := redis.Int64(r.do(ctx, "PTTL", key))
+	switch {
+	case err != nil:
+		return 0, err
+	case ms == -2:
+		return 0, repo.ErrNotFound
+	case ms < 0:
+		return 0, nil
+	}
+
+	return time.Duration(ms) * time.Millisecond, nil
+}
+
+func (r *RedisRepo) Incr(ctx context.Context, key string) (int64, error) {
+	return redis.Int64(r.do(ctx, "INCR", key))
+}
+
+func (r *RedisRepo) IncrWithExpiry(ctx context.Context, key string, ttl time.Duration) (int64, error) {
+	if ttl <= 0 {
+		return r.Incr(ctx, key)
+	}
 
-	_, err := conn.Do("SETEX", key, seconds, value)
+	return redis.Int64(r.Eval(ctx, incrWithExpiry, []string{key}, ttl.Milliseconds()))
+}
+
+func (r *RedisRepo) HSet(ctx context.Context, key string, fields map[string]string) error {
+	if len(fields) == 0 {
+		return nil
+	}
+
+	_, err := r.do(ctx, "HSET", redis.Args{key}.AddFlat(fields)...)
+	return err
+}
+
+func (r *RedisRepo) HGet(ctx context.Context, key, field string) (string, error) {
+	return notFound(redis.String(r.do(ctx, "HGET", key, field)))
+}
+
+func (r *RedisRepo) HGetAll(ctx context.Context, key string) (map[string]string, error) {
+	return redis.StringMap(r.do(ctx, "HGETALL", key))
+}
+
+func (r *RedisRepo) HDel(ctx context.Context, key string, fields ...string) (int64, error) {
+	if len(fields) == 0 {
+		return 0, nil
+	}
+
+	return redis.Int64(r.do(ctx, "HDEL", redis.Args{key}.AddFlat(fields)...))
+}
+
+func (r *RedisRepo) HIncrBy(ctx context.Context, key, field string, n int64) (int64, error) {
+	return redis.Int64(r.do(ctx, "HINCRBY", key, field, n))
+}
+
+func (r *RedisRepo) ZAdd(ctx context.Context, key string, members ...repo.Z) error {
+	if len(members) == 0 {
+		return nil
+	}
 
+	_, err := r.do(ctx, "ZADD", zaddArgs(key, members)...)
 	return err
 }
 
-func (r *RedisRepo) Get(key string) (interface{}, error) {
-	conn := r.Rds.Get()
+func (r *RedisRepo) ZRem(ctx context.Context, key string, members ...string) (int64, error) {
+	if len(members) == 0 {
+		return 0, nil
+	}
+
+	return redis.Int64(r.do(ctx, "ZREM", redis.Args{key}.AddFlat(members)...))
+}
+
+func (r *RedisRepo) ZCard(ctx context.Context, key string) (int64, error) {
+	return redis.Int64(r.do(ctx, "ZCARD", key))
+}
+
+func (r *RedisRepo) ZScore(ctx context.Context, key, member string) (float64, error) {
+	score, err := redis.Float64(r.do(ctx, "ZSCORE", key, member))
+	if err == redis.ErrNil {
+		return 0, repo.ErrNotFound
+	}
+
+	return score, err
+}
+
+func (r *RedisRepo) ZRangeByScore(ctx context.Context, key string, min, max float64, limit int) ([]repo.Z, error) {
+	args := redis.Args{key, score(min), score(max), "WITHSCORES"}
+	if limit > 0 {
+		args = append(args, "LIMIT", 0, limit)
+	}
+
+	values, err := redis.Strings(r.do(ctx, "ZRANGEBYSCORE", args...))
+	if err != nil {
+		return nil, err
+	}
+
+	members := make([]repo.Z, 0, len(values)/2)
+	for i := 0; i+1 < len(values); i += 2 {
+		score, err := strconv.ParseFloat(values[i+1], 64)
+		if err != nil {
+			return nil, err
+		}
+		members = append(members, repo.Z{Member: values[i], Score: score})
+	}
+
+	return members, nil
+}
+
+func (r *RedisRepo) ZRemRangeByScore(ctx context.Context, key string, min, max float64) (int64, error) {
+	return redis.Int64(r.do(ctx, "ZREMRANGEBYSCORE", key, score(min), score(max)))
+}
+
+func (r *RedisRepo) Eval(ctx context.Context, script *repo.Script, keys []string, args ...interface{}) (interface{}, error) {
+	s, ok := r.scripts.Load(script)
+	if !ok {
+		s, _ = r.scripts.LoadOrStore(script, redis.NewScript(script.Keys, script.Src))
+	}
+
+	conn, err := r.Rds.GetContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+
+	return s.(*redis.Script).DoContext(ctx, conn, redis.Args{}.AddFlat(keys).Add(args...)...)
+}
+
+func (r *RedisRepo) Pipeline(ctx context.Context, fn func(repo.Pipe)) error {
+	p := &pipe{}
+	fn(p)
+	if len(p.cmds) == 0 {
+		return nil
+	}
+
+	conn, err := r.Rds.GetContext(ctx)
+	if err != nil {
+		return err
+	}
 	defer conn.Close()
 
-	return conn.Do("GET", key)
+	if err := conn.Send("MULTI"); err != nil {
+		return err
+	}
+	for _, cmd := range p.cmds {
+		if err := conn.Send(cmd.name, cmd.args...); err != nil {
+			return err
+		}
+	}
+
+	replies, err := redis.Values(redis.DoContext(conn, ctx, "EXEC"))
+	if err != nil {
+		return err
+	}
+
+	// errors of single commands are returned in replies of EXEC
+	for _, reply := range replies {
+		if err, ok := reply.(redis.Error); ok {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (r *RedisRepo) Publish(ctx context.Context, channel string, message []byte) error {
+	_, err := r.do(ctx, "PUBLISH", channel, message)
+	return err
+}
+
+func (r *RedisRepo) Subscribe(ctx context.Context, channels ...string) (<-chan repo.Message, error) {
+	c, err := r.Rds.GetContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	conn := redis.PubSubConn{Conn: c}
+	if err := conn.Subscribe(redis.Args{}.AddFlat(channels)...); err != nil {
+		conn.Close()
+		return nil, err
+	}
+
+	messages := make(chan repo.Message, subscriberBuffer)
+	go func() {
+		defer close(messages)
+		defer conn.Close()
+
+		for {
+			switch v := conn.ReceiveContext(ctx).(type) {
+			case redis.Message:
+				select {
+				case messages <- repo.Message{Channel: v.Channel, Data: v.Data}:
+				default:
+				}
+			case error:
+				return
+			}
+		}
+	}()
+
+	return messages, nil
+}
+
+type command struct {
+	name string
+	args []interface{}
+}
+
+// pipe queues commands for MULTI/EXEC
+type pipe struct {
+	cmds []command
+}
+
+func (p *pipe) add(name string, args ...interface{}) {
+	p.cmds = append(p.cmds, command{name: name, args: args})
+}
+
+func (p *pipe) Set(key, value string, ttl time.Duration) {
+	p.add("SET", append(redis.Args{key, value}, expiry(ttl)...)...)
+}
+
+func (p *pipe) Del(keys ...string) {
+	if len(keys) > 0 {
+		p.add("DEL", redis.Args{}.AddFlat(keys)...)
+	}
+}
+
+func (p *pipe) Expire(key string, ttl time.Duration) {
+	if ttl <= 0 {
+		p.add("PERSIST", key)
+		return
+	}
+	p.add("PEXPIRE", key, ttl.Milliseconds())
+}
+
+func (p *pipe) Incr(key string) {
+	p.add("INCR", key)
+}
+
+func (p *pipe) HSet(key string, fields map[string]string) {
+	if len(fields) > 0 {
+		p.add("HSET", redis.Args{key}.AddFlat(fields)...)
+	}
+}
+
+func (p *pipe) ZAdd(key string, members ...repo.Z) {
+	if len(members) > 0 {
+		p.add("ZADD", zaddArgs(key, members)...)
+	}
+}
+
+func (p *pipe) ZRem(key string, members ...string) {
+	if len(members) > 0 {
+		p.add("ZREM", redis.Args{key}.AddFlat(members)...)
+	}
+}
+
+func expiry(ttl time.Duration) redis.Args {
+	if ttl <= 0 {
+		return nil
+	}
+
+	return redis.Args{"PX", ttl.Milliseconds()}
+}
+
+func zaddArgs(key string, members []repo.Z) redis.Args {
+	args := redis.Args{key}
+	for _, m := range members {
+		args = append(args, m.Score, m.Member)
+	}
+
+	return args
+}
+
+// score formats bound of a score range, infinities are accepted
+func score(f float64) string {
+	switch {
+	case math.IsInf(f, 1):
+		return "+inf"
+	case math.IsInf(f, -1):
+		return "-inf"
+	}
+
+	return strconv.FormatFloat(f, 'f', -1, 64)
+}
+
+func notFound(s string, err error) (string, error) {
+	if err == redis.ErrNil {
+		return "", repo.ErrNotFound
+	}
+
+	return s, err
 }
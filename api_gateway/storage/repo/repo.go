@@ -1,8 +1,90 @@
 package repo
 
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+// ErrNotFound is returned when a key, a field or a member does not exist
+var ErrNotFound = errors.New("redis: not found")
+
+// RedisRepo is a typed client of Redis. TTL of zero means the key does not
+// expire.
 type RedisRepo interface {
-	Set(key, value string) error
-	SetWithTTL(key, value string, seconds int) error
-	Get(key string) (interface{}, error)
-	Exists(key string) (interface{}, error)
+	// Ping checks connection to the server
+	Ping(ctx context.Context) error
+
+	Exists(ctx context.Context, key string) (bool, error)
+	Get(ctx context.Context, key string) (string, error)
+	Set(ctx context.Context, key, value string, ttl time.Duration) error
+	// SetNX sets the key only if it does not exist and reports whether it was set
+	SetNX(ctx context.Context, key, value string, ttl time.Duration) (bool, error)
+	Del(ctx context.Context, keys ...string) (int64, error)
+	Expire(ctx context.Context, key string, ttl time.Duration) (bool, error)
+	TTL(ctx context.Context, key string) (time.Duration, error)
+
+	Incr(ctx context.Context, key string) (int64, error)
+	// IncrWithExpiry increments the key and sets ttl when the key is created,
+	// so counters of a window expire with the window
+	IncrWithExpiry(ctx context.Context, key string, ttl time.Duration) (int64, error)
+
+	HSet(ctx context.Context, key string, fields map[string]string) error
+	HGet(ctx context.Context, key, field string) (string, error)
+	HGetAll(ctx context.Context, key string) (map[string]string, error)
+	HDel(ctx context.Context, key string, fields ...string) (int64, error)
+	HIncrBy(ctx context.Context, key, field string, n int64) (int64, error)
+
+	ZAdd(ctx context.Context, key string, members ...Z) error
+	ZRem(ctx context.Context, key string, members ...string) (int64, error)
+	ZCard(ctx context.Context, key string) (int64, error)
+	ZScore(ctx context.Context, key, member string) (float64, error)
+	// ZRangeByScore returns members with min <= score <= max in ascending
+	// order of scores, all of them if limit is zero
+	ZRangeByScore(ctx context.Context, key string, min, max float64, limit int) ([]Z, error)
+	ZRemRangeByScore(ctx context.Context, key string, min, max float64) (int64, error)
+
+	// Eval runs the script, it is sent by its SHA1 when the server has it cached
+	Eval(ctx context.Context, script *Script, keys []string, args ...interface{}) (interface{}, error)
+	// Pipeline sends commands queued by fn in one round trip and executes
+	// them atomically
+	Pipeline(ctx context.Context, fn func(Pipe)) error
+
+	Publish(ctx context.Context, channel string, message []byte) error
+	// Subscribe returns messages of the channels, the channel is closed when
+	// ctx is done or connection is lost. Messages are dropped for a slow
+	// subscriber.
+	Subscribe(ctx context.Context, channels ...string) (<-chan Message, error)
+}
+
+// Pipe queues write commands of a pipeline
+type Pipe interface {
+	Set(key, value string, ttl time.Duration)
+	Del(keys ...string)
+	Expire(key string, ttl time.Duration)
+	Incr(key string)
+	HSet(key string, fields map[string]string)
+	ZAdd(key string, members ...Z)
+	ZRem(key string, members ...string)
+}
+
+// Z is a member of a sorted set
+type Z struct {
+	Member string
+	Score  float64
+}
+
+type Message struct {
+	Channel string
+	Data    []byte
+}
+
+// Script is a Lua script, Keys is the number of keys it takes
+type Script struct {
+	Src  string
+	Keys int
+}
+
+func NewScript(keys int, src string) *Script {
+	return &Script{Src: src, Keys: keys}
 }
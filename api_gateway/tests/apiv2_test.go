@@ -0,0 +1,288 @@
+package tests
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	v2 "github.com/burxondv/new-services/api-gateway/api/handlers/v2"
+	"github.com/burxondv/new-services/api-gateway/config"
+	pc "github.com/burxondv/new-services/api-gateway/genproto/comment"
+	pm "github.com/burxondv/new-services/api-gateway/genproto/moderation"
+	pn "github.com/burxondv/new-services/api-gateway/genproto/notification"
+	pp "github.com/burxondv/new-services/api-gateway/genproto/post"
+	pu "github.com/burxondv/new-services/api-gateway/genproto/user"
+	"github.com/burxondv/new-services/api-gateway/pkg/cache"
+	"github.com/burxondv/new-services/api-gateway/pkg/identity"
+	"github.com/burxondv/new-services/api-gateway/pkg/logger"
+	"github.com/burxondv/new-services/api-gateway/pkg/publicapi"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang/protobuf/proto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+// fakeServices serve the posts, comments and users of the API in memory and
+// record requests they got
+type fakeServices struct {
+	pp.UnimplementedPostServiceServer
+	pc.UnimplementedCommentServiceServer
+	pu.UnimplementedUserServiceServer
+
+	mu       sync.Mutex
+	requests []interface{}
+}
+
+func (s *fakeServices) record(req interface{}) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.requests = append(s.requests, req)
+}
+
+func (s *fakeServices) last() interface{} {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if len(s.requests) == 0 {
+		return nil
+	}
+	return s.requests[len(s.requests)-1]
+}
+
+func (s *fakeServices) GetPostById(ctx context.Context, req *pp.Request) (*pp.PostResponse, error) {
+	s.record(req)
+	return &pp.PostResponse{Id: req.Str, UserId: "author", Title: "Post"}, nil
+}
+
+func (s *fakeServices) UpdatePost(ctx context.Context, req *pp.UpdatePostRequest) (*pp.PostResponse, error) {
+	s.record(req)
+	return &pp.PostResponse{Id: req.Id, UserId: "author", Title: req.Title}, nil
+}
+
+func (s *fakeServices) DeleteComment(ctx context.Context, req *pc.Request) (*pc.CommentResponse, error) {
+	s.record(req)
+	return &pc.CommentResponse{Id: req.Str, PostId: "commented"}, nil
+}
+
+// fakeServiceManager connects to fakeServices through an in-memory listener,
+// calls go through the same interceptor of the generated API as in main
+type fakeServiceManager struct {
+	conn *grpc.ClientConn
+}
+
+func (m *fakeServiceManager) UserService() pu.UserServiceClient {
+	return pu.NewUserServiceClient(m.conn)
+}
+func (m *fakeServiceManager) PostService() pp.PostServiceClient {
+	return pp.NewPostServiceClient(m.conn)
+}
+func (m *fakeServiceManager) CommentService() pc.CommentServiceClient {
+	return pc.NewCommentServiceClient(m.conn)
+}
+func (m *fakeServiceManager) NotificationService() pn.NotificationServiceClient {
+	return pn.NewNotificationServiceClient(m.conn)
+}
+func (m *fakeServiceManager) ModerationService() pm.ModerationServiceClient {
+	return pm.NewModerationServiceClient(m.conn)
+}
+func (m *fakeServiceManager) Conn(name string) *grpc.ClientConn          { return m.conn }
+func (m *fakeServiceManager) Check(ctx context.Context) map[string]error { return nil }
+func (m *fakeServiceManager) Close() error                               { return m.conn.Close() }
+func (m *fakeServiceManager) Backends(ctx context.Context, name string) (map[string]*grpc.ClientConn, error) {
+	return map[string]*grpc.ClientConn{"bufnet": m.conn}, nil
+}
+
+type apiV2 struct {
+	router   *gin.Engine
+	services *fakeServices
+	store    *memoryStore
+}
+
+// newAPIV2 serves api v2 for the logged in user viewer
+func newAPIV2(t *testing.T) *apiV2 {
+	services := &fakeServices{}
+	server := grpc.NewServer()
+	pp.RegisterPostServiceServer(server, services)
+	pc.RegisterCommentServiceServer(server, services)
+	pu.RegisterUserServiceServer(server, services)
+
+	lis := bufconn.Listen(1024 * 1024)
+	go server.Serve(lis)
+	t.Cleanup(server.Stop)
+
+	conn, err := grpc.Dial("bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) { return lis.DialContext(ctx) }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithChainUnaryInterceptor(publicapi.UnaryClientInterceptor()),
+	)
+	require.Nil(t, err)
+	t.Cleanup(func() { conn.Close() })
+
+	store := newMemoryStore()
+	handler, err := v2.New(&fakeServiceManager{conn: conn}, cache.New(store), config.Config{
+		APIV2AllowedOrigins: []string{"https://app.example.com"},
+	}, logger.New("debug", "test"))
+	require.Nil(t, err)
+
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.Use(func(c *gin.Context) {
+		ctx := identity.With(c.Request.Context(), identity.Identity{Subject: "viewer", Role: "user"})
+		c.Request = c.Request.WithContext(ctx)
+	})
+	router.Any("/v2/*any", handler.REST)
+	for _, service := range handler.Services() {
+		router.POST("/"+service+"/:method", handler.GRPCWeb)
+	}
+
+	return &apiV2{router: router, services: services, store: store}
+}
+
+func (a *apiV2) rest(method, path, body string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(method, path, strings.NewReader(body))
+	w := httptest.NewRecorder()
+	a.router.ServeHTTP(w, req)
+
+	return w
+}
+
+// grpcWebRequest frames req as the only message of a gRPC-Web call
+func grpcWebRequest(t *testing.T, method string, req proto.Message) *http.Request {
+	data, err := proto.Marshal(req)
+	require.Nil(t, err)
+
+	body := &bytes.Buffer{}
+	body.WriteByte(0)
+	binary.Write(body, binary.BigEndian, uint32(len(data)))
+	body.Write(data)
+
+	r := httptest.NewRequest(http.MethodPost, method, body)
+	r.Header.Set("Content-Type", "application/grpc-web+proto")
+
+	return r
+}
+
+// grpcWeb calls the method with gRPC-Web and decodes the message of the
+// response into res, it returns grpc-status of the response
+func (a *apiV2) grpcWeb(t *testing.T, method string, req, res proto.Message) string {
+	w := httptest.NewRecorder()
+	a.router.ServeHTTP(w, grpcWebRequest(t, method, req))
+
+	grpcStatus := w.Header().Get("Grpc-Status")
+	for {
+		var header [5]byte
+		if _, err := io.ReadFull(w.Body, header[:]); err != nil {
+			break
+		}
+		frame := make([]byte, binary.BigEndian.Uint32(header[1:]))
+		_, err := io.ReadFull(w.Body, frame)
+		require.Nil(t, err)
+
+		// trailers are sent in a frame with the highest bit of the flag set
+		if header[0]&0x80 != 0 {
+			for _, line := range strings.Split(string(frame), "\r\n") {
+				if v := strings.TrimPrefix(strings.ToLower(line), "grpc-status:"); v != strings.ToLower(line) {
+					grpcStatus = strings.TrimSpace(v)
+				}
+			}
+			continue
+		}
+		require.Nil(t, proto.Unmarshal(frame, res))
+	}
+
+	return grpcStatus
+}
+
+func TestAPIV2_RESTSetsCaller(t *testing.T) {
+	api := newAPIV2(t)
+
+	w := api.rest(http.MethodGet, "/v2/posts/post?viewer_id=other", "")
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Contains(t, w.Body.String(), `"title":"Post"`)
+	assert.Equal(t, "viewer", api.services.last().(*pp.Request).ViewerId)
+
+	// reads don't invalidate the cache
+	assert.Empty(t, api.store.get("cache:"+cache.PostResource("post")+":version"))
+}
+
+func TestAPIV2_RESTInvalidatesCache(t *testing.T) {
+	api := newAPIV2(t)
+
+	w := api.rest(http.MethodPut, "/v2/posts/post", `{"title":"Updated","editor_id":"other"}`)
+	assert.Equal(t, http.StatusOK, w.Code)
+	update := api.services.last().(*pp.UpdatePostRequest)
+	assert.Equal(t, "post", update.Id)
+	assert.Equal(t, "viewer", update.EditorId)
+
+	assert.Equal(t, "1", api.store.get("cache:"+cache.PostResource("post")+":version"))
+	assert.Equal(t, "1", api.store.get("cache:"+cache.UserResource("author")+":version"))
+
+	w = api.rest(http.MethodDelete, "/v2/comments/comment", "")
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "1", api.store.get("cache:"+cache.PostResource("commented")+":version"))
+}
+
+func TestAPIV2_GRPCWebForwards(t *testing.T) {
+	api := newAPIV2(t)
+
+	var post pp.PostResponse
+	status := api.grpcWeb(t, "/post.PostService/GetPostById", &pp.Request{Str: "post", ViewerId: "other"}, &post)
+	assert.Equal(t, "0", status)
+	assert.Equal(t, "post", post.Id)
+	assert.Equal(t, "Post", post.Title)
+	assert.Equal(t, "viewer", api.services.last().(*pp.Request).ViewerId)
+
+	// methods the fake doesn't implement fail with their gRPC status
+	var user pu.UserResponse
+	status = api.grpcWeb(t, "/user.UserService/GetUserById", &pu.Request{Str: "user"}, &user)
+	assert.Equal(t, "12", status) // Unimplemented
+
+	w := api.rest(http.MethodPost, "/post.PostService/GetPostById", "{}")
+	assert.Equal(t, http.StatusUnsupportedMediaType, w.Code)
+}
+
+func TestAPIV2_GRPCWebInvalidatesCache(t *testing.T) {
+	api := newAPIV2(t)
+
+	var comment pc.CommentResponse
+	status := api.grpcWeb(t, "/comment.CommentService/DeleteComment", &pc.Request{Str: "comment"}, &comment)
+	assert.Equal(t, "0", status)
+	assert.Equal(t, "commented", comment.PostId)
+	assert.Equal(t, "1", api.store.get("cache:"+cache.PostResource("commented")+":version"))
+
+	var post pp.PostResponse
+	status = api.grpcWeb(t, "/post.PostService/UpdatePost", &pp.UpdatePostRequest{Id: "post", Title: "Updated"}, &post)
+	assert.Equal(t, "0", status)
+	assert.Equal(t, "1", api.store.get("cache:"+cache.PostResource("post")+":version"))
+	assert.Equal(t, "1", api.store.get("cache:"+cache.UserResource("author")+":version"))
+}
+
+func TestAPIV2_GRPCWebOrigins(t *testing.T) {
+	api := newAPIV2(t)
+
+	// cross-origin calls of other origins get no CORS headers, so browsers
+	// don't expose their responses
+	origin := func(origin string) string {
+		req := grpcWebRequest(t, "/post.PostService/GetPostById", &pp.Request{Str: "post"})
+		req.Header.Set("Origin", origin)
+		w := httptest.NewRecorder()
+		api.router.ServeHTTP(w, req)
+
+		return w.Header().Get("Access-Control-Allow-Origin")
+	}
+
+	assert.Equal(t, "https://app.example.com", origin("https://app.example.com"))
+	assert.Empty(t, origin("https://evil.example.com"))
+}
@@ -0,0 +1,45 @@
+package tests
+
+import (
+	"context"
+	"testing"
+
+	"github.com/burxondv/new-services/api-gateway/pkg/cache"
+	"github.com/burxondv/new-services/api-gateway/pkg/events"
+	"github.com/burxondv/new-services/api-gateway/pkg/logger"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func version(store *memoryStore, resource string) string {
+	return store.get("cache:" + resource + ":version")
+}
+
+func TestCacheEvents_UserDeleted(t *testing.T) {
+	store := newMemoryStore()
+	c := cache.New(store)
+
+	event, err := events.New(events.UserDeleted, "user", events.User{Id: "user"})
+	require.Nil(t, err)
+	require.Nil(t, c.HandleEvent(context.Background(), event, logger.New("debug", "test")))
+
+	// posts and comments of the user are deleted, so all posts are changed
+	assert.Equal(t, "1", version(store, cache.PostsResource))
+	assert.Equal(t, "1", version(store, cache.UserResource("user")))
+	assert.Equal(t, "1", version(store, cache.AccountResource("user")))
+	assert.Equal(t, "1", version(store, cache.RelationsResource("user")))
+}
+
+func TestCacheEvents_PostDeleted(t *testing.T) {
+	store := newMemoryStore()
+	c := cache.New(store)
+
+	event, err := events.New(events.PostDeleted, "post", events.Post{Id: "post", UserId: "author"})
+	require.Nil(t, err)
+	require.Nil(t, c.HandleEvent(context.Background(), event, logger.New("debug", "test")))
+
+	assert.Equal(t, "1", version(store, cache.PostResource("post")))
+	assert.Equal(t, "1", version(store, cache.UserResource("author")))
+	assert.Empty(t, version(store, cache.PostsResource))
+}
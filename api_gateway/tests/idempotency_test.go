@@ -0,0 +1,130 @@
+package tests
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/burxondv/new-services/api-gateway/api/handlers/token"
+	"github.com/burxondv/new-services/api-gateway/api/middleware"
+	"github.com/burxondv/new-services/api-gateway/config"
+	"github.com/burxondv/new-services/api-gateway/pkg/logger"
+	"github.com/burxondv/new-services/api-gateway/storage/repo"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+// idempotentRouter serves POST /posts with handler behind the middleware
+func idempotentRouter(store repo.RedisRepo, handler gin.HandlerFunc) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	log := logger.New("debug", "test")
+
+	router := gin.New()
+	router.Use(middleware.Idempotency(store, token.JWTHandler{Log: log}, config.Config{
+		IdempotencyTTL:     60,
+		IdempotencyLockTTL: 60,
+		MaxAttachmentSize:  1 << 20,
+	}, log))
+	router.POST("/posts", handler)
+
+	return router
+}
+
+func idempotentRequest(router http.Handler, key, body string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodPost, "/posts", strings.NewReader(body))
+	req.Header.Set(middleware.IdempotencyKeyHeader, key)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	return w
+}
+
+func TestIdempotency_Replay(t *testing.T) {
+	calls := 0
+	router := idempotentRouter(newMemoryStore(), func(c *gin.Context) {
+		calls++
+		c.JSON(http.StatusCreated, gin.H{"id": "1"})
+	})
+
+	first := idempotentRequest(router, "key", `{"title":"post"}`)
+	assert.Equal(t, http.StatusCreated, first.Code)
+	assert.Empty(t, first.Header().Get(middleware.IdempotentReplayedHeader))
+
+	retry := idempotentRequest(router, "key", `{"title":"post"}`)
+	assert.Equal(t, http.StatusCreated, retry.Code)
+	assert.Equal(t, "true", retry.Header().Get(middleware.IdempotentReplayedHeader))
+	assert.Equal(t, first.Body.String(), retry.Body.String())
+	assert.Equal(t, first.Header().Get("Content-Type"), retry.Header().Get("Content-Type"))
+	assert.Equal(t, 1, calls)
+}
+
+func TestIdempotency_OtherBody(t *testing.T) {
+	calls := 0
+	router := idempotentRouter(newMemoryStore(), func(c *gin.Context) {
+		calls++
+		c.JSON(http.StatusCreated, gin.H{"id": "1"})
+	})
+
+	assert.Equal(t, http.StatusCreated, idempotentRequest(router, "key", `{"title":"post"}`).Code)
+	assert.Equal(t, http.StatusUnprocessableEntity, idempotentRequest(router, "key", `{"title":"other"}`).Code)
+	assert.Equal(t, 1, calls)
+}
+
+func TestIdempotency_InProgress(t *testing.T) {
+	started := make(chan struct{})
+	release := make(chan struct{})
+	router := idempotentRouter(newMemoryStore(), func(c *gin.Context) {
+		close(started)
+		<-release
+		c.JSON(http.StatusCreated, gin.H{"id": "1"})
+	})
+
+	first := make(chan *httptest.ResponseRecorder)
+	go func() {
+		first <- idempotentRequest(router, "key", `{"title":"post"}`)
+	}()
+	<-started
+
+	duplicate := idempotentRequest(router, "key", `{"title":"post"}`)
+	assert.Equal(t, http.StatusConflict, duplicate.Code)
+	assert.Equal(t, "1", duplicate.Header().Get("Retry-After"))
+
+	close(release)
+	assert.Equal(t, http.StatusCreated, (<-first).Code)
+}
+
+func TestIdempotency_TooLarge(t *testing.T) {
+	calls := 0
+	router := idempotentRouter(newMemoryStore(), func(c *gin.Context) {
+		calls++
+		c.JSON(http.StatusCreated, gin.H{"id": "1"})
+	})
+
+	body := `{"title":"` + strings.Repeat("a", 2<<20) + `"}`
+	assert.Equal(t, http.StatusRequestEntityTooLarge, idempotentRequest(router, "key", body).Code)
+	assert.Equal(t, 0, calls)
+
+	// the key is not locked by the rejected request
+	assert.Equal(t, http.StatusCreated, idempotentRequest(router, "key", `{"title":"post"}`).Code)
+}
+
+func TestIdempotency_ServerErrorIsNotStored(t *testing.T) {
+	calls := 0
+	router := idempotentRouter(newMemoryStore(), func(c *gin.Context) {
+		calls++
+		if calls == 1 {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "failed"})
+			return
+		}
+		c.JSON(http.StatusCreated, gin.H{"id": "1"})
+	})
+
+	assert.Equal(t, http.StatusInternalServerError, idempotentRequest(router, "key", `{"title":"post"}`).Code)
+
+	retry := idempotentRequest(router, "key", `{"title":"post"}`)
+	assert.Equal(t, http.StatusCreated, retry.Code)
+	assert.Empty(t, retry.Header().Get(middleware.IdempotentReplayedHeader))
+	assert.Equal(t, 2, calls)
+}
@@ -0,0 +1,57 @@
+package tests
+
+import (
+	"context"
+	"testing"
+
+	pp "github.com/burxondv/new-services/api-gateway/genproto/post"
+	"github.com/burxondv/new-services/api-gateway/pkg/identity"
+	"github.com/burxondv/new-services/api-gateway/pkg/publicapi"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+)
+
+func TestPublicAPI_SetCaller(t *testing.T) {
+	ctx := identity.With(context.Background(), identity.Identity{Subject: "viewer", Role: "user"})
+
+	req := &pp.Request{Str: "post", ViewerId: "other"}
+	publicapi.SetCaller(ctx, req)
+	assert.Equal(t, "viewer", req.ViewerId)
+	assert.Equal(t, "post", req.Str)
+
+	update := &pp.UpdatePostRequest{Id: "post", EditorId: "other"}
+	publicapi.SetCaller(ctx, update)
+	assert.Equal(t, "viewer", update.EditorId)
+
+	// callers who are not logged in are nobody
+	req = &pp.Request{Str: "post", ViewerId: "other"}
+	publicapi.SetCaller(context.Background(), req)
+	assert.Empty(t, req.ViewerId)
+
+	// messages are changed only by pointer
+	assert.NotPanics(t, func() {
+		publicapi.SetCaller(ctx, pp.Request{})
+		publicapi.SetCaller(ctx, nil)
+	})
+}
+
+func TestPublicAPI_UnaryClientInterceptor(t *testing.T) {
+	ctx := identity.With(context.Background(), identity.Identity{Subject: "viewer", Role: "user"})
+	interceptor := publicapi.UnaryClientInterceptor()
+
+	var got *pp.Request
+	invoker := func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, opts ...grpc.CallOption) error {
+		got = req.(*pp.Request)
+		return nil
+	}
+
+	// calls of v1 handlers set callers themselves
+	err := interceptor(ctx, "/post.PostService/GetPostById", &pp.Request{ViewerId: "other"}, &pp.PostResponse{}, nil, invoker)
+	assert.Nil(t, err)
+	assert.Equal(t, "other", got.ViewerId)
+
+	err = interceptor(publicapi.With(ctx), "/post.PostService/GetPostById", &pp.Request{ViewerId: "other"}, &pp.PostResponse{}, nil, invoker)
+	assert.Nil(t, err)
+	assert.Equal(t, "viewer", got.ViewerId)
+}
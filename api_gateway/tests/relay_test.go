@@ -0,0 +1,91 @@
+package tests
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"testing"
+	"time"
+
+	pp "github.com/burxondv/new-services/api-gateway/genproto/post"
+	"github.com/burxondv/new-services/api-gateway/pkg/logger"
+	"github.com/burxondv/new-services/api-gateway/pkg/realtime"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+// likeReplica is a replica of post_service which streams one like
+type likeReplica struct {
+	pp.UnimplementedPostServiceServer
+
+	like *pp.LikeEvent
+}
+
+func (s *likeReplica) StreamLikes(req *pp.StreamRequest, stream pp.PostService_StreamLikesServer) error {
+	if err := stream.Send(s.like); err != nil {
+		return err
+	}
+	<-stream.Context().Done()
+	return nil
+}
+
+// replicaManager has replicas of post_service only
+type replicaManager struct {
+	fakeServiceManager
+
+	replicas map[string]*grpc.ClientConn
+}
+
+func (m *replicaManager) Backends(ctx context.Context, name string) (map[string]*grpc.ClientConn, error) {
+	if name != "post_service" {
+		return nil, fmt.Errorf("%s has no replicas", name)
+	}
+	return m.replicas, nil
+}
+
+func likeReplicaConn(t *testing.T, like *pp.LikeEvent) *grpc.ClientConn {
+	server := grpc.NewServer()
+	pp.RegisterPostServiceServer(server, &likeReplica{like: like})
+
+	lis := bufconn.Listen(1024 * 1024)
+	go server.Serve(lis)
+	t.Cleanup(server.Stop)
+
+	conn, err := grpc.Dial("bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) { return lis.DialContext(ctx) }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	require.Nil(t, err)
+	t.Cleanup(func() { conn.Close() })
+
+	return conn
+}
+
+func TestRelay_StreamsEveryReplica(t *testing.T) {
+	shared := &pp.LikeEvent{Id: "shared", PostId: "post"}
+	manager := &replicaManager{replicas: map[string]*grpc.ClientConn{
+		"10.0.0.1:9000": likeReplicaConn(t, &pp.LikeEvent{Id: "first", PostId: "first"}),
+		"10.0.0.2:9000": likeReplicaConn(t, &pp.LikeEvent{Id: "second", PostId: "second"}),
+		"10.0.0.3:9000": likeReplicaConn(t, shared),
+		"10.0.0.4:9000": likeReplicaConn(t, shared),
+	}}
+
+	store := newMemoryStore()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	realtime.NewRelay(realtime.NewBroker(store), manager, logger.New("debug", "test")).Run(ctx)
+
+	// events of every replica are published, events streamed by several
+	// replicas only once
+	assert.Eventually(t, func() bool { return len(store.channels()) == 3 }, 5*time.Second, 10*time.Millisecond)
+	time.Sleep(50 * time.Millisecond)
+	assert.ElementsMatch(t, []string{
+		realtime.LikesChannel("first"),
+		realtime.LikesChannel("second"),
+		realtime.LikesChannel("post"),
+	}, store.channels())
+}
@@ -0,0 +1,102 @@
+package tests
+
+import (
+	"context"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/burxondv/new-services/api-gateway/storage/repo"
+)
+
+// memoryStore keeps keys in memory, it has only commands used by the
+// middlewares, the cache and the relay
+type memoryStore struct {
+	repo.RedisRepo
+
+	mu        sync.Mutex
+	keys      map[string]string
+	published []string
+}
+
+func newMemoryStore() *memoryStore {
+	return &memoryStore{keys: map[string]string{}}
+}
+
+func (s *memoryStore) Get(ctx context.Context, key string) (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	val, ok := s.keys[key]
+	if !ok {
+		return "", repo.ErrNotFound
+	}
+	return val, nil
+}
+
+func (s *memoryStore) Set(ctx context.Context, key, value string, ttl time.Duration) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.keys[key] = value
+	return nil
+}
+
+func (s *memoryStore) SetNX(ctx context.Context, key, value string, ttl time.Duration) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.keys[key]; ok {
+		return false, nil
+	}
+	s.keys[key] = value
+	return true, nil
+}
+
+func (s *memoryStore) Del(ctx context.Context, keys ...string) (int64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var n int64
+	for _, key := range keys {
+		if _, ok := s.keys[key]; ok {
+			delete(s.keys, key)
+			n++
+		}
+	}
+	return n, nil
+}
+
+func (s *memoryStore) Incr(ctx context.Context, key string) (int64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	n, _ := strconv.ParseInt(s.keys[key], 10, 64)
+	n++
+	s.keys[key] = strconv.FormatInt(n, 10)
+	return n, nil
+}
+
+func (s *memoryStore) Publish(ctx context.Context, channel string, message []byte) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.published = append(s.published, channel)
+	return nil
+}
+
+// channels returns channels of published messages in order
+func (s *memoryStore) channels() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return append([]string{}, s.published...)
+}
+
+// get returns the value of the key, empty when it is not set
+func (s *memoryStore) get(key string) string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.keys[key]
+}
@@ -1,17 +1,36 @@
 package main
 
 import (
+	"context"
 	"fmt"
 	"net"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
 
 	"github.com/burxondv/new-services/comment-service/config"
 	c "github.com/burxondv/new-services/comment-service/genproto/comment"
+	"github.com/burxondv/new-services/comment-service/migrations"
+	"github.com/burxondv/new-services/comment-service/pkg/backoff"
 	"github.com/burxondv/new-services/comment-service/pkg/db"
+	"github.com/burxondv/new-services/comment-service/pkg/events"
+	"github.com/burxondv/new-services/comment-service/pkg/health"
+	"github.com/burxondv/new-services/comment-service/pkg/identity"
 	"github.com/burxondv/new-services/comment-service/pkg/logger"
+	"github.com/burxondv/new-services/comment-service/pkg/metrics"
+	"github.com/burxondv/new-services/comment-service/pkg/migrate"
+	"github.com/burxondv/new-services/comment-service/pkg/moderation"
+	"github.com/burxondv/new-services/comment-service/pkg/mtls"
+	"github.com/burxondv/new-services/comment-service/pkg/rpcclient"
+	"github.com/burxondv/new-services/comment-service/pkg/tracing"
 	"github.com/burxondv/new-services/comment-service/service"
 	grpcclient "github.com/burxondv/new-services/comment-service/service/grpc_client"
 
+	"github.com/gomodule/redigo/redis"
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/keepalive"
 	"google.golang.org/grpc/reflection"
 )
 
@@ -20,30 +39,131 @@ func main() {
 	log := logger.New(cfg.LogLevel, "golang")
 	defer logger.Cleanup(log)
 
+	shutdownTracing, err := tracing.Init(context.Background(), tracing.Config{
+		ServiceName:  "comment_service",
+		Exporter:     cfg.TraceExporter,
+		OTLPEndpoint: cfg.OTLPEndpoint,
+		File:         cfg.TraceFile,
+		SampleRatio:  cfg.TraceSampleRatio,
+	})
+	if err != nil {
+		log.Fatal("failed to init tracing", logger.Error(err))
+	}
+	defer shutdownTracing(context.Background())
+
+	// SIGINT and SIGTERM start graceful shutdown
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+
 	connDb, err := db.ConnectToDB(cfg)
 	if err != nil {
-		fmt.Println("failed connect database", err)
+		log.Fatal("failed to connect database", logger.Error(err))
+	}
+	defer connDb.Close()
+
+	err = backoff.Wait(ctx, time.Duration(cfg.StartupTimeout)*time.Second, connDb.PingContext, func(err error, delay time.Duration) {
+		log.Warn("database is not ready", logger.Duration("retry_in", delay), logger.Error(err))
+	})
+	if err != nil {
+		log.Fatal("failed to connect database", logger.Error(err))
+	}
+
+	// `migrate up | down [steps] | status` only manages the schema
+	if len(os.Args) > 1 && os.Args[1] == "migrate" {
+		if err := migrate.Command(connDb.DB, migrations.FS, os.Args[2:], os.Stdout); err != nil {
+			log.Fatal("failed to migrate", logger.Error(err))
+		}
+		return
+	}
+
+	if err := migrate.Up(connDb.DB, migrations.FS); err != nil {
+		log.Fatal("failed to apply migrations", logger.Error(err))
+	}
+
+	// certs is nil when TLS is disabled, calls are not encrypted then and
+	// internal methods are open to every caller
+	var certs *mtls.Certs
+	if cfg.TLSEnabled {
+		certs, err = mtls.Load(mtls.Config{CertFile: cfg.TLSCertFile, KeyFile: cfg.TLSKeyFile, CAFile: cfg.TLSCAFile}, log)
+		if err != nil {
+			log.Fatal("failed to load certificates", logger.Error(err))
+		}
+		go certs.Run(ctx, time.Duration(cfg.TLSReloadInterval)*time.Second)
+	} else {
+		log.Warn("tls is disabled, internal methods are open to every caller")
+	}
+
+	signer := identity.NewSigner(cfg.IdentitySecret, time.Duration(cfg.IdentityMaxAge)*time.Second)
+	grpcClient, err := grpcclient.New(cfg, certs, signer)
+	if err != nil {
+		log.Fatal("failed to create grpc clients", logger.Error(err))
 	}
+	defer grpcClient.Close()
 
-	grpcClient, err := grpcclient.New(cfg)
+	rules := []moderation.Rule{}
+	if cfg.ModerationRulesPath != "" {
+		rules, err = moderation.LoadRules(cfg.ModerationRulesPath)
+		if err != nil {
+			log.Fatal("failed to load moderation rules", logger.Error(err))
+		}
+	}
+	classifier, err := moderation.NewRuleClassifier(rules)
 	if err != nil {
-		fmt.Println("failed while grpc client", err.Error())
+		log.Fatal("failed to create moderation classifier", logger.Error(err))
+	}
+
+	commentService := service.NewCommentService(connDb, log, grpcClient, classifier)
+
+	pool := &redis.Pool{
+		MaxIdle: 10,
+		Dial: func() (redis.Conn, error) {
+			return redis.Dial("tcp", fmt.Sprintf("%s:%s", cfg.RedisHost, cfg.RedisPort))
+		},
 	}
+	defer pool.Close()
+	bus := events.NewRedisBus(pool, log)
+	hostname, _ := os.Hostname()
+	go commentService.RunOutboxRelay(ctx, bus, time.Duration(cfg.OutboxRelayInterval)*time.Millisecond)
+	go commentService.RunConsumer(ctx, bus, hostname)
 
-	commentService := service.NewCommentService(connDb, log, grpcClient)
+	metrics.RegisterDB(connDb.DB)
+	go func() {
+		if err := metrics.Serve(cfg.MetricsPort); err != nil {
+			log.Error("failed to serve metrics", logger.Error(err))
+		}
+	}()
 
 	lis, err := net.Listen("tcp", cfg.CommentServicePort)
 	if err != nil {
 		log.Fatal("failed while listening: %v", logger.Error(err))
 	}
 
-	s := grpc.NewServer()
+	s := grpc.NewServer(
+		grpc.StatsHandler(otelgrpc.NewServerHandler()),
+		grpc.Creds(certs.ServerCredentials()),
+		grpc.ChainUnaryInterceptor(logger.UnaryServerInterceptor(log), metrics.UnaryServerInterceptor(), certs.UnaryServerInterceptor(service.InternalCallers), signer.UnaryServerInterceptor()),
+		grpc.ChainStreamInterceptor(logger.StreamServerInterceptor(log), metrics.StreamServerInterceptor(), certs.StreamServerInterceptor(service.InternalCallers), signer.StreamServerInterceptor()),
+		// clients ping idle connections, see rpcclient.Dial
+		grpc.KeepaliveEnforcementPolicy(keepalive.EnforcementPolicy{MinTime: rpcclient.KeepaliveMinTime, PermitWithoutStream: true}),
+	)
 	reflection.Register(s)
 	c.RegisterCommentServiceServer(s, commentService)
 
+	healthServer := health.Register(s, log, map[string]health.Check{
+		"postgres": connDb.PingContext,
+		"redis":    health.Redis(pool),
+	}, "comment.CommentService")
+	go healthServer.Run(ctx, time.Duration(cfg.HealthCheckInterval)*time.Second)
+
 	log.Info("main: server running",
 		logger.String("port", cfg.CommentServicePort))
-	if err := s.Serve(lis); err != nil {
-		log.Fatal("failed while listening: %v", logger.Error(err))
-	}
+	go func() {
+		if err := s.Serve(lis); err != nil {
+			log.Fatal("failed while listening: %v", logger.Error(err))
+		}
+	}()
+
+	<-ctx.Done()
+	log.Info("main: shutting down")
+	healthServer.Stop(s, time.Duration(cfg.ShutdownTimeout)*time.Second)
 }
@@ -0,0 +1 @@
+alter table "comments" add column "parent_id" uuid;
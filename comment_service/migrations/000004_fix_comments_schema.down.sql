@@ -0,0 +1,10 @@
+DROP INDEX IF EXISTS "comments_parent_id_idx";
+DROP INDEX IF EXISTS "comments_user_id_idx";
+DROP INDEX IF EXISTS "comments_post_id_idx";
+
+ALTER TABLE "comments"
+    ALTER COLUMN "post_id" TYPE TEXT,
+    ALTER COLUMN "user_id" TYPE TEXT,
+    ALTER COLUMN "text" TYPE VARCHAR;
+
+ALTER TABLE "comments" DROP CONSTRAINT IF EXISTS "comments_pkey";
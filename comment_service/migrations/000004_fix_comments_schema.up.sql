@@ -0,0 +1,13 @@
+delete from "comments" where "id" is null;
+
+alter table "comments" add primary key ("id");
+
+-- ids of posts and users are uuids in their services
+alter table "comments"
+    alter column "post_id" type uuid using "post_id"::uuid,
+    alter column "user_id" type uuid using "user_id"::uuid,
+    alter column "text" type text;
+
+create index "comments_post_id_idx" on "comments"("post_id", "created_at");
+create index "comments_user_id_idx" on "comments"("user_id");
+create index "comments_parent_id_idx" on "comments"("parent_id") where "parent_id" is not null;
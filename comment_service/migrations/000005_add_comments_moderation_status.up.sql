@@ -0,0 +1 @@
+alter table "comments" add column "moderation_status" text not null default 'visible';
@@ -0,0 +1,4 @@
+create table "shadow_bans"(
+    "user_id" uuid primary key,
+    "until" timestamp
+);
@@ -4,6 +4,7 @@ import (
 	"fmt"
 
 	"github.com/burxondv/new-services/comment-service/config"
+	"github.com/burxondv/new-services/comment-service/pkg/tracing"
 
 	"github.com/jmoiron/sqlx"
 	_ "github.com/lib/pq" //postgres drivers
@@ -18,12 +19,12 @@ func ConnectToDB(cfg config.Config) (*sqlx.DB, error) {
 		cfg.PostgresDatabase,
 	)
 
-	connDb, err := sqlx.Open("postgres", psqlString)
+	connDb, err := tracing.OpenDB("postgres", psqlString)
 	if err != nil {
 		return nil, err
 	}
 
-	return connDb, nil
+	return sqlx.NewDb(connDb, "postgres"), nil
 }
 
 func ConnectToDBForSuite(cfg config.Config) (*sqlx.DB, func()) {
@@ -0,0 +1,119 @@
+package events
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Types of domain events
+const (
+	UserCreated    = "UserCreated"
+	UserDeleted    = "UserDeleted"
+	PostCreated    = "PostCreated"
+	PostDeleted    = "PostDeleted"
+	CommentWritten = "CommentWritten"
+
+	// deleted data is erased after grace period
+	UserPurged = "UserPurged"
+	PostPurged = "PostPurged"
+
+	// content is held for review by moderation classifier
+	PostHeld    = "PostHeld"
+	CommentHeld = "CommentHeld"
+
+	// suspension, ban or shadow ban of the user is changed
+	UserRestricted = "UserRestricted"
+
+	// scheduled post is published by the scheduler
+	PostPublished = "PostPublished"
+	// posts of the user are hidden or shown again, post service writes it
+	// after it applied the shadow ban of UserRestricted
+	PostsRestricted = "PostsRestricted"
+)
+
+// Event is written to outbox of the service which made the change and relayed
+// to Bus. Id is used by consumers to handle every event once.
+type Event struct {
+	Id          string          `json:"id"`
+	Type        string          `json:"type"`
+	AggregateId string          `json:"aggregate_id"`
+	Payload     json.RawMessage `json:"payload"`
+	OccurredAt  time.Time       `json:"occurred_at"`
+}
+
+// Payloads of events...
+type User struct {
+	Id        string `json:"id"`
+	FirstName string `json:"first_name"`
+	LastName  string `json:"last_name"`
+	Email     string `json:"email"`
+}
+
+type Post struct {
+	Id     string `json:"id"`
+	UserId string `json:"user_id"`
+	Title  string `json:"title"`
+}
+
+type Comment struct {
+	Id       string `json:"id"`
+	PostId   string `json:"post_id"`
+	UserId   string `json:"user_id"`
+	ParentId string `json:"parent_id"`
+}
+
+// Held is payload of PostHeld and CommentHeld
+type Held struct {
+	Id     string   `json:"id"`
+	UserId string   `json:"user_id"`
+	Labels []string `json:"labels"` // labels of matched classifier rules
+}
+
+// Restriction is payload of UserRestricted with all restrictions of the user,
+// times are RFC3339 and empty when the restriction does not expire
+type Restriction struct {
+	Id                string `json:"id"`
+	SuspendedUntil    string `json:"suspended_until"`
+	Banned            bool   `json:"banned"`
+	BannedUntil       string `json:"banned_until"`
+	ShadowBanned      bool   `json:"shadow_banned"`
+	ShadowBannedUntil string `json:"shadow_banned_until"`
+}
+
+func New(eventType, aggregateId string, payload interface{}) (Event, error) {
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return Event{}, err
+	}
+
+	return Event{
+		Id:          uuid.NewString(),
+		Type:        eventType,
+		AggregateId: aggregateId,
+		Payload:     data,
+		OccurredAt:  time.Now().UTC(),
+	}, nil
+}
+
+// Decode unmarshals payload of the event
+func (e Event) Decode(payload interface{}) error {
+	return json.Unmarshal(e.Payload, payload)
+}
+
+type Handler func(ctx context.Context, event Event) error
+
+type Bus interface {
+	Publish(ctx context.Context, event Event) error
+
+	// Subscribe calls handler for events until ctx is done. Every event is
+	// handled by one consumer of the group at least once, so handlers must
+	// be idempotent.
+	Subscribe(ctx context.Context, group, consumer string, handler Handler) error
+}
+
+// handler is retried maxAttempts times, then the event is skipped, so one
+// broken event does not stop the consumer
+const maxAttempts = 5
@@ -1,8 +1,12 @@
 package logger
 
 import (
+	"context"
 	"time"
 
+	"github.com/burxondv/new-services/comment-service/pkg/requestid"
+
+	"go.opentelemetry.io/otel/trace"
 	"go.uber.org/zap"
 	"go.uber.org/zap/zapcore"
 )
@@ -19,6 +23,8 @@ var (
 	Error = zap.Error
 	// Bool ...
 	Bool = zap.Bool
+	// Duration ...
+	Duration = zap.Duration
 
 	// Any ...
 	Any = zap.Any
@@ -103,6 +109,28 @@ func WithFields(l Logger, fields ...Field) Logger {
 	}
 }
 
+// WithTrace adds ids of the trace and the span of ctx to the logs, so logs
+// of one request can be found by its trace id
+func WithTrace(l Logger, ctx context.Context) Logger {
+	spanCtx := trace.SpanContextFromContext(ctx)
+	if !spanCtx.IsValid() {
+		return l
+	}
+
+	return WithFields(l,
+		String("trace_id", spanCtx.TraceID().String()),
+		String("span_id", spanCtx.SpanID().String()),
+	)
+}
+
+// WithContext adds id of the request and ids of the trace of ctx to the logs
+func WithContext(l Logger, ctx context.Context) Logger {
+	if id := requestid.FromContext(ctx); id != "" {
+		l = WithFields(l, String("request_id", id))
+	}
+	return WithTrace(l, ctx)
+}
+
 // Cleanup ...
 func Cleanup(l Logger) error {
 	switch v := l.(type) {
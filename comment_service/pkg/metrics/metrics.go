@@ -0,0 +1,77 @@
+package metrics
+
+import (
+	"context"
+	"database/sql"
+	"net/http"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+)
+
+var (
+	rpcHandled = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "grpc_server_handled_total",
+		Help: "RPCs completed on the server by method and status code.",
+	}, []string{"method", "code"})
+
+	rpcDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "grpc_server_handling_seconds",
+		Help:    "Duration of RPCs on the server by method and status code.",
+		Buckets: prometheus.DefBuckets,
+	}, []string{"method", "code"})
+
+	// business...
+	CommentsWritten = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "comments_written_total",
+		Help: "Comments written by users.",
+	})
+)
+
+func init() {
+	prometheus.MustRegister(rpcHandled, rpcDuration, CommentsWritten)
+}
+
+// UnaryServerInterceptor records rate, latency and errors of unary RPCs
+func UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		start := time.Now()
+		res, err := handler(ctx, req)
+		observe(info.FullMethod, start, err)
+		return res, err
+	}
+}
+
+// StreamServerInterceptor records streams when they are closed, so duration
+// is the lifetime of the stream
+func StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		start := time.Now()
+		err := handler(srv, ss)
+		observe(info.FullMethod, start, err)
+		return err
+	}
+}
+
+func observe(method string, start time.Time, err error) {
+	code := status.Code(err).String()
+	rpcHandled.WithLabelValues(method, code).Inc()
+	rpcDuration.WithLabelValues(method, code).Observe(time.Since(start).Seconds())
+}
+
+// RegisterDB exports stats of connection pool of db
+func RegisterDB(db *sql.DB) {
+	prometheus.MustRegister(collectors.NewDBStatsCollector(db, "postgres"))
+}
+
+// Serve exposes metrics on addr at /metrics, it blocks like
+// http.ListenAndServe
+func Serve(addr string) error {
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", promhttp.Handler())
+	return http.ListenAndServe(addr, mux)
+}
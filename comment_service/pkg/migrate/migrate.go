@@ -0,0 +1,228 @@
+package migrate
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"regexp"
+	"sort"
+	"strconv"
+
+	"github.com/golang-migrate/migrate/v4"
+	"github.com/golang-migrate/migrate/v4/database/postgres"
+	"github.com/golang-migrate/migrate/v4/source/iofs"
+)
+
+var fileName = regexp.MustCompile(`^(\d+)_(.+)\.(up|down)\.sql$`)
+
+// Migration is a pair of up and down files of a version
+type Migration struct {
+	Version uint
+	Name    string
+	Applied bool
+}
+
+// List returns migrations of fsys ordered by version, every version must have
+// both up and down files
+func List(fsys fs.FS) ([]Migration, error) {
+	entries, err := fs.ReadDir(fsys, ".")
+	if err != nil {
+		return nil, err
+	}
+
+	files := make(map[uint]map[string]string)
+	for _, entry := range entries {
+		match := fileName.FindStringSubmatch(entry.Name())
+		if match == nil {
+			continue
+		}
+
+		version, err := strconv.ParseUint(match[1], 10, 64)
+		if err != nil {
+			return nil, err
+		}
+		if files[uint(version)] == nil {
+			files[uint(version)] = make(map[string]string)
+		}
+		files[uint(version)][match[3]] = match[2]
+	}
+
+	migrations := []Migration{}
+	for version, names := range files {
+		if names["up"] == "" || names["down"] == "" {
+			return nil, fmt.Errorf("migration %d must have up and down files", version)
+		}
+		if names["up"] != names["down"] {
+			return nil, fmt.Errorf("migration %d has different names: %s, %s", version, names["up"], names["down"])
+		}
+		migrations = append(migrations, Migration{Version: version, Name: names["up"]})
+	}
+	sort.Slice(migrations, func(i, j int) bool {
+		return migrations[i].Version < migrations[j].Version
+	})
+
+	return migrations, nil
+}
+
+// Runner applies migrations embedded in the binary. Versions are kept in
+// schema_migrations like by the migrate CLI, so databases migrated with the
+// Makefile continue from their version. Concurrent runners of replicas are
+// serialized by an advisory lock.
+type Runner struct {
+	fsys fs.FS
+	m    *migrate.Migrate
+}
+
+func New(db *sql.DB, fsys fs.FS) (*Runner, error) {
+	ctx := context.Background()
+
+	// the driver closes only this connection, db stays open for the service
+	conn, err := db.Conn(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	driver, err := postgres.WithConnection(ctx, conn, &postgres.Config{})
+	if err != nil {
+		conn.Close()
+		return nil, err
+	}
+
+	source, err := iofs.New(fsys, ".")
+	if err != nil {
+		driver.Close()
+		return nil, err
+	}
+
+	m, err := migrate.NewWithInstance("iofs", source, "postgres", driver)
+	if err != nil {
+		source.Close()
+		driver.Close()
+		return nil, err
+	}
+
+	return &Runner{fsys: fsys, m: m}, nil
+}
+
+func (r *Runner) Close() error {
+	srcErr, dbErr := r.m.Close()
+	if srcErr != nil {
+		return srcErr
+	}
+
+	return dbErr
+}
+
+// Up applies all pending migrations
+func (r *Runner) Up() error {
+	err := r.m.Up()
+	if errors.Is(err, migrate.ErrNoChange) {
+		return nil
+	}
+
+	return err
+}
+
+// Down reverts the last steps migrations
+func (r *Runner) Down(steps int) error {
+	if steps <= 0 {
+		return fmt.Errorf("steps must be positive: %d", steps)
+	}
+
+	err := r.m.Steps(-steps)
+	if errors.Is(err, migrate.ErrNoChange) {
+		return nil
+	}
+
+	return err
+}
+
+// Status returns embedded migrations, the current version and whether the
+// last migration failed in the middle
+func (r *Runner) Status() ([]Migration, uint, bool, error) {
+	migrations, err := List(r.fsys)
+	if err != nil {
+		return nil, 0, false, err
+	}
+
+	version, dirty, err := r.m.Version()
+	if errors.Is(err, migrate.ErrNilVersion) {
+		version, dirty = 0, false
+	} else if err != nil {
+		return nil, 0, false, err
+	}
+
+	for i := range migrations {
+		migrations[i].Applied = migrations[i].Version <= version
+	}
+
+	return migrations, version, dirty, nil
+}
+
+// Up applies all pending migrations of fsys to db
+func Up(db *sql.DB, fsys fs.FS) error {
+	r, err := New(db, fsys)
+	if err != nil {
+		return err
+	}
+	defer r.Close()
+
+	return r.Up()
+}
+
+// Command runs `migrate up`, `migrate down [steps]` or `migrate status`, args
+// are arguments after "migrate"
+func Command(db *sql.DB, fsys fs.FS, args []string, out io.Writer) error {
+	if len(args) == 0 {
+		return errors.New("usage: migrate up | down [steps] | status")
+	}
+
+	r, err := New(db, fsys)
+	if err != nil {
+		return err
+	}
+	defer r.Close()
+
+	switch args[0] {
+	case "up":
+		if err := r.Up(); err != nil {
+			return err
+		}
+	case "down":
+		steps := 1
+		if len(args) > 1 {
+			steps, err = strconv.Atoi(args[1])
+			if err != nil {
+				return fmt.Errorf("invalid steps: %s", args[1])
+			}
+		}
+		if err := r.Down(steps); err != nil {
+			return err
+		}
+	case "status":
+	default:
+		return fmt.Errorf("unknown migrate command: %s", args[0])
+	}
+
+	migrations, version, dirty, err := r.Status()
+	if err != nil {
+		return err
+	}
+
+	for _, m := range migrations {
+		state := "pending"
+		if m.Applied {
+			state = "applied"
+		}
+		if dirty && m.Version == version {
+			state = "dirty"
+		}
+		fmt.Fprintf(out, "%06d %-40s %s\n", m.Version, m.Name, state)
+	}
+	fmt.Fprintf(out, "version: %d, dirty: %v\n", version, dirty)
+
+	return nil
+}
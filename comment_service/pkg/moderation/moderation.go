@@ -0,0 +1,126 @@
+package moderation
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"regexp"
+)
+
+// Verdicts of classifier in order of severity...
+const (
+	Allow  = "allow"
+	Hold   = "hold"   // content is saved, but shown only to its author until it is reviewed
+	Reject = "reject" // content is not saved
+)
+
+type Result struct {
+	Verdict string
+	Labels  []string // labels of matched rules
+}
+
+// Classifier checks text of content before it is saved, other implementations
+// (like an external ML service) can replace RuleClassifier
+type Classifier interface {
+	Classify(ctx context.Context, text string) (Result, error)
+}
+
+// Rule matches a keyword or a regular expression
+type Rule struct {
+	Label   string `json:"label"`
+	Keyword string `json:"keyword"` // whole words, case insensitive
+	Pattern string `json:"pattern"` // RE2 regular expression
+	Verdict string `json:"verdict"` // hold or reject
+}
+
+type rule struct {
+	Rule
+	re *regexp.Regexp
+}
+
+// RuleClassifier returns the most severe verdict of matched rules, text
+// matching no rules is allowed
+type RuleClassifier struct {
+	rules []rule
+}
+
+func NewRuleClassifier(rules []Rule) (*RuleClassifier, error) {
+	c := &RuleClassifier{}
+	for i, r := range rules {
+		if r.Label == "" {
+			return nil, fmt.Errorf("rule %d: label is required", i)
+		}
+		if severity(r.Verdict) <= severity(Allow) {
+			return nil, fmt.Errorf("rule %q: unknown verdict %q", r.Label, r.Verdict)
+		}
+
+		var pattern string
+		switch {
+		case r.Keyword != "" && r.Pattern != "":
+			return nil, fmt.Errorf("rule %q: keyword and pattern are exclusive", r.Label)
+		case r.Keyword != "":
+			pattern = `(?i)\b` + regexp.QuoteMeta(r.Keyword) + `\b`
+		case r.Pattern != "":
+			pattern = r.Pattern
+		default:
+			return nil, fmt.Errorf("rule %q: keyword or pattern is required", r.Label)
+		}
+
+		re, err := regexp.Compile(pattern)
+		if err != nil {
+			return nil, fmt.Errorf("rule %q: %w", r.Label, err)
+		}
+		c.rules = append(c.rules, rule{Rule: r, re: re})
+	}
+
+	return c, nil
+}
+
+// LoadRules reads JSON array of rules from the file
+func LoadRules(path string) ([]Rule, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	rules := []Rule{}
+	if err := json.Unmarshal(data, &rules); err != nil {
+		return nil, fmt.Errorf("moderation rules %s: %w", path, err)
+	}
+
+	return rules, nil
+}
+
+func (c *RuleClassifier) Classify(ctx context.Context, text string) (Result, error) {
+	res := Result{Verdict: Allow}
+	seen := map[string]bool{}
+	for _, r := range c.rules {
+		if !r.re.MatchString(text) {
+			continue
+		}
+
+		if severity(r.Verdict) > severity(res.Verdict) {
+			res.Verdict = r.Verdict
+		}
+		if !seen[r.Label] {
+			seen[r.Label] = true
+			res.Labels = append(res.Labels, r.Label)
+		}
+	}
+
+	return res, nil
+}
+
+func severity(verdict string) int {
+	switch verdict {
+	case Allow:
+		return 0
+	case Hold:
+		return 1
+	case Reject:
+		return 2
+	}
+
+	return -1
+}
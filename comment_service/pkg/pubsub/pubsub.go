@@ -0,0 +1,81 @@
+package pubsub
+
+import "sync"
+
+// Hub fans out events published by the service to subscribed gRPC streams.
+// Events are published by topic, subscribers of empty topic receive events
+// of every topic.
+type Hub[T any] struct {
+	mu   sync.RWMutex
+	subs map[string]map[chan T]struct{}
+	size int
+}
+
+// NewHub returns hub where every subscriber has buffer of given size
+func NewHub[T any](size int) *Hub[T] {
+	return &Hub[T]{
+		subs: map[string]map[chan T]struct{}{},
+		size: size,
+	}
+}
+
+// Subscribe returns channel of events and function which must be called
+// when the subscriber leaves, it closes the channel
+func (h *Hub[T]) Subscribe(topic string) (<-chan T, func()) {
+	ch := make(chan T, h.size)
+
+	h.mu.Lock()
+	if h.subs[topic] == nil {
+		h.subs[topic] = map[chan T]struct{}{}
+	}
+	h.subs[topic][ch] = struct{}{}
+	h.mu.Unlock()
+
+	var once sync.Once
+	return ch, func() {
+		once.Do(func() {
+			h.mu.Lock()
+			delete(h.subs[topic], ch)
+			if len(h.subs[topic]) == 0 {
+				delete(h.subs, topic)
+			}
+			h.mu.Unlock()
+
+			close(ch)
+		})
+	}
+}
+
+// Publish never blocks the caller, the event is dropped for subscribers
+// which are too slow to read their buffer
+func (h *Hub[T]) Publish(topic string, event T) {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+
+	for ch := range h.subs[topic] {
+		select {
+		case ch <- event:
+		default:
+		}
+	}
+	if topic == "" {
+		return
+	}
+	for ch := range h.subs[""] {
+		select {
+		case ch <- event:
+		default:
+		}
+	}
+}
+
+// Subscribers returns number of subscribers which receive events of the topic
+func (h *Hub[T]) Subscribers(topic string) int {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+
+	if topic == "" {
+		return len(h.subs[""])
+	}
+	return len(h.subs[topic]) + len(h.subs[""])
+}
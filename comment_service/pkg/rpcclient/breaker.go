@@ -0,0 +1,126 @@
+package rpcclient
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	breakerThreshold = 5
+	breakerCooldown  = 10 * time.Second
+)
+
+type State int
+
+const (
+	Closed State = iota
+	Open
+	HalfOpen
+)
+
+// Breaker stops calls to a dependency after threshold calls in a row failed
+// because it is down. After cooldown one call is let through, the breaker
+// closes when it succeeds and opens again when it fails.
+type Breaker struct {
+	name      string
+	threshold int
+	cooldown  time.Duration
+
+	mu       sync.Mutex
+	state    State
+	failures int
+	openedAt time.Time
+	probing  bool
+}
+
+func NewBreaker(name string, threshold int, cooldown time.Duration) *Breaker {
+	return &Breaker{
+		name:      name,
+		threshold: threshold,
+		cooldown:  cooldown,
+	}
+}
+
+func (b *Breaker) State() State {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	return b.state
+}
+
+// Allow returns an Unavailable error when the call must not be made, calls
+// which are allowed must be reported with Done
+func (b *Breaker) Allow() error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.state == Open && time.Since(b.openedAt) >= b.cooldown {
+		b.state = HalfOpen
+	}
+	if b.state == Open || (b.state == HalfOpen && b.probing) {
+		return status.Errorf(codes.Unavailable, "%s is unavailable, circuit breaker is open", b.name)
+	}
+	if b.state == HalfOpen {
+		b.probing = true
+	}
+
+	return nil
+}
+
+// Done records the result of an allowed call
+func (b *Breaker) Done(err error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.probing = false
+	if !IsUnavailable(err) {
+		b.state = Closed
+		b.failures = 0
+		return
+	}
+
+	b.failures++
+	if b.state == HalfOpen || b.failures >= b.threshold {
+		b.state = Open
+		b.openedAt = time.Now()
+	}
+}
+
+func (b *Breaker) UnaryClientInterceptor() grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		if err := b.Allow(); err != nil {
+			return err
+		}
+
+		err := invoker(ctx, method, req, reply, cc, opts...)
+		b.Done(err)
+		return err
+	}
+}
+
+func (b *Breaker) StreamClientInterceptor() grpc.StreamClientInterceptor {
+	return func(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+		if err := b.Allow(); err != nil {
+			return nil, err
+		}
+
+		stream, err := streamer(ctx, desc, cc, method, opts...)
+		b.Done(err)
+		return stream, err
+	}
+}
+
+// IsUnavailable tells if err means the called service is down or too slow,
+// callers use it to fall back instead of failing
+func IsUnavailable(err error) bool {
+	switch status.Code(err) {
+	case codes.Unavailable, codes.DeadlineExceeded:
+		return true
+	}
+	return false
+}
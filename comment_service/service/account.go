@@ -0,0 +1,43 @@
+package service
+
+import (
+	"context"
+
+	u "github.com/burxondv/new-services/comment-service/genproto/user"
+	"github.com/burxondv/new-services/comment-service/pkg/identity"
+	"github.com/burxondv/new-services/comment-service/pkg/logger"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// checkAccount returns PermissionDenied for banned and suspended users, shadow
+// banned users can write but their comments are shown only to them
+func (s *CommentService) checkAccount(ctx context.Context, userId string) (*u.AccountResponse, error) {
+	res, err := s.Client.User().GetAccountStatus(ctx, &u.Request{Str: userId})
+	if err != nil {
+		s.reqLog(ctx).Error("failed to get account status in service", logger.Error(err))
+		return &u.AccountResponse{}, err
+	}
+
+	if res.State != "active" {
+		return &u.AccountResponse{}, status.Errorf(codes.PermissionDenied, "account is %s", res.State)
+	}
+
+	return res, nil
+}
+
+// checkAuthor allows only the author and admins to change content of authorId,
+// the caller is the identity signed by the gateway
+func checkAuthor(ctx context.Context, authorId string) (identity.Identity, error) {
+	caller, ok := identity.FromContext(ctx)
+	if !ok {
+		return identity.Identity{}, status.Error(codes.Unauthenticated, "caller is unknown")
+	}
+
+	if caller.Subject != authorId && !caller.IsAdmin() {
+		return caller, status.Error(codes.PermissionDenied, "only author can change comments")
+	}
+
+	return caller, nil
+}
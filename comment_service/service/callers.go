@@ -0,0 +1,11 @@
+package service
+
+// InternalCallers are the services allowed to call internal methods, the
+// gateway can't call them
+var InternalCallers = map[string][]string{
+	"/comment.CommentService/GetCommentsForPost":    {"post_service"},
+	"/comment.CommentService/GetCommentsByUser":     {"user_service"},
+	"/comment.CommentService/CountCommentsForPosts": {"post_service"},
+	"/comment.CommentService/GetComment":            {"moderation_service"},
+	"/comment.CommentService/ModerateComment":       {"moderation_service"},
+}
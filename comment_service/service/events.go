@@ -0,0 +1,121 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/burxondv/new-services/comment-service/pkg/events"
+	"github.com/burxondv/new-services/comment-service/pkg/logger"
+)
+
+// consumerGroup is the group of all comment service replicas in the event bus
+const consumerGroup = "comment_service"
+
+// RunOutboxRelay publishes events written to outbox, it is safe to run in
+// every replica, see RelayEvents
+func (s *CommentService) RunOutboxRelay(ctx context.Context, bus events.Bus, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			_, err := s.storage.Outbox().RelayEvents(ctx, 100, func(event events.Event) error {
+				return bus.Publish(ctx, event)
+			})
+			if err != nil {
+				s.Logger.Error("outbox: failed to relay events", logger.Error(err))
+			}
+		}
+	}
+}
+
+// RunConsumer handles events of other services until ctx is done, consumer
+// must be unique among replicas
+func (s *CommentService) RunConsumer(ctx context.Context, bus events.Bus, consumer string) {
+	for ctx.Err() == nil {
+		err := bus.Subscribe(ctx, consumerGroup, consumer, s.HandleEvent)
+		if err != nil && ctx.Err() == nil {
+			s.Logger.Error("consumer: subscription is broken", logger.Error(err))
+			time.Sleep(time.Second)
+		}
+	}
+}
+
+// HandleEvent deletes comments of deleted users and posts and erases them
+// when they are purged, shadow bans of users are saved to hide their comments
+func (s *CommentService) HandleEvent(ctx context.Context, event events.Event) error {
+	switch event.Type {
+	case events.UserDeleted:
+		var user events.User
+		if err := event.Decode(&user); err != nil {
+			s.Logger.Error("consumer: failed to decode event", logger.String("event_id", event.Id), logger.Error(err))
+			return nil
+		}
+
+		deleted, err := s.storage.Comment().DeleteUserComments(ctx, event.Id, user.Id)
+		if err != nil {
+			return err
+		}
+		s.Logger.Info("consumer: comments of deleted user are deleted", logger.String("user_id", user.Id), logger.Int("comments", int(deleted)))
+	case events.PostDeleted:
+		var post events.Post
+		if err := event.Decode(&post); err != nil {
+			s.Logger.Error("consumer: failed to decode event", logger.String("event_id", event.Id), logger.Error(err))
+			return nil
+		}
+
+		deleted, err := s.storage.Comment().DeletePostComments(ctx, event.Id, post.Id)
+		if err != nil {
+			return err
+		}
+		s.Logger.Info("consumer: comments of deleted post are deleted", logger.String("post_id", post.Id), logger.Int("comments", int(deleted)))
+	case events.UserPurged:
+		var user events.User
+		if err := event.Decode(&user); err != nil {
+			s.Logger.Error("consumer: failed to decode event", logger.String("event_id", event.Id), logger.Error(err))
+			return nil
+		}
+
+		purged, err := s.storage.Comment().PurgeUserComments(ctx, event.Id, user.Id)
+		if err != nil {
+			return err
+		}
+		s.Logger.Info("consumer: comments of purged user are erased", logger.String("user_id", user.Id), logger.Int("comments", int(purged)))
+	case events.PostPurged:
+		var post events.Post
+		if err := event.Decode(&post); err != nil {
+			s.Logger.Error("consumer: failed to decode event", logger.String("event_id", event.Id), logger.Error(err))
+			return nil
+		}
+
+		purged, err := s.storage.Comment().PurgePostComments(ctx, event.Id, post.Id)
+		if err != nil {
+			return err
+		}
+		s.Logger.Info("consumer: comments of purged post are erased", logger.String("post_id", post.Id), logger.Int("comments", int(purged)))
+	case events.UserRestricted:
+		var restriction events.Restriction
+		if err := event.Decode(&restriction); err != nil {
+			s.Logger.Error("consumer: failed to decode event", logger.String("event_id", event.Id), logger.Error(err))
+			return nil
+		}
+
+		var until time.Time
+		if restriction.ShadowBannedUntil != "" {
+			var err error
+			until, err = time.Parse(time.RFC3339, restriction.ShadowBannedUntil)
+			if err != nil {
+				s.Logger.Error("consumer: failed to parse shadow ban time", logger.String("event_id", event.Id), logger.Error(err))
+				return nil
+			}
+		}
+
+		_, err := s.storage.Comment().SetShadowBan(ctx, event.Id, restriction.Id, restriction.ShadowBanned, until.UTC())
+		return err
+	}
+
+	return nil
+}
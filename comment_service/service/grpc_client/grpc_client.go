@@ -4,43 +4,58 @@ import (
 	"fmt"
 
 	"github.com/burxondv/new-services/comment-service/config"
+	cn "github.com/burxondv/new-services/comment-service/genproto/notification"
 	cp "github.com/burxondv/new-services/comment-service/genproto/post"
 	cu "github.com/burxondv/new-services/comment-service/genproto/user"
+	"github.com/burxondv/new-services/comment-service/pkg/identity"
+	"github.com/burxondv/new-services/comment-service/pkg/mtls"
+	"github.com/burxondv/new-services/comment-service/pkg/rpcclient"
 
 	"google.golang.org/grpc"
-	"google.golang.org/grpc/credentials/insecure"
 )
 
 type Clients interface {
 	User() cu.UserServiceClient
 	Post() cp.PostServiceClient
+	Notification() cn.NotificationServiceClient
 }
 
 type ServiceManager struct {
-	Config      config.Config
-	userService cu.UserServiceClient
-	postService cp.PostServiceClient
+	Config              config.Config
+	userService         cu.UserServiceClient
+	postService         cp.PostServiceClient
+	notificationService cn.NotificationServiceClient
+	conns               []*grpc.ClientConn
 }
 
-func New(cfg config.Config) (*ServiceManager, error) {
-	connUser, err := grpc.Dial(
-		fmt.Sprintf("%s:%s", cfg.UserServiceHost, cfg.UserServicePort),
-		grpc.WithTransportCredentials(insecure.NewCredentials()))
+func New(cfg config.Config, certs *mtls.Certs, signer *identity.Signer) (*ServiceManager, error) {
+	// the end user of a call is sent to the called service
+	opts := []grpc.DialOption{
+		grpc.WithChainUnaryInterceptor(signer.UnaryClientInterceptor()),
+		grpc.WithChainStreamInterceptor(signer.StreamClientInterceptor()),
+	}
+
+	connUser, err := rpcclient.Dial("comment_service", "user_service", cfg.UserServiceHost, cfg.UserServicePort, certs, opts...)
 	if err != nil {
 		return nil, fmt.Errorf("user service dial host:%s, port:%s", cfg.UserServiceHost, cfg.UserServicePort)
 	}
 
-	connPost, err := grpc.Dial(
-		fmt.Sprintf("%s:%s", cfg.PostServiceHost, cfg.PostServicePort),
-		grpc.WithTransportCredentials(insecure.NewCredentials()))
+	connPost, err := rpcclient.Dial("comment_service", "post_service", cfg.PostServiceHost, cfg.PostServicePort, certs, opts...)
 	if err != nil {
 		return nil, fmt.Errorf("post service dial host:%s, port:%s", cfg.PostServiceHost, cfg.PostServicePort)
 	}
 
+	connNotification, err := rpcclient.Dial("comment_service", "notification_service", cfg.NotificationServiceHost, cfg.NotificationServicePort, certs, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("notification service dial host:%s, port:%s", cfg.NotificationServiceHost, cfg.NotificationServicePort)
+	}
+
 	return &ServiceManager{
-		Config:      cfg,
-		userService: cu.NewUserServiceClient(connUser),
-		postService: cp.NewPostServiceClient(connPost),
+		Config:              cfg,
+		userService:         cu.NewUserServiceClient(connUser),
+		postService:         cp.NewPostServiceClient(connPost),
+		notificationService: cn.NewNotificationServiceClient(connNotification),
+		conns:               []*grpc.ClientConn{connUser, connPost, connNotification},
 	}, nil
 }
 
@@ -51,3 +66,18 @@ func (s *ServiceManager) User() cu.UserServiceClient {
 func (s *ServiceManager) Post() cp.PostServiceClient {
 	return s.postService
 }
+
+func (s *ServiceManager) Notification() cn.NotificationServiceClient {
+	return s.notificationService
+}
+
+// Close closes connections to all services
+func (s *ServiceManager) Close() error {
+	var res error
+	for _, conn := range s.conns {
+		if err := conn.Close(); err != nil {
+			res = err
+		}
+	}
+	return res
+}
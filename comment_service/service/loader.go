@@ -0,0 +1,52 @@
+package service
+
+import (
+	"context"
+
+	p "github.com/burxondv/new-services/comment-service/genproto/post"
+	u "github.com/burxondv/new-services/comment-service/genproto/user"
+	"github.com/burxondv/new-services/comment-service/pkg/loader"
+)
+
+// userLoader gets users with one GetUsersByIds call, create it per request
+func (s *CommentService) userLoader() *loader.Loader[string, *u.UserResponse] {
+	return loader.New(func(ctx context.Context, ids []string) (map[string]*u.UserResponse, error) {
+		res, err := s.Client.User().GetUsersByIds(ctx, &u.IdsRequest{Ids: ids})
+		if err != nil {
+			return nil, err
+		}
+
+		users := map[string]*u.UserResponse{}
+		for _, user := range res.Users {
+			users[user.Id] = user
+		}
+
+		return users, nil
+	})
+}
+
+// postLoader gets posts with one GetPostsByIds call, create it per request
+func (s *CommentService) postLoader() *loader.Loader[string, *p.PostResponse] {
+	return loader.New(func(ctx context.Context, ids []string) (map[string]*p.PostResponse, error) {
+		res, err := s.Client.Post().GetPostsByIds(ctx, &p.IdsRequest{Ids: ids})
+		if err != nil {
+			return nil, err
+		}
+
+		posts := map[string]*p.PostResponse{}
+		for _, post := range res.Posts {
+			posts[post.Id] = post
+		}
+
+		return posts, nil
+	})
+}
+
+// fullName returns empty name of deleted user
+func fullName(user *u.UserResponse) string {
+	if user == nil {
+		return ""
+	}
+
+	return user.FirstName + " " + user.LastName
+}
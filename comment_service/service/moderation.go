@@ -0,0 +1,90 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+
+	c "github.com/burxondv/new-services/comment-service/genproto/comment"
+	"github.com/burxondv/new-services/comment-service/pkg/logger"
+	"github.com/burxondv/new-services/comment-service/pkg/moderation"
+	"github.com/burxondv/new-services/comment-service/storage/repo"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// classify checks text of written comment, rejected comment is not saved and
+// held comment is shown only to its author until it is reviewed by moderators
+func (s *CommentService) classify(ctx context.Context, comment *repo.Comment) error {
+	res, err := s.classifier.Classify(ctx, comment.Text)
+	if err != nil {
+		s.reqLog(ctx).Error("failed to classify comment in service", logger.Error(err))
+		return err
+	}
+
+	switch res.Verdict {
+	case moderation.Reject:
+		return status.Errorf(codes.InvalidArgument, "comment violates content rules: %s", strings.Join(res.Labels, ", "))
+	case moderation.Hold:
+		comment.ModerationStatus = repo.ModerationHeld
+		comment.ModerationLabels = res.Labels
+	}
+
+	return nil
+}
+
+// GetComment returns the comment with any moderation status, it lets
+// moderation service find author of reported comment
+func (s *CommentService) GetComment(ctx context.Context, req *c.Request) (*c.CommentResponse, error) {
+	res, err := s.storage.Comment().GetComment(ctx, req.Str)
+	if err == sql.ErrNoRows {
+		return &c.CommentResponse{}, status.Error(codes.NotFound, "comment not found")
+	} else if err != nil {
+		s.reqLog(ctx).Error("failed to get comment in service", logger.Error(err))
+		return &c.CommentResponse{}, err
+	}
+
+	return commentResponse(res), nil
+}
+
+// ModerateComment applies decision of moderator: approve makes the comment
+// visible, hide shows it only to its author and remove deletes it
+func (s *CommentService) ModerateComment(ctx context.Context, req *c.ModerateRequest) (*c.CommentResponse, error) {
+	var moderationStatus string
+	switch req.Action {
+	case "approve":
+		moderationStatus = repo.ModerationVisible
+	case "hide":
+		moderationStatus = repo.ModerationHidden
+	case "remove":
+		if _, err := s.storage.Comment().GetComment(ctx, req.Id); err == sql.ErrNoRows {
+			return &c.CommentResponse{}, status.Error(codes.NotFound, "comment not found")
+		}
+		return s.deleteComment(ctx, req.Id)
+	default:
+		return &c.CommentResponse{}, status.Errorf(codes.InvalidArgument, "unknown moderation action %q", req.Action)
+	}
+
+	res, err := s.storage.Comment().ModerateComment(ctx, req.Id, moderationStatus)
+	if err == sql.ErrNoRows {
+		return &c.CommentResponse{}, status.Error(codes.NotFound, "comment not found")
+	} else if err != nil {
+		s.reqLog(ctx).Error("failed to moderate comment in service", logger.Error(err))
+		return &c.CommentResponse{}, err
+	}
+
+	return commentResponse(res), nil
+}
+
+func commentResponse(comment repo.Comment) *c.CommentResponse {
+	return &c.CommentResponse{
+		Id:               comment.Id,
+		PostId:           comment.PostId,
+		UserId:           comment.UserId,
+		Text:             comment.Text,
+		ParentId:         comment.ParentId,
+		CreatedAt:        comment.CreatedAt,
+		ModerationStatus: comment.ModerationStatus,
+	}
+}
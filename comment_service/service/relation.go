@@ -0,0 +1,51 @@
+package service
+
+import (
+	"context"
+
+	u "github.com/burxondv/new-services/comment-service/genproto/user"
+	"github.com/burxondv/new-services/comment-service/pkg/logger"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// checkBlocked returns PermissionDenied if the owner of the post or comment
+// blocked the user
+func (s *CommentService) checkBlocked(ctx context.Context, ownerId, userId string) error {
+	if ownerId == "" || ownerId == userId {
+		return nil
+	}
+
+	res, err := s.Client.User().IsBlocked(ctx, &u.RelationRequest{UserId: ownerId, TargetId: userId})
+	if err != nil {
+		s.reqLog(ctx).Error("failed to check block in service", logger.Error(err))
+		return err
+	}
+
+	if res.Exists {
+		return status.Error(codes.PermissionDenied, "you are blocked by the author")
+	}
+
+	return nil
+}
+
+// mutedUsers returns set of users muted by the viewer, comments of them are
+// not listed to the viewer
+func (s *CommentService) mutedUsers(ctx context.Context, viewerId string) (map[string]bool, error) {
+	muted := map[string]bool{}
+	if viewerId == "" {
+		return muted, nil
+	}
+
+	res, err := s.Client.User().GetMutedIds(ctx, &u.Request{Str: viewerId})
+	if err != nil {
+		return muted, err
+	}
+
+	for _, id := range res.Ids {
+		muted[id] = true
+	}
+
+	return muted, nil
+}
@@ -2,74 +2,157 @@ package service
 
 import (
 	"context"
-	"log"
+	"database/sql"
 
 	"github.com/jmoiron/sqlx"
 
 	c "github.com/burxondv/new-services/comment-service/genproto/comment"
+	n "github.com/burxondv/new-services/comment-service/genproto/notification"
 	p "github.com/burxondv/new-services/comment-service/genproto/post"
-	u "github.com/burxondv/new-services/comment-service/genproto/user"
 	"github.com/burxondv/new-services/comment-service/pkg/logger"
+	"github.com/burxondv/new-services/comment-service/pkg/metrics"
+	"github.com/burxondv/new-services/comment-service/pkg/moderation"
+	"github.com/burxondv/new-services/comment-service/pkg/pubsub"
 	grpcclient "github.com/burxondv/new-services/comment-service/service/grpc_client"
 	"github.com/burxondv/new-services/comment-service/storage"
 	"github.com/burxondv/new-services/comment-service/storage/repo"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 type CommentService struct {
-	storage storage.IStorage
-	Logger  logger.Logger
-	Client  grpcclient.Clients
+	storage    storage.IStorage
+	Logger     logger.Logger
+	Client     grpcclient.Clients
+	comments   *pubsub.Hub[*c.CommentResponse]
+	classifier moderation.Classifier
 }
 
-func NewCommentService(db *sqlx.DB, log logger.Logger, client grpcclient.Clients) *CommentService {
+func NewCommentService(db *sqlx.DB, log logger.Logger, client grpcclient.Clients, classifier moderation.Classifier) *CommentService {
 	return &CommentService{
-		storage: storage.NewStoragePg(db),
-		Logger:  log,
-		Client:  client,
+		storage:    storage.NewStoragePg(db, log),
+		Logger:     log,
+		Client:     client,
+		comments:   pubsub.NewHub[*c.CommentResponse](streamBuffer),
+		classifier: classifier,
 	}
 }
 
+// reqLog returns logger with ids of the request and of its trace
+func (s *CommentService) reqLog(ctx context.Context) logger.Logger {
+	return logger.WithContext(s.Logger, ctx)
+}
+
 func (s *CommentService) WriteComment(ctx context.Context, req *c.CommentRequest) (*c.CommentResponse, error) {
 	comRes := c.CommentResponse{}
-	res, err := s.storage.Comment().WriteComment(repo.Comment{
-		Id:     req.Id,
-		PostId: req.PostId,
-		UserId: req.UserId,
-		Text:   req.Text,
-	})
+
+	account, err := s.checkAccount(ctx, req.UserId)
 	if err != nil {
-		log.Println("failed to write comment in service: ", err)
 		return &c.CommentResponse{}, err
 	}
 
-	comRes.Id = res.Id
-	comRes.PostId = res.PostId
-	comRes.UserId = res.UserId
-	comRes.Text = req.Text
-	comRes.CreatedAt = res.CreatedAt
+	parent := repo.Comment{}
+	if req.ParentId != "" {
+		var err error
+		parent, err = s.storage.Comment().GetComment(ctx, req.ParentId)
+		if err == sql.ErrNoRows {
+			return &c.CommentResponse{}, status.Error(codes.NotFound, "parent comment not found")
+		} else if err != nil {
+			s.reqLog(ctx).Error("failed to get parent comment in write comment in service", logger.Error(err))
+			return &c.CommentResponse{}, err
+		}
 
-	post, err := s.Client.Post().GetPostForComment(ctx, &p.Request{Str: res.PostId})
+		if parent.PostId != req.PostId {
+			return &c.CommentResponse{}, status.Error(codes.InvalidArgument, "parent comment belongs to another post")
+		}
+	}
+
+	// posts the user can't see are not found
+	post, err := s.Client.Post().GetPostById(ctx, &p.Request{Str: req.PostId, ViewerId: req.UserId})
 	if err != nil {
-		log.Println("failed to get post in write comment in service: ", err)
+		s.reqLog(ctx).Error("failed to get post in write comment in service", logger.Error(err))
+		return &c.CommentResponse{}, err
+	}
+
+	// users blocked by author of the post or of the replied comment can not
+	// write there
+	if err = s.checkBlocked(ctx, post.UserId, req.UserId); err != nil {
+		return &c.CommentResponse{}, err
+	}
+	if err = s.checkBlocked(ctx, parent.UserId, req.UserId); err != nil {
 		return &c.CommentResponse{}, err
 	}
 
-	comRes.PostTitle = post.Title
+	comment := repo.Comment{
+		Id:       req.Id,
+		PostId:   req.PostId,
+		UserId:   req.UserId,
+		Text:     req.Text,
+		ParentId: req.ParentId,
 
-	user, err := s.Client.User().GetUserForClient(ctx, &u.Request{Str: res.UserId})
-	if err != nil {
-		log.Println("failed to get user in write comment in service")
+		ModerationStatus: repo.ModerationVisible,
+	}
+	if err := s.classify(ctx, &comment); err != nil {
 		return &c.CommentResponse{}, err
 	}
-	comRes.UserName = user.FirstName + " " + user.LastName
-	comRes.UserType = user.UserType
 
-	postUser, err := s.Client.User().GetUserForClient(ctx, &u.Request{Str: post.UserId})
+	res, err := s.storage.Comment().WriteComment(ctx, comment)
 	if err != nil {
-		log.Println("failed to get post's user in write comment in service: ", err)
+		s.reqLog(ctx).Error("failed to write comment in service", logger.Error(err))
+		return &c.CommentResponse{}, err
+	}
+	metrics.CommentsWritten.Inc()
+
+	comRes.Id = res.Id
+	comRes.PostId = res.PostId
+	comRes.UserId = res.UserId
+	comRes.Text = req.Text
+	comRes.ParentId = res.ParentId
+	comRes.CreatedAt = res.CreatedAt
+	comRes.ModerationStatus = res.ModerationStatus
+
+	if err = s.fillNames(ctx, post, &comRes); err != nil {
+		s.reqLog(ctx).Error("failed to get users in write comment in service", logger.Error(err))
 		return &c.CommentResponse{}, err
 	}
-	comRes.PostUserName = postUser.FirstName + " " + postUser.LastName
+
+	// held comment is not streamed and notified until it is approved, comment
+	// of shadow banned user is never
+	if res.ModerationStatus != repo.ModerationVisible || account.ShadowBanned {
+		return &comRes, nil
+	}
+
+	s.comments.Publish(comRes.PostId, &comRes)
+
+	// author of the parent comment is notified about reply only
+	if parent.UserId != "" {
+		s.notify(ctx, &n.NotifyRequest{
+			Type:      "reply",
+			UserId:    parent.UserId,
+			ActorId:   res.UserId,
+			PostId:    res.PostId,
+			CommentId: res.Id,
+			Text:      res.Text,
+		})
+	}
+	if parent.UserId != post.UserId {
+		s.notify(ctx, &n.NotifyRequest{
+			Type:      "comment",
+			UserId:    post.UserId,
+			ActorId:   res.UserId,
+			PostId:    res.PostId,
+			CommentId: res.Id,
+			Text:      res.Text,
+		})
+	}
+	s.notify(ctx, &n.NotifyRequest{
+		Type:      "mention",
+		ActorId:   res.UserId,
+		PostId:    res.PostId,
+		CommentId: res.Id,
+		Text:      res.Text,
+	})
 
 	return &comRes, nil
 }
@@ -77,67 +160,157 @@ func (s *CommentService) WriteComment(ctx context.Context, req *c.CommentRequest
 func (s *CommentService) GetComments(ctx context.Context, req *c.Request) (*c.CommentsResponse, error) {
 	coms := c.CommentsResponse{}
 
-	res, err := s.storage.Comment().GetComments(req.Str)
+	// comments of posts the viewer can't see are not found
+	post, err := s.Client.Post().GetPostById(ctx, &p.Request{Str: req.Str, ViewerId: req.ViewerId})
 	if err != nil {
-		log.Println("failed to get comments in service: ", err)
+		s.reqLog(ctx).Error("failed to get post in get comments in service", logger.Error(err))
 		return &c.CommentsResponse{}, err
 	}
 
-	for _, val := range res {
-		coms.Comments = append(coms.Comments, &c.CommentResponse{Id: val.Id, PostId: val.PostId, UserId: val.UserId, Text: val.Text, CreatedAt: val.CreatedAt})
+	res, err := s.storage.Comment().GetComments(ctx, req.Str)
+	if err != nil {
+		s.reqLog(ctx).Error("failed to get comments in service", logger.Error(err))
+		return &c.CommentsResponse{}, err
 	}
 
-	post, err := s.Client.Post().GetPostForComment(ctx, &p.Request{Str: req.Str})
+	muted, err := s.mutedUsers(ctx, req.ViewerId)
 	if err != nil {
-		log.Println("failed to get post in get comments in service: ", err)
+		s.reqLog(ctx).Error("failed to get muted users in get comments in service", logger.Error(err))
 		return &c.CommentsResponse{}, err
 	}
 
-	for _, comment := range coms.Comments {
-		user, err := s.Client.User().GetUserForClient(ctx, &u.Request{Str: comment.UserId})
-		if err != nil {
-			log.Println("failed to get user in get comments in service: ", err)
-			return &c.CommentsResponse{}, err
+	for _, val := range res {
+		if (val.ModerationStatus != repo.ModerationVisible || val.AuthorShadowBanned) && val.UserId != req.ViewerId {
+			continue
+		}
+		if muted[val.UserId] {
+			continue
 		}
-		comment.UserName = user.FirstName + " " + user.LastName
-		comment.UserType = user.UserType
+		coms.Comments = append(coms.Comments, &c.CommentResponse{Id: val.Id, PostId: val.PostId, UserId: val.UserId, Text: val.Text, ParentId: val.ParentId, CreatedAt: val.CreatedAt, ModerationStatus: val.ModerationStatus})
+	}
+
+	if err = s.fillNames(ctx, post, coms.Comments...); err != nil {
+		s.reqLog(ctx).Error("failed to get users in get comments in service", logger.Error(err))
+		return &c.CommentsResponse{}, err
 	}
 
-	postUser, err := s.Client.User().GetUserForClient(ctx, &u.Request{Str: post.UserId})
+	return &coms, nil
+}
+
+func (s *CommentService) GetCommentsForPost(ctx context.Context, req *c.Request) (*c.CommentsResponse, error) {
+	coms := c.CommentsResponse{}
+
+	res, err := s.storage.Comment().GetComments(ctx, req.Str)
 	if err != nil {
-		log.Println("failed to get post user in get comments in service: ", err)
+		s.reqLog(ctx).Error("failed to get comments for post in service", logger.Error(err))
 		return &c.CommentsResponse{}, err
 	}
 
-	for _, comment := range coms.Comments {
-		comment.PostTitle = post.Title
-		comment.PostUserName = postUser.FirstName + " " + postUser.LastName
+	for _, val := range res {
+		if val.ModerationStatus != repo.ModerationVisible || val.AuthorShadowBanned {
+			continue
+		}
+		coms.Comments = append(coms.Comments, &c.CommentResponse{Id: val.Id, PostId: val.PostId, UserId: val.UserId, Text: val.Text, ParentId: val.ParentId, CreatedAt: val.CreatedAt})
 	}
 
 	return &coms, nil
 }
 
-func (s *CommentService) GetCommentsForPost(ctx context.Context, req *c.Request) (*c.CommentsResponse, error) {
+// GetCommentsByUser returns comments of the user with post titles, it is
+// used to export data of the user
+func (s *CommentService) GetCommentsByUser(ctx context.Context, req *c.Request) (*c.CommentsResponse, error) {
 	coms := c.CommentsResponse{}
 
-	res, err := s.storage.Comment().GetComments(req.Str)
+	res, err := s.storage.Comment().GetCommentsByUser(ctx, req.Str)
 	if err != nil {
-		log.Println("failed to get comments for post in service: ", err)
+		s.reqLog(ctx).Error("failed to get comments by user in service", logger.Error(err))
 		return &c.CommentsResponse{}, err
 	}
 
+	postIds := []string{}
 	for _, val := range res {
-		coms.Comments = append(coms.Comments, &c.CommentResponse{Id: val.Id, PostId: val.PostId, UserId: val.UserId, Text: val.Text, CreatedAt: val.CreatedAt})
+		coms.Comments = append(coms.Comments, &c.CommentResponse{Id: val.Id, PostId: val.PostId, UserId: val.UserId, Text: val.Text, ParentId: val.ParentId, CreatedAt: val.CreatedAt})
+		postIds = append(postIds, val.PostId)
+	}
+
+	posts, err := s.postLoader().LoadMany(ctx, postIds)
+	if err != nil {
+		s.reqLog(ctx).Error("failed to get posts for comments by user in service", logger.Error(err))
+		return &c.CommentsResponse{}, err
+	}
+
+	for _, comment := range coms.Comments {
+		if post, ok := posts[comment.PostId]; ok {
+			comment.PostTitle = post.Title
+		}
 	}
 
 	return &coms, nil
 }
 
+// CountCommentsForPosts lets post service count comments of many posts
+// with one call
+func (s *CommentService) CountCommentsForPosts(ctx context.Context, req *c.IdsRequest) (*c.CommentCountsResponse, error) {
+	if len(req.Ids) == 0 {
+		return &c.CommentCountsResponse{}, nil
+	}
+
+	res, err := s.storage.Comment().CountCommentsForPosts(ctx, req.Ids)
+	if err != nil {
+		s.reqLog(ctx).Error("failed to count comments for posts in service", logger.Error(err))
+		return &c.CommentCountsResponse{}, err
+	}
+
+	return &c.CommentCountsResponse{Counts: res}, nil
+}
+
+// fillNames sets post title and names of authors of the comments and the
+// post, all users are got with one call
+func (s *CommentService) fillNames(ctx context.Context, post *p.PostResponse, comments ...*c.CommentResponse) error {
+	ids := []string{post.UserId}
+	for _, comment := range comments {
+		ids = append(ids, comment.UserId)
+	}
+
+	users, err := s.userLoader().LoadMany(ctx, ids)
+	if err != nil {
+		return err
+	}
+
+	for _, comment := range comments {
+		comment.PostTitle = post.Title
+		comment.PostUserName = fullName(users[post.UserId])
+		comment.UserName = fullName(users[comment.UserId])
+		if user, ok := users[comment.UserId]; ok {
+			comment.UserType = user.UserType
+		}
+	}
+
+	return nil
+}
+
 func (s *CommentService) DeleteComment(ctx context.Context, id *c.Request) (*c.CommentResponse, error) {
+	comment, err := s.storage.Comment().GetComment(ctx, id.Str)
+	if err == sql.ErrNoRows {
+		return &c.CommentResponse{}, status.Error(codes.NotFound, "comment not found")
+	} else if err != nil {
+		s.reqLog(ctx).Error("failed to get comment in delete comment service", logger.Error(err))
+		return &c.CommentResponse{}, err
+	}
+	if _, err := checkAuthor(ctx, comment.UserId); err != nil {
+		return &c.CommentResponse{}, err
+	}
+
+	return s.deleteComment(ctx, id.Str)
+}
+
+// deleteComment deletes the comment without checking the caller, moderators
+// remove comments of others with it
+func (s *CommentService) deleteComment(ctx context.Context, id string) (*c.CommentResponse, error) {
 	comRes := c.CommentResponse{}
-	res, err := s.storage.Comment().DeleteComment(id.Str)
+	res, err := s.storage.Comment().DeleteComment(ctx, id)
 	if err != nil {
-		log.Println("failed to delete comment service: ", err)
+		s.reqLog(ctx).Error("failed to delete comment service", logger.Error(err))
 		return &c.CommentResponse{}, err
 	}
 
@@ -149,25 +322,14 @@ func (s *CommentService) DeleteComment(ctx context.Context, id *c.Request) (*c.C
 
 	post, err := s.Client.Post().GetPostForComment(ctx, &p.Request{Str: res.PostId})
 	if err != nil {
-		log.Println("failed to get post in delete comment service: ", err)
-		return &c.CommentResponse{}, err
-	}
-	comRes.PostTitle = post.Title
-
-	user, err := s.Client.User().GetUserForClient(ctx, &u.Request{Str: res.UserId})
-	if err != nil {
-		log.Println("failed to get user in delete comment service: ", err)
+		s.reqLog(ctx).Error("failed to get post in delete comment service", logger.Error(err))
 		return &c.CommentResponse{}, err
 	}
-	comRes.UserName = user.FirstName + " " + user.LastName
-	comRes.UserType = user.UserType
 
-	postUser, err := s.Client.User().GetUserForClient(ctx, &u.Request{Str: post.UserId})
-	if err != nil {
-		log.Println("failed to get post user in delete comment service: ", err)
+	if err = s.fillNames(ctx, post, &comRes); err != nil {
+		s.reqLog(ctx).Error("failed to get users in delete comment service", logger.Error(err))
 		return &c.CommentResponse{}, err
 	}
-	comRes.PostUserName = postUser.FirstName + " " + postUser.LastName
 
 	return &comRes, nil
 }
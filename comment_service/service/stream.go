@@ -0,0 +1,26 @@
+package service
+
+import (
+	c "github.com/burxondv/new-services/comment-service/genproto/comment"
+)
+
+// events are dropped for a stream which falls behind by more than streamBuffer
+const streamBuffer = 64
+
+// StreamComments sends comments written after the call until the client
+// cancels the stream
+func (s *CommentService) StreamComments(req *c.StreamRequest, stream c.CommentService_StreamCommentsServer) error {
+	comments, cancel := s.comments.Subscribe(req.PostId)
+	defer cancel()
+
+	for {
+		select {
+		case <-stream.Context().Done():
+			return nil
+		case comment := <-comments:
+			if err := stream.Send(comment); err != nil {
+				return err
+			}
+		}
+	}
+}
@@ -1,42 +1,94 @@
 package postgres
 
 import (
-	"log"
+	"context"
+	"database/sql"
 	"time"
 
+	"github.com/burxondv/new-services/comment-service/pkg/events"
+	"github.com/burxondv/new-services/comment-service/pkg/logger"
 	"github.com/burxondv/new-services/comment-service/storage/repo"
+
+	"github.com/lib/pq"
 )
 
-func (r *CommentRepo) WriteComment(comment repo.Comment) (repo.Comment, error) {
+// authorShadowBanned is true for comments of shadow banned users, ban without
+// until does not expire
+const authorShadowBanned = `exists(select 1 from shadow_bans sb where sb.user_id = comments.user_id and (sb.until is null or sb.until > timezone('utc', now())))`
+
+func (r *CommentRepo) WriteComment(ctx context.Context, comment repo.Comment) (repo.Comment, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return repo.Comment{}, err
+	}
+	defer tx.Rollback()
+
 	var res repo.Comment
-	err := r.db.QueryRow(`
+	err = tx.QueryRowContext(ctx, `
 		insert into 
-			comments(id, post_id, user_id, text)
+			comments(id, post_id, user_id, text, parent_id, moderation_status)
 		values
-			($1, $2, $3, $4) 
+			($1, $2, $3, $4, $5, coalesce($6, 'visible')) 
 		returning 
-			id, post_id, user_id, text, created_at`, comment.Id, comment.PostId, comment.UserId, comment.Text).Scan(&res.Id, &res.PostId, &res.UserId, &res.Text, &res.CreatedAt)
+			id, post_id, user_id, text, coalesce(parent_id::text, ''), created_at, moderation_status`, comment.Id, comment.PostId, comment.UserId, comment.Text, nullString(comment.ParentId), nullString(comment.ModerationStatus)).Scan(&res.Id, &res.PostId, &res.UserId, &res.Text, &res.ParentId, &res.CreatedAt, &res.ModerationStatus)
+
+	if err != nil {
+		logger.WithContext(r.log, ctx).Error("failed to create comment in sql", logger.Error(err))
+		return repo.Comment{}, err
+	}
+
+	err = insertEvent(ctx, tx, events.CommentWritten, res.Id, events.Comment{
+		Id:       res.Id,
+		PostId:   res.PostId,
+		UserId:   res.UserId,
+		ParentId: res.ParentId,
+	})
+	if err != nil {
+		logger.WithContext(r.log, ctx).Error("failed to create comment written event in sql", logger.Error(err))
+		return repo.Comment{}, err
+	}
+
+	if res.ModerationStatus == repo.ModerationHeld {
+		err = insertEvent(ctx, tx, events.CommentHeld, res.Id, events.Held{Id: res.Id, UserId: res.UserId, Labels: comment.ModerationLabels})
+		if err != nil {
+			logger.WithContext(r.log, ctx).Error("failed to create comment held event in sql", logger.Error(err))
+			return repo.Comment{}, err
+		}
+	}
+
+	return res, tx.Commit()
+}
+
+func (r *CommentRepo) GetComment(ctx context.Context, id string) (repo.Comment, error) {
+	var res repo.Comment
+	err := r.db.QueryRowContext(ctx, `
+		select 
+			id, post_id, user_id, text, coalesce(parent_id::text, ''), created_at, moderation_status 
+		from 
+			comments 
+		where 
+			id = $1 and deleted_at is null`, id).Scan(&res.Id, &res.PostId, &res.UserId, &res.Text, &res.ParentId, &res.CreatedAt, &res.ModerationStatus)
 
 	if err != nil {
-		log.Println("failed to create comment in sql: ", err)
+		logger.WithContext(r.log, ctx).Error("failed to get comment in sql", logger.Error(err))
 		return repo.Comment{}, err
 	}
 
 	return res, nil
 }
 
-func (r *CommentRepo) GetComments(id string) ([]repo.Comment, error) {
+func (r *CommentRepo) GetComments(ctx context.Context, id string) ([]repo.Comment, error) {
 	var res []repo.Comment
-	rows, err := r.db.Query(`
+	rows, err := r.db.QueryContext(ctx, `
 		select 
-			id, post_id, user_id, text, created_at 
+			id, post_id, user_id, text, coalesce(parent_id::text, ''), created_at, moderation_status, `+authorShadowBanned+`
 		from 
 			comments 
 		where 
 			post_id = $1 and deleted_at is null`, id)
 
 	if err != nil {
-		log.Println("failed to get comment in sql: ", err)
+		logger.WithContext(r.log, ctx).Error("failed to get comment in sql", logger.Error(err))
 		return []repo.Comment{}, nil
 	}
 
@@ -48,11 +100,14 @@ func (r *CommentRepo) GetComments(id string) ([]repo.Comment, error) {
 			&comment.PostId,
 			&comment.UserId,
 			&comment.Text,
+			&comment.ParentId,
 			&comment.CreatedAt,
+			&comment.ModerationStatus,
+			&comment.AuthorShadowBanned,
 		)
 
 		if err != nil {
-			log.Println("failed to scanning comment in sql: ", err)
+			logger.WithContext(r.log, ctx).Error("failed to scanning comment in sql", logger.Error(err))
 			return []repo.Comment{}, err
 		}
 
@@ -62,9 +117,106 @@ func (r *CommentRepo) GetComments(id string) ([]repo.Comment, error) {
 	return res, nil
 }
 
-func (r *CommentRepo) DeleteComment(id string) (repo.Comment, error) {
+// GetCommentsByUser returns comments written by the user, newest first
+func (r *CommentRepo) GetCommentsByUser(ctx context.Context, userId string) ([]repo.Comment, error) {
+	var res []repo.Comment
+	rows, err := r.db.QueryContext(ctx, `
+		select 
+			id, post_id, user_id, text, coalesce(parent_id::text, ''), created_at, moderation_status 
+		from 
+			comments 
+		where 
+			user_id = $1 and deleted_at is null
+		order by
+			created_at desc`, userId)
+
+	if err != nil {
+		logger.WithContext(r.log, ctx).Error("failed to get comments by user in sql", logger.Error(err))
+		return []repo.Comment{}, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		comment := repo.Comment{}
+
+		err = rows.Scan(
+			&comment.Id,
+			&comment.PostId,
+			&comment.UserId,
+			&comment.Text,
+			&comment.ParentId,
+			&comment.CreatedAt,
+			&comment.ModerationStatus,
+		)
+
+		if err != nil {
+			logger.WithContext(r.log, ctx).Error("failed to scanning comment in sql", logger.Error(err))
+			return []repo.Comment{}, err
+		}
+
+		res = append(res, comment)
+	}
+
+	return res, rows.Err()
+}
+
+// CountCommentsForPosts counts comments of many posts with one query, posts
+// without comments are missing in the result
+func (r *CommentRepo) CountCommentsForPosts(ctx context.Context, postIds []string) (map[string]int64, error) {
+	rows, err := r.db.QueryContext(ctx, `
+		select
+			post_id, count(*)
+		from
+			comments
+		where
+			post_id = any($1::uuid[]) and moderation_status = 'visible' and deleted_at is null and not `+authorShadowBanned+`
+		group by post_id`, pq.Array(postIds))
+	if err != nil {
+		logger.WithContext(r.log, ctx).Error("failed to count comments for posts in sql", logger.Error(err))
+		return map[string]int64{}, err
+	}
+	defer rows.Close()
+
+	res := map[string]int64{}
+	for rows.Next() {
+		var (
+			postId string
+			count  int64
+		)
+		if err = rows.Scan(&postId, &count); err != nil {
+			logger.WithContext(r.log, ctx).Error("failed to scanning comments count in sql", logger.Error(err))
+			return map[string]int64{}, err
+		}
+
+		res[postId] = count
+	}
+
+	return res, rows.Err()
+}
+
+// ModerateComment sets moderation status of the comment
+func (r *CommentRepo) ModerateComment(ctx context.Context, id, status string) (repo.Comment, error) {
 	var res repo.Comment
-	err := r.db.QueryRow(`
+	err := r.db.QueryRowContext(ctx, `
+		update
+			comments
+		set
+			moderation_status = $1
+		where
+			id = $2 and deleted_at is null
+		returning
+			id, post_id, user_id, text, coalesce(parent_id::text, ''), created_at, moderation_status`, status, id).Scan(&res.Id, &res.PostId, &res.UserId, &res.Text, &res.ParentId, &res.CreatedAt, &res.ModerationStatus)
+	if err != nil {
+		logger.WithContext(r.log, ctx).Error("failed to moderate comment in sql", logger.Error(err))
+		return repo.Comment{}, err
+	}
+
+	return res, nil
+}
+
+func (r *CommentRepo) DeleteComment(ctx context.Context, id string) (repo.Comment, error) {
+	var res repo.Comment
+	err := r.db.QueryRowContext(ctx, `
 		update 
 			comments 
 		set 
@@ -75,8 +227,157 @@ func (r *CommentRepo) DeleteComment(id string) (repo.Comment, error) {
 			id, post_id, user_id, text, created_at`, time.Now(), id).Scan(&res.Id, &res.PostId, &res.UserId, &res.Text, &res.CreatedAt)
 
 	if err != nil {
-		log.Println("failed to delete comment in sql", err)
+		logger.WithContext(r.log, ctx).Error("failed to delete comment in sql", logger.Error(err))
 	}
 
 	return res, nil
 }
+
+// DeleteUserComments deletes comments of deleted user once per event
+func (r *CommentRepo) DeleteUserComments(ctx context.Context, eventId, userId string) (int64, error) {
+	return r.deleteComments(ctx, eventId, "user_id", userId)
+}
+
+// DeletePostComments deletes comments of deleted post once per event
+func (r *CommentRepo) DeletePostComments(ctx context.Context, eventId, postId string) (int64, error) {
+	return r.deleteComments(ctx, eventId, "post_id", postId)
+}
+
+func (r *CommentRepo) deleteComments(ctx context.Context, eventId, column, value string) (int64, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, err
+	}
+	defer tx.Rollback()
+
+	ok, err := markProcessed(ctx, tx, eventId)
+	if err != nil {
+		logger.WithContext(r.log, ctx).Error("failed to mark event processed in sql", logger.Error(err))
+		return 0, err
+	}
+	if !ok {
+		return 0, nil
+	}
+
+	res, err := tx.ExecContext(ctx, `
+		update
+			comments
+		set
+			deleted_at = $1
+		where
+			`+column+` = $2 and deleted_at is null`, time.Now(), value)
+	if err != nil {
+		logger.WithContext(r.log, ctx).Error("failed to delete comments in sql", logger.String("column", column), logger.Error(err))
+		return 0, err
+	}
+
+	deleted, err := res.RowsAffected()
+	if err != nil {
+		return 0, err
+	}
+
+	return deleted, tx.Commit()
+}
+
+// PurgeUserComments erases comments of purged user once per event, replies
+// of other users to erased comments become top level comments
+func (r *CommentRepo) PurgeUserComments(ctx context.Context, eventId, userId string) (int64, error) {
+	return r.purgeComments(ctx, eventId, "user_id", userId)
+}
+
+// PurgePostComments erases comments of purged post once per event
+func (r *CommentRepo) PurgePostComments(ctx context.Context, eventId, postId string) (int64, error) {
+	return r.purgeComments(ctx, eventId, "post_id", postId)
+}
+
+func (r *CommentRepo) purgeComments(ctx context.Context, eventId, column, value string) (int64, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, err
+	}
+	defer tx.Rollback()
+
+	ok, err := markProcessed(ctx, tx, eventId)
+	if err != nil {
+		logger.WithContext(r.log, ctx).Error("failed to mark event processed in sql", logger.Error(err))
+		return 0, err
+	}
+	if !ok {
+		return 0, nil
+	}
+
+	_, err = tx.ExecContext(ctx, `
+		update
+			comments
+		set
+			parent_id = null
+		where
+			parent_id in (select id from comments where `+column+` = $1)`, value)
+	if err != nil {
+		logger.WithContext(r.log, ctx).Error("failed to detach replies in sql", logger.String("column", column), logger.Error(err))
+		return 0, err
+	}
+
+	res, err := tx.ExecContext(ctx, `delete from comments where `+column+` = $1`, value)
+	if err != nil {
+		logger.WithContext(r.log, ctx).Error("failed to purge comments in sql", logger.String("column", column), logger.Error(err))
+		return 0, err
+	}
+
+	purged, err := res.RowsAffected()
+	if err != nil {
+		return 0, err
+	}
+
+	if column == "user_id" {
+		_, err = tx.ExecContext(ctx, `delete from shadow_bans where user_id = $1`, value)
+		if err != nil {
+			logger.WithContext(r.log, ctx).Error("failed to purge shadow ban of user in sql", logger.Error(err))
+			return 0, err
+		}
+	}
+
+	return purged, tx.Commit()
+}
+
+// SetShadowBan saves or removes shadow ban of the user once per event, zero
+// until does not expire. It reports whether the event was handled.
+func (r *CommentRepo) SetShadowBan(ctx context.Context, eventId, userId string, banned bool, until time.Time) (bool, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return false, err
+	}
+	defer tx.Rollback()
+
+	ok, err := markProcessed(ctx, tx, eventId)
+	if err != nil {
+		logger.WithContext(r.log, ctx).Error("failed to mark event processed in sql", logger.Error(err))
+		return false, err
+	}
+	if !ok {
+		return false, nil
+	}
+
+	if banned {
+		_, err = tx.ExecContext(ctx, `
+			insert into
+				shadow_bans(user_id, until)
+			values
+				($1, $2)
+			on conflict (user_id) do update
+			set
+				until = excluded.until`, userId, sql.NullTime{Time: until, Valid: !until.IsZero()})
+	} else {
+		_, err = tx.ExecContext(ctx, `delete from shadow_bans where user_id = $1`, userId)
+	}
+	if err != nil {
+		logger.WithContext(r.log, ctx).Error("failed to set shadow ban in sql", logger.Error(err))
+		return false, err
+	}
+
+	return true, tx.Commit()
+}
+
+func nullString(str string) sql.NullString {
+	return sql.NullString{String: str, Valid: str != ""}
+}
@@ -0,0 +1,153 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"sort"
+	"time"
+
+	"github.com/burxondv/new-services/comment-service/pkg/events"
+	"github.com/burxondv/new-services/comment-service/pkg/logger"
+
+	"github.com/lib/pq"
+)
+
+// outboxClaimLease is how long claimed events are not relayed by others
+const outboxClaimLease = time.Minute
+
+// insertEvent writes event to outbox in the transaction of the change, so the
+// event is published only if the change is committed
+func insertEvent(ctx context.Context, tx *sql.Tx, eventType, aggregateId string, payload interface{}) error {
+	event, err := events.New(eventType, aggregateId, payload)
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.ExecContext(ctx, `
+		insert into
+			outbox(id, type, aggregate_id, payload, created_at)
+		values
+			($1, $2, $3, $4, $5)`, event.Id, event.Type, event.AggregateId, []byte(event.Payload), event.OccurredAt)
+
+	return err
+}
+
+// markProcessed returns false if the event was already handled, consumers
+// check it in the transaction of their change
+func markProcessed(ctx context.Context, tx *sql.Tx, eventId string) (bool, error) {
+	res, err := tx.ExecContext(ctx, `
+		insert into
+			processed_events(event_id)
+		values
+			($1)
+		on conflict do nothing`, eventId)
+	if err != nil {
+		return false, err
+	}
+
+	rows, err := res.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+
+	return rows > 0, nil
+}
+
+// RelayEvents publishes unpublished events in order of creation. Events are
+// claimed for outboxClaimLease and published after the claim is committed, so
+// a slow broker doesn't hold row locks and a connection. Events of a relay
+// which stopped before marking them are claimed again when the lease ends,
+// every event is published at least once.
+func (r *OutboxRepo) RelayEvents(ctx context.Context, limit int, publish func(events.Event) error) (int, error) {
+	now := time.Now().UTC()
+	rows, err := r.db.QueryContext(ctx, `
+		update
+			outbox
+		set
+			claimed_until = $2
+		where id in (
+			select
+				id
+			from
+				outbox
+			where
+				published_at is null and (claimed_until is null or claimed_until < $3)
+			order by created_at
+			limit $1
+			for update skip locked
+		)
+		returning
+			id, type, aggregate_id, payload, created_at`, limit, now.Add(outboxClaimLease), now)
+	if err != nil {
+		logger.WithContext(r.log, ctx).Error("failed to claim outbox events in sql", logger.Error(err))
+		return 0, err
+	}
+
+	pending := []events.Event{}
+	for rows.Next() {
+		event := events.Event{}
+		var payload []byte
+		err = rows.Scan(&event.Id, &event.Type, &event.AggregateId, &payload, &event.OccurredAt)
+		if err != nil {
+			rows.Close()
+			logger.WithContext(r.log, ctx).Error("failed to scanning outbox events in sql", logger.Error(err))
+			return 0, err
+		}
+		event.Payload = payload
+
+		pending = append(pending, event)
+	}
+	rows.Close()
+	if err = rows.Err(); err != nil {
+		return 0, err
+	}
+
+	// returning doesn't keep the order of the subquery
+	sort.SliceStable(pending, func(i, j int) bool {
+		return pending[i].OccurredAt.Before(pending[j].OccurredAt)
+	})
+
+	published := []string{}
+	var publishErr error
+	for _, event := range pending {
+		if publishErr = publish(event); publishErr != nil {
+			break
+		}
+		published = append(published, event.Id)
+	}
+
+	if len(published) > 0 {
+		_, err = r.db.ExecContext(ctx, `
+			update
+				outbox
+			set
+				published_at = $1
+			where
+				id = any($2)`, time.Now().UTC(), pq.Array(published))
+		if err != nil {
+			logger.WithContext(r.log, ctx).Error("failed to mark outbox events published in sql", logger.Error(err))
+			return 0, err
+		}
+	}
+
+	if rest := pending[len(published):]; len(rest) > 0 {
+		// events which were not published are relayed again without waiting
+		// for the lease
+		ids := []string{}
+		for _, event := range rest {
+			ids = append(ids, event.Id)
+		}
+		_, err = r.db.ExecContext(ctx, `
+			update
+				outbox
+			set
+				claimed_until = null
+			where
+				id = any($1)`, pq.Array(ids))
+		if err != nil {
+			logger.WithContext(r.log, ctx).Error("failed to release outbox events in sql", logger.Error(err))
+		}
+	}
+
+	return len(published), publishErr
+}
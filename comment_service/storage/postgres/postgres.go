@@ -1,13 +1,31 @@
 package postgres
 
-import "github.com/jmoiron/sqlx"
+import (
+	"github.com/burxondv/new-services/comment-service/pkg/logger"
+
+	"github.com/jmoiron/sqlx"
+)
 
 type CommentRepo struct {
-	db *sqlx.DB
+	db  *sqlx.DB
+	log logger.Logger
 }
 
-func NewCommentRepo(db *sqlx.DB) *CommentRepo {
+func NewCommentRepo(db *sqlx.DB, log logger.Logger) *CommentRepo {
 	return &CommentRepo{
-		db: db,
+		db:  db,
+		log: log,
+	}
+}
+
+type OutboxRepo struct {
+	db  *sqlx.DB
+	log logger.Logger
+}
+
+func NewOutboxRepo(db *sqlx.DB, log logger.Logger) *OutboxRepo {
+	return &OutboxRepo{
+		db:  db,
+		log: log,
 	}
 }
@@ -8,5 +8,18 @@ type Comment struct {
 	UserName     string
 	PostUserName string
 	Text         string
+	ParentId     string
 	CreatedAt    string
+
+	ModerationStatus string
+	ModerationLabels []string // labels of classifier rules which held the comment, not stored
+
+	AuthorShadowBanned bool // comments of shadow banned users are shown only to them, not stored
 }
+
+// moderation statuses, not visible comments are shown only to their authors...
+const (
+	ModerationVisible = "visible"
+	ModerationHeld    = "held" // waits for review
+	ModerationHidden  = "hidden"
+)
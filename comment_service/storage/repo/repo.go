@@ -1,7 +1,29 @@
 package repo
 
+import (
+	"context"
+	"time"
+
+	"github.com/burxondv/new-services/comment-service/pkg/events"
+)
+
 type CommentStorageI interface {
-	WriteComment(Comment) (Comment, error)
-	GetComments(id string) ([]Comment, error)
-	DeleteComment(id string) (Comment, error)
+	WriteComment(context.Context, Comment) (Comment, error)
+	GetComment(ctx context.Context, id string) (Comment, error)
+	GetComments(ctx context.Context, id string) ([]Comment, error)
+	GetCommentsByUser(ctx context.Context, userId string) ([]Comment, error)
+	CountCommentsForPosts(ctx context.Context, postIds []string) (map[string]int64, error)
+	DeleteComment(ctx context.Context, id string) (Comment, error)
+	ModerateComment(ctx context.Context, id, status string) (Comment, error)
+
+	// events...
+	DeleteUserComments(ctx context.Context, eventId, userId string) (int64, error)
+	DeletePostComments(ctx context.Context, eventId, postId string) (int64, error)
+	PurgeUserComments(ctx context.Context, eventId, userId string) (int64, error)
+	PurgePostComments(ctx context.Context, eventId, postId string) (int64, error)
+	SetShadowBan(ctx context.Context, eventId, userId string, banned bool, until time.Time) (bool, error)
+}
+
+type OutboxStorageI interface {
+	RelayEvents(ctx context.Context, limit int, publish func(events.Event) error) (int, error)
 }
@@ -1,6 +1,7 @@
 package storage
 
 import (
+	"github.com/burxondv/new-services/comment-service/pkg/logger"
 	"github.com/burxondv/new-services/comment-service/storage/postgres"
 	"github.com/burxondv/new-services/comment-service/storage/repo"
 
@@ -9,20 +10,27 @@ import (
 
 type IStorage interface {
 	Comment() repo.CommentStorageI
+	Outbox() repo.OutboxStorageI
 }
 
 type storagePg struct {
 	db          *sqlx.DB
 	commentRepo repo.CommentStorageI
+	outboxRepo  repo.OutboxStorageI
 }
 
-func NewStoragePg(db *sqlx.DB) *storagePg {
+func NewStoragePg(db *sqlx.DB, log logger.Logger) *storagePg {
 	return &storagePg{
 		db:          db,
-		commentRepo: postgres.NewCommentRepo(db),
+		commentRepo: postgres.NewCommentRepo(db, log),
+		outboxRepo:  postgres.NewOutboxRepo(db, log),
 	}
 }
 
 func (s storagePg) Comment() repo.CommentStorageI {
 	return s.commentRepo
 }
+
+func (s storagePg) Outbox() repo.OutboxStorageI {
+	return s.outboxRepo
+}
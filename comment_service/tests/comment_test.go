@@ -5,6 +5,7 @@ import (
 
 	"github.com/burxondv/new-services/comment-service/config"
 	"github.com/burxondv/new-services/comment-service/pkg/db"
+	"github.com/burxondv/new-services/comment-service/pkg/logger"
 	"github.com/burxondv/new-services/comment-service/storage/postgres"
 	"github.com/burxondv/new-services/comment-service/storage/repo"
 
@@ -19,7 +20,7 @@ type CommentSuiteTest struct {
 
 func (s *CommentSuiteTest) SetupSuite() {
 	pgPool, cleanUpfunc := db.ConnectToDBForSuite(config.Load())
-	s.repo = postgres.NewCommentRepo(pgPool)
+	s.repo = postgres.NewCommentRepo(pgPool, logger.New("debug", "test"))
 	s.CleanUpfunc = cleanUpfunc
 }
 
@@ -0,0 +1,67 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/burxondv/new-services/comment-service/pkg/loader"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoader_LoadMany(t *testing.T) {
+	calls := [][]string{}
+	l := loader.New(func(ctx context.Context, keys []string) (map[string]int, error) {
+		calls = append(calls, keys)
+
+		res := map[string]int{}
+		for _, key := range keys {
+			if key != "missing" {
+				res[key] = len(key)
+			}
+		}
+		return res, nil
+	})
+
+	res, err := l.LoadMany(context.Background(), []string{"a", "bb", "a", "missing"})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]int{"a": 1, "bb": 2}, res)
+
+	// loaded and missing keys are not fetched again
+	val, ok, err := l.Load(context.Background(), "bb")
+	require.NoError(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, 2, val)
+
+	_, ok, err = l.Load(context.Background(), "missing")
+	require.NoError(t, err)
+	assert.False(t, ok)
+
+	res, err = l.LoadMany(context.Background(), []string{"a", "ccc"})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]int{"a": 1, "ccc": 3}, res)
+
+	assert.Equal(t, [][]string{{"a", "bb", "missing"}, {"ccc"}}, calls)
+}
+
+func TestLoader_Error(t *testing.T) {
+	fail := true
+	l := loader.New(func(ctx context.Context, keys []string) (map[string]string, error) {
+		if fail {
+			return nil, errors.New("unavailable")
+		}
+		return map[string]string{"a": "A"}, nil
+	})
+
+	_, err := l.LoadMany(context.Background(), []string{"a"})
+	assert.Error(t, err)
+
+	// failed keys are fetched again
+	fail = false
+	val, ok, err := l.Load(context.Background(), "a")
+	require.NoError(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, "A", val)
+}
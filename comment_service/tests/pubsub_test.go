@@ -0,0 +1,39 @@
+package postgres
+
+import (
+	"testing"
+
+	"github.com/burxondv/new-services/comment-service/pkg/pubsub"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPubsub_Hub(t *testing.T) {
+	hub := pubsub.NewHub[string](1)
+
+	post, cancelPost := hub.Subscribe("post-1")
+	all, cancelAll := hub.Subscribe("")
+	assert.Equal(t, 2, hub.Subscribers("post-1"))
+	assert.Equal(t, 1, hub.Subscribers("post-2"))
+
+	hub.Publish("post-1", "first")
+	hub.Publish("post-2", "second")
+
+	assert.Equal(t, "first", <-post)
+	assert.Equal(t, "first", <-all)
+	// buffer of the subscriber is full, second event is dropped
+	select {
+	case event := <-all:
+		t.Fatalf("unexpected event %q", event)
+	default:
+	}
+
+	cancelPost()
+	cancelPost()
+	_, ok := <-post
+	assert.False(t, ok)
+	assert.Equal(t, 1, hub.Subscribers("post-1"))
+
+	cancelAll()
+	assert.Equal(t, 0, hub.Subscribers("post-1"))
+}
@@ -0,0 +1,31 @@
+create table "cases"(
+    "id" uuid primary key,
+    "target_type" text not null,
+    "target_id" uuid not null,
+    "owner_id" uuid,
+    "status" text not null default 'open',
+    "source" text not null,
+    "reasons" text[] not null default '{}',
+    "assignee_id" uuid,
+    "action" text not null default '',
+    "note" text not null default '',
+    "created_at" timestamp default current_timestamp,
+    "updated_at" timestamp default current_timestamp,
+    "resolved_at" timestamp
+);
+
+-- reports of the same content are collected in one case until it is resolved
+create unique index "cases_target_idx" on "cases"("target_type", "target_id") where "status" <> 'resolved';
+create index "cases_queue_idx" on "cases"("status", "created_at");
+
+create table "reports"(
+    "id" uuid primary key,
+    "case_id" uuid not null references "cases"("id") on delete cascade,
+    "reporter_id" uuid not null,
+    "reason" text not null,
+    "details" text not null default '',
+    "created_at" timestamp default current_timestamp,
+    unique("case_id", "reporter_id")
+);
+
+create index "reports_reporter_id_idx" on "reports"("reporter_id");
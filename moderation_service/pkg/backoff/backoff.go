@@ -0,0 +1,44 @@
+package backoff
+
+import (
+	"context"
+	"math/rand"
+	"time"
+)
+
+const (
+	initialDelay = 500 * time.Millisecond
+	maxDelay     = 10 * time.Second
+)
+
+// Wait calls check until it succeeds, the delay between attempts doubles up
+// to maxDelay. It gives up with the last error when ctx is done or timeout
+// passes. onRetry is called before every delay, it may be nil.
+func Wait(ctx context.Context, timeout time.Duration, check func(context.Context) error, onRetry func(err error, delay time.Duration)) error {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	delay := initialDelay
+	for {
+		err := check(ctx)
+		if err == nil {
+			return nil
+		}
+
+		// jitter spreads attempts of instances started together
+		wait := delay/2 + time.Duration(rand.Int63n(int64(delay/2)+1))
+		if onRetry != nil {
+			onRetry(err, wait)
+		}
+		select {
+		case <-ctx.Done():
+			return err
+		case <-time.After(wait):
+		}
+
+		delay *= 2
+		if delay > maxDelay {
+			delay = maxDelay
+		}
+	}
+}
@@ -0,0 +1,49 @@
+package db
+
+import (
+	"fmt"
+
+	"github.com/burxondv/new-services/moderation-service/config"
+	"github.com/burxondv/new-services/moderation-service/pkg/tracing"
+
+	"github.com/jmoiron/sqlx"
+	_ "github.com/lib/pq" //postgres drivers
+)
+
+func ConnectToDB(cfg config.Config) (*sqlx.DB, error) {
+	psqlString := fmt.Sprintf("host=%s port=%s user=%s password=%s dbname=%s sslmode=disable",
+		cfg.PostgresHost,
+		cfg.PostgresPort,
+		cfg.PostgresUser,
+		cfg.PostgresPassword,
+		cfg.PostgresDatabase,
+	)
+
+	connDb, err := tracing.OpenDB("postgres", psqlString)
+	if err != nil {
+		return nil, err
+	}
+
+	return sqlx.NewDb(connDb, "postgres"), nil
+}
+
+func ConnectToDBForSuite(cfg config.Config) (*sqlx.DB, func()) {
+	psqlString := fmt.Sprintf("host=%s port=%s user=%s password=%s dbname=%s sslmode=disable",
+		cfg.PostgresHost,
+		cfg.PostgresPort,
+		cfg.PostgresUser,
+		cfg.PostgresPassword,
+		cfg.PostgresDatabase,
+	)
+
+	connDb, err := sqlx.Open("postgres", psqlString)
+	if err != nil {
+		return nil, func() {}
+	}
+
+	cleanUpfunc := func() {
+		connDb.Close()
+	}
+
+	return connDb, cleanUpfunc
+}
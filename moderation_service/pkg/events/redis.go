@@ -0,0 +1,223 @@
+package events
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/burxondv/new-services/moderation-service/pkg/logger"
+
+	"github.com/gomodule/redigo/redis"
+)
+
+const (
+	// Stream is Redis stream of domain events of all services
+	Stream = "domain_events"
+
+	// stream is trimmed to about streamMaxLen events
+	streamMaxLen = 100000
+
+	readCount  = 10
+	readBlock  = 2 * time.Second
+	retryDelay = time.Second
+
+	// pending events of other consumers idle for claimIdle are taken over,
+	// consumers of replaced pods never come back for them
+	claimIdle     = time.Minute
+	claimInterval = 30 * time.Second
+)
+
+// RedisBus keeps events in Redis Stream, groups are Redis consumer groups
+type RedisBus struct {
+	pool *redis.Pool
+	log  logger.Logger
+}
+
+func NewRedisBus(pool *redis.Pool, log logger.Logger) *RedisBus {
+	return &RedisBus{pool: pool, log: log}
+}
+
+func (b *RedisBus) Publish(ctx context.Context, event Event) error {
+	data, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+
+	conn, err := b.pool.GetContext(ctx)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	_, err = conn.Do("XADD", Stream, "MAXLEN", "~", streamMaxLen, "*", "event", data)
+	return err
+}
+
+func (b *RedisBus) Subscribe(ctx context.Context, group, consumer string, handler Handler) error {
+	conn, err := b.pool.GetContext(ctx)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	// a new group reads the stream from the beginning
+	_, err = conn.Do("XGROUP", "CREATE", Stream, group, "0", "MKSTREAM")
+	if err != nil && !strings.HasPrefix(err.Error(), "BUSYGROUP") {
+		return err
+	}
+
+	attempts := map[string]int{}
+	// pending events are left after failed handlers or crash of the consumer
+	// with the same name, they are handled before new ones
+	start := "0"
+	var lastClaim time.Time
+	for ctx.Err() == nil {
+		if start == ">" && time.Since(lastClaim) >= claimInterval {
+			claimed, err := b.claimStale(conn, group, consumer)
+			if err != nil {
+				return err
+			}
+			lastClaim = time.Now()
+			if claimed > 0 {
+				b.log.Info("claimed pending events of other consumers", logger.Int("count", claimed))
+				start = "0"
+			}
+		}
+
+		args := redis.Args{"GROUP", group, consumer, "COUNT", readCount}
+		if start == ">" {
+			args = args.Add("BLOCK", readBlock.Milliseconds())
+		}
+		reply, err := conn.Do("XREADGROUP", args.Add("STREAMS", Stream, start)...)
+		if err != nil && err != redis.ErrNil {
+			return err
+		}
+
+		messages, err := b.parseMessages(reply)
+		if err != nil {
+			return err
+		}
+		if start == "0" && len(messages) == 0 {
+			start = ">"
+			continue
+		}
+
+		failed := false
+		for _, msg := range messages {
+			err := handler(ctx, msg.event)
+			if err != nil {
+				attempts[msg.id]++
+				if attempts[msg.id] < maxAttempts {
+					b.log.Warn("failed to handle event, will retry", logger.String("event_id", msg.event.Id), logger.Error(err))
+					failed = true
+					continue
+				}
+				b.log.Error("failed to handle event, skipped", logger.String("event_id", msg.event.Id), logger.Error(err))
+			}
+
+			delete(attempts, msg.id)
+			if _, err := conn.Do("XACK", Stream, group, msg.id); err != nil {
+				return err
+			}
+		}
+
+		if failed {
+			start = "0"
+			select {
+			case <-ctx.Done():
+			case <-time.After(retryDelay):
+			}
+		}
+	}
+
+	return ctx.Err()
+}
+
+// claimStale moves pending events which other consumers of the group didn't
+// acknowledge for claimIdle to consumer, they are read then as its own pending
+// events
+func (b *RedisBus) claimStale(conn redis.Conn, group, consumer string) (int, error) {
+	claimed := 0
+	cursor := "0-0"
+	for {
+		// reply is [next cursor, [entries], ...]
+		reply, err := redis.Values(conn.Do("XAUTOCLAIM", Stream, group, consumer, claimIdle.Milliseconds(), cursor, "COUNT", readCount, "JUSTID"))
+		if err != nil {
+			return claimed, err
+		}
+		if len(reply) < 2 {
+			return claimed, fmt.Errorf("unexpected autoclaim reply length %d", len(reply))
+		}
+
+		ids, err := redis.Values(reply[1], nil)
+		if err != nil {
+			return claimed, err
+		}
+		claimed += len(ids)
+
+		cursor, err = redis.String(reply[0], nil)
+		if err != nil || cursor == "0-0" {
+			return claimed, err
+		}
+	}
+}
+
+type message struct {
+	id    string
+	event Event
+}
+
+// parseMessages parses XREADGROUP reply of one stream:
+// [[stream, [[id, [field, value, ...]], ...]]]
+func (b *RedisBus) parseMessages(reply interface{}) ([]message, error) {
+	if reply == nil {
+		return nil, nil
+	}
+
+	streams, err := redis.Values(reply, nil)
+	if err != nil || len(streams) == 0 {
+		return nil, err
+	}
+
+	stream, err := redis.Values(streams[0], nil)
+	if err != nil {
+		return nil, err
+	}
+	if len(stream) != 2 {
+		return nil, fmt.Errorf("unexpected stream reply length %d", len(stream))
+	}
+
+	entries, err := redis.Values(stream[1], nil)
+	if err != nil {
+		return nil, err
+	}
+
+	res := []message{}
+	for _, entry := range entries {
+		vals, err := redis.Values(entry, nil)
+		if err != nil {
+			return nil, err
+		}
+		if len(vals) != 2 {
+			return nil, fmt.Errorf("unexpected stream entry length %d", len(vals))
+		}
+
+		id, err := redis.String(vals[0], nil)
+		if err != nil {
+			return nil, err
+		}
+
+		// fields of deleted entries are nil, they are acknowledged as skipped
+		fields, _ := redis.StringMap(vals[1], nil)
+		msg := message{id: id}
+		if err := json.Unmarshal([]byte(fields["event"]), &msg.event); err != nil {
+			b.log.Error("failed to parse event", logger.String("event_id", id), logger.Error(err))
+		}
+
+		res = append(res, msg)
+	}
+
+	return res, nil
+}
@@ -0,0 +1,109 @@
+package health
+
+import (
+	"context"
+	"time"
+
+	"github.com/burxondv/new-services/moderation-service/pkg/logger"
+
+	"github.com/gomodule/redigo/redis"
+	"google.golang.org/grpc"
+	grpchealth "google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+// checkTimeout limits one round of checks
+const checkTimeout = 3 * time.Second
+
+// Check reports whether a dependency of the service works
+type Check func(ctx context.Context) error
+
+// Server is the standard gRPC health service, its status follows checks of
+// dependencies of the service
+type Server struct {
+	*grpchealth.Server
+	services []string
+	checks   map[string]Check
+	log      logger.Logger
+}
+
+// Register adds health service to s. Status is reported for the server as
+// a whole and for services, which are full names of gRPC services of s.
+func Register(s *grpc.Server, log logger.Logger, checks map[string]Check, services ...string) *Server {
+	hs := &Server{
+		Server:   grpchealth.NewServer(),
+		services: append([]string{""}, services...),
+		checks:   checks,
+		log:      log,
+	}
+	healthpb.RegisterHealthServer(s, hs)
+
+	return hs
+}
+
+// Run checks dependencies every interval until ctx is done, the service is
+// not serving while any of them fails
+func (hs *Server) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		hs.check(ctx)
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (hs *Server) check(ctx context.Context) {
+	ctx, cancel := context.WithTimeout(ctx, checkTimeout)
+	defer cancel()
+
+	status := healthpb.HealthCheckResponse_SERVING
+	for name, check := range hs.checks {
+		if err := check(ctx); err != nil {
+			status = healthpb.HealthCheckResponse_NOT_SERVING
+			hs.log.Warn("health check failed", logger.String("dependency", name), logger.Error(err))
+		}
+	}
+
+	for _, service := range hs.services {
+		hs.SetServingStatus(service, status)
+	}
+}
+
+// Stop reports the service as not serving, so new calls go to other
+// instances, and waits for calls in progress. Calls still running after
+// timeout, like open streams, are canceled.
+func (hs *Server) Stop(s *grpc.Server, timeout time.Duration) {
+	hs.Shutdown()
+
+	done := make(chan struct{})
+	go func() {
+		s.GracefulStop()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(timeout):
+		s.Stop()
+		<-done
+	}
+}
+
+// Redis checks connection to Redis of pool
+func Redis(pool *redis.Pool) Check {
+	return func(ctx context.Context) error {
+		conn, err := pool.GetContext(ctx)
+		if err != nil {
+			return err
+		}
+		defer conn.Close()
+
+		_, err = redis.DoContext(conn, ctx, "PING")
+		return err
+	}
+}
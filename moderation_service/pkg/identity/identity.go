@@ -0,0 +1,163 @@
+package identity
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"strconv"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// metadata keys, the signature covers the other three
+const (
+	SubjectKey   = "x-identity-sub"
+	RoleKey      = "x-identity-role"
+	IssuedKey    = "x-identity-issued"
+	SignatureKey = "x-identity-signature"
+)
+
+// Identity is the end user a call is made for, the gateway takes it from the
+// verified JWT
+type Identity struct {
+	Subject string
+	Role    string
+}
+
+// IsAdmin tells if the user may change content of others
+func (i Identity) IsAdmin() bool {
+	return i.Role == "admin" || i.Role == "super_admin"
+}
+
+type ctxKey struct{}
+
+func With(ctx context.Context, id Identity) context.Context {
+	return context.WithValue(ctx, ctxKey{}, id)
+}
+
+// FromContext returns the identity of the call, calls made by services
+// themselves, e.g. by background jobs, have none
+func FromContext(ctx context.Context) (Identity, bool) {
+	id, ok := ctx.Value(ctxKey{}).(Identity)
+	return id, ok
+}
+
+// Signer signs identities of outgoing calls and verifies identities of
+// incoming ones with HMAC, the gateway and all services share its secret.
+// Signatures are accepted for maxAge, so captured metadata can't be replayed
+// for long.
+type Signer struct {
+	secret []byte
+	maxAge time.Duration
+}
+
+func NewSigner(secret string, maxAge time.Duration) *Signer {
+	return &Signer{secret: []byte(secret), maxAge: maxAge}
+}
+
+func (s *Signer) signature(sub, role, issued string) string {
+	mac := hmac.New(sha256.New, s.secret)
+	mac.Write([]byte(sub + "\n" + role + "\n" + issued))
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
+
+// Outgoing adds signed metadata of the identity in ctx, ctx is returned as is
+// when it has no identity
+func (s *Signer) Outgoing(ctx context.Context) context.Context {
+	id, ok := FromContext(ctx)
+	if !ok {
+		return ctx
+	}
+
+	issued := strconv.FormatInt(time.Now().Unix(), 10)
+	return metadata.AppendToOutgoingContext(ctx,
+		SubjectKey, id.Subject,
+		RoleKey, id.Role,
+		IssuedKey, issued,
+		SignatureKey, s.signature(id.Subject, id.Role, issued),
+	)
+}
+
+// Incoming verifies the identity in metadata of ctx and puts it into ctx.
+// Calls without identity are let through, calls with a wrong or expired
+// signature are not.
+func (s *Signer) Incoming(ctx context.Context) (context.Context, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	sub := first(md, SubjectKey)
+	if sub == "" {
+		return ctx, nil
+	}
+	role := first(md, RoleKey)
+	issued := first(md, IssuedKey)
+
+	expected := s.signature(sub, role, issued)
+	if !hmac.Equal([]byte(expected), []byte(first(md, SignatureKey))) {
+		return ctx, status.Error(codes.Unauthenticated, "identity signature is invalid")
+	}
+
+	unix, err := strconv.ParseInt(issued, 10, 64)
+	if err != nil {
+		return ctx, status.Error(codes.Unauthenticated, "identity issue time is invalid")
+	}
+	age := time.Since(time.Unix(unix, 0))
+	if age > s.maxAge || age < -s.maxAge {
+		return ctx, status.Error(codes.Unauthenticated, "identity is expired")
+	}
+
+	return With(ctx, Identity{Subject: sub, Role: role}), nil
+}
+
+func first(md metadata.MD, key string) string {
+	if values := md.Get(key); len(values) > 0 {
+		return values[0]
+	}
+	return ""
+}
+
+func (s *Signer) UnaryClientInterceptor() grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		return invoker(s.Outgoing(ctx), method, req, reply, cc, opts...)
+	}
+}
+
+func (s *Signer) StreamClientInterceptor() grpc.StreamClientInterceptor {
+	return func(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+		return streamer(s.Outgoing(ctx), desc, cc, method, opts...)
+	}
+}
+
+func (s *Signer) UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		ctx, err := s.Incoming(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		return handler(ctx, req)
+	}
+}
+
+func (s *Signer) StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx, err := s.Incoming(ss.Context())
+		if err != nil {
+			return err
+		}
+
+		return handler(srv, &serverStream{ServerStream: ss, ctx: ctx})
+	}
+}
+
+type serverStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *serverStream) Context() context.Context {
+	return s.ctx
+}
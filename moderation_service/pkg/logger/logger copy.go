@@ -0,0 +1,143 @@
+package logger
+
+import (
+	"context"
+	"time"
+
+	"github.com/burxondv/new-services/moderation-service/pkg/requestid"
+
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+)
+
+// Field ...
+type Field = zapcore.Field
+
+var (
+	// Int ..
+	Int = zap.Int
+	// String ...
+	String = zap.String
+	// Error ...
+	Error = zap.Error
+	// Bool ...
+	Bool = zap.Bool
+	// Duration ...
+	Duration = zap.Duration
+
+	// Any ...
+	Any = zap.Any
+)
+
+// Logger ...
+type Logger interface {
+	Debug(msg string, fields ...Field)
+	Info(msg string, fields ...Field)
+	Warn(msg string, fields ...Field)
+	Error(msg string, fields ...Field)
+	Fatal(msg string, fields ...Field)
+}
+
+type loggerImpl struct {
+	zap *zap.Logger
+}
+
+var (
+	customTimeFormat string
+)
+
+// New ...
+func New(level string, namespace string) Logger {
+	if level == "" {
+		level = LevelInfo
+	}
+
+	logger := loggerImpl{
+		zap: newZapLogger(level, time.RFC3339),
+	}
+
+	logger.zap = logger.zap.Named(namespace)
+
+	zap.RedirectStdLog(logger.zap)
+
+	return &logger
+}
+
+func (l *loggerImpl) Debug(msg string, fields ...Field) {
+	l.zap.Debug(msg, fields...)
+}
+
+func (l *loggerImpl) Info(msg string, fields ...Field) {
+	l.zap.Info(msg, fields...)
+}
+
+func (l *loggerImpl) Warn(msg string, fields ...Field) {
+	l.zap.Warn(msg, fields...)
+}
+
+func (l *loggerImpl) Error(msg string, fields ...Field) {
+	l.zap.Error(msg, fields...)
+}
+
+func (l *loggerImpl) Fatal(msg string, fields ...Field) {
+	l.zap.Fatal(msg, fields...)
+}
+
+// GetNamed ...
+func GetNamed(l Logger, name string) Logger {
+	switch v := l.(type) {
+	case *loggerImpl:
+		v.zap = v.zap.Named(name)
+		return v
+	default:
+		l.Info("logger.GetNamed: invalid logger type")
+		return l
+	}
+}
+
+// WithFields ...
+func WithFields(l Logger, fields ...Field) Logger {
+	switch v := l.(type) {
+	case *loggerImpl:
+		return &loggerImpl{
+			zap: v.zap.With(fields...),
+		}
+	default:
+		l.Info("logger.WithFields: invalid logger type")
+		return l
+	}
+}
+
+// WithTrace adds ids of the trace and the span of ctx to the logs, so logs
+// of one request can be found by its trace id
+func WithTrace(l Logger, ctx context.Context) Logger {
+	spanCtx := trace.SpanContextFromContext(ctx)
+	if !spanCtx.IsValid() {
+		return l
+	}
+
+	return WithFields(l,
+		String("trace_id", spanCtx.TraceID().String()),
+		String("span_id", spanCtx.SpanID().String()),
+	)
+}
+
+// WithContext adds id of the request and ids of the trace of ctx to the logs
+func WithContext(l Logger, ctx context.Context) Logger {
+	if id := requestid.FromContext(ctx); id != "" {
+		l = WithFields(l, String("request_id", id))
+	}
+	return WithTrace(l, ctx)
+}
+
+// Cleanup ...
+func Cleanup(l Logger) error {
+	switch v := l.(type) {
+	case *loggerImpl:
+		return v.zap.Sync()
+	default:
+		l.Info("logger.Cleanup: invalid logger type")
+		return nil
+	}
+}
@@ -0,0 +1,72 @@
+package mtls
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+// Caller returns the service name from the verified certificate of the caller
+func Caller(ctx context.Context) (string, bool) {
+	p, ok := peer.FromContext(ctx)
+	if !ok {
+		return "", false
+	}
+	info, ok := p.AuthInfo.(credentials.TLSInfo)
+	if !ok || len(info.State.VerifiedChains) == 0 || len(info.State.VerifiedChains[0]) == 0 {
+		return "", false
+	}
+
+	return info.State.VerifiedChains[0][0].Subject.CommonName, true
+}
+
+// allowed tells if method may be called, methods which are not in rules are
+// open to every caller with a valid certificate
+func allowed(ctx context.Context, rules map[string][]string, method string) error {
+	callers, ok := rules[method]
+	if !ok {
+		return nil
+	}
+
+	caller, ok := Caller(ctx)
+	if !ok {
+		return status.Error(codes.Unauthenticated, "caller has no certificate")
+	}
+	for _, c := range callers {
+		if c == caller {
+			return nil
+		}
+	}
+
+	return status.Errorf(codes.PermissionDenied, "%s is not allowed to call %s", caller, method)
+}
+
+// UnaryServerInterceptor allows only the callers in rules to call internal
+// methods, rules are keyed by full method names like /user.UserService/GetUserForClient
+func (c *Certs) UnaryServerInterceptor(rules map[string][]string) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if c != nil {
+			if err := allowed(ctx, rules, info.FullMethod); err != nil {
+				return nil, err
+			}
+		}
+
+		return handler(ctx, req)
+	}
+}
+
+func (c *Certs) StreamServerInterceptor(rules map[string][]string) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if c != nil {
+			if err := allowed(ss.Context(), rules, info.FullMethod); err != nil {
+				return err
+			}
+		}
+
+		return handler(srv, ss)
+	}
+}
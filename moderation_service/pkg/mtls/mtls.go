@@ -0,0 +1,196 @@
+package mtls
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/burxondv/new-services/moderation-service/pkg/logger"
+
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+type Config struct {
+	CertFile string
+	KeyFile  string
+	CAFile   string // certificates of services are signed by it
+}
+
+// Certs keeps the certificate of this service and the CA, both are reloaded
+// by Run when their files change. The common name of a certificate is the
+// name of its service, e.g. post_service.
+//
+// A nil *Certs means TLS is disabled, its credentials are insecure and its
+// interceptors let every caller in.
+type Certs struct {
+	cfg Config
+	log logger.Logger
+
+	mu      sync.RWMutex
+	cert    *tls.Certificate
+	pool    *x509.CertPool
+	modTime time.Time
+}
+
+func Load(cfg Config, log logger.Logger) (*Certs, error) {
+	c := &Certs{cfg: cfg, log: log}
+	if err := c.load(); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+func (c *Certs) load() error {
+	modTime, err := c.lastModified()
+	if err != nil {
+		return err
+	}
+
+	cert, err := tls.LoadX509KeyPair(c.cfg.CertFile, c.cfg.KeyFile)
+	if err != nil {
+		return fmt.Errorf("load certificate: %w", err)
+	}
+
+	ca, err := os.ReadFile(c.cfg.CAFile)
+	if err != nil {
+		return fmt.Errorf("read CA: %w", err)
+	}
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(ca) {
+		return errors.New("no certificates in CA file")
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.cert = &cert
+	c.pool = pool
+	c.modTime = modTime
+	return nil
+}
+
+// lastModified is the latest modification time of the three files
+func (c *Certs) lastModified() (time.Time, error) {
+	var res time.Time
+	for _, path := range []string{c.cfg.CertFile, c.cfg.KeyFile, c.cfg.CAFile} {
+		info, err := os.Stat(path)
+		if err != nil {
+			return res, err
+		}
+		if info.ModTime().After(res) {
+			res = info.ModTime()
+		}
+	}
+	return res, nil
+}
+
+// Run reloads the files when any of them changes, the old certificates are
+// kept when new ones can't be loaded
+func (c *Certs) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		modTime, err := c.lastModified()
+		c.mu.RLock()
+		changed := err == nil && !modTime.Equal(c.modTime)
+		c.mu.RUnlock()
+		if !changed {
+			continue
+		}
+
+		if err := c.load(); err != nil {
+			c.log.Error("failed to reload certificates", logger.Error(err))
+			continue
+		}
+		c.log.Info("certificates are reloaded")
+	}
+}
+
+func (c *Certs) current() (*tls.Certificate, *x509.CertPool) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	return c.cert, c.pool
+}
+
+// ServerCredentials require callers to present a certificate signed by the CA
+func (c *Certs) ServerCredentials() credentials.TransportCredentials {
+	if c == nil {
+		return insecure.NewCredentials()
+	}
+
+	return credentials.NewTLS(&tls.Config{
+		MinVersion: tls.VersionTLS12,
+		// a new config per connection picks up reloaded certificates
+		GetConfigForClient: func(*tls.ClientHelloInfo) (*tls.Config, error) {
+			cert, pool := c.current()
+			return &tls.Config{
+				MinVersion:   tls.VersionTLS12,
+				Certificates: []tls.Certificate{*cert},
+				ClientCAs:    pool,
+				ClientAuth:   tls.RequireAndVerifyClientCert,
+				NextProtos:   []string{"h2"},
+			}, nil
+		},
+	})
+}
+
+// ClientCredentials present the certificate of this service and accept only
+// the service called name
+func (c *Certs) ClientCredentials(name string) credentials.TransportCredentials {
+	if c == nil {
+		return insecure.NewCredentials()
+	}
+
+	return credentials.NewTLS(&tls.Config{
+		MinVersion: tls.VersionTLS12,
+		// services are verified by name below instead of host, addresses
+		// come from DNS or static lists and are not in certificates
+		InsecureSkipVerify: true,
+		VerifyConnection: func(state tls.ConnectionState) error {
+			return c.verify(state.PeerCertificates, name, x509.ExtKeyUsageServerAuth)
+		},
+		GetClientCertificate: func(*tls.CertificateRequestInfo) (*tls.Certificate, error) {
+			cert, _ := c.current()
+			return cert, nil
+		},
+	})
+}
+
+func (c *Certs) verify(certs []*x509.Certificate, name string, usage x509.ExtKeyUsage) error {
+	if len(certs) == 0 {
+		return errors.New("no certificate")
+	}
+
+	_, pool := c.current()
+	intermediates := x509.NewCertPool()
+	for _, cert := range certs[1:] {
+		intermediates.AddCert(cert)
+	}
+	_, err := certs[0].Verify(x509.VerifyOptions{
+		Roots:         pool,
+		Intermediates: intermediates,
+		KeyUsages:     []x509.ExtKeyUsage{usage},
+	})
+	if err != nil {
+		return err
+	}
+
+	if certs[0].Subject.CommonName != name {
+		return fmt.Errorf("certificate is of %q, not %q", certs[0].Subject.CommonName, name)
+	}
+	return nil
+}
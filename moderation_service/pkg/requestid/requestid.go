@@ -0,0 +1,100 @@
+package requestid
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+const (
+	// Header carries id of the request between clients and the gateway
+	Header = "X-Request-ID"
+	// MetadataKey carries id of the request between services
+	MetadataKey = "x-request-id"
+	// CallerKey carries name of the service which makes the call
+	CallerKey = "x-caller"
+)
+
+// ids from clients longer than maxLength are replaced by new ones
+const maxLength = 128
+
+type ctxKey struct{}
+
+// New returns random id for a request which came without one
+func New() string {
+	b := make([]byte, 16)
+	rand.Read(b)
+	return hex.EncodeToString(b)
+}
+
+// Valid reports whether id sent by a client can be used as is, it must be
+// short printable ASCII without spaces to be safe in logs and headers
+func Valid(id string) bool {
+	if id == "" || len(id) > maxLength {
+		return false
+	}
+	for i := 0; i < len(id); i++ {
+		if id[i] <= ' ' || id[i] > '~' {
+			return false
+		}
+	}
+	return true
+}
+
+// With returns ctx which carries id of the request
+func With(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, ctxKey{}, id)
+}
+
+// FromContext returns id of the request, it is empty outside of requests
+func FromContext(ctx context.Context) string {
+	id, _ := ctx.Value(ctxKey{}).(string)
+	return id
+}
+
+// FromIncoming returns ctx with id of the request received in gRPC metadata,
+// new id is generated when the caller did not send a valid one
+func FromIncoming(ctx context.Context) context.Context {
+	if id := incoming(ctx, MetadataKey); Valid(id) {
+		return With(ctx, id)
+	}
+	return With(ctx, New())
+}
+
+// Caller returns name of the service which made the call received in ctx
+func Caller(ctx context.Context) string {
+	return incoming(ctx, CallerKey)
+}
+
+func incoming(ctx context.Context, key string) string {
+	if values := metadata.ValueFromIncomingContext(ctx, key); len(values) > 0 {
+		return values[0]
+	}
+	return ""
+}
+
+// UnaryClientInterceptor sends id of the request and name of the calling
+// service with every call
+func UnaryClientInterceptor(service string) grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		return invoker(outgoing(ctx, service), method, req, reply, cc, opts...)
+	}
+}
+
+// StreamClientInterceptor is UnaryClientInterceptor for streams
+func StreamClientInterceptor(service string) grpc.StreamClientInterceptor {
+	return func(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+		return streamer(outgoing(ctx, service), desc, cc, method, opts...)
+	}
+}
+
+func outgoing(ctx context.Context, service string) context.Context {
+	ctx = metadata.AppendToOutgoingContext(ctx, CallerKey, service)
+	if id := FromContext(ctx); id != "" {
+		ctx = metadata.AppendToOutgoingContext(ctx, MetadataKey, id)
+	}
+	return ctx
+}
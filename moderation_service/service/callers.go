@@ -0,0 +1,5 @@
+package service
+
+// InternalCallers are the services allowed to call internal methods, the
+// gateway can't call them. Every method of moderation service is public.
+var InternalCallers = map[string][]string{}
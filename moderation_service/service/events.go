@@ -0,0 +1,72 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/burxondv/new-services/moderation-service/pkg/events"
+	"github.com/burxondv/new-services/moderation-service/pkg/logger"
+	"github.com/burxondv/new-services/moderation-service/storage/repo"
+)
+
+// consumerGroup is the group of all moderation service replicas in the event bus
+const consumerGroup = "moderation_service"
+
+// RunConsumer handles events of other services until ctx is done, consumer
+// must be unique among replicas
+func (s *ModerationService) RunConsumer(ctx context.Context, bus events.Bus, consumer string) {
+	for ctx.Err() == nil {
+		err := bus.Subscribe(ctx, consumerGroup, consumer, s.HandleEvent)
+		if err != nil && ctx.Err() == nil {
+			s.Logger.Error("consumer: subscription is broken", logger.Error(err))
+			time.Sleep(time.Second)
+		}
+	}
+}
+
+// HandleEvent opens cases for content held by classifiers and erases data of
+// purged users and posts
+func (s *ModerationService) HandleEvent(ctx context.Context, event events.Event) error {
+	switch event.Type {
+	case events.PostHeld, events.CommentHeld:
+		var held events.Held
+		if err := event.Decode(&held); err != nil {
+			s.Logger.Error("consumer: failed to decode event", logger.String("event_id", event.Id), logger.Error(err))
+			return nil
+		}
+
+		targetType := repo.TargetPost
+		if event.Type == events.CommentHeld {
+			targetType = repo.TargetComment
+		}
+
+		_, err := s.storage.Moderation().HoldContent(ctx, event.Id, repo.Case{
+			TargetType: targetType,
+			TargetId:   held.Id,
+			OwnerId:    held.UserId,
+			Source:     repo.SourceAuto,
+			Reasons:    held.Labels,
+		})
+		return err
+	case events.UserPurged:
+		var user events.User
+		if err := event.Decode(&user); err != nil {
+			s.Logger.Error("consumer: failed to decode event", logger.String("event_id", event.Id), logger.Error(err))
+			return nil
+		}
+
+		_, err := s.storage.Moderation().DeleteUserData(ctx, event.Id, user.Id)
+		return err
+	case events.PostPurged:
+		var post events.Post
+		if err := event.Decode(&post); err != nil {
+			s.Logger.Error("consumer: failed to decode event", logger.String("event_id", event.Id), logger.Error(err))
+			return nil
+		}
+
+		_, err := s.storage.Moderation().DeleteTargetCases(ctx, event.Id, repo.TargetPost, post.Id)
+		return err
+	}
+
+	return nil
+}
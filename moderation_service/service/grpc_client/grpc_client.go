@@ -0,0 +1,96 @@
+package grpcclient
+
+import (
+	"fmt"
+
+	"github.com/burxondv/new-services/moderation-service/config"
+	mc "github.com/burxondv/new-services/moderation-service/genproto/comment"
+	mn "github.com/burxondv/new-services/moderation-service/genproto/notification"
+	mp "github.com/burxondv/new-services/moderation-service/genproto/post"
+	mu "github.com/burxondv/new-services/moderation-service/genproto/user"
+	"github.com/burxondv/new-services/moderation-service/pkg/identity"
+	"github.com/burxondv/new-services/moderation-service/pkg/mtls"
+	"github.com/burxondv/new-services/moderation-service/pkg/rpcclient"
+
+	"google.golang.org/grpc"
+)
+
+type Clients interface {
+	User() mu.UserServiceClient
+	Post() mp.PostServiceClient
+	Comment() mc.CommentServiceClient
+	Notification() mn.NotificationServiceClient
+}
+
+type ServiceManager struct {
+	Config              config.Config
+	userService         mu.UserServiceClient
+	postService         mp.PostServiceClient
+	commentService      mc.CommentServiceClient
+	notificationService mn.NotificationServiceClient
+	conns               []*grpc.ClientConn
+}
+
+func New(cfg config.Config, certs *mtls.Certs, signer *identity.Signer) (*ServiceManager, error) {
+	// the end user of a call is sent to the called service
+	opts := []grpc.DialOption{
+		grpc.WithChainUnaryInterceptor(signer.UnaryClientInterceptor()),
+		grpc.WithChainStreamInterceptor(signer.StreamClientInterceptor()),
+	}
+
+	connUser, err := rpcclient.Dial("moderation_service", "user_service", cfg.UserServiceHost, cfg.UserServicePort, certs, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("user service dial host:%s, port:%s", cfg.UserServiceHost, cfg.UserServicePort)
+	}
+
+	connPost, err := rpcclient.Dial("moderation_service", "post_service", cfg.PostServiceHost, cfg.PostServicePort, certs, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("post service dial host:%s, port:%s", cfg.PostServiceHost, cfg.PostServicePort)
+	}
+
+	connComment, err := rpcclient.Dial("moderation_service", "comment_service", cfg.CommentServiceHost, cfg.CommentServicePort, certs, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("comment service dial host:%s, port:%s", cfg.CommentServiceHost, cfg.CommentServicePort)
+	}
+
+	connNotification, err := rpcclient.Dial("moderation_service", "notification_service", cfg.NotificationServiceHost, cfg.NotificationServicePort, certs, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("notification service dial host:%s, port:%s", cfg.NotificationServiceHost, cfg.NotificationServicePort)
+	}
+
+	return &ServiceManager{
+		Config:              cfg,
+		userService:         mu.NewUserServiceClient(connUser),
+		postService:         mp.NewPostServiceClient(connPost),
+		commentService:      mc.NewCommentServiceClient(connComment),
+		notificationService: mn.NewNotificationServiceClient(connNotification),
+		conns:               []*grpc.ClientConn{connUser, connPost, connComment, connNotification},
+	}, nil
+}
+
+func (s *ServiceManager) User() mu.UserServiceClient {
+	return s.userService
+}
+
+func (s *ServiceManager) Post() mp.PostServiceClient {
+	return s.postService
+}
+
+func (s *ServiceManager) Comment() mc.CommentServiceClient {
+	return s.commentService
+}
+
+func (s *ServiceManager) Notification() mn.NotificationServiceClient {
+	return s.notificationService
+}
+
+// Close closes connections to all services
+func (s *ServiceManager) Close() error {
+	var res error
+	for _, conn := range s.conns {
+		if err := conn.Close(); err != nil {
+			res = err
+		}
+	}
+	return res
+}
@@ -0,0 +1,210 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	mc "github.com/burxondv/new-services/moderation-service/genproto/comment"
+	m "github.com/burxondv/new-services/moderation-service/genproto/moderation"
+	mn "github.com/burxondv/new-services/moderation-service/genproto/notification"
+	mp "github.com/burxondv/new-services/moderation-service/genproto/post"
+	mu "github.com/burxondv/new-services/moderation-service/genproto/user"
+	"github.com/burxondv/new-services/moderation-service/pkg/logger"
+	"github.com/burxondv/new-services/moderation-service/pkg/requestid"
+	"github.com/burxondv/new-services/moderation-service/storage/repo"
+
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// suspension can not be longer than a year, longer ones are bans
+const maxSuspendHours = 24 * 365
+
+// ResolveCase applies action of the moderator to the target and closes the
+// case. The case stays unresolved if the action fails, so it can be retried.
+func (s *ModerationService) ResolveCase(ctx context.Context, req *m.ResolveRequest) (*m.CaseResponse, error) {
+	if req.ModeratorId == "" {
+		return &m.CaseResponse{}, status.Error(codes.InvalidArgument, "moderator_id is required")
+	}
+
+	switch req.Action {
+	case repo.ActionDismiss, repo.ActionHide, repo.ActionRemove, repo.ActionWarn:
+	case repo.ActionSuspend:
+		if req.SuspendHours <= 0 || req.SuspendHours > maxSuspendHours {
+			return &m.CaseResponse{}, status.Errorf(codes.InvalidArgument, "suspend_hours must be from 1 to %d", maxSuspendHours)
+		}
+	default:
+		return &m.CaseResponse{}, status.Errorf(codes.InvalidArgument, "unknown action %q", req.Action)
+	}
+
+	c, err := s.getCase(ctx, req.Id)
+	if err != nil {
+		return &m.CaseResponse{}, err
+	}
+	if c.Status == repo.StatusResolved {
+		return &m.CaseResponse{}, status.Error(codes.FailedPrecondition, "case is already resolved")
+	}
+
+	if err := s.apply(ctx, c, req); err != nil {
+		return &m.CaseResponse{}, err
+	}
+
+	res, err := s.storage.Moderation().ResolveCase(ctx, c.Id, req.ModeratorId, req.Action, req.Note)
+	if err == sql.ErrNoRows {
+		// resolved by another moderator in the meantime
+		return &m.CaseResponse{}, s.notOpen(ctx, c.Id)
+	} else if err != nil {
+		s.reqLog(ctx).Error("failed to resolve case in service", logger.Error(err))
+		return &m.CaseResponse{}, err
+	}
+
+	if req.Action != repo.ActionDismiss {
+		s.notifyOwner(ctx, c, req)
+	}
+
+	return caseResponse(res), nil
+}
+
+// apply changes the target of the case, content which is already deleted
+// needs no changes
+func (s *ModerationService) apply(ctx context.Context, c repo.Case, req *m.ResolveRequest) error {
+	switch req.Action {
+	case repo.ActionDismiss:
+		return s.approveHeld(ctx, c)
+	case repo.ActionHide, repo.ActionRemove:
+		return s.moderate(ctx, c, req.Action)
+	case repo.ActionSuspend:
+		if c.OwnerId == "" {
+			return status.Error(codes.FailedPrecondition, "owner of the target is not known")
+		}
+
+		until := time.Now().Add(time.Duration(req.SuspendHours) * time.Hour)
+		_, err := s.Client.User().SuspendUser(ctx, &mu.SuspendRequest{
+			Id:     c.OwnerId,
+			Until:  until.UTC().Format(time.RFC3339),
+			Reason: req.Note,
+		})
+		if err != nil {
+			s.reqLog(ctx).Error("failed to suspend user in service", logger.Error(err))
+			return err
+		}
+	}
+
+	return nil
+}
+
+// moderate hides or removes reported content
+func (s *ModerationService) moderate(ctx context.Context, c repo.Case, action string) error {
+	var err error
+	switch c.TargetType {
+	case repo.TargetPost:
+		_, err = s.Client.Post().ModeratePost(ctx, &mp.ModerateRequest{Id: c.TargetId, Action: action})
+	case repo.TargetComment:
+		_, err = s.Client.Comment().ModerateComment(ctx, &mc.ModerateRequest{Id: c.TargetId, Action: action})
+	default:
+		return status.Errorf(codes.InvalidArgument, "%s can not be applied to %s", action, c.TargetType)
+	}
+
+	if status.Code(err) == codes.NotFound {
+		return nil
+	} else if err != nil {
+		s.reqLog(ctx).Error("failed to moderate content in service", logger.Error(err))
+		return err
+	}
+
+	return nil
+}
+
+// approveHeld makes content held by classifier visible, content hidden by
+// earlier decisions stays hidden
+func (s *ModerationService) approveHeld(ctx context.Context, c repo.Case) error {
+	var (
+		moderationStatus string
+		err              error
+	)
+	switch c.TargetType {
+	case repo.TargetPost:
+		var posts *mp.PostsResponse
+		posts, err = s.Client.Post().GetPostsByIds(ctx, &mp.IdsRequest{Ids: []string{c.TargetId}})
+		for _, post := range posts.GetPosts() {
+			moderationStatus = post.ModerationStatus
+		}
+	case repo.TargetComment:
+		var comment *mc.CommentResponse
+		comment, err = s.Client.Comment().GetComment(ctx, &mc.Request{Str: c.TargetId})
+		moderationStatus = comment.GetModerationStatus()
+	default:
+		return nil
+	}
+
+	if status.Code(err) == codes.NotFound {
+		return nil
+	} else if err != nil {
+		s.reqLog(ctx).Error("failed to get content for dismiss in service", logger.Error(err))
+		return err
+	}
+
+	if moderationStatus != "held" {
+		return nil
+	}
+
+	return s.moderate(ctx, c, "approve")
+}
+
+// notifyOwner tells the owner about the decision in background, failed
+// notification doesn't fail the request
+func (s *ModerationService) notifyOwner(ctx context.Context, c repo.Case, req *m.ResolveRequest) {
+	if c.OwnerId == "" {
+		return
+	}
+
+	notif := &mn.NotifyRequest{
+		Type:   "moderation",
+		UserId: c.OwnerId,
+		Text:   decisionText(c, req),
+	}
+	// notifications of removed content are deleted with it
+	switch {
+	case req.Action == repo.ActionRemove:
+	case c.TargetType == repo.TargetPost:
+		notif.PostId = c.TargetId
+	case c.TargetType == repo.TargetComment:
+		notif.CommentId = c.TargetId
+	}
+
+	spanCtx := trace.SpanContextFromContext(ctx)
+	reqId := requestid.FromContext(ctx)
+	go func() {
+		ctx := requestid.With(trace.ContextWithSpanContext(context.Background(), spanCtx), reqId)
+		ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+		defer cancel()
+
+		_, err := s.Client.Notification().Notify(ctx, notif)
+		if err != nil {
+			s.reqLog(ctx).Error("failed to send notification", logger.String("case_id", c.Id), logger.Error(err))
+		}
+	}()
+}
+
+func decisionText(c repo.Case, req *m.ResolveRequest) string {
+	var text string
+	switch req.Action {
+	case repo.ActionHide:
+		text = fmt.Sprintf("Your %s was hidden by moderators", c.TargetType)
+	case repo.ActionRemove:
+		text = fmt.Sprintf("Your %s was removed by moderators", c.TargetType)
+	case repo.ActionWarn:
+		text = "You received a warning from moderators"
+	case repo.ActionSuspend:
+		text = fmt.Sprintf("Your account is suspended for %d hours", req.SuspendHours)
+	}
+
+	if req.Note != "" {
+		text += ": " + req.Note
+	}
+
+	return text
+}
@@ -0,0 +1,277 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+
+	mc "github.com/burxondv/new-services/moderation-service/genproto/comment"
+	m "github.com/burxondv/new-services/moderation-service/genproto/moderation"
+	mp "github.com/burxondv/new-services/moderation-service/genproto/post"
+	mu "github.com/burxondv/new-services/moderation-service/genproto/user"
+	"github.com/burxondv/new-services/moderation-service/pkg/logger"
+	grpcclient "github.com/burxondv/new-services/moderation-service/service/grpc_client"
+	"github.com/burxondv/new-services/moderation-service/storage"
+	"github.com/burxondv/new-services/moderation-service/storage/repo"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	defaultLimit = 20
+	maxLimit     = 100
+
+	maxDetailsLength = 1000
+)
+
+type ModerationService struct {
+	storage storage.IStorage
+	Logger  logger.Logger
+	Client  grpcclient.Clients
+}
+
+func NewModerationService(db *sqlx.DB, log logger.Logger, client grpcclient.Clients) *ModerationService {
+	return &ModerationService{
+		storage: storage.NewStoragePg(db, log),
+		Logger:  log,
+		Client:  client,
+	}
+}
+
+// reqLog returns logger with ids of the request and of its trace
+func (s *ModerationService) reqLog(ctx context.Context) logger.Logger {
+	return logger.WithContext(s.Logger, ctx)
+}
+
+// CreateReport adds report of the user to the queue. Only content which the
+// reporter can see can be reported, reports of the same target are collected
+// in one case.
+func (s *ModerationService) CreateReport(ctx context.Context, req *m.ReportRequest) (*m.ReportResponse, error) {
+	if req.ReporterId == "" {
+		return &m.ReportResponse{}, status.Error(codes.InvalidArgument, "reporter_id is required")
+	}
+	if _, err := uuid.Parse(req.TargetId); err != nil {
+		return &m.ReportResponse{}, status.Error(codes.InvalidArgument, "target_id must be uuid")
+	}
+	if !validReason(req.Reason) {
+		return &m.ReportResponse{}, status.Errorf(codes.InvalidArgument, "unknown reason %q", req.Reason)
+	}
+	if len([]rune(req.Details)) > maxDetailsLength {
+		return &m.ReportResponse{}, status.Errorf(codes.InvalidArgument, "details must be at most %d characters", maxDetailsLength)
+	}
+
+	ownerId, err := s.targetOwner(ctx, req.TargetType, req.TargetId, req.ReporterId)
+	if err != nil {
+		return &m.ReportResponse{}, err
+	}
+	if ownerId == req.ReporterId {
+		return &m.ReportResponse{}, status.Error(codes.InvalidArgument, "own content can not be reported")
+	}
+
+	id := req.Id
+	if id == "" {
+		id = uuid.NewString()
+	}
+
+	_, report, err := s.storage.Moderation().CreateReport(ctx, repo.Case{
+		TargetType: req.TargetType,
+		TargetId:   req.TargetId,
+		OwnerId:    ownerId,
+		Source:     repo.SourceUser,
+		Reasons:    []string{req.Reason},
+	}, repo.Report{
+		Id:         id,
+		ReporterId: req.ReporterId,
+		Reason:     req.Reason,
+		Details:    req.Details,
+	})
+	if err == repo.ErrAlreadyReported {
+		return &m.ReportResponse{}, status.Error(codes.AlreadyExists, err.Error())
+	} else if err != nil {
+		s.reqLog(ctx).Error("failed to create report in service", logger.Error(err))
+		return &m.ReportResponse{}, err
+	}
+
+	return reportResponse(report), nil
+}
+
+// targetOwner returns author of the content or id of the user, the target
+// must be visible for the reporter
+func (s *ModerationService) targetOwner(ctx context.Context, targetType, targetId, reporterId string) (string, error) {
+	switch targetType {
+	case repo.TargetPost:
+		post, err := s.Client.Post().GetPostById(ctx, &mp.Request{Str: targetId, ViewerId: reporterId})
+		if err != nil {
+			return "", err
+		}
+
+		return post.UserId, nil
+	case repo.TargetComment:
+		comment, err := s.Client.Comment().GetComment(ctx, &mc.Request{Str: targetId})
+		if err != nil {
+			return "", err
+		}
+		if comment.ModerationStatus != "visible" && comment.UserId != reporterId {
+			return "", status.Error(codes.NotFound, "comment not found")
+		}
+
+		return comment.UserId, nil
+	case repo.TargetUser:
+		user, err := s.Client.User().GetUserById(ctx, &mu.Request{Str: targetId, ViewerId: reporterId})
+		if err != nil {
+			return "", err
+		}
+
+		return user.Id, nil
+	}
+
+	return "", status.Errorf(codes.InvalidArgument, "unknown target type %q", targetType)
+}
+
+func (s *ModerationService) GetQueue(ctx context.Context, req *m.QueueRequest) (*m.CasesResponse, error) {
+	switch req.Status {
+	case "", repo.StatusOpen, repo.StatusAssigned, repo.StatusResolved:
+	default:
+		return &m.CasesResponse{}, status.Errorf(codes.InvalidArgument, "unknown status %q", req.Status)
+	}
+
+	limit := req.Limit
+	if limit <= 0 {
+		limit = defaultLimit
+	}
+	if limit > maxLimit {
+		limit = maxLimit
+	}
+
+	offset := req.Offset
+	if offset < 0 {
+		offset = 0
+	}
+
+	res, err := s.storage.Moderation().GetCases(ctx, repo.CaseFilter{
+		Status:     req.Status,
+		TargetType: req.TargetType,
+		AssigneeId: req.AssigneeId,
+		Limit:      limit,
+		Offset:     offset,
+	})
+	if err != nil {
+		s.reqLog(ctx).Error("failed to get queue in service", logger.Error(err))
+		return &m.CasesResponse{}, err
+	}
+
+	cases := &m.CasesResponse{}
+	for _, c := range res {
+		cases.Cases = append(cases.Cases, caseResponse(c))
+	}
+
+	return cases, nil
+}
+
+// GetCase returns the case with its reports
+func (s *ModerationService) GetCase(ctx context.Context, req *m.Request) (*m.CaseResponse, error) {
+	res, err := s.getCase(ctx, req.Str)
+	if err != nil {
+		return &m.CaseResponse{}, err
+	}
+
+	reports, err := s.storage.Moderation().GetReports(ctx, res.Id)
+	if err != nil {
+		s.reqLog(ctx).Error("failed to get reports of case in service", logger.Error(err))
+		return &m.CaseResponse{}, err
+	}
+
+	caseResp := caseResponse(res)
+	for _, report := range reports {
+		caseResp.ReportList = append(caseResp.ReportList, reportResponse(report))
+	}
+
+	return caseResp, nil
+}
+
+func (s *ModerationService) AssignCase(ctx context.Context, req *m.AssignRequest) (*m.CaseResponse, error) {
+	if req.ModeratorId == "" {
+		return &m.CaseResponse{}, status.Error(codes.InvalidArgument, "moderator_id is required")
+	}
+	if _, err := s.getCase(ctx, req.Id); err != nil {
+		return &m.CaseResponse{}, err
+	}
+
+	res, err := s.storage.Moderation().AssignCase(ctx, req.Id, req.ModeratorId)
+	if err == sql.ErrNoRows {
+		return &m.CaseResponse{}, s.notOpen(ctx, req.Id)
+	} else if err != nil {
+		s.reqLog(ctx).Error("failed to assign case in service", logger.Error(err))
+		return &m.CaseResponse{}, err
+	}
+
+	return caseResponse(res), nil
+}
+
+func (s *ModerationService) getCase(ctx context.Context, id string) (repo.Case, error) {
+	if _, err := uuid.Parse(id); err != nil {
+		return repo.Case{}, status.Error(codes.NotFound, "case not found")
+	}
+
+	res, err := s.storage.Moderation().GetCase(ctx, id)
+	if err == sql.ErrNoRows {
+		return repo.Case{}, status.Error(codes.NotFound, "case not found")
+	} else if err != nil {
+		s.reqLog(ctx).Error("failed to get case in service", logger.Error(err))
+		return repo.Case{}, err
+	}
+
+	return res, nil
+}
+
+// notOpen returns error for the case which can not be changed
+func (s *ModerationService) notOpen(ctx context.Context, id string) error {
+	if _, err := s.getCase(ctx, id); err != nil {
+		return err
+	}
+
+	return status.Error(codes.FailedPrecondition, "case is already resolved")
+}
+
+func validReason(reason string) bool {
+	for _, r := range repo.Reasons {
+		if r == reason {
+			return true
+		}
+	}
+
+	return false
+}
+
+func caseResponse(c repo.Case) *m.CaseResponse {
+	return &m.CaseResponse{
+		Id:         c.Id,
+		TargetType: c.TargetType,
+		TargetId:   c.TargetId,
+		OwnerId:    c.OwnerId,
+		Status:     c.Status,
+		Source:     c.Source,
+		Reasons:    c.Reasons,
+		Reports:    c.Reports,
+		AssigneeId: c.AssigneeId,
+		Action:     c.Action,
+		Note:       c.Note,
+		CreatedAt:  c.CreatedAt,
+		UpdatedAt:  c.UpdatedAt,
+		ResolvedAt: c.ResolvedAt,
+	}
+}
+
+func reportResponse(r repo.Report) *m.ReportResponse {
+	return &m.ReportResponse{
+		Id:         r.Id,
+		CaseId:     r.CaseId,
+		ReporterId: r.ReporterId,
+		Reason:     r.Reason,
+		Details:    r.Details,
+		CreatedAt:  r.CreatedAt,
+	}
+}
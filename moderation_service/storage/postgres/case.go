@@ -0,0 +1,239 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/burxondv/new-services/moderation-service/pkg/logger"
+	"github.com/burxondv/new-services/moderation-service/storage/repo"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+)
+
+// caseColumns are selected by every case query, scanned by scanCase
+const caseColumns = `
+	id, target_type, target_id, coalesce(owner_id::text, ''), status, source, reasons,
+	(select count(*) from reports r where r.case_id = c.id),
+	coalesce(assignee_id::text, ''), action, note, created_at, updated_at, resolved_at`
+
+type scanner interface {
+	Scan(dest ...interface{}) error
+}
+
+func scanCase(row scanner) (repo.Case, error) {
+	var (
+		c          repo.Case
+		createdAt  time.Time
+		updatedAt  time.Time
+		resolvedAt sql.NullTime
+	)
+
+	err := row.Scan(
+		&c.Id,
+		&c.TargetType,
+		&c.TargetId,
+		&c.OwnerId,
+		&c.Status,
+		&c.Source,
+		pq.Array(&c.Reasons),
+		&c.Reports,
+		&c.AssigneeId,
+		&c.Action,
+		&c.Note,
+		&createdAt,
+		&updatedAt,
+		&resolvedAt,
+	)
+	if err != nil {
+		return repo.Case{}, err
+	}
+
+	c.CreatedAt = createdAt.Format(time.RFC3339)
+	c.UpdatedAt = updatedAt.Format(time.RFC3339)
+	if resolvedAt.Valid {
+		c.ResolvedAt = resolvedAt.Time.Format(time.RFC3339)
+	}
+
+	return c, nil
+}
+
+// upsertCase opens case of the target or adds reasons to its open case and
+// returns id of the case
+func upsertCase(ctx context.Context, tx *sql.Tx, c repo.Case) (string, error) {
+	if c.Reasons == nil {
+		c.Reasons = []string{}
+	}
+
+	var id string
+	err := tx.QueryRowContext(ctx, `
+		insert into
+			cases(id, target_type, target_id, owner_id, source, reasons)
+		values
+			($1, $2, $3, $4, $5, $6)
+		on conflict (target_type, target_id) where status <> 'resolved' do update
+		set
+			reasons = array(select distinct unnest(cases.reasons || excluded.reasons) order by 1),
+			updated_at = $7
+		returning id`, uuid.NewString(), c.TargetType, c.TargetId, nullString(c.OwnerId), c.Source, pq.Array(c.Reasons), time.Now()).Scan(&id)
+
+	return id, err
+}
+
+func (r *ModerationRepo) CreateReport(ctx context.Context, c repo.Case, report repo.Report) (repo.Case, repo.Report, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return repo.Case{}, repo.Report{}, err
+	}
+	defer tx.Rollback()
+
+	report.CaseId, err = upsertCase(ctx, tx, c)
+	if err != nil {
+		logger.WithContext(r.log, ctx).Error("failed to open case in sql", logger.Error(err))
+		return repo.Case{}, repo.Report{}, err
+	}
+
+	var createdAt time.Time
+	err = tx.QueryRowContext(ctx, `
+		insert into
+			reports(id, case_id, reporter_id, reason, details)
+		values
+			($1, $2, $3, $4, $5)
+		on conflict (case_id, reporter_id) do nothing
+		returning created_at`, report.Id, report.CaseId, report.ReporterId, report.Reason, report.Details).Scan(&createdAt)
+	if err == sql.ErrNoRows {
+		return repo.Case{}, repo.Report{}, repo.ErrAlreadyReported
+	} else if err != nil {
+		logger.WithContext(r.log, ctx).Error("failed to create report in sql", logger.Error(err))
+		return repo.Case{}, repo.Report{}, err
+	}
+	report.CreatedAt = createdAt.Format(time.RFC3339)
+
+	res, err := scanCase(tx.QueryRowContext(ctx, `select `+caseColumns+` from cases c where id = $1`, report.CaseId))
+	if err != nil {
+		logger.WithContext(r.log, ctx).Error("failed to get reported case in sql", logger.Error(err))
+		return repo.Case{}, repo.Report{}, err
+	}
+
+	return res, report, tx.Commit()
+}
+
+// GetCases returns the queue, unresolved cases are returned oldest first and
+// resolved ones last resolved first
+func (r *ModerationRepo) GetCases(ctx context.Context, filter repo.CaseFilter) ([]repo.Case, error) {
+	rows, err := r.db.QueryContext(ctx, `
+		select
+			`+caseColumns+`
+		from
+			cases c
+		where
+			case when $1 = '' then status <> 'resolved' else status = $1 end and
+			($2 = '' or target_type = $2) and
+			($3 = '' or assignee_id::text = $3)
+		order by resolved_at desc nulls last, created_at
+		limit $4 offset $5`, filter.Status, filter.TargetType, filter.AssigneeId, filter.Limit, filter.Offset)
+	if err != nil {
+		logger.WithContext(r.log, ctx).Error("failed to get cases in sql", logger.Error(err))
+		return []repo.Case{}, err
+	}
+	defer rows.Close()
+
+	res := []repo.Case{}
+	for rows.Next() {
+		c, err := scanCase(rows)
+		if err != nil {
+			logger.WithContext(r.log, ctx).Error("failed to scanning case in sql", logger.Error(err))
+			return []repo.Case{}, err
+		}
+
+		res = append(res, c)
+	}
+
+	return res, rows.Err()
+}
+
+func (r *ModerationRepo) GetCase(ctx context.Context, id string) (repo.Case, error) {
+	res, err := scanCase(r.db.QueryRowContext(ctx, `select `+caseColumns+` from cases c where id = $1`, id))
+	if err != nil {
+		logger.WithContext(r.log, ctx).Error("failed to get case in sql", logger.Error(err))
+		return repo.Case{}, err
+	}
+
+	return res, nil
+}
+
+func (r *ModerationRepo) GetReports(ctx context.Context, caseId string) ([]repo.Report, error) {
+	rows, err := r.db.QueryContext(ctx, `
+		select
+			id, case_id, reporter_id, reason, details, created_at
+		from
+			reports
+		where
+			case_id = $1
+		order by created_at`, caseId)
+	if err != nil {
+		logger.WithContext(r.log, ctx).Error("failed to get reports in sql", logger.Error(err))
+		return []repo.Report{}, err
+	}
+	defer rows.Close()
+
+	res := []repo.Report{}
+	for rows.Next() {
+		var (
+			report    repo.Report
+			createdAt time.Time
+		)
+		err = rows.Scan(&report.Id, &report.CaseId, &report.ReporterId, &report.Reason, &report.Details, &createdAt)
+		if err != nil {
+			logger.WithContext(r.log, ctx).Error("failed to scanning report in sql", logger.Error(err))
+			return []repo.Report{}, err
+		}
+
+		report.CreatedAt = createdAt.Format(time.RFC3339)
+		res = append(res, report)
+	}
+
+	return res, rows.Err()
+}
+
+// AssignCase assigns unresolved case to the moderator, assigned case can be
+// taken by another moderator
+func (r *ModerationRepo) AssignCase(ctx context.Context, id, moderatorId string) (repo.Case, error) {
+	res, err := scanCase(r.db.QueryRowContext(ctx, `
+		update
+			cases c
+		set
+			assignee_id = $1, status = 'assigned', updated_at = $2
+		where
+			id = $3 and status <> 'resolved'
+		returning `+caseColumns, moderatorId, time.Now(), id))
+	if err != nil {
+		logger.WithContext(r.log, ctx).Error("failed to assign case in sql", logger.Error(err))
+		return repo.Case{}, err
+	}
+
+	return res, nil
+}
+
+func (r *ModerationRepo) ResolveCase(ctx context.Context, id, moderatorId, action, note string) (repo.Case, error) {
+	now := time.Now()
+	res, err := scanCase(r.db.QueryRowContext(ctx, `
+		update
+			cases c
+		set
+			assignee_id = $1, status = 'resolved', action = $2, note = $3, updated_at = $4, resolved_at = $4
+		where
+			id = $5 and status <> 'resolved'
+		returning `+caseColumns, moderatorId, action, note, now, id))
+	if err != nil {
+		logger.WithContext(r.log, ctx).Error("failed to resolve case in sql", logger.Error(err))
+		return repo.Case{}, err
+	}
+
+	return res, nil
+}
+
+func nullString(str string) sql.NullString {
+	return sql.NullString{String: str, Valid: str != ""}
+}
@@ -0,0 +1,129 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/burxondv/new-services/moderation-service/pkg/logger"
+	"github.com/burxondv/new-services/moderation-service/storage/repo"
+)
+
+// markProcessed returns false if the event was already handled, consumers
+// check it in the transaction of their change
+func markProcessed(ctx context.Context, tx *sql.Tx, eventId string) (bool, error) {
+	res, err := tx.ExecContext(ctx, `
+		insert into
+			processed_events(event_id)
+		values
+			($1)
+		on conflict do nothing`, eventId)
+	if err != nil {
+		return false, err
+	}
+
+	rows, err := res.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+
+	return rows > 0, nil
+}
+
+// HoldContent opens case for content held by classifier once per event,
+// labels are added to reasons of open case of the content
+func (r *ModerationRepo) HoldContent(ctx context.Context, eventId string, c repo.Case) (bool, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return false, err
+	}
+	defer tx.Rollback()
+
+	ok, err := markProcessed(ctx, tx, eventId)
+	if err != nil {
+		logger.WithContext(r.log, ctx).Error("failed to mark event processed in sql", logger.Error(err))
+		return false, err
+	}
+	if !ok {
+		return false, nil
+	}
+
+	if _, err := upsertCase(ctx, tx, c); err != nil {
+		logger.WithContext(r.log, ctx).Error("failed to open case for held content in sql", logger.Error(err))
+		return false, err
+	}
+
+	return true, tx.Commit()
+}
+
+// DeleteUserData erases reports of purged user and cases about the user and
+// the content of the user once per event
+func (r *ModerationRepo) DeleteUserData(ctx context.Context, eventId, userId string) (int64, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, err
+	}
+	defer tx.Rollback()
+
+	ok, err := markProcessed(ctx, tx, eventId)
+	if err != nil {
+		logger.WithContext(r.log, ctx).Error("failed to mark event processed in sql", logger.Error(err))
+		return 0, err
+	}
+	if !ok {
+		return 0, nil
+	}
+
+	reports, err := tx.ExecContext(ctx, `delete from reports where reporter_id = $1`, userId)
+	if err != nil {
+		logger.WithContext(r.log, ctx).Error("failed to delete user reports in sql", logger.Error(err))
+		return 0, err
+	}
+
+	cases, err := tx.ExecContext(ctx, `delete from cases where owner_id = $1 or (target_type = 'user' and target_id = $1)`, userId)
+	if err != nil {
+		logger.WithContext(r.log, ctx).Error("failed to delete user cases in sql", logger.Error(err))
+		return 0, err
+	}
+
+	deletedReports, err := reports.RowsAffected()
+	if err != nil {
+		return 0, err
+	}
+	deletedCases, err := cases.RowsAffected()
+	if err != nil {
+		return 0, err
+	}
+
+	return deletedReports + deletedCases, tx.Commit()
+}
+
+// DeleteTargetCases erases cases of purged content once per event
+func (r *ModerationRepo) DeleteTargetCases(ctx context.Context, eventId, targetType, targetId string) (int64, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, err
+	}
+	defer tx.Rollback()
+
+	ok, err := markProcessed(ctx, tx, eventId)
+	if err != nil {
+		logger.WithContext(r.log, ctx).Error("failed to mark event processed in sql", logger.Error(err))
+		return 0, err
+	}
+	if !ok {
+		return 0, nil
+	}
+
+	res, err := tx.ExecContext(ctx, `delete from cases where target_type = $1 and target_id = $2`, targetType, targetId)
+	if err != nil {
+		logger.WithContext(r.log, ctx).Error("failed to delete target cases in sql", logger.Error(err))
+		return 0, err
+	}
+
+	deleted, err := res.RowsAffected()
+	if err != nil {
+		return 0, err
+	}
+
+	return deleted, tx.Commit()
+}
@@ -0,0 +1,19 @@
+package postgres
+
+import (
+	"github.com/burxondv/new-services/moderation-service/pkg/logger"
+
+	"github.com/jmoiron/sqlx"
+)
+
+type ModerationRepo struct {
+	db  *sqlx.DB
+	log logger.Logger
+}
+
+func NewModerationRepo(db *sqlx.DB, log logger.Logger) *ModerationRepo {
+	return &ModerationRepo{
+		db:  db,
+		log: log,
+	}
+}
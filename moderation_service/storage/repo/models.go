@@ -0,0 +1,67 @@
+package repo
+
+// Case collects reports of one target until moderator resolves it
+type Case struct {
+	Id         string
+	TargetType string
+	TargetId   string
+	OwnerId    string
+	Status     string
+	Source     string
+	Reasons    []string // reasons of reports and labels of classifier
+	Reports    int64
+	AssigneeId string
+	Action     string
+	Note       string
+	CreatedAt  string
+	UpdatedAt  string
+	ResolvedAt string
+}
+
+type Report struct {
+	Id         string
+	CaseId     string
+	ReporterId string
+	Reason     string
+	Details    string
+	CreatedAt  string
+}
+
+// types of reported targets...
+const (
+	TargetPost    = "post"
+	TargetComment = "comment"
+	TargetUser    = "user"
+)
+
+// case statuses...
+const (
+	StatusOpen     = "open"
+	StatusAssigned = "assigned"
+	StatusResolved = "resolved"
+)
+
+// case sources...
+const (
+	SourceUser = "user" // reported by users
+	SourceAuto = "auto" // held by classifier of post or comment service
+)
+
+// actions of moderators...
+const (
+	ActionDismiss = "dismiss" // content is fine, held content is approved
+	ActionHide    = "hide"    // content is shown only to its author
+	ActionRemove  = "remove"  // content is deleted
+	ActionWarn    = "warn"    // owner is notified with the note
+	ActionSuspend = "suspend" // owner can not log in for some hours
+)
+
+var Reasons = []string{"spam", "harassment", "hate", "violence", "nudity", "misinformation", "other"}
+
+type CaseFilter struct {
+	Status     string // open and assigned cases when empty
+	TargetType string
+	AssigneeId string
+	Limit      int64
+	Offset     int64
+}
@@ -0,0 +1,27 @@
+package repo
+
+import "context"
+
+import "errors"
+
+// ErrAlreadyReported is returned when the user already reported the target
+// of open case
+var ErrAlreadyReported = errors.New("target is already reported by the user")
+
+type ModerationStorageI interface {
+	// CreateReport adds report to the open case of the target, the case is
+	// opened for the first report
+	CreateReport(ctx context.Context, c Case, r Report) (Case, Report, error)
+	GetCases(ctx context.Context, filter CaseFilter) ([]Case, error)
+	GetCase(ctx context.Context, id string) (Case, error)
+	GetReports(ctx context.Context, caseId string) ([]Report, error)
+	// AssignCase and ResolveCase return sql.ErrNoRows when the case is
+	// resolved or missing
+	AssignCase(ctx context.Context, id, moderatorId string) (Case, error)
+	ResolveCase(ctx context.Context, id, moderatorId, action, note string) (Case, error)
+
+	// events...
+	HoldContent(ctx context.Context, eventId string, c Case) (bool, error)
+	DeleteUserData(ctx context.Context, eventId, userId string) (int64, error)
+	DeleteTargetCases(ctx context.Context, eventId, targetType, targetId string) (int64, error)
+}
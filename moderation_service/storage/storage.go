@@ -0,0 +1,29 @@
+package storage
+
+import (
+	"github.com/burxondv/new-services/moderation-service/pkg/logger"
+	"github.com/burxondv/new-services/moderation-service/storage/postgres"
+	"github.com/burxondv/new-services/moderation-service/storage/repo"
+
+	"github.com/jmoiron/sqlx"
+)
+
+type IStorage interface {
+	Moderation() repo.ModerationStorageI
+}
+
+type storagePg struct {
+	db             *sqlx.DB
+	moderationRepo repo.ModerationStorageI
+}
+
+func NewStoragePg(db *sqlx.DB, log logger.Logger) *storagePg {
+	return &storagePg{
+		db:             db,
+		moderationRepo: postgres.NewModerationRepo(db, log),
+	}
+}
+
+func (s storagePg) Moderation() repo.ModerationStorageI {
+	return s.moderationRepo
+}
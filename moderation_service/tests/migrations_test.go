@@ -0,0 +1,22 @@
+package tests
+
+import (
+	"testing"
+
+	"github.com/burxondv/new-services/moderation-service/migrations"
+	"github.com/burxondv/new-services/moderation-service/pkg/migrate"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMigrations_Embedded(t *testing.T) {
+	list, err := migrate.List(migrations.FS)
+	require.NoError(t, err)
+
+	versions := []uint{}
+	for _, m := range list {
+		versions = append(versions, m.Version)
+	}
+	assert.Equal(t, []uint{1, 2}, versions)
+}
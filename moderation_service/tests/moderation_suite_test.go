@@ -0,0 +1,135 @@
+package tests
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/burxondv/new-services/moderation-service/config"
+	"github.com/burxondv/new-services/moderation-service/pkg/db"
+	"github.com/burxondv/new-services/moderation-service/pkg/logger"
+	"github.com/burxondv/new-services/moderation-service/storage/postgres"
+	"github.com/burxondv/new-services/moderation-service/storage/repo"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+)
+
+type ModerationSuiteTest struct {
+	suite.Suite
+	CleanUpfunc func()
+	repo        repo.ModerationStorageI
+}
+
+func (s *ModerationSuiteTest) SetupSuite() {
+	pgPool, cleanUp := db.ConnectToDBForSuite(config.Load())
+	s.repo = postgres.NewModerationRepo(pgPool, logger.New("debug", "test"))
+	s.CleanUpfunc = cleanUp
+}
+
+func (s *ModerationSuiteTest) TestReports() {
+	target := repo.Case{
+		TargetType: repo.TargetPost,
+		TargetId:   uuid.NewString(),
+		OwnerId:    uuid.NewString(),
+		Source:     repo.SourceUser,
+	}
+	reporterId := uuid.NewString()
+
+	target.Reasons = []string{"spam"}
+	c, report, err := s.repo.CreateReport(context.Background(), target, repo.Report{Id: uuid.NewString(), ReporterId: reporterId, Reason: "spam"})
+	s.Require().Nil(err)
+	s.Equal(repo.StatusOpen, c.Status)
+	s.Equal(int64(1), c.Reports)
+	s.Equal(c.Id, report.CaseId)
+
+	// the same user reports the target once
+	_, _, err = s.repo.CreateReport(context.Background(), target, repo.Report{Id: uuid.NewString(), ReporterId: reporterId, Reason: "spam"})
+	s.ErrorIs(err, repo.ErrAlreadyReported)
+
+	// reports of other users are added to the open case
+	target.Reasons = []string{"hate"}
+	c, _, err = s.repo.CreateReport(context.Background(), target, repo.Report{Id: uuid.NewString(), ReporterId: uuid.NewString(), Reason: "hate"})
+	s.Require().Nil(err)
+	s.Equal(report.CaseId, c.Id)
+	s.Equal(int64(2), c.Reports)
+	s.Equal([]string{"hate", "spam"}, c.Reasons)
+
+	reports, err := s.repo.GetReports(context.Background(), c.Id)
+	s.Nil(err)
+	s.Len(reports, 2)
+
+	moderatorId := uuid.NewString()
+	c, err = s.repo.AssignCase(context.Background(), c.Id, moderatorId)
+	s.Nil(err)
+	s.Equal(repo.StatusAssigned, c.Status)
+	s.Equal(moderatorId, c.AssigneeId)
+
+	cases, err := s.repo.GetCases(context.Background(), repo.CaseFilter{AssigneeId: moderatorId, Limit: 10})
+	s.Nil(err)
+	s.Require().Len(cases, 1)
+	s.Equal(c.Id, cases[0].Id)
+
+	c, err = s.repo.ResolveCase(context.Background(), c.Id, moderatorId, repo.ActionHide, "")
+	s.Nil(err)
+	s.Equal(repo.StatusResolved, c.Status)
+	s.Equal(repo.ActionHide, c.Action)
+	s.NotEmpty(c.ResolvedAt)
+
+	_, err = s.repo.ResolveCase(context.Background(), c.Id, moderatorId, repo.ActionRemove, "")
+	s.ErrorIs(err, sql.ErrNoRows)
+
+	// new report of resolved target opens a new case
+	target.Reasons = []string{"spam"}
+	newCase, _, err := s.repo.CreateReport(context.Background(), target, repo.Report{Id: uuid.NewString(), ReporterId: reporterId, Reason: "spam"})
+	s.Nil(err)
+	s.NotEqual(c.Id, newCase.Id)
+
+	deleted, err := s.repo.DeleteTargetCases(context.Background(), uuid.NewString(), repo.TargetPost, target.TargetId)
+	s.Nil(err)
+	s.Equal(int64(2), deleted)
+}
+
+func (s *ModerationSuiteTest) TestHoldContent() {
+	target := repo.Case{
+		TargetType: repo.TargetComment,
+		TargetId:   uuid.NewString(),
+		OwnerId:    uuid.NewString(),
+		Source:     repo.SourceAuto,
+		Reasons:    []string{"spam"},
+	}
+
+	eventId := uuid.NewString()
+	ok, err := s.repo.HoldContent(context.Background(), eventId, target)
+	s.Nil(err)
+	s.True(ok)
+
+	// the same event is handled once
+	ok, err = s.repo.HoldContent(context.Background(), eventId, target)
+	s.Nil(err)
+	s.False(ok)
+
+	cases, err := s.repo.GetCases(context.Background(), repo.CaseFilter{TargetType: repo.TargetComment, Limit: 100})
+	s.Nil(err)
+	found := false
+	for _, c := range cases {
+		if c.TargetId == target.TargetId {
+			found = true
+			s.Equal(repo.SourceAuto, c.Source)
+			s.Equal(int64(0), c.Reports)
+		}
+	}
+	s.True(found)
+
+	deleted, err := s.repo.DeleteUserData(context.Background(), uuid.NewString(), target.OwnerId)
+	s.Nil(err)
+	s.Equal(int64(1), deleted)
+}
+
+func (suite *ModerationSuiteTest) TearDownSuite() {
+	suite.CleanUpfunc()
+}
+
+func TestModerationRepositoryTestSuite(t *testing.T) {
+	suite.Run(t, new(ModerationSuiteTest))
+}
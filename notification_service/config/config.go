@@ -0,0 +1,123 @@
+package config
+
+import (
+	"os"
+
+	"github.com/spf13/cast"
+)
+
+type Config struct {
+	PostgresHost     string
+	PostgresPort     string
+	PostgresUser     string
+	PostgresPassword string
+	PostgresDatabase string
+	Environment      string
+	LogLevel         string
+
+	UserServiceHost         string
+	UserServicePort         string
+	NotificationServiceHost string
+	NotificationServicePort string
+
+	// email digest...
+	SMTPHost       string
+	SMTPPort       string
+	EmailFrom      string
+	EmailPassword  string // digest is not sent when it is empty
+	DigestInterval int    // in seconds
+
+	// events...
+	RedisHost string
+	RedisPort string
+
+	// tracing...
+	TraceExporter    string // none, otlp, stdout or file
+	OTLPEndpoint     string
+	TraceFile        string
+	TraceSampleRatio float64
+
+	// metrics...
+	MetricsPort string
+
+	// startup and shutdown...
+	StartupTimeout      int // in seconds, how long the database is waited for
+	HealthCheckInterval int // in seconds
+	ShutdownTimeout     int // in seconds, calls still running after it are canceled
+
+	// tls...
+	TLSEnabled        bool // services are called with mutual TLS, certificates are made by `make dev-certs` in api_gateway
+	TLSCertFile       string
+	TLSKeyFile        string
+	TLSCAFile         string
+	TLSReloadInterval int // in seconds, how often certificate files are checked for changes
+
+	// identity...
+	IdentitySecret string // signs the end user of gRPC calls, the same in the gateway and all services
+	IdentityMaxAge int    // in seconds, how long a signed identity is accepted
+}
+
+func Load() Config {
+	c := Config{}
+
+	c.PostgresUser = cast.ToString(getOrReturnDefault("POSTGRES_User", "postgres"))
+	c.PostgresPassword = cast.ToString(getOrReturnDefault("POSTGRES_PASSWORD", "b"))
+	c.PostgresHost = cast.ToString(getOrReturnDefault("POSTGRES_HOST", "localhost"))
+	c.PostgresPort = cast.ToString(getOrReturnDefault("POSTGRES_PORT", "5432"))
+	c.PostgresDatabase = cast.ToString(getOrReturnDefault("POSTGRES_DATABASE", "new_notificationdb"))
+	c.Environment = cast.ToString(getOrReturnDefault("ENVIRONMENT", "develop"))
+	c.LogLevel = cast.ToString(getOrReturnDefault("LOG_LEVEL", "debug"))
+
+	c.UserServiceHost = cast.ToString(getOrReturnDefault("USER_SERVICE_HOST", "localhost"))
+	c.UserServicePort = cast.ToString(getOrReturnDefault("USER_SERVICE_PORT", "8000"))
+
+	c.NotificationServiceHost = cast.ToString(getOrReturnDefault("NOTIFICATION_SERVICE_HOST", "localhost"))
+	c.NotificationServicePort = cast.ToString(getOrReturnDefault("NOTIFICATION_SERVICE_PORT", ":8030"))
+
+	// email digest...
+	c.SMTPHost = cast.ToString(getOrReturnDefault("SMTP_HOST", "smtp.gmail.com"))
+	c.SMTPPort = cast.ToString(getOrReturnDefault("SMTP_PORT", "587"))
+	c.EmailFrom = cast.ToString(getOrReturnDefault("EMAIL_FROM", ""))
+	c.EmailPassword = cast.ToString(getOrReturnDefault("EMAIL_PASSWORD", ""))
+	c.DigestInterval = cast.ToInt(getOrReturnDefault("DIGEST_INTERVAL", 3600))
+
+	// events...
+	c.RedisHost = cast.ToString(getOrReturnDefault("REDIS_HOST", "localhost"))
+	c.RedisPort = cast.ToString(getOrReturnDefault("REDIS_PORT", "6379"))
+
+	// tracing...
+	c.TraceExporter = cast.ToString(getOrReturnDefault("TRACE_EXPORTER", "none"))
+	c.OTLPEndpoint = cast.ToString(getOrReturnDefault("OTLP_ENDPOINT", "localhost:4317"))
+	c.TraceFile = cast.ToString(getOrReturnDefault("TRACE_FILE", "traces.json"))
+	c.TraceSampleRatio = cast.ToFloat64(getOrReturnDefault("TRACE_SAMPLE_RATIO", 1.0))
+
+	// metrics...
+	c.MetricsPort = cast.ToString(getOrReturnDefault("METRICS_PORT", ":9130"))
+
+	// startup and shutdown...
+	c.StartupTimeout = cast.ToInt(getOrReturnDefault("STARTUP_TIMEOUT", 60))
+	c.HealthCheckInterval = cast.ToInt(getOrReturnDefault("HEALTH_CHECK_INTERVAL", 5))
+	c.ShutdownTimeout = cast.ToInt(getOrReturnDefault("SHUTDOWN_TIMEOUT", 15))
+
+	// tls...
+	c.TLSEnabled = cast.ToBool(getOrReturnDefault("TLS_ENABLED", false))
+	c.TLSCertFile = cast.ToString(getOrReturnDefault("TLS_CERT_FILE", "../certs/notification_service.crt"))
+	c.TLSKeyFile = cast.ToString(getOrReturnDefault("TLS_KEY_FILE", "../certs/notification_service.key"))
+	c.TLSCAFile = cast.ToString(getOrReturnDefault("TLS_CA_FILE", "../certs/ca.crt"))
+	c.TLSReloadInterval = cast.ToInt(getOrReturnDefault("TLS_RELOAD_INTERVAL", 30))
+
+	// identity...
+	c.IdentitySecret = cast.ToString(getOrReturnDefault("IDENTITY_SECRET", "dev-identity-secret"))
+	c.IdentityMaxAge = cast.ToInt(getOrReturnDefault("IDENTITY_MAX_AGE", 60))
+
+	return c
+}
+
+func getOrReturnDefault(key string, defaultValue interface{}) interface{} {
+	_, exists := os.LookupEnv(key)
+	if exists {
+		return os.Getenv(key)
+	}
+
+	return defaultValue
+}
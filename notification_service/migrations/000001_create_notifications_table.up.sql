@@ -0,0 +1,22 @@
+create table "notifications"(
+    "id" uuid primary key,
+    "user_id" uuid not null,
+    "actor_id" uuid,
+    "type" text not null,
+    "post_id" uuid,
+    "comment_id" uuid,
+    "text" text,
+    "read_at" timestamp,
+    "emailed_at" timestamp,
+    "created_at" timestamp default current_timestamp
+);
+
+create index "notifications_user_id_idx" on "notifications"("user_id", "created_at" desc);
+
+create table "notification_preferences"(
+    "user_id" uuid,
+    "type" text,
+    "in_app" boolean default true,
+    "email" boolean default false,
+    primary key("user_id", "type")
+);
@@ -0,0 +1,4 @@
+create table "processed_events"(
+    "event_id" uuid primary key,
+    "processed_at" timestamp default current_timestamp
+);
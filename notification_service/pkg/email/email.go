@@ -0,0 +1,49 @@
+package email
+
+import (
+	"fmt"
+	"net/smtp"
+	"strings"
+)
+
+type Sender interface {
+	Send(to []string, subject, body string) error
+}
+
+type SMTPSender struct {
+	host     string
+	port     string
+	from     string
+	password string
+}
+
+func NewSMTPSender(host, port, from, password string) *SMTPSender {
+	return &SMTPSender{
+		host:     host,
+		port:     port,
+		from:     from,
+		password: password,
+	}
+}
+
+func (s *SMTPSender) Send(to []string, subject, body string) error {
+	// Authentication
+	auth := smtp.PlainAuth("", s.from, s.password, s.host)
+
+	// sending email
+	return smtp.SendMail(s.host+":"+s.port, auth, s.from, to, Message(s.from, to, subject, body))
+}
+
+// Message builds plain text email with headers
+func Message(from string, to []string, subject, body string) []byte {
+	var b strings.Builder
+	fmt.Fprintf(&b, "From: %s\r\n", from)
+	fmt.Fprintf(&b, "To: %s\r\n", strings.Join(to, ", "))
+	fmt.Fprintf(&b, "Subject: %s\r\n", strings.NewReplacer("\r", "", "\n", "").Replace(subject))
+	b.WriteString("MIME-Version: 1.0\r\n")
+	b.WriteString("Content-Type: text/plain; charset=\"UTF-8\"\r\n")
+	b.WriteString("\r\n")
+	b.WriteString(strings.ReplaceAll(body, "\n", "\r\n"))
+
+	return []byte(b.String())
+}
@@ -0,0 +1,76 @@
+package events
+
+import (
+	"context"
+	"sync"
+
+	"github.com/burxondv/new-services/notification-service/pkg/logger"
+)
+
+// MemoryBus keeps events in memory, it is used in tests
+type MemoryBus struct {
+	mu      sync.Mutex
+	events  []Event
+	offsets map[string]int // next event of every group
+	notify  chan struct{}  // closed on publish
+	log     logger.Logger
+}
+
+func NewMemoryBus(log logger.Logger) *MemoryBus {
+	return &MemoryBus{
+		offsets: map[string]int{},
+		notify:  make(chan struct{}),
+		log:     log,
+	}
+}
+
+func (b *MemoryBus) Publish(ctx context.Context, event Event) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.events = append(b.events, event)
+	close(b.notify)
+	b.notify = make(chan struct{})
+
+	return nil
+}
+
+func (b *MemoryBus) Subscribe(ctx context.Context, group, consumer string, handler Handler) error {
+	for {
+		b.mu.Lock()
+		if b.offsets[group] == len(b.events) {
+			wait := b.notify
+			b.mu.Unlock()
+
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-wait:
+			}
+			continue
+		}
+
+		event := b.events[b.offsets[group]]
+		b.offsets[group]++
+		b.mu.Unlock()
+
+		for attempt := 1; ; attempt++ {
+			err := handler(ctx, event)
+			if err == nil {
+				break
+			}
+			if attempt == maxAttempts || ctx.Err() != nil {
+				b.log.Error("failed to handle event, skipped", logger.String("event_id", event.Id), logger.Error(err))
+				break
+			}
+		}
+	}
+}
+
+// Events returns all published events
+func (b *MemoryBus) Events() []Event {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	return append([]Event{}, b.events...)
+}
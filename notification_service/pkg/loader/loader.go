@@ -0,0 +1,80 @@
+package loader
+
+import (
+	"context"
+	"sync"
+)
+
+// BatchFunc fetches values of all keys with one call, keys which are not
+// found are missing in the result
+type BatchFunc[K comparable, V any] func(ctx context.Context, keys []K) (map[K]V, error)
+
+// Loader is a request scoped dataloader, it fetches values of many keys with
+// one batch call and caches them, so every key is fetched once per request.
+// Create a new loader for every request.
+type Loader[K comparable, V any] struct {
+	fetch BatchFunc[K, V]
+
+	mu     sync.Mutex
+	cache  map[K]V
+	loaded map[K]struct{}
+}
+
+func New[K comparable, V any](fetch BatchFunc[K, V]) *Loader[K, V] {
+	return &Loader[K, V]{
+		fetch:  fetch,
+		cache:  map[K]V{},
+		loaded: map[K]struct{}{},
+	}
+}
+
+// LoadMany returns values of found keys, only keys which are not loaded yet
+// are fetched
+func (l *Loader[K, V]) LoadMany(ctx context.Context, keys []K) (map[K]V, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	missing := []K{}
+	for _, key := range keys {
+		if _, ok := l.loaded[key]; ok {
+			continue
+		}
+
+		l.loaded[key] = struct{}{}
+		missing = append(missing, key)
+	}
+
+	if len(missing) > 0 {
+		res, err := l.fetch(ctx, missing)
+		if err != nil {
+			for _, key := range missing {
+				delete(l.loaded, key)
+			}
+			return nil, err
+		}
+
+		for key, val := range res {
+			l.cache[key] = val
+		}
+	}
+
+	res := make(map[K]V, len(keys))
+	for _, key := range keys {
+		if val, ok := l.cache[key]; ok {
+			res[key] = val
+		}
+	}
+
+	return res, nil
+}
+
+// Load returns value of the key, ok is false when the key is not found
+func (l *Loader[K, V]) Load(ctx context.Context, key K) (val V, ok bool, err error) {
+	res, err := l.LoadMany(ctx, []K{key})
+	if err != nil {
+		return val, false, err
+	}
+
+	val, ok = res[key]
+	return val, ok, nil
+}
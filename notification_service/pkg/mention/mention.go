@@ -0,0 +1,32 @@
+package mention
+
+import (
+	"regexp"
+	"strings"
+)
+
+// MaxPerText limits notifications sent for one text
+const MaxPerText = 10
+
+// name must not be a part of word or email like user@mail.com
+var mentionRegexp = regexp.MustCompile(`(?:^|[^\p{L}\p{N}_.@])@([\p{L}\p{N}_]+)`)
+
+// Parse returns lowercased unique names mentioned in the text like @john
+func Parse(text string) []string {
+	seen := map[string]bool{}
+	res := []string{}
+	for _, match := range mentionRegexp.FindAllStringSubmatch(text, -1) {
+		name := strings.ToLower(match[1])
+		if seen[name] {
+			continue
+		}
+		if len(res) == MaxPerText {
+			break
+		}
+
+		seen[name] = true
+		res = append(res, name)
+	}
+
+	return res
+}
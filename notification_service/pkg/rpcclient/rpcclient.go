@@ -0,0 +1,134 @@
+package rpcclient
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/burxondv/new-services/notification-service/pkg/mtls"
+	"github.com/burxondv/new-services/notification-service/pkg/requestid"
+
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/keepalive"
+	"google.golang.org/grpc/resolver"
+	"google.golang.org/grpc/resolver/manual"
+)
+
+const (
+	// client pings idle connections so broken ones are noticed before a call
+	keepaliveTime    = 30 * time.Second
+	keepaliveTimeout = 10 * time.Second
+
+	// KeepaliveMinTime is how often servers let clients ping, it must be
+	// below keepaliveTime or servers close connections with too_many_pings
+	KeepaliveMinTime = 20 * time.Second
+)
+
+// idempotent are the methods which only read, they are retried when a
+// service is unavailable. Streams are never retried.
+var idempotent = map[string][]string{
+	"user.UserService": {
+		"GetUserById", "GetUserByEmail", "GetAllUsers", "SearchUsers", "GetFollowers",
+		"GetBlockedUsers", "GetMutedUsers", "GetDataExport", "GetDataExportContent",
+		"CheckField", "GetUserForClient", "IsFollowing", "GetUsersByFirstNames",
+		"GetUsersByIds", "GetAccountStatus", "IsBlocked", "GetMutedIds", "GetSameRoleUsers",
+	},
+	"post.PostService": {
+		"GetPostById", "GetPostByUserId", "SearchPosts", "GetAttachments", "GetAttachmentContent",
+		"GetRevisions", "DiffRevisions", "GetPostsByTag", "AutocompleteTags", "GetTrendingTags",
+		"GetPostForUser", "GetPostForComment", "GetLikesByUser", "GetPostsByIds", "CountPostsForUsers",
+	},
+	"comment.CommentService": {
+		"GetComments", "GetComment", "GetCommentsForPost", "GetCommentsByUser", "CountCommentsForPosts",
+	},
+	"notification.NotificationService": {
+		"GetNotifications", "GetPreferences",
+	},
+	"moderation.ModerationService": {
+		"GetQueue", "GetCase",
+	},
+}
+
+// serviceConfig balances calls over all addresses of a service and retries
+// idempotent methods
+var serviceConfig = func() string {
+	type name struct {
+		Service string `json:"service"`
+		Method  string `json:"method"`
+	}
+
+	names := []name{}
+	for service, methods := range idempotent {
+		for _, method := range methods {
+			names = append(names, name{Service: service, Method: method})
+		}
+	}
+
+	config := map[string]interface{}{
+		"loadBalancingConfig": []interface{}{
+			map[string]interface{}{"round_robin": map[string]interface{}{}},
+		},
+		"methodConfig": []interface{}{
+			map[string]interface{}{
+				"name": names,
+				"retryPolicy": map[string]interface{}{
+					"maxAttempts":          3,
+					"initialBackoff":       "0.1s",
+					"maxBackoff":           "1s",
+					"backoffMultiplier":    2,
+					"retryableStatusCodes": []string{"UNAVAILABLE"},
+				},
+			},
+		},
+	}
+
+	res, err := json.Marshal(config)
+	if err != nil {
+		panic(err)
+	}
+	return string(res)
+}()
+
+// Dial connects caller to the dependency name. host is one host name, which
+// is resolved with DNS and may have several addresses, or a comma separated
+// list of hosts. Calls go round-robin over the addresses, idempotent calls
+// are retried and a circuit breaker fails calls fast while name is down.
+// Connections use mutual TLS unless certs is nil.
+func Dial(caller, name, host, port string, certs *mtls.Certs, opts ...grpc.DialOption) (*grpc.ClientConn, error) {
+	target := fmt.Sprintf("dns:///%s:%s", host, port)
+	if strings.Contains(host, ",") {
+		addrs := []resolver.Address{}
+		for _, h := range strings.Split(host, ",") {
+			if h = strings.TrimSpace(h); h != "" {
+				addrs = append(addrs, resolver.Address{Addr: h + ":" + port})
+			}
+		}
+
+		r := manual.NewBuilderWithScheme("static")
+		r.InitialState(resolver.State{Addresses: addrs})
+		opts = append(opts, grpc.WithResolvers(r))
+		target = "static:///" + name
+	}
+
+	breaker := NewBreaker(name, breakerThreshold, breakerCooldown)
+	opts = append([]grpc.DialOption{
+		grpc.WithTransportCredentials(certs.ClientCredentials(name)),
+		grpc.WithStatsHandler(otelgrpc.NewClientHandler()),
+		grpc.WithDefaultServiceConfig(serviceConfig),
+		grpc.WithKeepaliveParams(keepalive.ClientParameters{
+			Time:                keepaliveTime,
+			Timeout:             keepaliveTimeout,
+			PermitWithoutStream: true,
+		}),
+		grpc.WithChainUnaryInterceptor(breaker.UnaryClientInterceptor(), requestid.UnaryClientInterceptor(caller)),
+		grpc.WithChainStreamInterceptor(breaker.StreamClientInterceptor(), requestid.StreamClientInterceptor(caller)),
+	}, opts...)
+
+	conn, err := grpc.Dial(target, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("%s dial %s: %w", name, target, err)
+	}
+	return conn, nil
+}
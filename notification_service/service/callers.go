@@ -0,0 +1,7 @@
+package service
+
+// InternalCallers are the services allowed to call internal methods, the
+// gateway can't call them
+var InternalCallers = map[string][]string{
+	"/notification.NotificationService/Notify": {"user_service", "post_service", "comment_service", "moderation_service"},
+}
@@ -0,0 +1,121 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/burxondv/new-services/notification-service/pkg/logger"
+	"github.com/burxondv/new-services/notification-service/storage/repo"
+)
+
+var digestTitles = map[string]string{
+	repo.TypeComment: "commented on your post",
+	repo.TypeReply:   "replied to your comment",
+	repo.TypeLike:    "liked your post",
+	repo.TypeFollow:  "started following you",
+	repo.TypeMention: "mentioned you",
+
+	repo.TypeModeration: "reviewed your content",
+}
+
+// RunDigest sends one email per user with notifications which are not read and not emailed yet
+func (s *NotificationService) RunDigest(ctx context.Context, interval time.Duration) {
+	if s.email == nil {
+		s.Logger.Info("digest: email is not configured, digest is disabled")
+		return
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := s.sendDigests(ctx); err != nil {
+				s.Logger.Error("digest: failed to send digests", logger.Error(err))
+			}
+		}
+	}
+}
+
+func (s *NotificationService) sendDigests(ctx context.Context) error {
+	pending, err := s.storage.Notification().GetPendingEmails(ctx, 1000)
+	if err != nil {
+		return err
+	}
+
+	byUser := map[string][]repo.Notification{}
+	users := []string{}
+	for _, notif := range pending {
+		if _, ok := byUser[notif.UserId]; !ok {
+			users = append(users, notif.UserId)
+		}
+		byUser[notif.UserId] = append(byUser[notif.UserId], notif)
+	}
+
+	// recipients and actors of all digests are got with one call
+	userIds := append([]string{}, users...)
+	for _, notif := range pending {
+		if notif.ActorId != "" {
+			userIds = append(userIds, notif.ActorId)
+		}
+	}
+
+	loaded, err := s.userLoader().LoadMany(ctx, userIds)
+	if err != nil {
+		return err
+	}
+
+	for _, userId := range users {
+		user, ok := loaded[userId]
+		if !ok {
+			s.Logger.Error("digest: user is not found", logger.String("user_id", userId))
+			continue
+		}
+
+		notifs := byUser[userId]
+		lines := []string{}
+		ids := []string{}
+		for _, notif := range notifs {
+			name := "Someone"
+			if notif.Type == repo.TypeModeration {
+				name = "Moderators"
+			} else if actor, ok := loaded[notif.ActorId]; ok {
+				name = actor.FirstName + " " + actor.LastName
+			}
+
+			lines = append(lines, digestLine(name, notif))
+			ids = append(ids, notif.Id)
+		}
+
+		subject := fmt.Sprintf("You have %d new notifications", len(notifs))
+		body := fmt.Sprintf("Hi %s,\n\n%s\n", user.FirstName, strings.Join(lines, "\n"))
+		if err := s.email.Send([]string{user.Email}, subject, body); err != nil {
+			s.Logger.Error("digest: failed to send email", logger.String("user_id", userId), logger.Error(err))
+			continue
+		}
+
+		if err := s.storage.Notification().MarkEmailed(ctx, ids); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func digestLine(actorName string, notif repo.Notification) string {
+	line := fmt.Sprintf("- %s %s", actorName, digestTitles[notif.Type])
+	if notif.Text != "" {
+		text := []rune(notif.Text)
+		if len(text) > 100 {
+			text = append(text[:100], '…')
+		}
+		line += fmt.Sprintf(": %q", string(text))
+	}
+
+	return line
+}
@@ -0,0 +1,50 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/burxondv/new-services/notification-service/pkg/events"
+	"github.com/burxondv/new-services/notification-service/pkg/logger"
+)
+
+// consumerGroup is the group of all notification service replicas in the event bus
+const consumerGroup = "notification_service"
+
+// RunConsumer handles events of other services until ctx is done, consumer
+// must be unique among replicas
+func (s *NotificationService) RunConsumer(ctx context.Context, bus events.Bus, consumer string) {
+	for ctx.Err() == nil {
+		err := bus.Subscribe(ctx, consumerGroup, consumer, s.HandleEvent)
+		if err != nil && ctx.Err() == nil {
+			s.Logger.Error("consumer: subscription is broken", logger.Error(err))
+			time.Sleep(time.Second)
+		}
+	}
+}
+
+// HandleEvent deletes notifications of deleted users and posts
+func (s *NotificationService) HandleEvent(ctx context.Context, event events.Event) error {
+	switch event.Type {
+	case events.UserDeleted:
+		var user events.User
+		if err := event.Decode(&user); err != nil {
+			s.Logger.Error("consumer: failed to decode event", logger.String("event_id", event.Id), logger.Error(err))
+			return nil
+		}
+
+		_, err := s.storage.Notification().DeleteUserNotifications(ctx, event.Id, user.Id)
+		return err
+	case events.PostDeleted:
+		var post events.Post
+		if err := event.Decode(&post); err != nil {
+			s.Logger.Error("consumer: failed to decode event", logger.String("event_id", event.Id), logger.Error(err))
+			return nil
+		}
+
+		_, err := s.storage.Notification().DeletePostNotifications(ctx, event.Id, post.Id)
+		return err
+	}
+
+	return nil
+}
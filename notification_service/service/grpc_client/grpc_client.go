@@ -0,0 +1,57 @@
+package grpcclient
+
+import (
+	"fmt"
+
+	"github.com/burxondv/new-services/notification-service/config"
+	nu "github.com/burxondv/new-services/notification-service/genproto/user"
+	"github.com/burxondv/new-services/notification-service/pkg/identity"
+	"github.com/burxondv/new-services/notification-service/pkg/mtls"
+	"github.com/burxondv/new-services/notification-service/pkg/rpcclient"
+
+	"google.golang.org/grpc"
+)
+
+type Clients interface {
+	User() nu.UserServiceClient
+}
+
+type ServiceManager struct {
+	Config      config.Config
+	userService nu.UserServiceClient
+	conns       []*grpc.ClientConn
+}
+
+func New(cfg config.Config, certs *mtls.Certs, signer *identity.Signer) (*ServiceManager, error) {
+	// the end user of a call is sent to the called service
+	opts := []grpc.DialOption{
+		grpc.WithChainUnaryInterceptor(signer.UnaryClientInterceptor()),
+		grpc.WithChainStreamInterceptor(signer.StreamClientInterceptor()),
+	}
+
+	connUser, err := rpcclient.Dial("notification_service", "user_service", cfg.UserServiceHost, cfg.UserServicePort, certs, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("user service dial host:%s, port:%s", cfg.UserServiceHost, cfg.UserServicePort)
+	}
+
+	return &ServiceManager{
+		Config:      cfg,
+		userService: nu.NewUserServiceClient(connUser),
+		conns:       []*grpc.ClientConn{connUser},
+	}, nil
+}
+
+func (s *ServiceManager) User() nu.UserServiceClient {
+	return s.userService
+}
+
+// Close closes connections to all services
+func (s *ServiceManager) Close() error {
+	var res error
+	for _, conn := range s.conns {
+		if err := conn.Close(); err != nil {
+			res = err
+		}
+	}
+	return res
+}
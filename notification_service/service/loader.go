@@ -0,0 +1,25 @@
+package service
+
+import (
+	"context"
+
+	u "github.com/burxondv/new-services/notification-service/genproto/user"
+	"github.com/burxondv/new-services/notification-service/pkg/loader"
+)
+
+// userLoader gets users with one GetUsersByIds call, create it per request
+func (s *NotificationService) userLoader() *loader.Loader[string, *u.UserResponse] {
+	return loader.New(func(ctx context.Context, ids []string) (map[string]*u.UserResponse, error) {
+		res, err := s.Client.User().GetUsersByIds(ctx, &u.IdsRequest{Ids: ids})
+		if err != nil {
+			return nil, err
+		}
+
+		users := map[string]*u.UserResponse{}
+		for _, user := range res.Users {
+			users[user.Id] = user
+		}
+
+		return users, nil
+	})
+}
@@ -0,0 +1,286 @@
+package service
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+
+	n "github.com/burxondv/new-services/notification-service/genproto/notification"
+	u "github.com/burxondv/new-services/notification-service/genproto/user"
+	"github.com/burxondv/new-services/notification-service/pkg/email"
+	"github.com/burxondv/new-services/notification-service/pkg/logger"
+	"github.com/burxondv/new-services/notification-service/pkg/mention"
+	"github.com/burxondv/new-services/notification-service/pkg/pubsub"
+	grpcclient "github.com/burxondv/new-services/notification-service/service/grpc_client"
+	"github.com/burxondv/new-services/notification-service/storage"
+	"github.com/burxondv/new-services/notification-service/storage/repo"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	defaultLimit = 20
+	maxLimit     = 100
+)
+
+type NotificationService struct {
+	storage       storage.IStorage
+	Logger        logger.Logger
+	Client        grpcclient.Clients
+	email         email.Sender // nil when email is not configured
+	notifications *pubsub.Hub[*n.NotificationResponse]
+}
+
+func NewNotificationService(db *sqlx.DB, log logger.Logger, client grpcclient.Clients, sender email.Sender) *NotificationService {
+	return &NotificationService{
+		storage:       storage.NewStoragePg(db, log),
+		Logger:        log,
+		Client:        client,
+		email:         sender,
+		notifications: pubsub.NewHub[*n.NotificationResponse](streamBuffer),
+	}
+}
+
+// reqLog returns logger with ids of the request and of its trace
+func (s *NotificationService) reqLog(ctx context.Context) logger.Logger {
+	return logger.WithContext(s.Logger, ctx)
+}
+
+func (s *NotificationService) Notify(ctx context.Context, req *n.NotifyRequest) (*n.NotifyResponse, error) {
+	if !validType(req.Type) {
+		return &n.NotifyResponse{}, status.Errorf(codes.InvalidArgument, "unknown notification type %q", req.Type)
+	}
+
+	receivers := []string{req.UserId}
+	if req.Type == repo.TypeMention {
+		names := mention.Parse(req.Text)
+		if len(names) == 0 {
+			return &n.NotifyResponse{}, nil
+		}
+
+		users, err := s.Client.User().GetUsersByFirstNames(ctx, &u.NamesRequest{Names: names})
+		if err != nil {
+			s.reqLog(ctx).Error("failed to get mentioned users", logger.Error(err))
+			return &n.NotifyResponse{}, err
+		}
+
+		receivers = []string{}
+		for _, user := range users.Users {
+			receivers = append(receivers, user.Id)
+		}
+	} else if req.UserId == "" {
+		return &n.NotifyResponse{}, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+
+	var created int64
+	for _, userId := range receivers {
+		// nobody is notified about own actions
+		if userId == req.ActorId {
+			continue
+		}
+
+		pref, err := s.preference(ctx, userId, req.Type)
+		if err != nil {
+			return &n.NotifyResponse{}, err
+		}
+		if !pref.InApp && !pref.Email {
+			continue
+		}
+
+		notif := repo.Notification{
+			Id:        uuid.NewString(),
+			UserId:    userId,
+			ActorId:   req.ActorId,
+			Type:      req.Type,
+			PostId:    req.PostId,
+			CommentId: req.CommentId,
+			Text:      req.Text,
+		}
+		ok, err := s.storage.Notification().CreateNotification(ctx, notif)
+		if err != nil {
+			s.reqLog(ctx).Error("failed to create notification", logger.Error(err))
+			return &n.NotifyResponse{}, err
+		}
+		if ok {
+			created++
+			if pref.InApp {
+				s.publish(ctx, notif)
+			}
+		}
+	}
+
+	return &n.NotifyResponse{Created: created}, nil
+}
+
+func (s *NotificationService) GetNotifications(ctx context.Context, req *n.GetNotificationsRequest) (*n.NotificationsResponse, error) {
+	limit := req.Limit
+	if limit <= 0 {
+		limit = defaultLimit
+	} else if limit > maxLimit {
+		limit = maxLimit
+	}
+	page := req.Page
+	if page < 1 {
+		page = 1
+	}
+
+	res, err := s.storage.Notification().GetNotifications(ctx, req.UserId, req.UnreadOnly, limit, (page-1)*limit)
+	if err != nil {
+		s.reqLog(ctx).Error("failed to get notifications", logger.Error(err))
+		return &n.NotificationsResponse{}, err
+	}
+
+	unread, err := s.storage.Notification().CountUnread(ctx, req.UserId)
+	if err != nil {
+		s.reqLog(ctx).Error("failed to count unread notifications", logger.Error(err))
+		return &n.NotificationsResponse{}, err
+	}
+
+	actorIds := []string{}
+	for _, val := range res {
+		if val.ActorId != "" {
+			actorIds = append(actorIds, val.ActorId)
+		}
+	}
+
+	actors, err := s.userLoader().LoadMany(ctx, actorIds)
+	if err != nil {
+		s.reqLog(ctx).Error("failed to get actors for get notifications", logger.Error(err))
+		return &n.NotificationsResponse{}, err
+	}
+
+	notifsResp := n.NotificationsResponse{Unread: unread}
+	for _, val := range res {
+		notif := notificationResponse(val)
+		if actor, ok := actors[val.ActorId]; ok {
+			notif.ActorName = actor.FirstName + " " + actor.LastName
+		}
+
+		notifsResp.Notifications = append(notifsResp.Notifications, notif)
+	}
+
+	return &notifsResp, nil
+}
+
+func (s *NotificationService) MarkRead(ctx context.Context, req *n.MarkReadRequest) (*n.MarkReadResponse, error) {
+	var (
+		updated int64
+		err     error
+	)
+	if req.All {
+		updated, err = s.storage.Notification().MarkAllRead(ctx, req.UserId)
+	} else if len(req.Ids) > 0 {
+		updated, err = s.storage.Notification().MarkRead(ctx, req.UserId, req.Ids)
+	}
+	if err != nil {
+		s.reqLog(ctx).Error("failed to mark notifications read", logger.Error(err))
+		return &n.MarkReadResponse{}, err
+	}
+
+	return &n.MarkReadResponse{Updated: updated}, nil
+}
+
+func (s *NotificationService) GetPreferences(ctx context.Context, req *n.Request) (*n.PreferencesResponse, error) {
+	prefs, err := s.preferences(ctx, req.Str)
+	if err != nil {
+		return &n.PreferencesResponse{}, err
+	}
+
+	return preferencesResponse(req.Str, prefs), nil
+}
+
+func (s *NotificationService) UpdatePreferences(ctx context.Context, req *n.PreferencesRequest) (*n.PreferencesResponse, error) {
+	prefs := []repo.Preference{}
+	for _, pref := range req.Preferences {
+		if !validType(pref.Type) {
+			return &n.PreferencesResponse{}, status.Errorf(codes.InvalidArgument, "unknown notification type %q", pref.Type)
+		}
+
+		prefs = append(prefs, repo.Preference{Type: pref.Type, InApp: pref.InApp, Email: pref.Email})
+	}
+
+	err := s.storage.Notification().UpdatePreferences(ctx, req.UserId, prefs)
+	if err != nil {
+		s.reqLog(ctx).Error("failed to update preferences", logger.Error(err))
+		return &n.PreferencesResponse{}, err
+	}
+
+	return s.GetPreferences(ctx, &n.Request{Str: req.UserId})
+}
+
+// preferences returns preference of every type, not saved ones are default:
+// shown in app and not emailed
+func (s *NotificationService) preferences(ctx context.Context, userId string) ([]repo.Preference, error) {
+	saved, err := s.storage.Notification().GetPreferences(ctx, userId)
+	if err != nil {
+		s.reqLog(ctx).Error("failed to get preferences", logger.Error(err))
+		return []repo.Preference{}, err
+	}
+
+	byType := map[string]repo.Preference{}
+	for _, pref := range saved {
+		byType[pref.Type] = pref
+	}
+
+	res := []repo.Preference{}
+	for _, t := range repo.Types {
+		pref, ok := byType[t]
+		if !ok {
+			pref = repo.Preference{Type: t, InApp: true}
+		}
+
+		res = append(res, pref)
+	}
+
+	return res, nil
+}
+
+func (s *NotificationService) preference(ctx context.Context, userId, notifType string) (repo.Preference, error) {
+	prefs, err := s.preferences(ctx, userId)
+	if err != nil {
+		return repo.Preference{}, err
+	}
+
+	for _, pref := range prefs {
+		if pref.Type == notifType {
+			return pref, nil
+		}
+	}
+
+	return repo.Preference{Type: notifType, InApp: true}, nil
+}
+
+func validType(notifType string) bool {
+	for _, t := range repo.Types {
+		if t == notifType {
+			return true
+		}
+	}
+
+	return false
+}
+
+func notificationResponse(val repo.Notification) *n.NotificationResponse {
+	return &n.NotificationResponse{
+		Id:        val.Id,
+		UserId:    val.UserId,
+		ActorId:   val.ActorId,
+		Type:      val.Type,
+		PostId:    val.PostId,
+		CommentId: val.CommentId,
+		Text:      val.Text,
+		Read:      val.Read,
+		CreatedAt: val.CreatedAt,
+	}
+}
+
+func preferencesResponse(userId string, prefs []repo.Preference) *n.PreferencesResponse {
+	res := n.PreferencesResponse{UserId: userId}
+	for _, pref := range prefs {
+		res.Preferences = append(res.Preferences, &n.Preference{Type: pref.Type, InApp: pref.InApp, Email: pref.Email})
+	}
+
+	return &res
+}
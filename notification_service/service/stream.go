@@ -0,0 +1,52 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	n "github.com/burxondv/new-services/notification-service/genproto/notification"
+	u "github.com/burxondv/new-services/notification-service/genproto/user"
+	"github.com/burxondv/new-services/notification-service/pkg/logger"
+	"github.com/burxondv/new-services/notification-service/storage/repo"
+)
+
+// events are dropped for a stream which falls behind by more than streamBuffer
+const streamBuffer = 64
+
+// StreamNotifications sends notifications shown in app as they are created
+// until the client cancels the stream
+func (s *NotificationService) StreamNotifications(req *n.StreamRequest, stream n.NotificationService_StreamNotificationsServer) error {
+	notifications, cancel := s.notifications.Subscribe(req.UserId)
+	defer cancel()
+
+	for {
+		select {
+		case <-stream.Context().Done():
+			return nil
+		case notif := <-notifications:
+			if err := stream.Send(notif); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func (s *NotificationService) publish(ctx context.Context, val repo.Notification) {
+	if s.notifications.Subscribers(val.UserId) == 0 {
+		return
+	}
+
+	notif := notificationResponse(val)
+	notif.CreatedAt = time.Now().UTC().Format(time.RFC3339)
+	if val.ActorId != "" {
+		actor, err := s.Client.User().GetUserForClient(ctx, &u.Request{Str: val.ActorId})
+		if err != nil {
+			// streamed notification is sent without actor name
+			s.reqLog(ctx).Error("failed to get actor for stream notification", logger.Error(err))
+		} else {
+			notif.ActorName = actor.FirstName + " " + actor.LastName
+		}
+	}
+
+	s.notifications.Publish(val.UserId, notif)
+}
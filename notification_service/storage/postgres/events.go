@@ -0,0 +1,98 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/burxondv/new-services/notification-service/pkg/logger"
+)
+
+// markProcessed returns false if the event was already handled, consumers
+// check it in the transaction of their change
+func markProcessed(ctx context.Context, tx *sql.Tx, eventId string) (bool, error) {
+	res, err := tx.ExecContext(ctx, `
+		insert into
+			processed_events(event_id)
+		values
+			($1)
+		on conflict do nothing`, eventId)
+	if err != nil {
+		return false, err
+	}
+
+	rows, err := res.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+
+	return rows > 0, nil
+}
+
+// DeleteUserNotifications deletes notifications and preferences of deleted
+// user and notifications about actions of the user once per event
+func (r *NotificationRepo) DeleteUserNotifications(ctx context.Context, eventId, userId string) (int64, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, err
+	}
+	defer tx.Rollback()
+
+	ok, err := markProcessed(ctx, tx, eventId)
+	if err != nil {
+		logger.WithContext(r.log, ctx).Error("failed to mark event processed in sql", logger.Error(err))
+		return 0, err
+	}
+	if !ok {
+		return 0, nil
+	}
+
+	res, err := tx.ExecContext(ctx, `delete from notifications where user_id = $1 or actor_id = $1`, userId)
+	if err != nil {
+		logger.WithContext(r.log, ctx).Error("failed to delete user notifications in sql", logger.Error(err))
+		return 0, err
+	}
+
+	_, err = tx.ExecContext(ctx, `delete from notification_preferences where user_id = $1`, userId)
+	if err != nil {
+		logger.WithContext(r.log, ctx).Error("failed to delete user preferences in sql", logger.Error(err))
+		return 0, err
+	}
+
+	deleted, err := res.RowsAffected()
+	if err != nil {
+		return 0, err
+	}
+
+	return deleted, tx.Commit()
+}
+
+// DeletePostNotifications deletes notifications about deleted post once per event
+func (r *NotificationRepo) DeletePostNotifications(ctx context.Context, eventId, postId string) (int64, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, err
+	}
+	defer tx.Rollback()
+
+	ok, err := markProcessed(ctx, tx, eventId)
+	if err != nil {
+		logger.WithContext(r.log, ctx).Error("failed to mark event processed in sql", logger.Error(err))
+		return 0, err
+	}
+	if !ok {
+		return 0, nil
+	}
+
+	res, err := tx.ExecContext(ctx, `delete from notifications where post_id = $1`, postId)
+	if err != nil {
+		logger.WithContext(r.log, ctx).Error("failed to delete post notifications in sql", logger.Error(err))
+		return 0, err
+	}
+
+	deleted, err := res.RowsAffected()
+	if err != nil {
+		return 0, err
+	}
+
+	return deleted, tx.Commit()
+}
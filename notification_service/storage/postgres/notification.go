@@ -0,0 +1,248 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/burxondv/new-services/notification-service/pkg/logger"
+	"github.com/burxondv/new-services/notification-service/storage/repo"
+
+	"github.com/lib/pq"
+)
+
+const notificationColumns = `id, user_id, actor_id, type, post_id, comment_id, text, read_at is not null, created_at`
+
+// CreateNotification skips the same unread notification, so repeated events
+// (like, unlike and like again) do not create duplicates
+func (r *NotificationRepo) CreateNotification(ctx context.Context, n repo.Notification) (bool, error) {
+	res, err := r.db.ExecContext(ctx, `
+		insert into
+			notifications(id, user_id, actor_id, type, post_id, comment_id, text)
+		select
+			$1::uuid, $2::uuid, $3::uuid, $4::text, $5::uuid, $6::uuid, $7::text
+		where not exists (
+			select 1 from notifications
+			where
+				user_id = $2 and actor_id is not distinct from $3 and type = $4 and
+				post_id is not distinct from $5 and comment_id is not distinct from $6 and read_at is null
+		)`, n.Id, n.UserId, nullString(n.ActorId), n.Type, nullString(n.PostId), nullString(n.CommentId), n.Text)
+	if err != nil {
+		logger.WithContext(r.log, ctx).Error("failed to create notification in sql", logger.Error(err))
+		return false, err
+	}
+
+	rows, err := res.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+
+	return rows > 0, nil
+}
+
+// GetNotifications returns notifications shown in app, types disabled
+// by user are stored only for email digest
+func (r *NotificationRepo) GetNotifications(ctx context.Context, userId string, unreadOnly bool, limit, offset int64) ([]repo.Notification, error) {
+	rows, err := r.db.QueryContext(ctx, `
+		select
+			`+notificationColumns+`
+		from
+			notifications n
+		where
+			user_id = $1 and ($2 = false or read_at is null) and not exists (
+				select 1 from notification_preferences p
+				where p.user_id = n.user_id and p.type = n.type and not p.in_app
+			)
+		order by created_at desc
+		limit $3 offset $4`, userId, unreadOnly, limit, offset)
+	if err != nil {
+		logger.WithContext(r.log, ctx).Error("failed to get notifications in sql", logger.Error(err))
+		return []repo.Notification{}, err
+	}
+
+	res, err := scanNotifications(rows)
+	if err != nil {
+		logger.WithContext(r.log, ctx).Error("failed to scanning notifications in sql", logger.Error(err))
+		return []repo.Notification{}, err
+	}
+
+	return res, nil
+}
+
+func (r *NotificationRepo) CountUnread(ctx context.Context, userId string) (int64, error) {
+	var count int64
+	err := r.db.QueryRowContext(ctx, `
+		select
+			count(*)
+		from
+			notifications n
+		where
+			user_id = $1 and read_at is null and not exists (
+				select 1 from notification_preferences p
+				where p.user_id = n.user_id and p.type = n.type and not p.in_app
+			)`, userId).Scan(&count)
+	if err != nil {
+		logger.WithContext(r.log, ctx).Error("failed to count unread notifications in sql", logger.Error(err))
+		return 0, err
+	}
+
+	return count, nil
+}
+
+func (r *NotificationRepo) MarkRead(ctx context.Context, userId string, ids []string) (int64, error) {
+	res, err := r.db.ExecContext(ctx, `
+		update
+			notifications
+		set
+			read_at = $1
+		where
+			user_id = $2 and id = any($3::uuid[]) and read_at is null`, time.Now(), userId, pq.Array(ids))
+	if err != nil {
+		logger.WithContext(r.log, ctx).Error("failed to mark notifications read in sql", logger.Error(err))
+		return 0, err
+	}
+
+	return res.RowsAffected()
+}
+
+func (r *NotificationRepo) MarkAllRead(ctx context.Context, userId string) (int64, error) {
+	res, err := r.db.ExecContext(ctx, `
+		update
+			notifications
+		set
+			read_at = $1
+		where
+			user_id = $2 and read_at is null`, time.Now(), userId)
+	if err != nil {
+		logger.WithContext(r.log, ctx).Error("failed to mark all notifications read in sql", logger.Error(err))
+		return 0, err
+	}
+
+	return res.RowsAffected()
+}
+
+// GetPreferences returns only saved preferences, defaults are set by service
+func (r *NotificationRepo) GetPreferences(ctx context.Context, userId string) ([]repo.Preference, error) {
+	rows, err := r.db.QueryContext(ctx, `
+		select
+			type, in_app, email
+		from
+			notification_preferences
+		where
+			user_id = $1`, userId)
+	if err != nil {
+		logger.WithContext(r.log, ctx).Error("failed to get preferences in sql", logger.Error(err))
+		return []repo.Preference{}, err
+	}
+	defer rows.Close()
+
+	res := []repo.Preference{}
+	for rows.Next() {
+		pref := repo.Preference{}
+		if err := rows.Scan(&pref.Type, &pref.InApp, &pref.Email); err != nil {
+			logger.WithContext(r.log, ctx).Error("failed to scanning preferences in sql", logger.Error(err))
+			return []repo.Preference{}, err
+		}
+
+		res = append(res, pref)
+	}
+
+	return res, rows.Err()
+}
+
+func (r *NotificationRepo) UpdatePreferences(ctx context.Context, userId string, prefs []repo.Preference) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	for _, pref := range prefs {
+		_, err = tx.ExecContext(ctx, `
+			insert into
+				notification_preferences(user_id, type, in_app, email)
+			values
+				($1, $2, $3, $4)
+			on conflict (user_id, type) do update set
+				in_app = excluded.in_app, email = excluded.email`, userId, pref.Type, pref.InApp, pref.Email)
+		if err != nil {
+			logger.WithContext(r.log, ctx).Error("failed to update preferences in sql", logger.Error(err))
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+// GetPendingEmails returns unread notifications which are not emailed yet
+// and their types are enabled for email by receiver
+func (r *NotificationRepo) GetPendingEmails(ctx context.Context, limit int) ([]repo.Notification, error) {
+	rows, err := r.db.QueryContext(ctx, `
+		select
+			`+notificationColumns+`
+		from
+			notifications n
+		where
+			read_at is null and emailed_at is null and exists (
+				select 1 from notification_preferences p
+				where p.user_id = n.user_id and p.type = n.type and p.email
+			)
+		order by user_id, created_at
+		limit $1`, limit)
+	if err != nil {
+		logger.WithContext(r.log, ctx).Error("failed to get pending emails in sql", logger.Error(err))
+		return []repo.Notification{}, err
+	}
+
+	res, err := scanNotifications(rows)
+	if err != nil {
+		logger.WithContext(r.log, ctx).Error("failed to scanning pending emails in sql", logger.Error(err))
+		return []repo.Notification{}, err
+	}
+
+	return res, nil
+}
+
+func (r *NotificationRepo) MarkEmailed(ctx context.Context, ids []string) error {
+	_, err := r.db.ExecContext(ctx, `
+		update
+			notifications
+		set
+			emailed_at = $1
+		where
+			id = any($2::uuid[])`, time.Now(), pq.Array(ids))
+	if err != nil {
+		logger.WithContext(r.log, ctx).Error("failed to mark notifications emailed in sql", logger.Error(err))
+		return err
+	}
+
+	return nil
+}
+
+func scanNotifications(rows *sql.Rows) ([]repo.Notification, error) {
+	defer rows.Close()
+
+	res := []repo.Notification{}
+	for rows.Next() {
+		var (
+			n                          repo.Notification
+			actorId, postId, commentId sql.NullString
+		)
+
+		err := rows.Scan(&n.Id, &n.UserId, &actorId, &n.Type, &postId, &commentId, &n.Text, &n.Read, &n.CreatedAt)
+		if err != nil {
+			return []repo.Notification{}, err
+		}
+
+		n.ActorId = actorId.String
+		n.PostId = postId.String
+		n.CommentId = commentId.String
+		res = append(res, n)
+	}
+
+	return res, rows.Err()
+}
+
+func nullString(str string) sql.NullString {
+	return sql.NullString{String: str, Valid: str != ""}
+}
@@ -0,0 +1,19 @@
+package postgres
+
+import (
+	"github.com/burxondv/new-services/notification-service/pkg/logger"
+
+	"github.com/jmoiron/sqlx"
+)
+
+type NotificationRepo struct {
+	db  *sqlx.DB
+	log logger.Logger
+}
+
+func NewNotificationRepo(db *sqlx.DB, log logger.Logger) *NotificationRepo {
+	return &NotificationRepo{
+		db:  db,
+		log: log,
+	}
+}
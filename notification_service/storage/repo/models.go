@@ -0,0 +1,34 @@
+package repo
+
+type Notification struct {
+	Id        string
+	UserId    string
+	ActorId   string
+	Type      string
+	PostId    string
+	CommentId string
+	Text      string
+	Read      bool
+	CreatedAt string
+}
+
+// notification types...
+const (
+	TypeComment = "comment"
+	TypeReply   = "reply"
+	TypeLike    = "like"
+	TypeFollow  = "follow"
+	TypeMention = "mention"
+
+	// decisions of moderators about content or account of the user, text
+	// has the note of moderator
+	TypeModeration = "moderation"
+)
+
+var Types = []string{TypeComment, TypeReply, TypeLike, TypeFollow, TypeMention, TypeModeration}
+
+type Preference struct {
+	Type  string
+	InApp bool
+	Email bool
+}
@@ -0,0 +1,23 @@
+package repo
+
+import "context"
+
+type NotificationStorageI interface {
+	CreateNotification(context.Context, Notification) (bool, error)
+	GetNotifications(ctx context.Context, userId string, unreadOnly bool, limit, offset int64) ([]Notification, error)
+	CountUnread(ctx context.Context, userId string) (int64, error)
+	MarkRead(ctx context.Context, userId string, ids []string) (int64, error)
+	MarkAllRead(ctx context.Context, userId string) (int64, error)
+
+	// preferences...
+	GetPreferences(ctx context.Context, userId string) ([]Preference, error)
+	UpdatePreferences(ctx context.Context, userId string, prefs []Preference) error
+
+	// email digest...
+	GetPendingEmails(ctx context.Context, limit int) ([]Notification, error)
+	MarkEmailed(ctx context.Context, ids []string) error
+
+	// events...
+	DeleteUserNotifications(ctx context.Context, eventId, userId string) (int64, error)
+	DeletePostNotifications(ctx context.Context, eventId, postId string) (int64, error)
+}
@@ -0,0 +1,29 @@
+package storage
+
+import (
+	"github.com/burxondv/new-services/notification-service/pkg/logger"
+	"github.com/burxondv/new-services/notification-service/storage/postgres"
+	"github.com/burxondv/new-services/notification-service/storage/repo"
+
+	"github.com/jmoiron/sqlx"
+)
+
+type IStorage interface {
+	Notification() repo.NotificationStorageI
+}
+
+type storagePg struct {
+	db               *sqlx.DB
+	notificationRepo repo.NotificationStorageI
+}
+
+func NewStoragePg(db *sqlx.DB, log logger.Logger) *storagePg {
+	return &storagePg{
+		db:               db,
+		notificationRepo: postgres.NewNotificationRepo(db, log),
+	}
+}
+
+func (s storagePg) Notification() repo.NotificationStorageI {
+	return s.notificationRepo
+}
@@ -0,0 +1,49 @@
+package tests
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/burxondv/new-services/notification-service/pkg/email"
+	"github.com/burxondv/new-services/notification-service/pkg/mention"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMention_Parse(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  []string
+	}{
+		{name: "several", input: "@John and @Jane, look", want: []string{"john", "jane"}},
+		{name: "duplicates", input: "@john @JOHN", want: []string{"john"}},
+		{name: "email is not mention", input: "write to john@gmail.com", want: []string{}},
+		{name: "new line", input: "hi\n@bob!", want: []string{"bob"}},
+		{name: "no mentions", input: "hello @ world", want: []string{}},
+	}
+
+	for _, tCase := range tests {
+		t.Run(tCase.name, func(t *testing.T) {
+			assert.Equal(t, tCase.want, mention.Parse(tCase.input))
+		})
+	}
+}
+
+func TestMention_Limit(t *testing.T) {
+	text := ""
+	for i := 0; i < mention.MaxPerText+5; i++ {
+		text += " @user" + strings.Repeat("x", i)
+	}
+
+	assert.Len(t, mention.Parse(text), mention.MaxPerText)
+}
+
+func TestEmail_Message(t *testing.T) {
+	msg := string(email.Message("from@mail.com", []string{"to@mail.com"}, "New\r\nBcc: x@mail.com", "line1\nline2"))
+
+	assert.Contains(t, msg, "To: to@mail.com\r\n")
+	assert.Contains(t, msg, "Subject: NewBcc: x@mail.com\r\n")
+	assert.NotContains(t, msg, "\r\nBcc:")
+	assert.True(t, strings.HasSuffix(msg, "\r\n\r\nline1\r\nline2"))
+}
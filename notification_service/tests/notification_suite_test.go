@@ -0,0 +1,126 @@
+package tests
+
+import (
+	"context"
+	"testing"
+
+	"github.com/burxondv/new-services/notification-service/config"
+	"github.com/burxondv/new-services/notification-service/pkg/db"
+	"github.com/burxondv/new-services/notification-service/pkg/logger"
+	"github.com/burxondv/new-services/notification-service/storage/postgres"
+	"github.com/burxondv/new-services/notification-service/storage/repo"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+)
+
+type NotificationSuiteTest struct {
+	suite.Suite
+	CleanUpfunc func()
+	repo        repo.NotificationStorageI
+}
+
+func (s *NotificationSuiteTest) SetupSuite() {
+	pgPool, cleanUp := db.ConnectToDBForSuite(config.Load())
+	s.repo = postgres.NewNotificationRepo(pgPool, logger.New("debug", "test"))
+	s.CleanUpfunc = cleanUp
+}
+
+func (s *NotificationSuiteTest) TestNotifications() {
+	userId, actorId, postId := uuid.NewString(), uuid.NewString(), uuid.NewString()
+	notif := repo.Notification{
+		Id:      uuid.NewString(),
+		UserId:  userId,
+		ActorId: actorId,
+		Type:    repo.TypeLike,
+		PostId:  postId,
+	}
+
+	created, err := s.repo.CreateNotification(context.Background(), notif)
+	s.Nil(err)
+	s.True(created)
+
+	// the same unread notification is not duplicated
+	notif.Id = uuid.NewString()
+	created, err = s.repo.CreateNotification(context.Background(), notif)
+	s.Nil(err)
+	s.False(created)
+
+	unread, err := s.repo.CountUnread(context.Background(), userId)
+	s.Nil(err)
+	s.Equal(int64(1), unread)
+
+	notifs, err := s.repo.GetNotifications(context.Background(), userId, true, 10, 0)
+	s.Nil(err)
+	s.Require().Len(notifs, 1)
+	s.Equal(actorId, notifs[0].ActorId)
+	s.False(notifs[0].Read)
+
+	updated, err := s.repo.MarkRead(context.Background(), userId, []string{notifs[0].Id})
+	s.Nil(err)
+	s.Equal(int64(1), updated)
+
+	notifs, err = s.repo.GetNotifications(context.Background(), userId, true, 10, 0)
+	s.Nil(err)
+	s.Len(notifs, 0)
+
+	err = s.repo.UpdatePreferences(context.Background(), userId, []repo.Preference{{Type: repo.TypeLike, InApp: false, Email: true}})
+	s.Nil(err)
+
+	prefs, err := s.repo.GetPreferences(context.Background(), userId)
+	s.Nil(err)
+	s.Equal([]repo.Preference{{Type: repo.TypeLike, InApp: false, Email: true}}, prefs)
+
+	// hidden in app, but sent in digest
+	notif.Id = uuid.NewString()
+	created, err = s.repo.CreateNotification(context.Background(), notif)
+	s.Nil(err)
+	s.True(created)
+
+	notifs, err = s.repo.GetNotifications(context.Background(), userId, false, 10, 0)
+	s.Nil(err)
+	s.Len(notifs, 0)
+
+	pending, err := s.repo.GetPendingEmails(context.Background(), 1000)
+	s.Nil(err)
+	ids := []string{}
+	for _, n := range pending {
+		ids = append(ids, n.Id)
+	}
+	s.Contains(ids, notif.Id)
+
+	s.Nil(s.repo.MarkEmailed(context.Background(), []string{notif.Id}))
+}
+
+func (s *NotificationSuiteTest) TestDeleteUserNotifications() {
+	userId := uuid.NewString()
+	_, err := s.repo.CreateNotification(context.Background(), repo.Notification{
+		Id:      uuid.NewString(),
+		UserId:  userId,
+		ActorId: uuid.NewString(),
+		Type:    repo.TypeFollow,
+	})
+	s.Require().Nil(err)
+
+	eventId := uuid.NewString()
+	deleted, err := s.repo.DeleteUserNotifications(context.Background(), eventId, userId)
+	s.Nil(err)
+	s.Equal(int64(1), deleted)
+
+	// the same event is handled once
+	deleted, err = s.repo.DeleteUserNotifications(context.Background(), eventId, userId)
+	s.Nil(err)
+	s.Equal(int64(0), deleted)
+
+	unread, err := s.repo.CountUnread(context.Background(), userId)
+	s.Nil(err)
+	s.Equal(int64(0), unread)
+}
+
+func (suite *NotificationSuiteTest) TearDownSuite() {
+	suite.CleanUpfunc()
+}
+
+func TestNotificationRepositoryTestSuite(t *testing.T) {
+	suite.Run(t, new(NotificationSuiteTest))
+}
@@ -7,6 +7,7 @@ import (
 	context "context"
 	fmt "fmt"
 	proto "github.com/golang/protobuf/proto"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
@@ -28,6 +29,7 @@ const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
 
 type Request struct {
 	Str                  string   `protobuf:"bytes,1,opt,name=str,proto3" json:"str"`
+	ViewerId             string   `protobuf:"bytes,2,opt,name=viewer_id,json=viewerId,proto3" json:"viewer_id"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -73,11 +75,168 @@ func (m *Request) GetStr() string {
 	return ""
 }
 
+func (m *Request) GetViewerId() string {
+	if m != nil {
+		return m.ViewerId
+	}
+	return ""
+}
+
+type ModerateRequest struct {
+	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id"`
+	Action               string   `protobuf:"bytes,2,opt,name=action,proto3" json:"action"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ModerateRequest) Reset()         { *m = ModerateRequest{} }
+func (m *ModerateRequest) String() string { return proto.CompactTextString(m) }
+func (*ModerateRequest) ProtoMessage()    {}
+func (*ModerateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_885638bbfd25b68b, []int{1}
+}
+func (m *ModerateRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ModerateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ModerateRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ModerateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ModerateRequest.Merge(m, src)
+}
+func (m *ModerateRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ModerateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ModerateRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ModerateRequest proto.InternalMessageInfo
+
+func (m *ModerateRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *ModerateRequest) GetAction() string {
+	if m != nil {
+		return m.Action
+	}
+	return ""
+}
+
+type IdsRequest struct {
+	Ids                  []string `protobuf:"bytes,1,rep,name=ids,proto3" json:"ids"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *IdsRequest) Reset()         { *m = IdsRequest{} }
+func (m *IdsRequest) String() string { return proto.CompactTextString(m) }
+func (*IdsRequest) ProtoMessage()    {}
+func (*IdsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_885638bbfd25b68b, []int{2}
+}
+func (m *IdsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *IdsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_IdsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *IdsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IdsRequest.Merge(m, src)
+}
+func (m *IdsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *IdsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_IdsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IdsRequest proto.InternalMessageInfo
+
+func (m *IdsRequest) GetIds() []string {
+	if m != nil {
+		return m.Ids
+	}
+	return nil
+}
+
+type CommentCountsResponse struct {
+	Counts               map[string]int64 `protobuf:"bytes,1,rep,name=counts,proto3" json:"counts" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *CommentCountsResponse) Reset()         { *m = CommentCountsResponse{} }
+func (m *CommentCountsResponse) String() string { return proto.CompactTextString(m) }
+func (*CommentCountsResponse) ProtoMessage()    {}
+func (*CommentCountsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_885638bbfd25b68b, []int{3}
+}
+func (m *CommentCountsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CommentCountsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CommentCountsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CommentCountsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CommentCountsResponse.Merge(m, src)
+}
+func (m *CommentCountsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *CommentCountsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CommentCountsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CommentCountsResponse proto.InternalMessageInfo
+
+func (m *CommentCountsResponse) GetCounts() map[string]int64 {
+	if m != nil {
+		return m.Counts
+	}
+	return nil
+}
+
 type CommentRequest struct {
 	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id"`
 	PostId               string   `protobuf:"bytes,2,opt,name=post_id,json=postId,proto3" json:"post_id"`
 	UserId               string   `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id"`
 	Text                 string   `protobuf:"bytes,4,opt,name=text,proto3" json:"text"`
+	ParentId             string   `protobuf:"bytes,5,opt,name=parent_id,json=parentId,proto3" json:"parent_id"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -87,7 +246,7 @@ func (m *CommentRequest) Reset()         { *m = CommentRequest{} }
 func (m *CommentRequest) String() string { return proto.CompactTextString(m) }
 func (*CommentRequest) ProtoMessage()    {}
 func (*CommentRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_885638bbfd25b68b, []int{1}
+	return fileDescriptor_885638bbfd25b68b, []int{4}
 }
 func (m *CommentRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -144,6 +303,60 @@ func (m *CommentRequest) GetText() string {
 	return ""
 }
 
+func (m *CommentRequest) GetParentId() string {
+	if m != nil {
+		return m.ParentId
+	}
+	return ""
+}
+
+type StreamRequest struct {
+	PostId               string   `protobuf:"bytes,1,opt,name=post_id,json=postId,proto3" json:"post_id"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *StreamRequest) Reset()         { *m = StreamRequest{} }
+func (m *StreamRequest) String() string { return proto.CompactTextString(m) }
+func (*StreamRequest) ProtoMessage()    {}
+func (*StreamRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_885638bbfd25b68b, []int{5}
+}
+func (m *StreamRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StreamRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StreamRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *StreamRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StreamRequest.Merge(m, src)
+}
+func (m *StreamRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *StreamRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_StreamRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StreamRequest proto.InternalMessageInfo
+
+func (m *StreamRequest) GetPostId() string {
+	if m != nil {
+		return m.PostId
+	}
+	return ""
+}
+
 type CommentsResponse struct {
 	Comments             []*CommentResponse `protobuf:"bytes,1,rep,name=comments,proto3" json:"comments"`
 	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
@@ -155,7 +368,7 @@ func (m *CommentsResponse) Reset()         { *m = CommentsResponse{} }
 func (m *CommentsResponse) String() string { return proto.CompactTextString(m) }
 func (*CommentsResponse) ProtoMessage()    {}
 func (*CommentsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_885638bbfd25b68b, []int{2}
+	return fileDescriptor_885638bbfd25b68b, []int{6}
 }
 func (m *CommentsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -201,6 +414,8 @@ type CommentResponse struct {
 	PostUserName         string   `protobuf:"bytes,7,opt,name=post_user_name,json=postUserName,proto3" json:"post_user_name"`
 	Text                 string   `protobuf:"bytes,8,opt,name=text,proto3" json:"text"`
 	CreatedAt            string   `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at"`
+	ParentId             string   `protobuf:"bytes,10,opt,name=parent_id,json=parentId,proto3" json:"parent_id"`
+	ModerationStatus     string   `protobuf:"bytes,11,opt,name=moderation_status,json=moderationStatus,proto3" json:"moderation_status"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -210,7 +425,7 @@ func (m *CommentResponse) Reset()         { *m = CommentResponse{} }
 func (m *CommentResponse) String() string { return proto.CompactTextString(m) }
 func (*CommentResponse) ProtoMessage()    {}
 func (*CommentResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_885638bbfd25b68b, []int{3}
+	return fileDescriptor_885638bbfd25b68b, []int{7}
 }
 func (m *CommentResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -302,9 +517,28 @@ func (m *CommentResponse) GetCreatedAt() string {
 	return ""
 }
 
+func (m *CommentResponse) GetParentId() string {
+	if m != nil {
+		return m.ParentId
+	}
+	return ""
+}
+
+func (m *CommentResponse) GetModerationStatus() string {
+	if m != nil {
+		return m.ModerationStatus
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*Request)(nil), "comment.Request")
+	proto.RegisterType((*ModerateRequest)(nil), "comment.ModerateRequest")
+	proto.RegisterType((*IdsRequest)(nil), "comment.IdsRequest")
+	proto.RegisterType((*CommentCountsResponse)(nil), "comment.CommentCountsResponse")
+	proto.RegisterMapType((map[string]int64)(nil), "comment.CommentCountsResponse.CountsEntry")
 	proto.RegisterType((*CommentRequest)(nil), "comment.CommentRequest")
+	proto.RegisterType((*StreamRequest)(nil), "comment.StreamRequest")
 	proto.RegisterType((*CommentsResponse)(nil), "comment.CommentsResponse")
 	proto.RegisterType((*CommentResponse)(nil), "comment.CommentResponse")
 }
@@ -312,32 +546,51 @@ func init() {
 func init() { proto.RegisterFile("comment/comment.proto", fileDescriptor_885638bbfd25b68b) }
 
 var fileDescriptor_885638bbfd25b68b = []byte{
-	// 389 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x53, 0xcd, 0x6e, 0xda, 0x40,
-	0x10, 0xc6, 0x86, 0x62, 0x3c, 0x50, 0x6a, 0xad, 0x54, 0xb1, 0x2d, 0xaa, 0x85, 0xac, 0x1e, 0x38,
-	0x51, 0x89, 0xf6, 0xd8, 0x1e, 0x5a, 0xaa, 0xb6, 0x5c, 0xaa, 0xca, 0xa5, 0xea, 0x11, 0x39, 0x78,
-	0x22, 0x59, 0xc2, 0xd8, 0xf1, 0x0e, 0x51, 0x78, 0x80, 0x3c, 0x43, 0xf2, 0x48, 0x39, 0xe6, 0x11,
-	0x22, 0xf2, 0x22, 0xd1, 0xae, 0xd7, 0x36, 0x01, 0x25, 0x4a, 0x4e, 0xec, 0x7c, 0x3f, 0xcc, 0x37,
-	0x33, 0x32, 0xbc, 0x5e, 0x24, 0x71, 0x8c, 0x2b, 0xfa, 0xa0, 0x7f, 0x47, 0x69, 0x96, 0x50, 0xc2,
-	0x2c, 0x5d, 0x7a, 0x7d, 0xb0, 0x7c, 0x3c, 0x59, 0xa3, 0x20, 0xe6, 0x40, 0x5d, 0x50, 0xc6, 0x8d,
-	0x81, 0x31, 0xb4, 0x7d, 0xf9, 0xf4, 0x8e, 0xa1, 0x3b, 0xc9, 0x75, 0x85, 0xa6, 0x0b, 0x66, 0x14,
-	0x6a, 0x89, 0x19, 0x85, 0xac, 0x07, 0x56, 0x9a, 0x08, 0x9a, 0x47, 0x21, 0x37, 0x15, 0xd8, 0x94,
-	0xe5, 0x54, 0x11, 0x6b, 0x81, 0x99, 0x24, 0xea, 0x39, 0x21, 0xcb, 0x69, 0xc8, 0x18, 0x34, 0x08,
-	0xcf, 0x88, 0x37, 0x14, 0xaa, 0xde, 0xde, 0x2f, 0x70, 0x74, 0x1f, 0xe1, 0xa3, 0x48, 0x93, 0x95,
-	0x40, 0xf6, 0x09, 0x5a, 0x3a, 0xa3, 0xe0, 0xc6, 0xa0, 0x3e, 0x6c, 0x8f, 0xf9, 0xa8, 0x98, 0xa1,
-	0x0c, 0x95, 0x6b, 0xfd, 0x52, 0xe9, 0x9d, 0x9b, 0xf0, 0x6a, 0x8f, 0x7d, 0x7a, 0xe6, 0x77, 0x00,
-	0x8a, 0xa0, 0x88, 0x96, 0xa8, 0x63, 0xdb, 0x12, 0x99, 0x49, 0x60, 0x77, 0xa4, 0xc6, 0xbd, 0x91,
-	0xfa, 0x60, 0x2b, 0x62, 0x15, 0xc4, 0xc8, 0x5f, 0x28, 0xaa, 0x25, 0x81, 0xdf, 0x41, 0x8c, 0x25,
-	0x49, 0x9b, 0x14, 0x79, 0xb3, 0x22, 0x67, 0x9b, 0x14, 0xd9, 0x7b, 0xe8, 0xaa, 0x8e, 0x95, 0xdd,
-	0x52, 0x8a, 0x8e, 0x44, 0xff, 0x15, 0x7f, 0x51, 0xac, 0xac, 0x55, 0xad, 0x4c, 0x66, 0x5d, 0x64,
-	0x18, 0x10, 0x86, 0xf3, 0x80, 0xb8, 0x9d, 0x67, 0xd5, 0xc8, 0x57, 0x1a, 0x5f, 0x98, 0xe5, 0xe9,
-	0xfe, 0x62, 0x76, 0x1a, 0x2d, 0x90, 0x4d, 0xa0, 0xf3, 0x3f, 0x8b, 0x08, 0x35, 0xcc, 0x7a, 0x87,
-	0xeb, 0x54, 0x37, 0x7e, 0xfb, 0xe0, 0x9e, 0xbd, 0x1a, 0xfb, 0x0c, 0xed, 0x9f, 0x48, 0xc5, 0xb1,
-	0x98, 0x53, 0x4a, 0x0b, 0xf3, 0x9b, 0x7d, 0xb3, 0xd8, 0x71, 0x7f, 0x81, 0x97, 0xdf, 0x71, 0x89,
-	0x55, 0x86, 0x43, 0xff, 0x63, 0xcd, 0x27, 0xc0, 0x76, 0x9a, 0xff, 0x48, 0xb2, 0x3f, 0x89, 0xa0,
-	0x67, 0x66, 0xf8, 0xe6, 0x5c, 0x6d, 0x5d, 0xe3, 0x7a, 0xeb, 0x1a, 0x37, 0x5b, 0xd7, 0xb8, 0xbc,
-	0x75, 0x6b, 0x47, 0x4d, 0xf5, 0x49, 0x7c, 0xbc, 0x0b, 0x00, 0x00, 0xff, 0xff, 0xa9, 0xd9, 0x92,
-	0xe5, 0x2b, 0x03, 0x00, 0x00,
+	// 689 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x55, 0xdf, 0x4e, 0x13, 0x5d,
+	0x10, 0xef, 0xb6, 0xd0, 0xd2, 0x29, 0x94, 0x72, 0x3e, 0xfe, 0xec, 0x57, 0x3e, 0x1a, 0xb2, 0xf9,
+	0x2e, 0x08, 0x26, 0xd4, 0xa0, 0x17, 0xc0, 0x9d, 0xad, 0x82, 0xbd, 0x90, 0x98, 0x82, 0xe1, 0xc6,
+	0x84, 0x1c, 0xbb, 0x13, 0xb2, 0xa1, 0xdd, 0xb3, 0xee, 0x39, 0xad, 0x36, 0xc6, 0x1b, 0x7d, 0x04,
+	0x13, 0xe3, 0x73, 0xf8, 0x14, 0xde, 0x69, 0xe2, 0x0b, 0x18, 0xf4, 0x41, 0xcc, 0xf9, 0xb7, 0xbb,
+	0x14, 0x21, 0x72, 0xd5, 0x9d, 0xdf, 0xcc, 0xfc, 0x66, 0xce, 0x6f, 0xce, 0x99, 0xc2, 0x52, 0x8f,
+	0x0d, 0x06, 0x18, 0x8a, 0xa6, 0xf9, 0xdd, 0x8a, 0x62, 0x26, 0x18, 0x29, 0x19, 0xb3, 0xfe, 0xdf,
+	0x19, 0x63, 0x67, 0x7d, 0x6c, 0xd2, 0x28, 0x68, 0xd2, 0x30, 0x64, 0x82, 0x8a, 0x80, 0x85, 0x5c,
+	0x87, 0x79, 0x3b, 0x50, 0xea, 0xe2, 0xcb, 0x21, 0x72, 0x41, 0x6a, 0x50, 0xe0, 0x22, 0x76, 0x9d,
+	0x75, 0x67, 0xa3, 0xdc, 0x95, 0x9f, 0x64, 0x15, 0xca, 0xa3, 0x00, 0x5f, 0x61, 0x7c, 0x1a, 0xf8,
+	0x6e, 0x5e, 0xe1, 0x33, 0x1a, 0xe8, 0xf8, 0xde, 0x2e, 0xcc, 0x3f, 0x61, 0x3e, 0xc6, 0x54, 0xa0,
+	0x65, 0xa8, 0x42, 0x3e, 0xf0, 0x0d, 0x41, 0x3e, 0xf0, 0xc9, 0x32, 0x14, 0x69, 0x4f, 0x56, 0x33,
+	0xc9, 0xc6, 0xf2, 0x1a, 0x00, 0x1d, 0x9f, 0x67, 0xea, 0x06, 0x3e, 0x77, 0x9d, 0xf5, 0x82, 0xac,
+	0x1b, 0xf8, 0xdc, 0xfb, 0xe8, 0xc0, 0x52, 0x5b, 0xb7, 0xdf, 0x66, 0xc3, 0x50, 0xf0, 0x2e, 0xf2,
+	0x88, 0x85, 0x1c, 0x49, 0x0b, 0x8a, 0x3d, 0x85, 0xa8, 0xf0, 0xca, 0xf6, 0xe6, 0x96, 0x3d, 0xf5,
+	0x1f, 0xe3, 0xb7, 0xb4, 0xf9, 0x28, 0x14, 0xf1, 0xb8, 0x6b, 0x32, 0xeb, 0xbb, 0x50, 0xc9, 0xc0,
+	0xb2, 0xfc, 0x39, 0x8e, 0xed, 0xb1, 0xcf, 0x71, 0x4c, 0x16, 0x61, 0x7a, 0x44, 0xfb, 0x43, 0x54,
+	0x5d, 0x17, 0xba, 0xda, 0xd8, 0xcb, 0xef, 0x38, 0xde, 0x7b, 0x07, 0xaa, 0xa6, 0xd0, 0x75, 0x67,
+	0x5e, 0x81, 0x52, 0xc4, 0xb8, 0x48, 0x15, 0x2b, 0x4a, 0xb3, 0xa3, 0x1c, 0x43, 0xae, 0xa5, 0x2c,
+	0x68, 0x87, 0x34, 0x3b, 0x3e, 0x21, 0x30, 0x25, 0xf0, 0xb5, 0x70, 0xa7, 0x14, 0xaa, 0xbe, 0xa5,
+	0xf2, 0x11, 0x8d, 0x31, 0x54, 0x3c, 0xd3, 0x5a, 0x79, 0x0d, 0x74, 0x7c, 0x6f, 0x03, 0xe6, 0x8e,
+	0x44, 0x8c, 0x74, 0x60, 0x7b, 0xc8, 0xd4, 0x74, 0xb2, 0x35, 0xbd, 0xc7, 0x50, 0x33, 0xed, 0xa6,
+	0x12, 0xde, 0x87, 0x19, 0xa3, 0x99, 0x15, 0xd1, 0x9d, 0x14, 0xd1, 0xc6, 0x76, 0x93, 0x48, 0xef,
+	0x6b, 0x1e, 0xe6, 0x27, 0xbc, 0x7f, 0x7f, 0xf4, 0x35, 0x00, 0xe5, 0x10, 0x81, 0xe8, 0xa3, 0x39,
+	0x7d, 0x59, 0x22, 0xc7, 0x12, 0xc8, 0x2a, 0x33, 0x75, 0x49, 0x99, 0x55, 0x28, 0x2b, 0x47, 0x48,
+	0x07, 0x68, 0x55, 0x90, 0xc0, 0x21, 0x1d, 0x60, 0xe2, 0x14, 0xe3, 0x08, 0xdd, 0x62, 0xea, 0x3c,
+	0x1e, 0x47, 0x48, 0xfe, 0x87, 0xaa, 0xaa, 0x98, 0xa6, 0x97, 0x54, 0xc4, 0xac, 0x44, 0x9f, 0x59,
+	0x0a, 0xab, 0xfc, 0x4c, 0x46, 0xf9, 0x35, 0x80, 0x5e, 0x8c, 0x54, 0xa0, 0x7f, 0x4a, 0x85, 0x5b,
+	0xd6, 0xbd, 0x1a, 0xe4, 0xc1, 0xc4, 0x60, 0xe0, 0xf2, 0x60, 0xc8, 0x1d, 0x58, 0x18, 0xe8, 0x27,
+	0x11, 0xb0, 0xf0, 0x94, 0x0b, 0x2a, 0x86, 0xdc, 0xad, 0xa8, 0xa0, 0x5a, 0xea, 0x38, 0x52, 0xf8,
+	0xf6, 0xe7, 0xe9, 0xe4, 0x2e, 0x1d, 0x61, 0x3c, 0x0a, 0x7a, 0x48, 0xda, 0x30, 0x7b, 0x12, 0x07,
+	0x02, 0x0d, 0x4c, 0x56, 0xae, 0x0e, 0x46, 0x0d, 0xbc, 0x7e, 0xed, 0xc4, 0xbc, 0x1c, 0x79, 0x0e,
+	0x95, 0x03, 0x14, 0x06, 0xe7, 0xa4, 0x96, 0x84, 0xda, 0xe4, 0x7f, 0x27, 0x93, 0x93, 0xbb, 0xe1,
+	0xad, 0xbf, 0xfb, 0xfe, 0xeb, 0x43, 0xbe, 0x4e, 0xdc, 0xe6, 0x68, 0xbb, 0x29, 0xa5, 0xe2, 0xcd,
+	0x37, 0x5c, 0xc4, 0x6f, 0xed, 0x6e, 0xe1, 0xe4, 0x04, 0xe6, 0x1e, 0x62, 0x1f, 0xd3, 0x1e, 0xaf,
+	0xf2, 0x5f, 0xdf, 0x5c, 0x5d, 0xd1, 0x2f, 0x6e, 0x12, 0x49, 0x6f, 0x29, 0x75, 0x05, 0xb2, 0x07,
+	0x90, 0xb6, 0x7d, 0x2b, 0xd6, 0x1c, 0x39, 0x48, 0x57, 0x91, 0x25, 0x48, 0xc3, 0x27, 0x96, 0xd4,
+	0x8d, 0x44, 0xfb, 0x50, 0xd5, 0x2f, 0x2b, 0x91, 0x6f, 0x39, 0x89, 0xbe, 0xf4, 0xe4, 0x6e, 0x62,
+	0xb9, 0xeb, 0x90, 0x36, 0x90, 0xcc, 0x0c, 0xf6, 0x59, 0xfc, 0x94, 0x71, 0x71, 0xbb, 0x51, 0xe4,
+	0x48, 0x0b, 0x16, 0x32, 0x24, 0xad, 0xb1, 0xbc, 0xb6, 0xb7, 0xe5, 0x38, 0x94, 0x8b, 0x74, 0x18,
+	0x4e, 0xb6, 0xc2, 0xc9, 0x3f, 0x49, 0x56, 0xba, 0x89, 0xeb, 0x8d, 0x9b, 0xb7, 0xa9, 0x97, 0x6b,
+	0xd5, 0xbe, 0x5c, 0x34, 0x9c, 0x6f, 0x17, 0x0d, 0xe7, 0xc7, 0x45, 0xc3, 0xf9, 0xf4, 0xb3, 0x91,
+	0x7b, 0x51, 0x54, 0xff, 0x23, 0xf7, 0x7e, 0x0f, 0x00, 0x8a, 0x1a, 0xee, 0x72, 0x87, 0x06, 0x00,
+	0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -356,8 +609,15 @@ type CommentServiceClient interface {
 	WriteComment(ctx context.Context, in *CommentRequest, opts ...grpc.CallOption) (*CommentResponse, error)
 	GetComments(ctx context.Context, in *Request, opts ...grpc.CallOption) (*CommentsResponse, error)
 	DeleteComment(ctx context.Context, in *Request, opts ...grpc.CallOption) (*CommentResponse, error)
+	// moderation...
+	GetComment(ctx context.Context, in *Request, opts ...grpc.CallOption) (*CommentResponse, error)
+	ModerateComment(ctx context.Context, in *ModerateRequest, opts ...grpc.CallOption) (*CommentResponse, error)
+	// streams...
+	StreamComments(ctx context.Context, in *StreamRequest, opts ...grpc.CallOption) (CommentService_StreamCommentsClient, error)
 	// for Client...
 	GetCommentsForPost(ctx context.Context, in *Request, opts ...grpc.CallOption) (*CommentsResponse, error)
+	GetCommentsByUser(ctx context.Context, in *Request, opts ...grpc.CallOption) (*CommentsResponse, error)
+	CountCommentsForPosts(ctx context.Context, in *IdsRequest, opts ...grpc.CallOption) (*CommentCountsResponse, error)
 }
 
 type commentServiceClient struct {
@@ -395,6 +655,56 @@ func (c *commentServiceClient) DeleteComment(ctx context.Context, in *Request, o
 	return out, nil
 }
 
+func (c *commentServiceClient) GetComment(ctx context.Context, in *Request, opts ...grpc.CallOption) (*CommentResponse, error) {
+	out := new(CommentResponse)
+	err := c.cc.Invoke(ctx, "/comment.CommentService/GetComment", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *commentServiceClient) ModerateComment(ctx context.Context, in *ModerateRequest, opts ...grpc.CallOption) (*CommentResponse, error) {
+	out := new(CommentResponse)
+	err := c.cc.Invoke(ctx, "/comment.CommentService/ModerateComment", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *commentServiceClient) StreamComments(ctx context.Context, in *StreamRequest, opts ...grpc.CallOption) (CommentService_StreamCommentsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_CommentService_serviceDesc.Streams[0], "/comment.CommentService/StreamComments", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &commentServiceStreamCommentsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type CommentService_StreamCommentsClient interface {
+	Recv() (*CommentResponse, error)
+	grpc.ClientStream
+}
+
+type commentServiceStreamCommentsClient struct {
+	grpc.ClientStream
+}
+
+func (x *commentServiceStreamCommentsClient) Recv() (*CommentResponse, error) {
+	m := new(CommentResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *commentServiceClient) GetCommentsForPost(ctx context.Context, in *Request, opts ...grpc.CallOption) (*CommentsResponse, error) {
 	out := new(CommentsResponse)
 	err := c.cc.Invoke(ctx, "/comment.CommentService/GetCommentsForPost", in, out, opts...)
@@ -404,14 +714,39 @@ func (c *commentServiceClient) GetCommentsForPost(ctx context.Context, in *Reque
 	return out, nil
 }
 
+func (c *commentServiceClient) GetCommentsByUser(ctx context.Context, in *Request, opts ...grpc.CallOption) (*CommentsResponse, error) {
+	out := new(CommentsResponse)
+	err := c.cc.Invoke(ctx, "/comment.CommentService/GetCommentsByUser", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *commentServiceClient) CountCommentsForPosts(ctx context.Context, in *IdsRequest, opts ...grpc.CallOption) (*CommentCountsResponse, error) {
+	out := new(CommentCountsResponse)
+	err := c.cc.Invoke(ctx, "/comment.CommentService/CountCommentsForPosts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // CommentServiceServer is the server API for CommentService service.
 type CommentServiceServer interface {
 	// methods...
 	WriteComment(context.Context, *CommentRequest) (*CommentResponse, error)
 	GetComments(context.Context, *Request) (*CommentsResponse, error)
 	DeleteComment(context.Context, *Request) (*CommentResponse, error)
+	// moderation...
+	GetComment(context.Context, *Request) (*CommentResponse, error)
+	ModerateComment(context.Context, *ModerateRequest) (*CommentResponse, error)
+	// streams...
+	StreamComments(*StreamRequest, CommentService_StreamCommentsServer) error
 	// for Client...
 	GetCommentsForPost(context.Context, *Request) (*CommentsResponse, error)
+	GetCommentsByUser(context.Context, *Request) (*CommentsResponse, error)
+	CountCommentsForPosts(context.Context, *IdsRequest) (*CommentCountsResponse, error)
 }
 
 // UnimplementedCommentServiceServer can be embedded to have forward compatible implementations.
@@ -427,9 +762,24 @@ func (*UnimplementedCommentServiceServer) GetComments(ctx context.Context, req *
 func (*UnimplementedCommentServiceServer) DeleteComment(ctx context.Context, req *Request) (*CommentResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteComment not implemented")
 }
+func (*UnimplementedCommentServiceServer) GetComment(ctx context.Context, req *Request) (*CommentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetComment not implemented")
+}
+func (*UnimplementedCommentServiceServer) ModerateComment(ctx context.Context, req *ModerateRequest) (*CommentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ModerateComment not implemented")
+}
+func (*UnimplementedCommentServiceServer) StreamComments(req *StreamRequest, srv CommentService_StreamCommentsServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamComments not implemented")
+}
 func (*UnimplementedCommentServiceServer) GetCommentsForPost(ctx context.Context, req *Request) (*CommentsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetCommentsForPost not implemented")
 }
+func (*UnimplementedCommentServiceServer) GetCommentsByUser(ctx context.Context, req *Request) (*CommentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCommentsByUser not implemented")
+}
+func (*UnimplementedCommentServiceServer) CountCommentsForPosts(ctx context.Context, req *IdsRequest) (*CommentCountsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CountCommentsForPosts not implemented")
+}
 
 func RegisterCommentServiceServer(s *grpc.Server, srv CommentServiceServer) {
 	s.RegisterService(&_CommentService_serviceDesc, srv)
@@ -489,83 +839,326 @@ func _CommentService_DeleteComment_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
-func _CommentService_GetCommentsForPost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+func _CommentService_GetComment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(Request)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CommentServiceServer).GetCommentsForPost(ctx, in)
+		return srv.(CommentServiceServer).GetComment(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/comment.CommentService/GetCommentsForPost",
+		FullMethod: "/comment.CommentService/GetComment",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CommentServiceServer).GetCommentsForPost(ctx, req.(*Request))
+		return srv.(CommentServiceServer).GetComment(ctx, req.(*Request))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-var _CommentService_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "comment.CommentService",
-	HandlerType: (*CommentServiceServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "WriteComment",
-			Handler:    _CommentService_WriteComment_Handler,
-		},
-		{
-			MethodName: "GetComments",
-			Handler:    _CommentService_GetComments_Handler,
-		},
-		{
-			MethodName: "DeleteComment",
-			Handler:    _CommentService_DeleteComment_Handler,
-		},
-		{
-			MethodName: "GetCommentsForPost",
-			Handler:    _CommentService_GetCommentsForPost_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "comment/comment.proto",
+func _CommentService_ModerateComment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ModerateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CommentServiceServer).ModerateComment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/comment.CommentService/ModerateComment",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CommentServiceServer).ModerateComment(ctx, req.(*ModerateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *Request) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func _CommentService_StreamComments_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
 	}
-	return dAtA[:n], nil
+	return srv.(CommentServiceServer).StreamComments(m, &commentServiceStreamCommentsServer{stream})
 }
 
-func (m *Request) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type CommentService_StreamCommentsServer interface {
+	Send(*CommentResponse) error
+	grpc.ServerStream
 }
 
-func (m *Request) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.Str) > 0 {
-		i -= len(m.Str)
-		copy(dAtA[i:], m.Str)
-		i = encodeVarintComment(dAtA, i, uint64(len(m.Str)))
+type commentServiceStreamCommentsServer struct {
+	grpc.ServerStream
+}
+
+func (x *commentServiceStreamCommentsServer) Send(m *CommentResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _CommentService_GetCommentsForPost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CommentServiceServer).GetCommentsForPost(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/comment.CommentService/GetCommentsForPost",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CommentServiceServer).GetCommentsForPost(ctx, req.(*Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CommentService_GetCommentsByUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CommentServiceServer).GetCommentsByUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/comment.CommentService/GetCommentsByUser",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CommentServiceServer).GetCommentsByUser(ctx, req.(*Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CommentService_CountCommentsForPosts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IdsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CommentServiceServer).CountCommentsForPosts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/comment.CommentService/CountCommentsForPosts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CommentServiceServer).CountCommentsForPosts(ctx, req.(*IdsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _CommentService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "comment.CommentService",
+	HandlerType: (*CommentServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "WriteComment",
+			Handler:    _CommentService_WriteComment_Handler,
+		},
+		{
+			MethodName: "GetComments",
+			Handler:    _CommentService_GetComments_Handler,
+		},
+		{
+			MethodName: "DeleteComment",
+			Handler:    _CommentService_DeleteComment_Handler,
+		},
+		{
+			MethodName: "GetComment",
+			Handler:    _CommentService_GetComment_Handler,
+		},
+		{
+			MethodName: "ModerateComment",
+			Handler:    _CommentService_ModerateComment_Handler,
+		},
+		{
+			MethodName: "GetCommentsForPost",
+			Handler:    _CommentService_GetCommentsForPost_Handler,
+		},
+		{
+			MethodName: "GetCommentsByUser",
+			Handler:    _CommentService_GetCommentsByUser_Handler,
+		},
+		{
+			MethodName: "CountCommentsForPosts",
+			Handler:    _CommentService_CountCommentsForPosts_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamComments",
+			Handler:       _CommentService_StreamComments_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "comment/comment.proto",
+}
+
+func (m *Request) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Request) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Request) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.ViewerId) > 0 {
+		i -= len(m.ViewerId)
+		copy(dAtA[i:], m.ViewerId)
+		i = encodeVarintComment(dAtA, i, uint64(len(m.ViewerId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Str) > 0 {
+		i -= len(m.Str)
+		copy(dAtA[i:], m.Str)
+		i = encodeVarintComment(dAtA, i, uint64(len(m.Str)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ModerateRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ModerateRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ModerateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Action) > 0 {
+		i -= len(m.Action)
+		copy(dAtA[i:], m.Action)
+		i = encodeVarintComment(dAtA, i, uint64(len(m.Action)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintComment(dAtA, i, uint64(len(m.Id)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
+func (m *IdsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *IdsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *IdsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Ids) > 0 {
+		for iNdEx := len(m.Ids) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Ids[iNdEx])
+			copy(dAtA[i:], m.Ids[iNdEx])
+			i = encodeVarintComment(dAtA, i, uint64(len(m.Ids[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CommentCountsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CommentCountsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CommentCountsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Counts) > 0 {
+		for k := range m.Counts {
+			v := m.Counts[k]
+			baseI := i
+			i = encodeVarintComment(dAtA, i, uint64(v))
+			i--
+			dAtA[i] = 0x10
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintComment(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintComment(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *CommentRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -590,6 +1183,13 @@ func (m *CommentRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.ParentId) > 0 {
+		i -= len(m.ParentId)
+		copy(dAtA[i:], m.ParentId)
+		i = encodeVarintComment(dAtA, i, uint64(len(m.ParentId)))
+		i--
+		dAtA[i] = 0x2a
+	}
 	if len(m.Text) > 0 {
 		i -= len(m.Text)
 		copy(dAtA[i:], m.Text)
@@ -621,6 +1221,40 @@ func (m *CommentRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *StreamRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StreamRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StreamRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.PostId) > 0 {
+		i -= len(m.PostId)
+		copy(dAtA[i:], m.PostId)
+		i = encodeVarintComment(dAtA, i, uint64(len(m.PostId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *CommentsResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -686,6 +1320,20 @@ func (m *CommentResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.ModerationStatus) > 0 {
+		i -= len(m.ModerationStatus)
+		copy(dAtA[i:], m.ModerationStatus)
+		i = encodeVarintComment(dAtA, i, uint64(len(m.ModerationStatus)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if len(m.ParentId) > 0 {
+		i -= len(m.ParentId)
+		copy(dAtA[i:], m.ParentId)
+		i = encodeVarintComment(dAtA, i, uint64(len(m.ParentId)))
+		i--
+		dAtA[i] = 0x52
+	}
 	if len(m.CreatedAt) > 0 {
 		i -= len(m.CreatedAt)
 		copy(dAtA[i:], m.CreatedAt)
@@ -773,13 +1421,17 @@ func (m *Request) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovComment(uint64(l))
 	}
+	l = len(m.ViewerId)
+	if l > 0 {
+		n += 1 + l + sovComment(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
 	return n
 }
 
-func (m *CommentRequest) Size() (n int) {
+func (m *ModerateRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -789,18 +1441,96 @@ func (m *CommentRequest) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovComment(uint64(l))
 	}
-	l = len(m.PostId)
+	l = len(m.Action)
 	if l > 0 {
 		n += 1 + l + sovComment(uint64(l))
 	}
-	l = len(m.UserId)
-	if l > 0 {
-		n += 1 + l + sovComment(uint64(l))
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *IdsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Ids) > 0 {
+		for _, s := range m.Ids {
+			l = len(s)
+			n += 1 + l + sovComment(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *CommentCountsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Counts) > 0 {
+		for k, v := range m.Counts {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovComment(uint64(len(k))) + 1 + sovComment(uint64(v))
+			n += mapEntrySize + 1 + sovComment(uint64(mapEntrySize))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *CommentRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovComment(uint64(l))
+	}
+	l = len(m.PostId)
+	if l > 0 {
+		n += 1 + l + sovComment(uint64(l))
+	}
+	l = len(m.UserId)
+	if l > 0 {
+		n += 1 + l + sovComment(uint64(l))
 	}
 	l = len(m.Text)
 	if l > 0 {
 		n += 1 + l + sovComment(uint64(l))
 	}
+	l = len(m.ParentId)
+	if l > 0 {
+		n += 1 + l + sovComment(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *StreamRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PostId)
+	if l > 0 {
+		n += 1 + l + sovComment(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -867,6 +1597,14 @@ func (m *CommentResponse) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovComment(uint64(l))
 	}
+	l = len(m.ParentId)
+	if l > 0 {
+		n += 1 + l + sovComment(uint64(l))
+	}
+	l = len(m.ModerationStatus)
+	if l > 0 {
+		n += 1 + l + sovComment(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -879,7 +1617,237 @@ func sovComment(x uint64) (n int) {
 func sozComment(x uint64) (n int) {
 	return sovComment(uint64((x << 1) ^ uint64((int64(x) >> 63))))
 }
-func (m *Request) Unmarshal(dAtA []byte) error {
+func (m *Request) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowComment
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Request: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Request: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Str", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowComment
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthComment
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthComment
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Str = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ViewerId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowComment
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthComment
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthComment
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ViewerId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipComment(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthComment
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ModerateRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowComment
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ModerateRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ModerateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowComment
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthComment
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthComment
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Action", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowComment
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthComment
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthComment
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Action = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipComment(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthComment
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *IdsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -902,15 +1870,15 @@ func (m *Request) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Request: wiretype end group for non-group")
+			return fmt.Errorf("proto: IdsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Request: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: IdsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Str", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Ids", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -938,7 +1906,171 @@ func (m *Request) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Str = string(dAtA[iNdEx:postIndex])
+			m.Ids = append(m.Ids, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipComment(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthComment
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CommentCountsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowComment
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CommentCountsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CommentCountsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Counts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowComment
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthComment
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthComment
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Counts == nil {
+				m.Counts = make(map[string]int64)
+			}
+			var mapkey string
+			var mapvalue int64
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowComment
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowComment
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthComment
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthComment
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowComment
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapvalue |= int64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipComment(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthComment
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Counts[mapkey] = mapvalue
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1119,6 +2251,121 @@ func (m *CommentRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.Text = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ParentId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowComment
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthComment
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthComment
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ParentId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipComment(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthComment
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StreamRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowComment
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StreamRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StreamRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PostId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowComment
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthComment
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthComment
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PostId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipComment(dAtA[iNdEx:])
@@ -1543,6 +2790,70 @@ func (m *CommentResponse) Unmarshal(dAtA []byte) error {
 			}
 			m.CreatedAt = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ParentId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowComment
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthComment
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthComment
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ParentId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ModerationStatus", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowComment
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthComment
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthComment
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ModerationStatus = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipComment(dAtA[iNdEx:])
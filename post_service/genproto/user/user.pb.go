@@ -7,6 +7,7 @@ import (
 	context "context"
 	fmt "fmt"
 	proto "github.com/golang/protobuf/proto"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
@@ -26,26 +27,27 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
 
-type ChangeRoleRequest struct {
+type DataExportRequest struct {
 	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id"`
-	Role                 string   `protobuf:"bytes,2,opt,name=role,proto3" json:"role"`
+	UserId               string   `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id"`
+	Format               string   `protobuf:"bytes,3,opt,name=format,proto3" json:"format"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ChangeRoleRequest) Reset()         { *m = ChangeRoleRequest{} }
-func (m *ChangeRoleRequest) String() string { return proto.CompactTextString(m) }
-func (*ChangeRoleRequest) ProtoMessage()    {}
-func (*ChangeRoleRequest) Descriptor() ([]byte, []int) {
+func (m *DataExportRequest) Reset()         { *m = DataExportRequest{} }
+func (m *DataExportRequest) String() string { return proto.CompactTextString(m) }
+func (*DataExportRequest) ProtoMessage()    {}
+func (*DataExportRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_ed89022014131a74, []int{0}
 }
-func (m *ChangeRoleRequest) XXX_Unmarshal(b []byte) error {
+func (m *DataExportRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ChangeRoleRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *DataExportRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ChangeRoleRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_DataExportRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -55,52 +57,63 @@ func (m *ChangeRoleRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, e
 		return b[:n], nil
 	}
 }
-func (m *ChangeRoleRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ChangeRoleRequest.Merge(m, src)
+func (m *DataExportRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DataExportRequest.Merge(m, src)
 }
-func (m *ChangeRoleRequest) XXX_Size() int {
+func (m *DataExportRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *ChangeRoleRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_ChangeRoleRequest.DiscardUnknown(m)
+func (m *DataExportRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DataExportRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ChangeRoleRequest proto.InternalMessageInfo
+var xxx_messageInfo_DataExportRequest proto.InternalMessageInfo
 
-func (m *ChangeRoleRequest) GetId() string {
+func (m *DataExportRequest) GetId() string {
 	if m != nil {
 		return m.Id
 	}
 	return ""
 }
 
-func (m *ChangeRoleRequest) GetRole() string {
+func (m *DataExportRequest) GetUserId() string {
 	if m != nil {
-		return m.Role
+		return m.UserId
 	}
 	return ""
 }
 
-type CheckFieldRequest struct {
-	Field                string   `protobuf:"bytes,1,opt,name=field,proto3" json:"field"`
-	Value                string   `protobuf:"bytes,2,opt,name=value,proto3" json:"value"`
+func (m *DataExportRequest) GetFormat() string {
+	if m != nil {
+		return m.Format
+	}
+	return ""
+}
+
+type DataExportResponse struct {
+	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id"`
+	UserId               string   `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id"`
+	Status               string   `protobuf:"bytes,3,opt,name=status,proto3" json:"status"`
+	Error                string   `protobuf:"bytes,4,opt,name=error,proto3" json:"error"`
+	CreatedAt            string   `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at"`
+	CompletedAt          string   `protobuf:"bytes,6,opt,name=completed_at,json=completedAt,proto3" json:"completed_at"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *CheckFieldRequest) Reset()         { *m = CheckFieldRequest{} }
-func (m *CheckFieldRequest) String() string { return proto.CompactTextString(m) }
-func (*CheckFieldRequest) ProtoMessage()    {}
-func (*CheckFieldRequest) Descriptor() ([]byte, []int) {
+func (m *DataExportResponse) Reset()         { *m = DataExportResponse{} }
+func (m *DataExportResponse) String() string { return proto.CompactTextString(m) }
+func (*DataExportResponse) ProtoMessage()    {}
+func (*DataExportResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_ed89022014131a74, []int{1}
 }
-func (m *CheckFieldRequest) XXX_Unmarshal(b []byte) error {
+func (m *DataExportResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *CheckFieldRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *DataExportResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_CheckFieldRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_DataExportResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -110,51 +123,81 @@ func (m *CheckFieldRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, e
 		return b[:n], nil
 	}
 }
-func (m *CheckFieldRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_CheckFieldRequest.Merge(m, src)
+func (m *DataExportResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DataExportResponse.Merge(m, src)
 }
-func (m *CheckFieldRequest) XXX_Size() int {
+func (m *DataExportResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *CheckFieldRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_CheckFieldRequest.DiscardUnknown(m)
+func (m *DataExportResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DataExportResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_CheckFieldRequest proto.InternalMessageInfo
+var xxx_messageInfo_DataExportResponse proto.InternalMessageInfo
 
-func (m *CheckFieldRequest) GetField() string {
+func (m *DataExportResponse) GetId() string {
 	if m != nil {
-		return m.Field
+		return m.Id
 	}
 	return ""
 }
 
-func (m *CheckFieldRequest) GetValue() string {
+func (m *DataExportResponse) GetUserId() string {
 	if m != nil {
-		return m.Value
+		return m.UserId
 	}
 	return ""
 }
 
-type Request struct {
-	Str                  string   `protobuf:"bytes,1,opt,name=str,proto3" json:"str"`
+func (m *DataExportResponse) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
+}
+
+func (m *DataExportResponse) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+func (m *DataExportResponse) GetCreatedAt() string {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return ""
+}
+
+func (m *DataExportResponse) GetCompletedAt() string {
+	if m != nil {
+		return m.CompletedAt
+	}
+	return ""
+}
+
+type DataExportContent struct {
+	FileName             string   `protobuf:"bytes,1,opt,name=file_name,json=fileName,proto3" json:"file_name"`
+	MimeType             string   `protobuf:"bytes,2,opt,name=mime_type,json=mimeType,proto3" json:"mime_type"`
+	Content              []byte   `protobuf:"bytes,3,opt,name=content,proto3" json:"content"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *Request) Reset()         { *m = Request{} }
-func (m *Request) String() string { return proto.CompactTextString(m) }
-func (*Request) ProtoMessage()    {}
-func (*Request) Descriptor() ([]byte, []int) {
+func (m *DataExportContent) Reset()         { *m = DataExportContent{} }
+func (m *DataExportContent) String() string { return proto.CompactTextString(m) }
+func (*DataExportContent) ProtoMessage()    {}
+func (*DataExportContent) Descriptor() ([]byte, []int) {
 	return fileDescriptor_ed89022014131a74, []int{2}
 }
-func (m *Request) XXX_Unmarshal(b []byte) error {
+func (m *DataExportContent) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *Request) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *DataExportContent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_Request.Marshal(b, m, deterministic)
+		return xxx_messageInfo_DataExportContent.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -164,45 +207,59 @@ func (m *Request) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *Request) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Request.Merge(m, src)
+func (m *DataExportContent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DataExportContent.Merge(m, src)
 }
-func (m *Request) XXX_Size() int {
+func (m *DataExportContent) XXX_Size() int {
 	return m.Size()
 }
-func (m *Request) XXX_DiscardUnknown() {
-	xxx_messageInfo_Request.DiscardUnknown(m)
+func (m *DataExportContent) XXX_DiscardUnknown() {
+	xxx_messageInfo_DataExportContent.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Request proto.InternalMessageInfo
+var xxx_messageInfo_DataExportContent proto.InternalMessageInfo
 
-func (m *Request) GetStr() string {
+func (m *DataExportContent) GetFileName() string {
 	if m != nil {
-		return m.Str
+		return m.FileName
 	}
 	return ""
 }
 
-type GetUsersRequest struct {
-	Page                 int64    `protobuf:"varint,1,opt,name=page,proto3" json:"page"`
-	Limit                int64    `protobuf:"varint,2,opt,name=limit,proto3" json:"limit"`
+func (m *DataExportContent) GetMimeType() string {
+	if m != nil {
+		return m.MimeType
+	}
+	return ""
+}
+
+func (m *DataExportContent) GetContent() []byte {
+	if m != nil {
+		return m.Content
+	}
+	return nil
+}
+
+type ChangeRoleRequest struct {
+	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id"`
+	Role                 string   `protobuf:"bytes,2,opt,name=role,proto3" json:"role"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *GetUsersRequest) Reset()         { *m = GetUsersRequest{} }
-func (m *GetUsersRequest) String() string { return proto.CompactTextString(m) }
-func (*GetUsersRequest) ProtoMessage()    {}
-func (*GetUsersRequest) Descriptor() ([]byte, []int) {
+func (m *ChangeRoleRequest) Reset()         { *m = ChangeRoleRequest{} }
+func (m *ChangeRoleRequest) String() string { return proto.CompactTextString(m) }
+func (*ChangeRoleRequest) ProtoMessage()    {}
+func (*ChangeRoleRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_ed89022014131a74, []int{3}
 }
-func (m *GetUsersRequest) XXX_Unmarshal(b []byte) error {
+func (m *ChangeRoleRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *GetUsersRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ChangeRoleRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_GetUsersRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ChangeRoleRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -212,52 +269,53 @@ func (m *GetUsersRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, err
 		return b[:n], nil
 	}
 }
-func (m *GetUsersRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetUsersRequest.Merge(m, src)
+func (m *ChangeRoleRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChangeRoleRequest.Merge(m, src)
 }
-func (m *GetUsersRequest) XXX_Size() int {
+func (m *ChangeRoleRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *GetUsersRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetUsersRequest.DiscardUnknown(m)
+func (m *ChangeRoleRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChangeRoleRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetUsersRequest proto.InternalMessageInfo
+var xxx_messageInfo_ChangeRoleRequest proto.InternalMessageInfo
 
-func (m *GetUsersRequest) GetPage() int64 {
+func (m *ChangeRoleRequest) GetId() string {
 	if m != nil {
-		return m.Page
+		return m.Id
 	}
-	return 0
+	return ""
 }
 
-func (m *GetUsersRequest) GetLimit() int64 {
+func (m *ChangeRoleRequest) GetRole() string {
 	if m != nil {
-		return m.Limit
+		return m.Role
 	}
-	return 0
+	return ""
 }
 
-type LoginRequest struct {
-	Email                string   `protobuf:"bytes,1,opt,name=email,proto3" json:"email"`
-	Password             string   `protobuf:"bytes,2,opt,name=password,proto3" json:"password"`
+type SuspendRequest struct {
+	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id"`
+	Until                string   `protobuf:"bytes,2,opt,name=until,proto3" json:"until"`
+	Reason               string   `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *LoginRequest) Reset()         { *m = LoginRequest{} }
-func (m *LoginRequest) String() string { return proto.CompactTextString(m) }
-func (*LoginRequest) ProtoMessage()    {}
-func (*LoginRequest) Descriptor() ([]byte, []int) {
+func (m *SuspendRequest) Reset()         { *m = SuspendRequest{} }
+func (m *SuspendRequest) String() string { return proto.CompactTextString(m) }
+func (*SuspendRequest) ProtoMessage()    {}
+func (*SuspendRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_ed89022014131a74, []int{4}
 }
-func (m *LoginRequest) XXX_Unmarshal(b []byte) error {
+func (m *SuspendRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *LoginRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *SuspendRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_LoginRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_SuspendRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -267,53 +325,61 @@ func (m *LoginRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error)
 		return b[:n], nil
 	}
 }
-func (m *LoginRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_LoginRequest.Merge(m, src)
+func (m *SuspendRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SuspendRequest.Merge(m, src)
 }
-func (m *LoginRequest) XXX_Size() int {
+func (m *SuspendRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *LoginRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_LoginRequest.DiscardUnknown(m)
+func (m *SuspendRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SuspendRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_LoginRequest proto.InternalMessageInfo
+var xxx_messageInfo_SuspendRequest proto.InternalMessageInfo
 
-func (m *LoginRequest) GetEmail() string {
+func (m *SuspendRequest) GetId() string {
 	if m != nil {
-		return m.Email
+		return m.Id
 	}
 	return ""
 }
 
-func (m *LoginRequest) GetPassword() string {
+func (m *SuspendRequest) GetUntil() string {
 	if m != nil {
-		return m.Password
+		return m.Until
 	}
 	return ""
 }
 
-type UpdateUserTokensRequest struct {
+func (m *SuspendRequest) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+type BanRequest struct {
 	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id"`
-	AccessToken          string   `protobuf:"bytes,2,opt,name=access_token,json=accessToken,proto3" json:"access_token"`
-	RefreshToken         string   `protobuf:"bytes,3,opt,name=refresh_token,json=refreshToken,proto3" json:"refresh_token"`
+	Lift                 bool     `protobuf:"varint,2,opt,name=lift,proto3" json:"lift"`
+	Until                string   `protobuf:"bytes,3,opt,name=until,proto3" json:"until"`
+	Reason               string   `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *UpdateUserTokensRequest) Reset()         { *m = UpdateUserTokensRequest{} }
-func (m *UpdateUserTokensRequest) String() string { return proto.CompactTextString(m) }
-func (*UpdateUserTokensRequest) ProtoMessage()    {}
-func (*UpdateUserTokensRequest) Descriptor() ([]byte, []int) {
+func (m *BanRequest) Reset()         { *m = BanRequest{} }
+func (m *BanRequest) String() string { return proto.CompactTextString(m) }
+func (*BanRequest) ProtoMessage()    {}
+func (*BanRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_ed89022014131a74, []int{5}
 }
-func (m *UpdateUserTokensRequest) XXX_Unmarshal(b []byte) error {
+func (m *BanRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *UpdateUserTokensRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *BanRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_UpdateUserTokensRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_BanRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -323,61 +389,74 @@ func (m *UpdateUserTokensRequest) XXX_Marshal(b []byte, deterministic bool) ([]b
 		return b[:n], nil
 	}
 }
-func (m *UpdateUserTokensRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_UpdateUserTokensRequest.Merge(m, src)
+func (m *BanRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BanRequest.Merge(m, src)
 }
-func (m *UpdateUserTokensRequest) XXX_Size() int {
+func (m *BanRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *UpdateUserTokensRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_UpdateUserTokensRequest.DiscardUnknown(m)
+func (m *BanRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_BanRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_UpdateUserTokensRequest proto.InternalMessageInfo
+var xxx_messageInfo_BanRequest proto.InternalMessageInfo
 
-func (m *UpdateUserTokensRequest) GetId() string {
+func (m *BanRequest) GetId() string {
 	if m != nil {
 		return m.Id
 	}
 	return ""
 }
 
-func (m *UpdateUserTokensRequest) GetAccessToken() string {
+func (m *BanRequest) GetLift() bool {
 	if m != nil {
-		return m.AccessToken
+		return m.Lift
+	}
+	return false
+}
+
+func (m *BanRequest) GetUntil() string {
+	if m != nil {
+		return m.Until
 	}
 	return ""
 }
 
-func (m *UpdateUserTokensRequest) GetRefreshToken() string {
+func (m *BanRequest) GetReason() string {
 	if m != nil {
-		return m.RefreshToken
+		return m.Reason
 	}
 	return ""
 }
 
-type UpdateUserRequest struct {
-	FirstName            string   `protobuf:"bytes,1,opt,name=first_name,json=firstName,proto3" json:"first_name"`
-	LastName             string   `protobuf:"bytes,2,opt,name=last_name,json=lastName,proto3" json:"last_name"`
-	Email                string   `protobuf:"bytes,3,opt,name=email,proto3" json:"email"`
-	Id                   string   `protobuf:"bytes,4,opt,name=id,proto3" json:"id"`
+// AccountResponse is the effective state of the account: banned users and
+// suspended users can not log in or write, content of shadow banned users is
+// shown only to themselves
+type AccountResponse struct {
+	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id"`
+	State                string   `protobuf:"bytes,2,opt,name=state,proto3" json:"state"`
+	Until                string   `protobuf:"bytes,3,opt,name=until,proto3" json:"until"`
+	Reason               string   `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason"`
+	ShadowBanned         bool     `protobuf:"varint,5,opt,name=shadow_banned,json=shadowBanned,proto3" json:"shadow_banned"`
+	ShadowBannedUntil    string   `protobuf:"bytes,6,opt,name=shadow_banned_until,json=shadowBannedUntil,proto3" json:"shadow_banned_until"`
+	ShadowBanReason      string   `protobuf:"bytes,7,opt,name=shadow_ban_reason,json=shadowBanReason,proto3" json:"shadow_ban_reason"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *UpdateUserRequest) Reset()         { *m = UpdateUserRequest{} }
-func (m *UpdateUserRequest) String() string { return proto.CompactTextString(m) }
-func (*UpdateUserRequest) ProtoMessage()    {}
-func (*UpdateUserRequest) Descriptor() ([]byte, []int) {
+func (m *AccountResponse) Reset()         { *m = AccountResponse{} }
+func (m *AccountResponse) String() string { return proto.CompactTextString(m) }
+func (*AccountResponse) ProtoMessage()    {}
+func (*AccountResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_ed89022014131a74, []int{6}
 }
-func (m *UpdateUserRequest) XXX_Unmarshal(b []byte) error {
+func (m *AccountResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *UpdateUserRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *AccountResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_UpdateUserRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_AccountResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -387,65 +466,87 @@ func (m *UpdateUserRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, e
 		return b[:n], nil
 	}
 }
-func (m *UpdateUserRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_UpdateUserRequest.Merge(m, src)
+func (m *AccountResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AccountResponse.Merge(m, src)
 }
-func (m *UpdateUserRequest) XXX_Size() int {
+func (m *AccountResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *UpdateUserRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_UpdateUserRequest.DiscardUnknown(m)
+func (m *AccountResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_AccountResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_UpdateUserRequest proto.InternalMessageInfo
+var xxx_messageInfo_AccountResponse proto.InternalMessageInfo
 
-func (m *UpdateUserRequest) GetFirstName() string {
+func (m *AccountResponse) GetId() string {
 	if m != nil {
-		return m.FirstName
+		return m.Id
 	}
 	return ""
 }
 
-func (m *UpdateUserRequest) GetLastName() string {
+func (m *AccountResponse) GetState() string {
 	if m != nil {
-		return m.LastName
+		return m.State
 	}
 	return ""
 }
 
-func (m *UpdateUserRequest) GetEmail() string {
+func (m *AccountResponse) GetUntil() string {
 	if m != nil {
-		return m.Email
+		return m.Until
 	}
 	return ""
 }
 
-func (m *UpdateUserRequest) GetId() string {
+func (m *AccountResponse) GetReason() string {
 	if m != nil {
-		return m.Id
+		return m.Reason
 	}
 	return ""
 }
 
-type CheckFieldResponse struct {
-	Exists               bool     `protobuf:"varint,1,opt,name=exists,proto3" json:"exists"`
+func (m *AccountResponse) GetShadowBanned() bool {
+	if m != nil {
+		return m.ShadowBanned
+	}
+	return false
+}
+
+func (m *AccountResponse) GetShadowBannedUntil() string {
+	if m != nil {
+		return m.ShadowBannedUntil
+	}
+	return ""
+}
+
+func (m *AccountResponse) GetShadowBanReason() string {
+	if m != nil {
+		return m.ShadowBanReason
+	}
+	return ""
+}
+
+type CheckFieldRequest struct {
+	Field                string   `protobuf:"bytes,1,opt,name=field,proto3" json:"field"`
+	Value                string   `protobuf:"bytes,2,opt,name=value,proto3" json:"value"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *CheckFieldResponse) Reset()         { *m = CheckFieldResponse{} }
-func (m *CheckFieldResponse) String() string { return proto.CompactTextString(m) }
-func (*CheckFieldResponse) ProtoMessage()    {}
-func (*CheckFieldResponse) Descriptor() ([]byte, []int) {
+func (m *CheckFieldRequest) Reset()         { *m = CheckFieldRequest{} }
+func (m *CheckFieldRequest) String() string { return proto.CompactTextString(m) }
+func (*CheckFieldRequest) ProtoMessage()    {}
+func (*CheckFieldRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_ed89022014131a74, []int{7}
 }
-func (m *CheckFieldResponse) XXX_Unmarshal(b []byte) error {
+func (m *CheckFieldRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *CheckFieldResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *CheckFieldRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_CheckFieldResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_CheckFieldRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -455,51 +556,52 @@ func (m *CheckFieldResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *CheckFieldResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_CheckFieldResponse.Merge(m, src)
+func (m *CheckFieldRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CheckFieldRequest.Merge(m, src)
 }
-func (m *CheckFieldResponse) XXX_Size() int {
+func (m *CheckFieldRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *CheckFieldResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_CheckFieldResponse.DiscardUnknown(m)
+func (m *CheckFieldRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CheckFieldRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_CheckFieldResponse proto.InternalMessageInfo
+var xxx_messageInfo_CheckFieldRequest proto.InternalMessageInfo
 
-func (m *CheckFieldResponse) GetExists() bool {
+func (m *CheckFieldRequest) GetField() string {
 	if m != nil {
-		return m.Exists
+		return m.Field
 	}
-	return false
+	return ""
 }
 
-type LoginResponse struct {
-	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id"`
-	FirstName            string   `protobuf:"bytes,2,opt,name=first_name,json=firstName,proto3" json:"first_name"`
-	LastName             string   `protobuf:"bytes,3,opt,name=last_name,json=lastName,proto3" json:"last_name"`
-	UserType             string   `protobuf:"bytes,4,opt,name=user_type,json=userType,proto3" json:"user_type"`
-	Email                string   `protobuf:"bytes,5,opt,name=email,proto3" json:"email"`
-	Password             string   `protobuf:"bytes,6,opt,name=password,proto3" json:"password"`
-	AccessToken          string   `protobuf:"bytes,7,opt,name=access_token,json=accessToken,proto3" json:"access_token"`
-	RefreshToken         string   `protobuf:"bytes,8,opt,name=refresh_token,json=refreshToken,proto3" json:"refresh_token"`
+func (m *CheckFieldRequest) GetValue() string {
+	if m != nil {
+		return m.Value
+	}
+	return ""
+}
+
+type Request struct {
+	Str                  string   `protobuf:"bytes,1,opt,name=str,proto3" json:"str"`
+	ViewerId             string   `protobuf:"bytes,2,opt,name=viewer_id,json=viewerId,proto3" json:"viewer_id"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *LoginResponse) Reset()         { *m = LoginResponse{} }
-func (m *LoginResponse) String() string { return proto.CompactTextString(m) }
-func (*LoginResponse) ProtoMessage()    {}
-func (*LoginResponse) Descriptor() ([]byte, []int) {
+func (m *Request) Reset()         { *m = Request{} }
+func (m *Request) String() string { return proto.CompactTextString(m) }
+func (*Request) ProtoMessage()    {}
+func (*Request) Descriptor() ([]byte, []int) {
 	return fileDescriptor_ed89022014131a74, []int{8}
 }
-func (m *LoginResponse) XXX_Unmarshal(b []byte) error {
+func (m *Request) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *LoginResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *Request) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_LoginResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_Request.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -509,103 +611,108 @@ func (m *LoginResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error
 		return b[:n], nil
 	}
 }
-func (m *LoginResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_LoginResponse.Merge(m, src)
+func (m *Request) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Request.Merge(m, src)
 }
-func (m *LoginResponse) XXX_Size() int {
+func (m *Request) XXX_Size() int {
 	return m.Size()
 }
-func (m *LoginResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_LoginResponse.DiscardUnknown(m)
+func (m *Request) XXX_DiscardUnknown() {
+	xxx_messageInfo_Request.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_LoginResponse proto.InternalMessageInfo
+var xxx_messageInfo_Request proto.InternalMessageInfo
 
-func (m *LoginResponse) GetId() string {
+func (m *Request) GetStr() string {
 	if m != nil {
-		return m.Id
+		return m.Str
 	}
 	return ""
 }
 
-func (m *LoginResponse) GetFirstName() string {
+func (m *Request) GetViewerId() string {
 	if m != nil {
-		return m.FirstName
+		return m.ViewerId
 	}
 	return ""
 }
 
-func (m *LoginResponse) GetLastName() string {
-	if m != nil {
-		return m.LastName
-	}
-	return ""
+type FollowRequest struct {
+	FollowerId           string   `protobuf:"bytes,1,opt,name=follower_id,json=followerId,proto3" json:"follower_id"`
+	FollowingId          string   `protobuf:"bytes,2,opt,name=following_id,json=followingId,proto3" json:"following_id"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *LoginResponse) GetUserType() string {
-	if m != nil {
-		return m.UserType
-	}
-	return ""
+func (m *FollowRequest) Reset()         { *m = FollowRequest{} }
+func (m *FollowRequest) String() string { return proto.CompactTextString(m) }
+func (*FollowRequest) ProtoMessage()    {}
+func (*FollowRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ed89022014131a74, []int{9}
 }
-
-func (m *LoginResponse) GetEmail() string {
-	if m != nil {
-		return m.Email
-	}
-	return ""
+func (m *FollowRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *LoginResponse) GetPassword() string {
-	if m != nil {
-		return m.Password
+func (m *FollowRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FollowRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return ""
+}
+func (m *FollowRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FollowRequest.Merge(m, src)
+}
+func (m *FollowRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *FollowRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_FollowRequest.DiscardUnknown(m)
 }
 
-func (m *LoginResponse) GetAccessToken() string {
+var xxx_messageInfo_FollowRequest proto.InternalMessageInfo
+
+func (m *FollowRequest) GetFollowerId() string {
 	if m != nil {
-		return m.AccessToken
+		return m.FollowerId
 	}
 	return ""
 }
 
-func (m *LoginResponse) GetRefreshToken() string {
+func (m *FollowRequest) GetFollowingId() string {
 	if m != nil {
-		return m.RefreshToken
+		return m.FollowingId
 	}
 	return ""
 }
 
-type UserResponse struct {
-	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id"`
-	FirstName            string   `protobuf:"bytes,2,opt,name=first_name,json=firstName,proto3" json:"first_name"`
-	LastName             string   `protobuf:"bytes,3,opt,name=last_name,json=lastName,proto3" json:"last_name"`
-	UserType             string   `protobuf:"bytes,4,opt,name=user_type,json=userType,proto3" json:"user_type"`
-	Email                string   `protobuf:"bytes,5,opt,name=email,proto3" json:"email"`
-	Password             string   `protobuf:"bytes,6,opt,name=password,proto3" json:"password"`
-	AccessToken          string   `protobuf:"bytes,7,opt,name=access_token,json=accessToken,proto3" json:"access_token"`
-	RefreshToken         string   `protobuf:"bytes,8,opt,name=refresh_token,json=refreshToken,proto3" json:"refresh_token"`
-	Posts                int64    `protobuf:"varint,9,opt,name=posts,proto3" json:"posts"`
-	CreatedAt            string   `protobuf:"bytes,10,opt,name=created_at,json=createdAt,proto3" json:"created_at"`
-	UpdatedAt            string   `protobuf:"bytes,11,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at"`
+type FollowResponse struct {
+	FollowerId           string   `protobuf:"bytes,1,opt,name=follower_id,json=followerId,proto3" json:"follower_id"`
+	FollowingId          string   `protobuf:"bytes,2,opt,name=following_id,json=followingId,proto3" json:"following_id"`
+	Following            bool     `protobuf:"varint,3,opt,name=following,proto3" json:"following"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *UserResponse) Reset()         { *m = UserResponse{} }
-func (m *UserResponse) String() string { return proto.CompactTextString(m) }
-func (*UserResponse) ProtoMessage()    {}
-func (*UserResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ed89022014131a74, []int{9}
+func (m *FollowResponse) Reset()         { *m = FollowResponse{} }
+func (m *FollowResponse) String() string { return proto.CompactTextString(m) }
+func (*FollowResponse) ProtoMessage()    {}
+func (*FollowResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ed89022014131a74, []int{10}
 }
-func (m *UserResponse) XXX_Unmarshal(b []byte) error {
+func (m *FollowResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *UserResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *FollowResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_UserResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_FollowResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -615,114 +722,179 @@ func (m *UserResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error)
 		return b[:n], nil
 	}
 }
-func (m *UserResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_UserResponse.Merge(m, src)
+func (m *FollowResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FollowResponse.Merge(m, src)
 }
-func (m *UserResponse) XXX_Size() int {
+func (m *FollowResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *UserResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_UserResponse.DiscardUnknown(m)
+func (m *FollowResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_FollowResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_UserResponse proto.InternalMessageInfo
+var xxx_messageInfo_FollowResponse proto.InternalMessageInfo
 
-func (m *UserResponse) GetId() string {
+func (m *FollowResponse) GetFollowerId() string {
 	if m != nil {
-		return m.Id
+		return m.FollowerId
 	}
 	return ""
 }
 
-func (m *UserResponse) GetFirstName() string {
+func (m *FollowResponse) GetFollowingId() string {
 	if m != nil {
-		return m.FirstName
+		return m.FollowingId
 	}
 	return ""
 }
 
-func (m *UserResponse) GetLastName() string {
+func (m *FollowResponse) GetFollowing() bool {
 	if m != nil {
-		return m.LastName
+		return m.Following
 	}
-	return ""
+	return false
 }
 
-func (m *UserResponse) GetUserType() string {
-	if m != nil {
-		return m.UserType
-	}
-	return ""
+// RelationRequest is a block or mute of target_id by user_id
+type RelationRequest struct {
+	UserId               string   `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id"`
+	TargetId             string   `protobuf:"bytes,2,opt,name=target_id,json=targetId,proto3" json:"target_id"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *UserResponse) GetEmail() string {
-	if m != nil {
-		return m.Email
+func (m *RelationRequest) Reset()         { *m = RelationRequest{} }
+func (m *RelationRequest) String() string { return proto.CompactTextString(m) }
+func (*RelationRequest) ProtoMessage()    {}
+func (*RelationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ed89022014131a74, []int{11}
+}
+func (m *RelationRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RelationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RelationRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return ""
+}
+func (m *RelationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RelationRequest.Merge(m, src)
+}
+func (m *RelationRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *RelationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RelationRequest.DiscardUnknown(m)
 }
 
-func (m *UserResponse) GetPassword() string {
+var xxx_messageInfo_RelationRequest proto.InternalMessageInfo
+
+func (m *RelationRequest) GetUserId() string {
 	if m != nil {
-		return m.Password
+		return m.UserId
 	}
 	return ""
 }
 
-func (m *UserResponse) GetAccessToken() string {
+func (m *RelationRequest) GetTargetId() string {
 	if m != nil {
-		return m.AccessToken
+		return m.TargetId
 	}
 	return ""
 }
 
-func (m *UserResponse) GetRefreshToken() string {
-	if m != nil {
-		return m.RefreshToken
+type RelationResponse struct {
+	UserId               string   `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id"`
+	TargetId             string   `protobuf:"bytes,2,opt,name=target_id,json=targetId,proto3" json:"target_id"`
+	Active               bool     `protobuf:"varint,3,opt,name=active,proto3" json:"active"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RelationResponse) Reset()         { *m = RelationResponse{} }
+func (m *RelationResponse) String() string { return proto.CompactTextString(m) }
+func (*RelationResponse) ProtoMessage()    {}
+func (*RelationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ed89022014131a74, []int{12}
+}
+func (m *RelationResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RelationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RelationResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return ""
+}
+func (m *RelationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RelationResponse.Merge(m, src)
+}
+func (m *RelationResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *RelationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RelationResponse.DiscardUnknown(m)
 }
 
-func (m *UserResponse) GetPosts() int64 {
+var xxx_messageInfo_RelationResponse proto.InternalMessageInfo
+
+func (m *RelationResponse) GetUserId() string {
 	if m != nil {
-		return m.Posts
+		return m.UserId
 	}
-	return 0
+	return ""
 }
 
-func (m *UserResponse) GetCreatedAt() string {
+func (m *RelationResponse) GetTargetId() string {
 	if m != nil {
-		return m.CreatedAt
+		return m.TargetId
 	}
 	return ""
 }
 
-func (m *UserResponse) GetUpdatedAt() string {
+func (m *RelationResponse) GetActive() bool {
 	if m != nil {
-		return m.UpdatedAt
+		return m.Active
 	}
-	return ""
+	return false
 }
 
-type UsersResponse struct {
-	Users                []*UserResponse `protobuf:"bytes,1,rep,name=users,proto3" json:"users"`
-	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
-	XXX_unrecognized     []byte          `json:"-"`
-	XXX_sizecache        int32           `json:"-"`
+type GetUsersRequest struct {
+	Page                 int64    `protobuf:"varint,1,opt,name=page,proto3" json:"page"`
+	Limit                int64    `protobuf:"varint,2,opt,name=limit,proto3" json:"limit"`
+	ViewerId             string   `protobuf:"bytes,3,opt,name=viewer_id,json=viewerId,proto3" json:"viewer_id"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *UsersResponse) Reset()         { *m = UsersResponse{} }
-func (m *UsersResponse) String() string { return proto.CompactTextString(m) }
-func (*UsersResponse) ProtoMessage()    {}
-func (*UsersResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ed89022014131a74, []int{10}
+func (m *GetUsersRequest) Reset()         { *m = GetUsersRequest{} }
+func (m *GetUsersRequest) String() string { return proto.CompactTextString(m) }
+func (*GetUsersRequest) ProtoMessage()    {}
+func (*GetUsersRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ed89022014131a74, []int{13}
 }
-func (m *UsersResponse) XXX_Unmarshal(b []byte) error {
+func (m *GetUsersRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *UsersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *GetUsersRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_UsersResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_GetUsersRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -732,1467 +904,5505 @@ func (m *UsersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error
 		return b[:n], nil
 	}
 }
-func (m *UsersResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_UsersResponse.Merge(m, src)
+func (m *GetUsersRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetUsersRequest.Merge(m, src)
 }
-func (m *UsersResponse) XXX_Size() int {
+func (m *GetUsersRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *UsersResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_UsersResponse.DiscardUnknown(m)
+func (m *GetUsersRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetUsersRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_UsersResponse proto.InternalMessageInfo
+var xxx_messageInfo_GetUsersRequest proto.InternalMessageInfo
 
-func (m *UsersResponse) GetUsers() []*UserResponse {
+func (m *GetUsersRequest) GetPage() int64 {
 	if m != nil {
-		return m.Users
+		return m.Page
 	}
-	return nil
+	return 0
 }
 
-func init() {
-	proto.RegisterType((*ChangeRoleRequest)(nil), "user.ChangeRoleRequest")
-	proto.RegisterType((*CheckFieldRequest)(nil), "user.CheckFieldRequest")
-	proto.RegisterType((*Request)(nil), "user.Request")
-	proto.RegisterType((*GetUsersRequest)(nil), "user.GetUsersRequest")
-	proto.RegisterType((*LoginRequest)(nil), "user.LoginRequest")
-	proto.RegisterType((*UpdateUserTokensRequest)(nil), "user.UpdateUserTokensRequest")
-	proto.RegisterType((*UpdateUserRequest)(nil), "user.UpdateUserRequest")
-	proto.RegisterType((*CheckFieldResponse)(nil), "user.CheckFieldResponse")
-	proto.RegisterType((*LoginResponse)(nil), "user.LoginResponse")
-	proto.RegisterType((*UserResponse)(nil), "user.UserResponse")
-	proto.RegisterType((*UsersResponse)(nil), "user.UsersResponse")
+func (m *GetUsersRequest) GetLimit() int64 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
 }
 
-func init() { proto.RegisterFile("user/user.proto", fileDescriptor_ed89022014131a74) }
+func (m *GetUsersRequest) GetViewerId() string {
+	if m != nil {
+		return m.ViewerId
+	}
+	return ""
+}
 
-var fileDescriptor_ed89022014131a74 = []byte{
-	// 693 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x55, 0xdd, 0x4e, 0x13, 0x41,
-	0x14, 0xa6, 0xdd, 0xb6, 0xb4, 0xa7, 0x2d, 0x94, 0xf1, 0x87, 0xa6, 0x84, 0x46, 0xc7, 0x1b, 0x2e,
-	0x0c, 0x46, 0x88, 0xa2, 0xe1, 0x02, 0x0b, 0x0a, 0x31, 0x31, 0x5e, 0x2c, 0x70, 0xdd, 0x8c, 0xdd,
-	0x03, 0x6c, 0xd8, 0xee, 0x2e, 0x33, 0x53, 0x94, 0x17, 0xf0, 0x19, 0x7c, 0x21, 0x13, 0x2f, 0x7d,
-	0x04, 0x83, 0x6f, 0xe0, 0x13, 0x98, 0xf9, 0xd9, 0x76, 0xbb, 0x65, 0x4d, 0xef, 0xbd, 0xd9, 0xcc,
-	0x39, 0x73, 0xbe, 0x39, 0xdf, 0x39, 0xdf, 0xec, 0x19, 0x58, 0x1e, 0x09, 0xe4, 0xcf, 0xd4, 0x67,
-	0x33, 0xe6, 0x91, 0x8c, 0x48, 0x49, 0xad, 0xe9, 0x0e, 0xac, 0x1c, 0x5c, 0xb0, 0xf0, 0x1c, 0xdd,
-	0x28, 0x40, 0x17, 0xaf, 0x46, 0x28, 0x24, 0x59, 0x82, 0xa2, 0xef, 0xb5, 0x0b, 0x8f, 0x0a, 0x1b,
-	0x35, 0xb7, 0xe8, 0x7b, 0x84, 0x40, 0x89, 0x47, 0x01, 0xb6, 0x8b, 0xda, 0xa3, 0xd7, 0x74, 0x4f,
-	0x01, 0x71, 0x70, 0x79, 0xe8, 0x63, 0xe0, 0x25, 0xc0, 0xfb, 0x50, 0x3e, 0x53, 0xb6, 0xc5, 0x1a,
-	0x43, 0x79, 0xaf, 0x59, 0x30, 0x4a, 0xf0, 0xc6, 0xa0, 0x6b, 0xb0, 0x98, 0xc0, 0x5a, 0xe0, 0x08,
-	0xc9, 0x2d, 0x48, 0x2d, 0xe9, 0x2e, 0x2c, 0x1f, 0xa1, 0x3c, 0x15, 0xc8, 0x45, 0x12, 0x44, 0xa0,
-	0x14, 0xb3, 0x73, 0xd4, 0x51, 0x8e, 0xab, 0xd7, 0xea, 0xe4, 0xc0, 0x1f, 0xfa, 0x52, 0x9f, 0xec,
-	0xb8, 0xc6, 0xa0, 0x6f, 0xa0, 0xf1, 0x21, 0x3a, 0xf7, 0xc3, 0x14, 0x2b, 0x1c, 0x32, 0x3f, 0x48,
-	0x58, 0x69, 0x83, 0x74, 0xa0, 0x1a, 0x33, 0x21, 0x3e, 0x47, 0xdc, 0xb3, 0xc4, 0xc6, 0x36, 0xbd,
-	0x82, 0xd5, 0xd3, 0xd8, 0x63, 0x12, 0x15, 0x83, 0x93, 0xe8, 0x12, 0x43, 0x91, 0xd7, 0x9b, 0xc7,
-	0xd0, 0x60, 0x83, 0x01, 0x0a, 0xd1, 0x97, 0x2a, 0xce, 0x1e, 0x55, 0x37, 0x3e, 0x0d, 0x25, 0x4f,
-	0xa0, 0xc9, 0xf1, 0x8c, 0xa3, 0xb8, 0xb0, 0x31, 0x8e, 0x8e, 0x69, 0x58, 0xa7, 0x0e, 0xa2, 0x23,
-	0x58, 0x99, 0xa4, 0x4c, 0x92, 0xad, 0x03, 0x9c, 0xf9, 0x5c, 0xc8, 0x7e, 0xc8, 0x86, 0x68, 0x93,
-	0xd6, 0xb4, 0xe7, 0x23, 0x1b, 0x22, 0x59, 0x83, 0x5a, 0xc0, 0x92, 0x5d, 0x5b, 0x83, 0x72, 0xe8,
-	0xcd, 0x71, 0xd5, 0x4e, 0xba, 0x6a, 0x43, 0xbf, 0x94, 0xd0, 0xa7, 0x4f, 0x81, 0xa4, 0x65, 0x14,
-	0x71, 0x14, 0x0a, 0x24, 0x0f, 0xa1, 0x82, 0x5f, 0x7c, 0x21, 0x85, 0xce, 0x59, 0x75, 0xad, 0x45,
-	0xff, 0x14, 0xa0, 0x69, 0x5b, 0x6b, 0x23, 0xb3, 0xed, 0x98, 0x66, 0x5c, 0xfc, 0x27, 0x63, 0x27,
-	0xc3, 0x78, 0x0d, 0x6a, 0xea, 0x4e, 0xf6, 0xe5, 0x4d, 0x8c, 0x96, 0x62, 0x55, 0x39, 0x4e, 0x6e,
-	0xe2, 0x54, 0x39, 0xe5, 0x3c, 0x11, 0x2b, 0xd3, 0x22, 0xce, 0x28, 0xb3, 0x38, 0x87, 0x32, 0xd5,
-	0x3b, 0x94, 0xf9, 0x5e, 0x84, 0x86, 0x11, 0xe5, 0xbf, 0xa9, 0x59, 0x65, 0x8e, 0x23, 0xa5, 0x7f,
-	0xcd, 0xfc, 0x58, 0xda, 0x50, 0x85, 0x0e, 0x38, 0x32, 0x89, 0x5e, 0x9f, 0xc9, 0x36, 0x98, 0x42,
-	0xad, 0xa7, 0xa7, 0x6f, 0xeb, 0x48, 0x5f, 0x61, 0xbd, 0x5d, 0x37, 0xdb, 0xd6, 0xd3, 0x93, 0xf4,
-	0x35, 0x34, 0xed, 0x0f, 0x6d, 0xfb, 0xb8, 0x01, 0x65, 0x55, 0xaa, 0xba, 0x64, 0xce, 0x46, 0x7d,
-	0x8b, 0x6c, 0xea, 0xe9, 0x94, 0x6e, 0xb5, 0x6b, 0x02, 0xb6, 0xbe, 0x56, 0xa0, 0xae, 0xfc, 0xc7,
-	0xc8, 0xaf, 0xfd, 0x01, 0x92, 0x97, 0x00, 0x07, 0x3a, 0xad, 0x72, 0x92, 0x3b, 0x80, 0x9d, 0x3b,
-	0x7c, 0x74, 0x81, 0x6c, 0x41, 0xdd, 0x8e, 0x95, 0xfd, 0x9b, 0xf7, 0x1e, 0x69, 0x9a, 0x20, 0xfb,
-	0xb7, 0xe5, 0x60, 0x5e, 0xc0, 0xd2, 0x18, 0xf3, 0x4e, 0x0b, 0x30, 0x17, 0x6c, 0x57, 0xa7, 0xea,
-	0x05, 0x81, 0xae, 0x99, 0x3c, 0x30, 0x41, 0x99, 0xa1, 0xd6, 0xb9, 0x37, 0xc1, 0x8a, 0x14, 0x78,
-	0x1b, 0xea, 0xc7, 0xc8, 0xf8, 0xe0, 0xc2, 0x80, 0x33, 0x09, 0x73, 0x40, 0xbb, 0x00, 0x93, 0x09,
-	0x42, 0x56, 0x6d, 0x50, 0x76, 0xa6, 0xe4, 0xd0, 0x7d, 0x0e, 0xf0, 0x16, 0x03, 0xb4, 0xe0, 0xb9,
-	0x2a, 0xec, 0x01, 0x4c, 0x46, 0x47, 0x92, 0x6f, 0xe6, 0x4d, 0xe8, 0xb4, 0x67, 0x37, 0xc6, 0x47,
-	0x1c, 0x41, 0x2b, 0x3b, 0x67, 0xc9, 0x7a, 0x96, 0xf8, 0xd4, 0xfc, 0xcd, 0x15, 0xb6, 0xac, 0xe7,
-	0x52, 0x72, 0x17, 0xd2, 0xf3, 0x3f, 0xe9, 0xd7, 0xd4, 0xe0, 0xa2, 0x0b, 0x64, 0x07, 0x5a, 0x56,
-	0x8e, 0xc3, 0x88, 0x1f, 0x04, 0x3e, 0x86, 0x72, 0xbe, 0xc2, 0xf7, 0x60, 0x69, 0xf2, 0x66, 0xa6,
-	0x9b, 0x3d, 0xf3, 0x92, 0xe6, 0x1c, 0xf0, 0x4a, 0x67, 0x3e, 0x66, 0xc3, 0xf1, 0x09, 0x73, 0x6a,
-	0xbc, 0xdf, 0xfa, 0x71, 0xdb, 0x2d, 0xfc, 0xbc, 0xed, 0x16, 0x7e, 0xdd, 0x76, 0x0b, 0xdf, 0x7e,
-	0x77, 0x17, 0x3e, 0x55, 0xf4, 0x6b, 0xbe, 0xfd, 0x37, 0x00, 0x00, 0xff, 0xff, 0xed, 0xd2, 0xbc,
-	0x29, 0xe0, 0x07, 0x00, 0x00,
+type LoginRequest struct {
+	Email                string   `protobuf:"bytes,1,opt,name=email,proto3" json:"email"`
+	Password             string   `protobuf:"bytes,2,opt,name=password,proto3" json:"password"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+func (m *LoginRequest) Reset()         { *m = LoginRequest{} }
+func (m *LoginRequest) String() string { return proto.CompactTextString(m) }
+func (*LoginRequest) ProtoMessage()    {}
+func (*LoginRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ed89022014131a74, []int{14}
+}
+func (m *LoginRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LoginRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LoginRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LoginRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LoginRequest.Merge(m, src)
+}
+func (m *LoginRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *LoginRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_LoginRequest.DiscardUnknown(m)
+}
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_LoginRequest proto.InternalMessageInfo
 
-// UserServiceClient is the client API for UserService service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type UserServiceClient interface {
-	// methods...
-	CreateUser(ctx context.Context, in *UserResponse, opts ...grpc.CallOption) (*UserResponse, error)
-	GetUserById(ctx context.Context, in *Request, opts ...grpc.CallOption) (*UserResponse, error)
-	GetUserByEmail(ctx context.Context, in *Request, opts ...grpc.CallOption) (*UserResponse, error)
-	GetAllUsers(ctx context.Context, in *GetUsersRequest, opts ...grpc.CallOption) (*UsersResponse, error)
-	SearchUsers(ctx context.Context, in *Request, opts ...grpc.CallOption) (*UsersResponse, error)
-	UpdateUser(ctx context.Context, in *UpdateUserRequest, opts ...grpc.CallOption) (*UserResponse, error)
-	DeleteUser(ctx context.Context, in *Request, opts ...grpc.CallOption) (*UserResponse, error)
-	// check...
-	CheckField(ctx context.Context, in *CheckFieldRequest, opts ...grpc.CallOption) (*CheckFieldResponse, error)
-	// Register...
-	UpdateUserTokens(ctx context.Context, in *UpdateUserTokensRequest, opts ...grpc.CallOption) (*UserResponse, error)
-	Login(ctx context.Context, in *LoginRequest, opts ...grpc.CallOption) (*LoginResponse, error)
-	// for Client...
-	GetUserForClient(ctx context.Context, in *Request, opts ...grpc.CallOption) (*UserResponse, error)
-	// rbac...
-	ChangeRoleUser(ctx context.Context, in *ChangeRoleRequest, opts ...grpc.CallOption) (*UserResponse, error)
-	GetSameRoleUsers(ctx context.Context, in *Request, opts ...grpc.CallOption) (*UsersResponse, error)
+func (m *LoginRequest) GetEmail() string {
+	if m != nil {
+		return m.Email
+	}
+	return ""
 }
 
-type userServiceClient struct {
-	cc *grpc.ClientConn
+func (m *LoginRequest) GetPassword() string {
+	if m != nil {
+		return m.Password
+	}
+	return ""
 }
 
-func NewUserServiceClient(cc *grpc.ClientConn) UserServiceClient {
-	return &userServiceClient{cc}
+type UpdateUserTokensRequest struct {
+	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id"`
+	AccessToken          string   `protobuf:"bytes,2,opt,name=access_token,json=accessToken,proto3" json:"access_token"`
+	RefreshToken         string   `protobuf:"bytes,3,opt,name=refresh_token,json=refreshToken,proto3" json:"refresh_token"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (c *userServiceClient) CreateUser(ctx context.Context, in *UserResponse, opts ...grpc.CallOption) (*UserResponse, error) {
-	out := new(UserResponse)
-	err := c.cc.Invoke(ctx, "/user.UserService/CreateUser", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *UpdateUserTokensRequest) Reset()         { *m = UpdateUserTokensRequest{} }
+func (m *UpdateUserTokensRequest) String() string { return proto.CompactTextString(m) }
+func (*UpdateUserTokensRequest) ProtoMessage()    {}
+func (*UpdateUserTokensRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ed89022014131a74, []int{15}
+}
+func (m *UpdateUserTokensRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UpdateUserTokensRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UpdateUserTokensRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *UpdateUserTokensRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateUserTokensRequest.Merge(m, src)
+}
+func (m *UpdateUserTokensRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *UpdateUserTokensRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateUserTokensRequest.DiscardUnknown(m)
 }
 
-func (c *userServiceClient) GetUserById(ctx context.Context, in *Request, opts ...grpc.CallOption) (*UserResponse, error) {
-	out := new(UserResponse)
-	err := c.cc.Invoke(ctx, "/user.UserService/GetUserById", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_UpdateUserTokensRequest proto.InternalMessageInfo
+
+func (m *UpdateUserTokensRequest) GetId() string {
+	if m != nil {
+		return m.Id
 	}
-	return out, nil
+	return ""
 }
 
-func (c *userServiceClient) GetUserByEmail(ctx context.Context, in *Request, opts ...grpc.CallOption) (*UserResponse, error) {
-	out := new(UserResponse)
-	err := c.cc.Invoke(ctx, "/user.UserService/GetUserByEmail", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *UpdateUserTokensRequest) GetAccessToken() string {
+	if m != nil {
+		return m.AccessToken
 	}
-	return out, nil
+	return ""
 }
 
-func (c *userServiceClient) GetAllUsers(ctx context.Context, in *GetUsersRequest, opts ...grpc.CallOption) (*UsersResponse, error) {
-	out := new(UsersResponse)
-	err := c.cc.Invoke(ctx, "/user.UserService/GetAllUsers", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *UpdateUserTokensRequest) GetRefreshToken() string {
+	if m != nil {
+		return m.RefreshToken
 	}
-	return out, nil
+	return ""
 }
 
-func (c *userServiceClient) SearchUsers(ctx context.Context, in *Request, opts ...grpc.CallOption) (*UsersResponse, error) {
-	out := new(UsersResponse)
-	err := c.cc.Invoke(ctx, "/user.UserService/SearchUsers", in, out, opts...)
-	if err != nil {
-		return nil, err
+type UpdateUserRequest struct {
+	FirstName            string   `protobuf:"bytes,1,opt,name=first_name,json=firstName,proto3" json:"first_name"`
+	LastName             string   `protobuf:"bytes,2,opt,name=last_name,json=lastName,proto3" json:"last_name"`
+	Email                string   `protobuf:"bytes,3,opt,name=email,proto3" json:"email"`
+	Id                   string   `protobuf:"bytes,4,opt,name=id,proto3" json:"id"`
+	ExpectedVersion      int64    `protobuf:"varint,5,opt,name=expected_version,json=expectedVersion,proto3" json:"expected_version"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UpdateUserRequest) Reset()         { *m = UpdateUserRequest{} }
+func (m *UpdateUserRequest) String() string { return proto.CompactTextString(m) }
+func (*UpdateUserRequest) ProtoMessage()    {}
+func (*UpdateUserRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ed89022014131a74, []int{16}
+}
+func (m *UpdateUserRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UpdateUserRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UpdateUserRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *UpdateUserRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateUserRequest.Merge(m, src)
+}
+func (m *UpdateUserRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *UpdateUserRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateUserRequest.DiscardUnknown(m)
 }
 
-func (c *userServiceClient) UpdateUser(ctx context.Context, in *UpdateUserRequest, opts ...grpc.CallOption) (*UserResponse, error) {
-	out := new(UserResponse)
-	err := c.cc.Invoke(ctx, "/user.UserService/UpdateUser", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_UpdateUserRequest proto.InternalMessageInfo
+
+func (m *UpdateUserRequest) GetFirstName() string {
+	if m != nil {
+		return m.FirstName
 	}
-	return out, nil
+	return ""
 }
 
-func (c *userServiceClient) DeleteUser(ctx context.Context, in *Request, opts ...grpc.CallOption) (*UserResponse, error) {
-	out := new(UserResponse)
-	err := c.cc.Invoke(ctx, "/user.UserService/DeleteUser", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *UpdateUserRequest) GetLastName() string {
+	if m != nil {
+		return m.LastName
 	}
-	return out, nil
+	return ""
 }
 
-func (c *userServiceClient) CheckField(ctx context.Context, in *CheckFieldRequest, opts ...grpc.CallOption) (*CheckFieldResponse, error) {
-	out := new(CheckFieldResponse)
-	err := c.cc.Invoke(ctx, "/user.UserService/CheckField", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *UpdateUserRequest) GetEmail() string {
+	if m != nil {
+		return m.Email
 	}
-	return out, nil
+	return ""
 }
 
-func (c *userServiceClient) UpdateUserTokens(ctx context.Context, in *UpdateUserTokensRequest, opts ...grpc.CallOption) (*UserResponse, error) {
-	out := new(UserResponse)
-	err := c.cc.Invoke(ctx, "/user.UserService/UpdateUserTokens", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *UpdateUserRequest) GetId() string {
+	if m != nil {
+		return m.Id
 	}
-	return out, nil
+	return ""
 }
 
-func (c *userServiceClient) Login(ctx context.Context, in *LoginRequest, opts ...grpc.CallOption) (*LoginResponse, error) {
-	out := new(LoginResponse)
-	err := c.cc.Invoke(ctx, "/user.UserService/Login", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
-}
-
-func (c *userServiceClient) GetUserForClient(ctx context.Context, in *Request, opts ...grpc.CallOption) (*UserResponse, error) {
-	out := new(UserResponse)
-	err := c.cc.Invoke(ctx, "/user.UserService/GetUserForClient", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
-}
-
-func (c *userServiceClient) ChangeRoleUser(ctx context.Context, in *ChangeRoleRequest, opts ...grpc.CallOption) (*UserResponse, error) {
-	out := new(UserResponse)
-	err := c.cc.Invoke(ctx, "/user.UserService/ChangeRoleUser", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
-}
-
-func (c *userServiceClient) GetSameRoleUsers(ctx context.Context, in *Request, opts ...grpc.CallOption) (*UsersResponse, error) {
-	out := new(UsersResponse)
-	err := c.cc.Invoke(ctx, "/user.UserService/GetSameRoleUsers", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *UpdateUserRequest) GetExpectedVersion() int64 {
+	if m != nil {
+		return m.ExpectedVersion
 	}
-	return out, nil
+	return 0
 }
 
-// UserServiceServer is the server API for UserService service.
-type UserServiceServer interface {
-	// methods...
-	CreateUser(context.Context, *UserResponse) (*UserResponse, error)
-	GetUserById(context.Context, *Request) (*UserResponse, error)
-	GetUserByEmail(context.Context, *Request) (*UserResponse, error)
-	GetAllUsers(context.Context, *GetUsersRequest) (*UsersResponse, error)
-	SearchUsers(context.Context, *Request) (*UsersResponse, error)
-	UpdateUser(context.Context, *UpdateUserRequest) (*UserResponse, error)
-	DeleteUser(context.Context, *Request) (*UserResponse, error)
-	// check...
-	CheckField(context.Context, *CheckFieldRequest) (*CheckFieldResponse, error)
-	// Register...
-	UpdateUserTokens(context.Context, *UpdateUserTokensRequest) (*UserResponse, error)
-	Login(context.Context, *LoginRequest) (*LoginResponse, error)
-	// for Client...
-	GetUserForClient(context.Context, *Request) (*UserResponse, error)
-	// rbac...
-	ChangeRoleUser(context.Context, *ChangeRoleRequest) (*UserResponse, error)
-	GetSameRoleUsers(context.Context, *Request) (*UsersResponse, error)
+type CheckFieldResponse struct {
+	Exists               bool     `protobuf:"varint,1,opt,name=exists,proto3" json:"exists"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-// UnimplementedUserServiceServer can be embedded to have forward compatible implementations.
-type UnimplementedUserServiceServer struct {
+func (m *CheckFieldResponse) Reset()         { *m = CheckFieldResponse{} }
+func (m *CheckFieldResponse) String() string { return proto.CompactTextString(m) }
+func (*CheckFieldResponse) ProtoMessage()    {}
+func (*CheckFieldResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ed89022014131a74, []int{17}
 }
-
-func (*UnimplementedUserServiceServer) CreateUser(ctx context.Context, req *UserResponse) (*UserResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateUser not implemented")
+func (m *CheckFieldResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedUserServiceServer) GetUserById(ctx context.Context, req *Request) (*UserResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetUserById not implemented")
+func (m *CheckFieldResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CheckFieldResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedUserServiceServer) GetUserByEmail(ctx context.Context, req *Request) (*UserResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetUserByEmail not implemented")
+func (m *CheckFieldResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CheckFieldResponse.Merge(m, src)
 }
-func (*UnimplementedUserServiceServer) GetAllUsers(ctx context.Context, req *GetUsersRequest) (*UsersResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetAllUsers not implemented")
+func (m *CheckFieldResponse) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedUserServiceServer) SearchUsers(ctx context.Context, req *Request) (*UsersResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SearchUsers not implemented")
+func (m *CheckFieldResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CheckFieldResponse.DiscardUnknown(m)
 }
-func (*UnimplementedUserServiceServer) UpdateUser(ctx context.Context, req *UpdateUserRequest) (*UserResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateUser not implemented")
+
+var xxx_messageInfo_CheckFieldResponse proto.InternalMessageInfo
+
+func (m *CheckFieldResponse) GetExists() bool {
+	if m != nil {
+		return m.Exists
+	}
+	return false
 }
-func (*UnimplementedUserServiceServer) DeleteUser(ctx context.Context, req *Request) (*UserResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteUser not implemented")
+
+type LoginResponse struct {
+	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id"`
+	FirstName            string   `protobuf:"bytes,2,opt,name=first_name,json=firstName,proto3" json:"first_name"`
+	LastName             string   `protobuf:"bytes,3,opt,name=last_name,json=lastName,proto3" json:"last_name"`
+	UserType             string   `protobuf:"bytes,4,opt,name=user_type,json=userType,proto3" json:"user_type"`
+	Email                string   `protobuf:"bytes,5,opt,name=email,proto3" json:"email"`
+	Password             string   `protobuf:"bytes,6,opt,name=password,proto3" json:"password"`
+	AccessToken          string   `protobuf:"bytes,7,opt,name=access_token,json=accessToken,proto3" json:"access_token"`
+	RefreshToken         string   `protobuf:"bytes,8,opt,name=refresh_token,json=refreshToken,proto3" json:"refresh_token"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
-func (*UnimplementedUserServiceServer) CheckField(ctx context.Context, req *CheckFieldRequest) (*CheckFieldResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CheckField not implemented")
+
+func (m *LoginResponse) Reset()         { *m = LoginResponse{} }
+func (m *LoginResponse) String() string { return proto.CompactTextString(m) }
+func (*LoginResponse) ProtoMessage()    {}
+func (*LoginResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ed89022014131a74, []int{18}
 }
-func (*UnimplementedUserServiceServer) UpdateUserTokens(ctx context.Context, req *UpdateUserTokensRequest) (*UserResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateUserTokens not implemented")
+func (m *LoginResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedUserServiceServer) Login(ctx context.Context, req *LoginRequest) (*LoginResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Login not implemented")
+func (m *LoginResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LoginResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedUserServiceServer) GetUserForClient(ctx context.Context, req *Request) (*UserResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetUserForClient not implemented")
+func (m *LoginResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LoginResponse.Merge(m, src)
 }
-func (*UnimplementedUserServiceServer) ChangeRoleUser(ctx context.Context, req *ChangeRoleRequest) (*UserResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ChangeRoleUser not implemented")
+func (m *LoginResponse) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedUserServiceServer) GetSameRoleUsers(ctx context.Context, req *Request) (*UsersResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetSameRoleUsers not implemented")
+func (m *LoginResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_LoginResponse.DiscardUnknown(m)
 }
 
-func RegisterUserServiceServer(s *grpc.Server, srv UserServiceServer) {
-	s.RegisterService(&_UserService_serviceDesc, srv)
-}
+var xxx_messageInfo_LoginResponse proto.InternalMessageInfo
 
-func _UserService_CreateUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UserResponse)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(UserServiceServer).CreateUser(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/user.UserService/CreateUser",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UserServiceServer).CreateUser(ctx, req.(*UserResponse))
+func (m *LoginResponse) GetId() string {
+	if m != nil {
+		return m.Id
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _UserService_GetUserById_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Request)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(UserServiceServer).GetUserById(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/user.UserService/GetUserById",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UserServiceServer).GetUserById(ctx, req.(*Request))
+func (m *LoginResponse) GetFirstName() string {
+	if m != nil {
+		return m.FirstName
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _UserService_GetUserByEmail_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Request)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(UserServiceServer).GetUserByEmail(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/user.UserService/GetUserByEmail",
+func (m *LoginResponse) GetLastName() string {
+	if m != nil {
+		return m.LastName
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UserServiceServer).GetUserByEmail(ctx, req.(*Request))
+	return ""
+}
+
+func (m *LoginResponse) GetUserType() string {
+	if m != nil {
+		return m.UserType
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _UserService_GetAllUsers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetUsersRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *LoginResponse) GetEmail() string {
+	if m != nil {
+		return m.Email
 	}
-	if interceptor == nil {
-		return srv.(UserServiceServer).GetAllUsers(ctx, in)
+	return ""
+}
+
+func (m *LoginResponse) GetPassword() string {
+	if m != nil {
+		return m.Password
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/user.UserService/GetAllUsers",
+	return ""
+}
+
+func (m *LoginResponse) GetAccessToken() string {
+	if m != nil {
+		return m.AccessToken
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UserServiceServer).GetAllUsers(ctx, req.(*GetUsersRequest))
+	return ""
+}
+
+func (m *LoginResponse) GetRefreshToken() string {
+	if m != nil {
+		return m.RefreshToken
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _UserService_SearchUsers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Request)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(UserServiceServer).SearchUsers(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/user.UserService/SearchUsers",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UserServiceServer).SearchUsers(ctx, req.(*Request))
-	}
-	return interceptor(ctx, in, info, handler)
+type UserResponse struct {
+	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id"`
+	FirstName            string   `protobuf:"bytes,2,opt,name=first_name,json=firstName,proto3" json:"first_name"`
+	LastName             string   `protobuf:"bytes,3,opt,name=last_name,json=lastName,proto3" json:"last_name"`
+	UserType             string   `protobuf:"bytes,4,opt,name=user_type,json=userType,proto3" json:"user_type"`
+	Email                string   `protobuf:"bytes,5,opt,name=email,proto3" json:"email"`
+	Password             string   `protobuf:"bytes,6,opt,name=password,proto3" json:"password"`
+	AccessToken          string   `protobuf:"bytes,7,opt,name=access_token,json=accessToken,proto3" json:"access_token"`
+	RefreshToken         string   `protobuf:"bytes,8,opt,name=refresh_token,json=refreshToken,proto3" json:"refresh_token"`
+	Posts                int64    `protobuf:"varint,9,opt,name=posts,proto3" json:"posts"`
+	CreatedAt            string   `protobuf:"bytes,10,opt,name=created_at,json=createdAt,proto3" json:"created_at"`
+	UpdatedAt            string   `protobuf:"bytes,11,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at"`
+	PurgeAfter           string   `protobuf:"bytes,12,opt,name=purge_after,json=purgeAfter,proto3" json:"purge_after"`
+	Version              int64    `protobuf:"varint,13,opt,name=version,proto3" json:"version"`
+	SuspendedUntil       string   `protobuf:"bytes,14,opt,name=suspended_until,json=suspendedUntil,proto3" json:"suspended_until"`
+	SuspensionReason     string   `protobuf:"bytes,15,opt,name=suspension_reason,json=suspensionReason,proto3" json:"suspension_reason"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func _UserService_UpdateUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateUserRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(UserServiceServer).UpdateUser(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/user.UserService/UpdateUser",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UserServiceServer).UpdateUser(ctx, req.(*UpdateUserRequest))
+func (m *UserResponse) Reset()         { *m = UserResponse{} }
+func (m *UserResponse) String() string { return proto.CompactTextString(m) }
+func (*UserResponse) ProtoMessage()    {}
+func (*UserResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ed89022014131a74, []int{19}
+}
+func (m *UserResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UserResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UserResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *UserResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UserResponse.Merge(m, src)
+}
+func (m *UserResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *UserResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_UserResponse.DiscardUnknown(m)
 }
 
-func _UserService_DeleteUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Request)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(UserServiceServer).DeleteUser(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/user.UserService/DeleteUser",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UserServiceServer).DeleteUser(ctx, req.(*Request))
+var xxx_messageInfo_UserResponse proto.InternalMessageInfo
+
+func (m *UserResponse) GetId() string {
+	if m != nil {
+		return m.Id
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _UserService_CheckField_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CheckFieldRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(UserServiceServer).CheckField(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/user.UserService/CheckField",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UserServiceServer).CheckField(ctx, req.(*CheckFieldRequest))
+func (m *UserResponse) GetFirstName() string {
+	if m != nil {
+		return m.FirstName
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _UserService_UpdateUserTokens_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateUserTokensRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(UserServiceServer).UpdateUserTokens(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/user.UserService/UpdateUserTokens",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UserServiceServer).UpdateUserTokens(ctx, req.(*UpdateUserTokensRequest))
+func (m *UserResponse) GetLastName() string {
+	if m != nil {
+		return m.LastName
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _UserService_Login_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(LoginRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(UserServiceServer).Login(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/user.UserService/Login",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UserServiceServer).Login(ctx, req.(*LoginRequest))
+func (m *UserResponse) GetUserType() string {
+	if m != nil {
+		return m.UserType
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _UserService_GetUserForClient_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Request)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(UserServiceServer).GetUserForClient(ctx, in)
+func (m *UserResponse) GetEmail() string {
+	if m != nil {
+		return m.Email
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/user.UserService/GetUserForClient",
+	return ""
+}
+
+func (m *UserResponse) GetPassword() string {
+	if m != nil {
+		return m.Password
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UserServiceServer).GetUserForClient(ctx, req.(*Request))
+	return ""
+}
+
+func (m *UserResponse) GetAccessToken() string {
+	if m != nil {
+		return m.AccessToken
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _UserService_ChangeRoleUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ChangeRoleRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *UserResponse) GetRefreshToken() string {
+	if m != nil {
+		return m.RefreshToken
 	}
-	if interceptor == nil {
-		return srv.(UserServiceServer).ChangeRoleUser(ctx, in)
+	return ""
+}
+
+func (m *UserResponse) GetPosts() int64 {
+	if m != nil {
+		return m.Posts
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/user.UserService/ChangeRoleUser",
+	return 0
+}
+
+func (m *UserResponse) GetCreatedAt() string {
+	if m != nil {
+		return m.CreatedAt
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UserServiceServer).ChangeRoleUser(ctx, req.(*ChangeRoleRequest))
+	return ""
+}
+
+func (m *UserResponse) GetUpdatedAt() string {
+	if m != nil {
+		return m.UpdatedAt
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _UserService_GetSameRoleUsers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Request)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *UserResponse) GetPurgeAfter() string {
+	if m != nil {
+		return m.PurgeAfter
 	}
-	if interceptor == nil {
-		return srv.(UserServiceServer).GetSameRoleUsers(ctx, in)
+	return ""
+}
+
+func (m *UserResponse) GetVersion() int64 {
+	if m != nil {
+		return m.Version
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/user.UserService/GetSameRoleUsers",
+	return 0
+}
+
+func (m *UserResponse) GetSuspendedUntil() string {
+	if m != nil {
+		return m.SuspendedUntil
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UserServiceServer).GetSameRoleUsers(ctx, req.(*Request))
+	return ""
+}
+
+func (m *UserResponse) GetSuspensionReason() string {
+	if m != nil {
+		return m.SuspensionReason
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-var _UserService_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "user.UserService",
-	HandlerType: (*UserServiceServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "CreateUser",
-			Handler:    _UserService_CreateUser_Handler,
-		},
-		{
-			MethodName: "GetUserById",
-			Handler:    _UserService_GetUserById_Handler,
-		},
-		{
-			MethodName: "GetUserByEmail",
-			Handler:    _UserService_GetUserByEmail_Handler,
-		},
-		{
-			MethodName: "GetAllUsers",
-			Handler:    _UserService_GetAllUsers_Handler,
-		},
-		{
-			MethodName: "SearchUsers",
-			Handler:    _UserService_SearchUsers_Handler,
-		},
-		{
-			MethodName: "UpdateUser",
-			Handler:    _UserService_UpdateUser_Handler,
-		},
-		{
-			MethodName: "DeleteUser",
-			Handler:    _UserService_DeleteUser_Handler,
-		},
-		{
-			MethodName: "CheckField",
-			Handler:    _UserService_CheckField_Handler,
-		},
-		{
-			MethodName: "UpdateUserTokens",
-			Handler:    _UserService_UpdateUserTokens_Handler,
-		},
-		{
-			MethodName: "Login",
-			Handler:    _UserService_Login_Handler,
-		},
-		{
-			MethodName: "GetUserForClient",
-			Handler:    _UserService_GetUserForClient_Handler,
-		},
-		{
-			MethodName: "ChangeRoleUser",
-			Handler:    _UserService_ChangeRoleUser_Handler,
-		},
-		{
-			MethodName: "GetSameRoleUsers",
-			Handler:    _UserService_GetSameRoleUsers_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "user/user.proto",
+type UsersResponse struct {
+	Users                []*UserResponse `protobuf:"bytes,1,rep,name=users,proto3" json:"users"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
 }
 
-func (m *ChangeRoleRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *UsersResponse) Reset()         { *m = UsersResponse{} }
+func (m *UsersResponse) String() string { return proto.CompactTextString(m) }
+func (*UsersResponse) ProtoMessage()    {}
+func (*UsersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ed89022014131a74, []int{20}
 }
-
-func (m *ChangeRoleRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *UsersResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *ChangeRoleRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.Role) > 0 {
-		i -= len(m.Role)
-		copy(dAtA[i:], m.Role)
-		i = encodeVarintUser(dAtA, i, uint64(len(m.Role)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Id) > 0 {
-		i -= len(m.Id)
-		copy(dAtA[i:], m.Id)
-		i = encodeVarintUser(dAtA, i, uint64(len(m.Id)))
-		i--
-		dAtA[i] = 0xa
+func (m *UsersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UsersResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *CheckFieldRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *UsersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UsersResponse.Merge(m, src)
 }
-
-func (m *CheckFieldRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *UsersResponse) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *CheckFieldRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.Value) > 0 {
-		i -= len(m.Value)
-		copy(dAtA[i:], m.Value)
-		i = encodeVarintUser(dAtA, i, uint64(len(m.Value)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Field) > 0 {
-		i -= len(m.Field)
-		copy(dAtA[i:], m.Field)
-		i = encodeVarintUser(dAtA, i, uint64(len(m.Field)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (m *UsersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_UsersResponse.DiscardUnknown(m)
 }
 
-func (m *Request) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_UsersResponse proto.InternalMessageInfo
+
+func (m *UsersResponse) GetUsers() []*UserResponse {
+	if m != nil {
+		return m.Users
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *Request) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type NamesRequest struct {
+	Names                []string `protobuf:"bytes,1,rep,name=names,proto3" json:"names"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *Request) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.Str) > 0 {
-		i -= len(m.Str)
-		copy(dAtA[i:], m.Str)
-		i = encodeVarintUser(dAtA, i, uint64(len(m.Str)))
-		i--
-		dAtA[i] = 0xa
+func (m *NamesRequest) Reset()         { *m = NamesRequest{} }
+func (m *NamesRequest) String() string { return proto.CompactTextString(m) }
+func (*NamesRequest) ProtoMessage()    {}
+func (*NamesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ed89022014131a74, []int{21}
+}
+func (m *NamesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *NamesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_NamesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *NamesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NamesRequest.Merge(m, src)
+}
+func (m *NamesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *NamesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_NamesRequest.DiscardUnknown(m)
 }
 
-func (m *GetUsersRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_NamesRequest proto.InternalMessageInfo
+
+func (m *NamesRequest) GetNames() []string {
+	if m != nil {
+		return m.Names
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *GetUsersRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type IdsRequest struct {
+	Ids                  []string `protobuf:"bytes,1,rep,name=ids,proto3" json:"ids"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *GetUsersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.Limit != 0 {
-		i = encodeVarintUser(dAtA, i, uint64(m.Limit))
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.Page != 0 {
-		i = encodeVarintUser(dAtA, i, uint64(m.Page))
-		i--
-		dAtA[i] = 0x8
+func (m *IdsRequest) Reset()         { *m = IdsRequest{} }
+func (m *IdsRequest) String() string { return proto.CompactTextString(m) }
+func (*IdsRequest) ProtoMessage()    {}
+func (*IdsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ed89022014131a74, []int{22}
+}
+func (m *IdsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *IdsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_IdsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *IdsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IdsRequest.Merge(m, src)
+}
+func (m *IdsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *IdsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_IdsRequest.DiscardUnknown(m)
 }
 
-func (m *LoginRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_IdsRequest proto.InternalMessageInfo
+
+func (m *IdsRequest) GetIds() []string {
+	if m != nil {
+		return m.Ids
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *LoginRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func init() {
+	proto.RegisterType((*DataExportRequest)(nil), "user.DataExportRequest")
+	proto.RegisterType((*DataExportResponse)(nil), "user.DataExportResponse")
+	proto.RegisterType((*DataExportContent)(nil), "user.DataExportContent")
+	proto.RegisterType((*ChangeRoleRequest)(nil), "user.ChangeRoleRequest")
+	proto.RegisterType((*SuspendRequest)(nil), "user.SuspendRequest")
+	proto.RegisterType((*BanRequest)(nil), "user.BanRequest")
+	proto.RegisterType((*AccountResponse)(nil), "user.AccountResponse")
+	proto.RegisterType((*CheckFieldRequest)(nil), "user.CheckFieldRequest")
+	proto.RegisterType((*Request)(nil), "user.Request")
+	proto.RegisterType((*FollowRequest)(nil), "user.FollowRequest")
+	proto.RegisterType((*FollowResponse)(nil), "user.FollowResponse")
+	proto.RegisterType((*RelationRequest)(nil), "user.RelationRequest")
+	proto.RegisterType((*RelationResponse)(nil), "user.RelationResponse")
+	proto.RegisterType((*GetUsersRequest)(nil), "user.GetUsersRequest")
+	proto.RegisterType((*LoginRequest)(nil), "user.LoginRequest")
+	proto.RegisterType((*UpdateUserTokensRequest)(nil), "user.UpdateUserTokensRequest")
+	proto.RegisterType((*UpdateUserRequest)(nil), "user.UpdateUserRequest")
+	proto.RegisterType((*CheckFieldResponse)(nil), "user.CheckFieldResponse")
+	proto.RegisterType((*LoginResponse)(nil), "user.LoginResponse")
+	proto.RegisterType((*UserResponse)(nil), "user.UserResponse")
+	proto.RegisterType((*UsersResponse)(nil), "user.UsersResponse")
+	proto.RegisterType((*NamesRequest)(nil), "user.NamesRequest")
+	proto.RegisterType((*IdsRequest)(nil), "user.IdsRequest")
 }
 
-func (m *LoginRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.Password) > 0 {
-		i -= len(m.Password)
-		copy(dAtA[i:], m.Password)
-		i = encodeVarintUser(dAtA, i, uint64(len(m.Password)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Email) > 0 {
-		i -= len(m.Email)
-		copy(dAtA[i:], m.Email)
-		i = encodeVarintUser(dAtA, i, uint64(len(m.Email)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func init() { proto.RegisterFile("user/user.proto", fileDescriptor_ed89022014131a74) }
+
+var fileDescriptor_ed89022014131a74 = []byte{
+	// 1497 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x58, 0xdd, 0x6e, 0x1b, 0x45,
+	0x14, 0x8e, 0xe3, 0x38, 0xb1, 0x8f, 0xff, 0xa7, 0x69, 0x63, 0xb9, 0x4d, 0xa0, 0x0b, 0x12, 0xe5,
+	0x47, 0x09, 0xa4, 0x2d, 0xfd, 0x83, 0x16, 0x3b, 0x6d, 0x22, 0x23, 0xe8, 0xc5, 0xba, 0x41, 0x5c,
+	0x61, 0xa6, 0xde, 0xb1, 0xb3, 0xea, 0x7a, 0x67, 0xbb, 0x33, 0x4e, 0x1a, 0x21, 0x6e, 0x78, 0x05,
+	0x6e, 0xb8, 0x83, 0x17, 0xe0, 0x3d, 0xb8, 0x44, 0x42, 0xe2, 0x1a, 0x15, 0xc4, 0x03, 0xf0, 0x04,
+	0x68, 0xfe, 0xd6, 0xeb, 0xb5, 0x37, 0x4d, 0x22, 0xee, 0xb8, 0xb1, 0xf6, 0x7c, 0x73, 0x7e, 0xe7,
+	0xcc, 0x39, 0x73, 0xc6, 0x50, 0x1d, 0x33, 0x12, 0x6e, 0x89, 0x9f, 0xcd, 0x20, 0xa4, 0x9c, 0xa2,
+	0x25, 0xf1, 0xdd, 0xbc, 0x32, 0xa4, 0x74, 0xe8, 0x91, 0x2d, 0x1c, 0xb8, 0x5b, 0xd8, 0xf7, 0x29,
+	0xc7, 0xdc, 0xa5, 0x3e, 0x53, 0x3c, 0xd6, 0x13, 0xa8, 0x3f, 0xc4, 0x1c, 0x3f, 0x7a, 0x11, 0xd0,
+	0x90, 0xdb, 0xe4, 0xf9, 0x98, 0x30, 0x8e, 0x2a, 0xb0, 0xe8, 0x3a, 0x8d, 0xcc, 0xeb, 0x99, 0x6b,
+	0x05, 0x7b, 0xd1, 0x75, 0xd0, 0x1a, 0xac, 0x08, 0x55, 0x3d, 0xd7, 0x69, 0x2c, 0x4a, 0x70, 0x59,
+	0x90, 0x1d, 0x07, 0x5d, 0x82, 0xe5, 0x01, 0x0d, 0x47, 0x98, 0x37, 0xb2, 0x0a, 0x57, 0x94, 0xf5,
+	0x73, 0x06, 0x50, 0x5c, 0x2d, 0x0b, 0xa8, 0xcf, 0xc8, 0x99, 0xf4, 0x32, 0x8e, 0xf9, 0x98, 0x19,
+	0xbd, 0x8a, 0x42, 0xab, 0x90, 0x23, 0x61, 0x48, 0xc3, 0xc6, 0x92, 0x84, 0x15, 0x81, 0xd6, 0x01,
+	0xfa, 0x21, 0xc1, 0x9c, 0x38, 0x3d, 0xcc, 0x1b, 0x39, 0xb9, 0x54, 0xd0, 0x48, 0x8b, 0xa3, 0xab,
+	0x50, 0xea, 0xd3, 0x51, 0xe0, 0x11, 0xcd, 0xb0, 0x2c, 0x19, 0x8a, 0x11, 0xd6, 0xe2, 0xd6, 0x30,
+	0xbe, 0x0b, 0x3b, 0xd4, 0xe7, 0xc4, 0xe7, 0xe8, 0x32, 0x14, 0x06, 0xae, 0x47, 0x7a, 0x3e, 0x1e,
+	0x11, 0xed, 0x74, 0x5e, 0x00, 0x8f, 0xf1, 0x88, 0x88, 0xc5, 0x91, 0x3b, 0x22, 0x3d, 0x7e, 0x1c,
+	0x10, 0xed, 0x7c, 0x5e, 0x00, 0x4f, 0x8e, 0x03, 0x82, 0x1a, 0xb0, 0xd2, 0x57, 0x4a, 0xa4, 0xff,
+	0x25, 0xdb, 0x90, 0xd6, 0x2d, 0xa8, 0xef, 0x1c, 0x60, 0x7f, 0x48, 0x6c, 0xea, 0x91, 0xb4, 0xed,
+	0x46, 0xb0, 0x14, 0x52, 0xcf, 0xa8, 0x95, 0xdf, 0xd6, 0x63, 0xa8, 0x74, 0xc7, 0x2c, 0x20, 0xbe,
+	0x93, 0x26, 0xb5, 0x0a, 0xb9, 0xb1, 0xcf, 0x5d, 0x4f, 0x8b, 0x29, 0x42, 0xec, 0x64, 0x48, 0x30,
+	0xa3, 0xbe, 0xd9, 0x49, 0x45, 0x59, 0x5f, 0x01, 0xb4, 0xb1, 0x7f, 0x82, 0x07, 0x9e, 0x3b, 0xe0,
+	0x52, 0x55, 0xde, 0x96, 0xdf, 0x13, 0xfd, 0xd9, 0xf9, 0xfa, 0x97, 0xa6, 0xf4, 0xff, 0x9d, 0x81,
+	0x6a, 0xab, 0xdf, 0xa7, 0x63, 0x3f, 0x3d, 0xfd, 0xab, 0x90, 0x13, 0x79, 0x35, 0x81, 0x2a, 0xe2,
+	0x6c, 0x76, 0xd0, 0x1b, 0x50, 0x66, 0x07, 0xd8, 0xa1, 0x47, 0xbd, 0xa7, 0xd8, 0xf7, 0x89, 0x23,
+	0xd3, 0x9f, 0xb7, 0x4b, 0x0a, 0x6c, 0x4b, 0x0c, 0x6d, 0xc2, 0x85, 0x29, 0xa6, 0x9e, 0x32, 0xa0,
+	0x0e, 0x42, 0x3d, 0xce, 0xba, 0x2f, 0x8d, 0xbd, 0x03, 0xf5, 0x09, 0x7f, 0x4f, 0xdb, 0x5d, 0x91,
+	0xdc, 0xd5, 0x88, 0xdb, 0x56, 0x81, 0x3e, 0x10, 0x19, 0x25, 0xfd, 0x67, 0xbb, 0x2e, 0xf1, 0xa2,
+	0xdc, 0xac, 0x42, 0x6e, 0x20, 0x68, 0x1d, 0xac, 0x22, 0x04, 0x7a, 0x88, 0xbd, 0x71, 0x14, 0xaf,
+	0x24, 0xac, 0xdb, 0xb0, 0x62, 0xc4, 0x6a, 0x90, 0x65, 0x3c, 0xd4, 0x42, 0xe2, 0x53, 0x1c, 0xb3,
+	0x43, 0x97, 0x1c, 0xc5, 0x6b, 0x24, 0xaf, 0x80, 0x8e, 0x63, 0x75, 0xa1, 0xbc, 0x4b, 0x3d, 0x8f,
+	0x1e, 0x19, 0xf9, 0xd7, 0xa0, 0x38, 0x90, 0x80, 0xe2, 0x57, 0x7a, 0xc0, 0x40, 0x1d, 0x47, 0x94,
+	0x82, 0xa2, 0x5c, 0x7f, 0x38, 0xd1, 0x58, 0x8c, 0xb0, 0x8e, 0x63, 0x85, 0x50, 0x31, 0x4a, 0x75,
+	0xda, 0xfe, 0x03, 0xad, 0xe8, 0x0a, 0x14, 0x22, 0x52, 0x26, 0x36, 0x6f, 0x4f, 0x00, 0x6b, 0x0f,
+	0xaa, 0x36, 0xf1, 0x64, 0x5f, 0x32, 0xa1, 0xc4, 0x5a, 0x43, 0x66, 0xaa, 0x35, 0x5c, 0x86, 0x02,
+	0xc7, 0xe1, 0x90, 0xf0, 0xd8, 0x8e, 0x28, 0xa0, 0xe3, 0x58, 0x5f, 0x43, 0x6d, 0xa2, 0x48, 0xbb,
+	0x7f, 0x2e, 0x4d, 0xe2, 0xbc, 0xe1, 0x3e, 0x77, 0x0f, 0x89, 0xf6, 0x56, 0x53, 0xd6, 0x97, 0x50,
+	0xdd, 0x23, 0x7c, 0x9f, 0x91, 0x90, 0x19, 0x57, 0x11, 0x2c, 0x05, 0x78, 0xa8, 0x5a, 0x44, 0xd6,
+	0x96, 0xdf, 0x22, 0xd5, 0x9e, 0x3b, 0x72, 0x55, 0x05, 0x65, 0x6d, 0x45, 0x4c, 0x67, 0x33, 0x9b,
+	0xc8, 0xe6, 0x27, 0x50, 0xfa, 0x8c, 0x0e, 0x5d, 0x3f, 0x76, 0x86, 0xc8, 0x08, 0xbb, 0x9e, 0x39,
+	0x43, 0x92, 0x40, 0x4d, 0xc8, 0x07, 0x98, 0xb1, 0x23, 0x1a, 0x46, 0x3e, 0x1b, 0xda, 0x7a, 0x0e,
+	0x6b, 0xfb, 0x81, 0x83, 0x39, 0x11, 0xee, 0x3d, 0xa1, 0xcf, 0x88, 0xcf, 0xd2, 0x0a, 0xfc, 0x2a,
+	0x94, 0x70, 0xbf, 0x4f, 0x18, 0xeb, 0x71, 0xc1, 0x67, 0x52, 0xa6, 0x30, 0x29, 0x2a, 0x2a, 0x2b,
+	0x24, 0x83, 0x90, 0xb0, 0x03, 0xcd, 0xa3, 0x1c, 0x2e, 0x69, 0x50, 0x32, 0x59, 0x3f, 0x65, 0xa0,
+	0x3e, 0xb1, 0x69, 0xac, 0xad, 0x03, 0x0c, 0xdc, 0x90, 0xf1, 0x78, 0xeb, 0x2c, 0x48, 0xc4, 0xf4,
+	0x4e, 0x0f, 0x9b, 0x55, 0x1d, 0x84, 0x87, 0xf5, 0x62, 0x14, 0x76, 0x36, 0x1e, 0xb6, 0xf2, 0x7f,
+	0x29, 0xf2, 0xff, 0x6d, 0xa8, 0x91, 0x17, 0x01, 0xe9, 0x8b, 0x96, 0x7e, 0x48, 0x42, 0xe6, 0x52,
+	0x5f, 0x56, 0x7e, 0xd6, 0xae, 0x1a, 0xfc, 0x0b, 0x05, 0x5b, 0xef, 0x01, 0x8a, 0x17, 0xa8, 0x3e,
+	0x15, 0x97, 0x60, 0x99, 0xbc, 0x70, 0x19, 0x67, 0xd2, 0xbd, 0xbc, 0xad, 0x29, 0xeb, 0x9f, 0x0c,
+	0x94, 0x75, 0x1a, 0x52, 0xba, 0xd6, 0x74, 0x70, 0x8b, 0x27, 0x06, 0x97, 0x4d, 0x04, 0x77, 0x19,
+	0x0a, 0xf2, 0x2c, 0xca, 0x5b, 0x43, 0x45, 0x93, 0x17, 0x80, 0xbc, 0x35, 0xa2, 0xc8, 0x73, 0x69,
+	0x09, 0x5f, 0x9e, 0x4e, 0xf8, 0x4c, 0x16, 0x57, 0x4e, 0x91, 0xc5, 0xfc, 0x9c, 0x2c, 0xfe, 0x9e,
+	0x85, 0x92, 0xca, 0xdf, 0xff, 0x26, 0x66, 0x61, 0x39, 0xa0, 0x22, 0xff, 0x05, 0x55, 0xa1, 0x92,
+	0x48, 0x8c, 0x12, 0x90, 0x1c, 0x25, 0xd6, 0x01, 0xc6, 0x81, 0x63, 0x96, 0x8b, 0x6a, 0x59, 0x23,
+	0x2d, 0xd9, 0x7f, 0x83, 0x71, 0x38, 0x24, 0x3d, 0x3c, 0xe0, 0x24, 0x6c, 0x94, 0xe4, 0x3a, 0x48,
+	0xa8, 0x25, 0x10, 0x31, 0x18, 0x98, 0xd3, 0x5a, 0x96, 0x66, 0x0d, 0x89, 0xde, 0x82, 0x2a, 0x53,
+	0xf7, 0x7b, 0x74, 0x3d, 0x55, 0xa4, 0x78, 0x25, 0x82, 0xd5, 0xdd, 0xf4, 0x2e, 0xd4, 0x15, 0x22,
+	0xc4, 0xcc, 0xdd, 0x54, 0x95, 0xac, 0xb5, 0xc9, 0x82, 0xbe, 0x9c, 0xee, 0x40, 0x59, 0xb7, 0x2a,
+	0x9d, 0xd8, 0x6b, 0x90, 0x13, 0x7b, 0x2f, 0x4e, 0x7d, 0xf6, 0x5a, 0x71, 0x1b, 0x6d, 0x0a, 0x6a,
+	0x33, 0x9e, 0x7b, 0x5b, 0x31, 0x58, 0x6f, 0x42, 0x49, 0xa4, 0x8f, 0xc5, 0xda, 0x91, 0x48, 0xaf,
+	0x92, 0x2c, 0xd8, 0x8a, 0xb0, 0x36, 0x00, 0x3a, 0x0e, 0x8b, 0xdd, 0x5f, 0xae, 0x63, 0x38, 0xc4,
+	0xe7, 0xf6, 0x8f, 0x35, 0x28, 0x0a, 0xed, 0x5d, 0x12, 0x1e, 0xba, 0x7d, 0x82, 0x3e, 0x04, 0xd8,
+	0x91, 0xbb, 0x29, 0x40, 0x34, 0xc7, 0x7c, 0x73, 0x0e, 0x66, 0x2d, 0xa0, 0x0e, 0x14, 0x75, 0xdb,
+	0x6d, 0x1f, 0x77, 0x1c, 0x54, 0x56, 0x4c, 0xda, 0xee, 0x5c, 0x99, 0xb5, 0xef, 0x7e, 0xfb, 0xeb,
+	0xfb, 0xc5, 0x3a, 0xaa, 0x6e, 0x1d, 0x6e, 0xcb, 0xa1, 0x98, 0x6d, 0x7d, 0xc3, 0x78, 0xf8, 0x2d,
+	0xba, 0x09, 0x95, 0x48, 0xd5, 0x23, 0x79, 0xdc, 0x4e, 0xa1, 0x6d, 0x01, 0xdd, 0x93, 0x1e, 0xb4,
+	0x3c, 0x4f, 0xe0, 0x0c, 0x5d, 0x54, 0x4c, 0x89, 0xbb, 0xa0, 0x79, 0x61, 0x22, 0xcb, 0x62, 0xc2,
+	0xd7, 0xa1, 0xd8, 0x25, 0x38, 0xec, 0x1f, 0x28, 0xe1, 0x84, 0xc1, 0x14, 0xa1, 0x7b, 0x00, 0x93,
+	0xd6, 0x8a, 0xd6, 0x34, 0x53, 0xb2, 0xd9, 0xa6, 0xb8, 0xfb, 0x01, 0xc0, 0x43, 0xe2, 0x11, 0x2d,
+	0x7c, 0xaa, 0x08, 0xef, 0x00, 0xa8, 0x9b, 0x5f, 0x8a, 0x68, 0xa7, 0xa6, 0x06, 0x8c, 0xe6, 0xea,
+	0x34, 0x18, 0x73, 0xb5, 0xb4, 0xef, 0x0f, 0xce, 0x29, 0x7c, 0x03, 0x4a, 0x7b, 0x84, 0x2b, 0xf8,
+	0xf4, 0xbb, 0xf3, 0x11, 0x14, 0xda, 0x1e, 0xed, 0x3f, 0x93, 0xf6, 0x2e, 0x1a, 0x91, 0xa9, 0x21,
+	0xa2, 0x79, 0x29, 0x09, 0x47, 0xd2, 0xf7, 0xa1, 0xb8, 0xef, 0x3f, 0x3d, 0xbf, 0xfc, 0x2d, 0x39,
+	0x06, 0x48, 0x07, 0x88, 0x73, 0xb6, 0xa4, 0xe6, 0x3f, 0x1f, 0x73, 0x72, 0x3e, 0xab, 0x1f, 0x03,
+	0xec, 0xfb, 0xa3, 0x73, 0x8b, 0xdf, 0x84, 0xf2, 0x1e, 0xe1, 0xc2, 0xfc, 0x99, 0x5c, 0xbe, 0x0f,
+	0x75, 0xcd, 0x31, 0x79, 0x23, 0x25, 0x45, 0x1b, 0x8a, 0x9c, 0x7d, 0xf3, 0x59, 0x0b, 0xe8, 0xa1,
+	0x34, 0x1b, 0x93, 0x5d, 0x9b, 0x65, 0x7e, 0xb5, 0x96, 0x4f, 0x61, 0x75, 0x4a, 0x8b, 0x79, 0xa5,
+	0xa5, 0x2a, 0x9b, 0x59, 0xd0, 0x12, 0xd6, 0x02, 0x6a, 0x01, 0x4c, 0x46, 0x02, 0xa3, 0x61, 0x66,
+	0x8a, 0x6f, 0x36, 0x66, 0x17, 0x22, 0x77, 0xf6, 0xa0, 0x96, 0x9c, 0xb5, 0xd0, 0x7a, 0xb2, 0x44,
+	0xa7, 0x66, 0xb0, 0x94, 0xaa, 0xdb, 0x86, 0x9c, 0x9c, 0x37, 0x4c, 0x33, 0x8c, 0xcf, 0x80, 0xcd,
+	0x0b, 0x53, 0x58, 0xec, 0xf4, 0xd5, 0x74, 0xe3, 0xd9, 0xa5, 0xe1, 0x8e, 0xe7, 0x12, 0x7f, 0x26,
+	0x21, 0xf3, 0x8d, 0xdd, 0x87, 0x62, 0x87, 0xed, 0x9a, 0xb9, 0x7b, 0x7e, 0x99, 0x9e, 0x14, 0x75,
+	0x4b, 0x26, 0x41, 0x1e, 0x90, 0xf6, 0xf1, 0xae, 0xb9, 0xff, 0x99, 0xf1, 0x3d, 0x7e, 0x61, 0xa4,
+	0x9d, 0xa6, 0xdb, 0xf2, 0x34, 0x68, 0x15, 0x1d, 0x87, 0xa1, 0x9a, 0xe2, 0xeb, 0x38, 0xaf, 0x92,
+	0xbc, 0x2b, 0xa3, 0xd6, 0x8f, 0xca, 0xae, 0xfa, 0x43, 0x20, 0x11, 0xb5, 0x2e, 0x89, 0xc4, 0xc3,
+	0x53, 0x06, 0x5e, 0xe8, 0x30, 0x5d, 0xae, 0x69, 0x85, 0x73, 0x52, 0xe0, 0xef, 0x43, 0xd1, 0x94,
+	0x4e, 0xc7, 0x99, 0x31, 0x3b, 0x13, 0x82, 0xb5, 0x80, 0x1e, 0x40, 0x65, 0xf2, 0xd2, 0x8f, 0x77,
+	0xf0, 0x99, 0xf7, 0x7f, 0x4a, 0xae, 0x6e, 0xcb, 0x70, 0xbb, 0x78, 0x14, 0x69, 0x38, 0x6d, 0xc1,
+	0xde, 0x81, 0xa2, 0xfe, 0xaf, 0x40, 0xda, 0xd5, 0x7d, 0x77, 0xfa, 0xef, 0x83, 0x14, 0xa3, 0x37,
+	0x60, 0xa5, 0x8d, 0x7d, 0x29, 0xa6, 0x83, 0x9a, 0xfc, 0x4b, 0x90, 0xbe, 0xbb, 0x77, 0xa1, 0xdc,
+	0x35, 0xcf, 0xe2, 0x33, 0xca, 0xb6, 0x6b, 0xbf, 0xbc, 0xdc, 0xc8, 0xfc, 0xfa, 0x72, 0x23, 0xf3,
+	0xc7, 0xcb, 0x8d, 0xcc, 0x0f, 0x7f, 0x6e, 0x2c, 0x3c, 0x5d, 0x96, 0xff, 0x4c, 0x5d, 0xff, 0x77,
+	0x00, 0x12, 0xab, 0xff, 0x6b, 0xd0, 0x12, 0x00, 0x00,
 }
 
-func (m *UpdateUserTokensRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// UserServiceClient is the client API for UserService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type UserServiceClient interface {
+	// methods...
+	CreateUser(ctx context.Context, in *UserResponse, opts ...grpc.CallOption) (*UserResponse, error)
+	GetUserById(ctx context.Context, in *Request, opts ...grpc.CallOption) (*UserResponse, error)
+	GetUserByEmail(ctx context.Context, in *Request, opts ...grpc.CallOption) (*UserResponse, error)
+	GetAllUsers(ctx context.Context, in *GetUsersRequest, opts ...grpc.CallOption) (*UsersResponse, error)
+	SearchUsers(ctx context.Context, in *Request, opts ...grpc.CallOption) (*UsersResponse, error)
+	UpdateUser(ctx context.Context, in *UpdateUserRequest, opts ...grpc.CallOption) (*UserResponse, error)
+	DeleteUser(ctx context.Context, in *Request, opts ...grpc.CallOption) (*UserResponse, error)
+	// follows...
+	FollowUser(ctx context.Context, in *FollowRequest, opts ...grpc.CallOption) (*FollowResponse, error)
+	UnfollowUser(ctx context.Context, in *FollowRequest, opts ...grpc.CallOption) (*FollowResponse, error)
+	GetFollowers(ctx context.Context, in *Request, opts ...grpc.CallOption) (*UsersResponse, error)
+	// blocks and mutes...
+	BlockUser(ctx context.Context, in *RelationRequest, opts ...grpc.CallOption) (*RelationResponse, error)
+	UnblockUser(ctx context.Context, in *RelationRequest, opts ...grpc.CallOption) (*RelationResponse, error)
+	GetBlockedUsers(ctx context.Context, in *Request, opts ...grpc.CallOption) (*UsersResponse, error)
+	MuteUser(ctx context.Context, in *RelationRequest, opts ...grpc.CallOption) (*RelationResponse, error)
+	UnmuteUser(ctx context.Context, in *RelationRequest, opts ...grpc.CallOption) (*RelationResponse, error)
+	GetMutedUsers(ctx context.Context, in *Request, opts ...grpc.CallOption) (*UsersResponse, error)
+	// data export...
+	RequestDataExport(ctx context.Context, in *Request, opts ...grpc.CallOption) (*DataExportResponse, error)
+	GetDataExport(ctx context.Context, in *DataExportRequest, opts ...grpc.CallOption) (*DataExportResponse, error)
+	GetDataExportContent(ctx context.Context, in *DataExportRequest, opts ...grpc.CallOption) (*DataExportContent, error)
+	// check...
+	CheckField(ctx context.Context, in *CheckFieldRequest, opts ...grpc.CallOption) (*CheckFieldResponse, error)
+	// Register...
+	UpdateUserTokens(ctx context.Context, in *UpdateUserTokensRequest, opts ...grpc.CallOption) (*UserResponse, error)
+	Login(ctx context.Context, in *LoginRequest, opts ...grpc.CallOption) (*LoginResponse, error)
+	// for Client...
+	GetUserForClient(ctx context.Context, in *Request, opts ...grpc.CallOption) (*UserResponse, error)
+	IsFollowing(ctx context.Context, in *FollowRequest, opts ...grpc.CallOption) (*CheckFieldResponse, error)
+	GetUsersByFirstNames(ctx context.Context, in *NamesRequest, opts ...grpc.CallOption) (*UsersResponse, error)
+	GetUsersByIds(ctx context.Context, in *IdsRequest, opts ...grpc.CallOption) (*UsersResponse, error)
+	GetAccountStatus(ctx context.Context, in *Request, opts ...grpc.CallOption) (*AccountResponse, error)
+	IsBlocked(ctx context.Context, in *RelationRequest, opts ...grpc.CallOption) (*CheckFieldResponse, error)
+	GetMutedIds(ctx context.Context, in *Request, opts ...grpc.CallOption) (*IdsRequest, error)
+	// rbac...
+	ChangeRoleUser(ctx context.Context, in *ChangeRoleRequest, opts ...grpc.CallOption) (*UserResponse, error)
+	GetSameRoleUsers(ctx context.Context, in *Request, opts ...grpc.CallOption) (*UsersResponse, error)
+	// for Moderator...
+	SuspendUser(ctx context.Context, in *SuspendRequest, opts ...grpc.CallOption) (*UserResponse, error)
+	// for Admin...
+	BanUser(ctx context.Context, in *BanRequest, opts ...grpc.CallOption) (*AccountResponse, error)
+	ShadowBanUser(ctx context.Context, in *BanRequest, opts ...grpc.CallOption) (*AccountResponse, error)
+}
+
+type userServiceClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewUserServiceClient(cc *grpc.ClientConn) UserServiceClient {
+	return &userServiceClient{cc}
+}
+
+func (c *userServiceClient) CreateUser(ctx context.Context, in *UserResponse, opts ...grpc.CallOption) (*UserResponse, error) {
+	out := new(UserResponse)
+	err := c.cc.Invoke(ctx, "/user.UserService/CreateUser", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *UpdateUserTokensRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *userServiceClient) GetUserById(ctx context.Context, in *Request, opts ...grpc.CallOption) (*UserResponse, error) {
+	out := new(UserResponse)
+	err := c.cc.Invoke(ctx, "/user.UserService/GetUserById", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *UpdateUserTokensRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.RefreshToken) > 0 {
-		i -= len(m.RefreshToken)
-		copy(dAtA[i:], m.RefreshToken)
-		i = encodeVarintUser(dAtA, i, uint64(len(m.RefreshToken)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.AccessToken) > 0 {
-		i -= len(m.AccessToken)
-		copy(dAtA[i:], m.AccessToken)
-		i = encodeVarintUser(dAtA, i, uint64(len(m.AccessToken)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Id) > 0 {
-		i -= len(m.Id)
-		copy(dAtA[i:], m.Id)
-		i = encodeVarintUser(dAtA, i, uint64(len(m.Id)))
-		i--
-		dAtA[i] = 0xa
+func (c *userServiceClient) GetUserByEmail(ctx context.Context, in *Request, opts ...grpc.CallOption) (*UserResponse, error) {
+	out := new(UserResponse)
+	err := c.cc.Invoke(ctx, "/user.UserService/GetUserByEmail", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *UpdateUserRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *userServiceClient) GetAllUsers(ctx context.Context, in *GetUsersRequest, opts ...grpc.CallOption) (*UsersResponse, error) {
+	out := new(UsersResponse)
+	err := c.cc.Invoke(ctx, "/user.UserService/GetAllUsers", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *UpdateUserRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *userServiceClient) SearchUsers(ctx context.Context, in *Request, opts ...grpc.CallOption) (*UsersResponse, error) {
+	out := new(UsersResponse)
+	err := c.cc.Invoke(ctx, "/user.UserService/SearchUsers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *UpdateUserRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.Id) > 0 {
-		i -= len(m.Id)
-		copy(dAtA[i:], m.Id)
-		i = encodeVarintUser(dAtA, i, uint64(len(m.Id)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.Email) > 0 {
-		i -= len(m.Email)
-		copy(dAtA[i:], m.Email)
-		i = encodeVarintUser(dAtA, i, uint64(len(m.Email)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.LastName) > 0 {
-		i -= len(m.LastName)
-		copy(dAtA[i:], m.LastName)
-		i = encodeVarintUser(dAtA, i, uint64(len(m.LastName)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.FirstName) > 0 {
-		i -= len(m.FirstName)
-		copy(dAtA[i:], m.FirstName)
-		i = encodeVarintUser(dAtA, i, uint64(len(m.FirstName)))
-		i--
-		dAtA[i] = 0xa
+func (c *userServiceClient) UpdateUser(ctx context.Context, in *UpdateUserRequest, opts ...grpc.CallOption) (*UserResponse, error) {
+	out := new(UserResponse)
+	err := c.cc.Invoke(ctx, "/user.UserService/UpdateUser", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *CheckFieldResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *userServiceClient) DeleteUser(ctx context.Context, in *Request, opts ...grpc.CallOption) (*UserResponse, error) {
+	out := new(UserResponse)
+	err := c.cc.Invoke(ctx, "/user.UserService/DeleteUser", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *CheckFieldResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *userServiceClient) FollowUser(ctx context.Context, in *FollowRequest, opts ...grpc.CallOption) (*FollowResponse, error) {
+	out := new(FollowResponse)
+	err := c.cc.Invoke(ctx, "/user.UserService/FollowUser", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *CheckFieldResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+func (c *userServiceClient) UnfollowUser(ctx context.Context, in *FollowRequest, opts ...grpc.CallOption) (*FollowResponse, error) {
+	out := new(FollowResponse)
+	err := c.cc.Invoke(ctx, "/user.UserService/UnfollowUser", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.Exists {
-		i--
-		if m.Exists {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x8
+	return out, nil
+}
+
+func (c *userServiceClient) GetFollowers(ctx context.Context, in *Request, opts ...grpc.CallOption) (*UsersResponse, error) {
+	out := new(UsersResponse)
+	err := c.cc.Invoke(ctx, "/user.UserService/GetFollowers", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *LoginResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *userServiceClient) BlockUser(ctx context.Context, in *RelationRequest, opts ...grpc.CallOption) (*RelationResponse, error) {
+	out := new(RelationResponse)
+	err := c.cc.Invoke(ctx, "/user.UserService/BlockUser", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *LoginResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *userServiceClient) UnblockUser(ctx context.Context, in *RelationRequest, opts ...grpc.CallOption) (*RelationResponse, error) {
+	out := new(RelationResponse)
+	err := c.cc.Invoke(ctx, "/user.UserService/UnblockUser", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *LoginResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.RefreshToken) > 0 {
-		i -= len(m.RefreshToken)
-		copy(dAtA[i:], m.RefreshToken)
-		i = encodeVarintUser(dAtA, i, uint64(len(m.RefreshToken)))
-		i--
-		dAtA[i] = 0x42
-	}
-	if len(m.AccessToken) > 0 {
-		i -= len(m.AccessToken)
-		copy(dAtA[i:], m.AccessToken)
-		i = encodeVarintUser(dAtA, i, uint64(len(m.AccessToken)))
-		i--
-		dAtA[i] = 0x3a
-	}
-	if len(m.Password) > 0 {
-		i -= len(m.Password)
-		copy(dAtA[i:], m.Password)
-		i = encodeVarintUser(dAtA, i, uint64(len(m.Password)))
-		i--
-		dAtA[i] = 0x32
-	}
-	if len(m.Email) > 0 {
-		i -= len(m.Email)
-		copy(dAtA[i:], m.Email)
-		i = encodeVarintUser(dAtA, i, uint64(len(m.Email)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.UserType) > 0 {
-		i -= len(m.UserType)
-		copy(dAtA[i:], m.UserType)
-		i = encodeVarintUser(dAtA, i, uint64(len(m.UserType)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.LastName) > 0 {
-		i -= len(m.LastName)
-		copy(dAtA[i:], m.LastName)
-		i = encodeVarintUser(dAtA, i, uint64(len(m.LastName)))
-		i--
-		dAtA[i] = 0x1a
+func (c *userServiceClient) GetBlockedUsers(ctx context.Context, in *Request, opts ...grpc.CallOption) (*UsersResponse, error) {
+	out := new(UsersResponse)
+	err := c.cc.Invoke(ctx, "/user.UserService/GetBlockedUsers", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.FirstName) > 0 {
-		i -= len(m.FirstName)
-		copy(dAtA[i:], m.FirstName)
-		i = encodeVarintUser(dAtA, i, uint64(len(m.FirstName)))
-		i--
-		dAtA[i] = 0x12
+	return out, nil
+}
+
+func (c *userServiceClient) MuteUser(ctx context.Context, in *RelationRequest, opts ...grpc.CallOption) (*RelationResponse, error) {
+	out := new(RelationResponse)
+	err := c.cc.Invoke(ctx, "/user.UserService/MuteUser", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.Id) > 0 {
-		i -= len(m.Id)
-		copy(dAtA[i:], m.Id)
-		i = encodeVarintUser(dAtA, i, uint64(len(m.Id)))
-		i--
-		dAtA[i] = 0xa
+	return out, nil
+}
+
+func (c *userServiceClient) UnmuteUser(ctx context.Context, in *RelationRequest, opts ...grpc.CallOption) (*RelationResponse, error) {
+	out := new(RelationResponse)
+	err := c.cc.Invoke(ctx, "/user.UserService/UnmuteUser", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *UserResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *userServiceClient) GetMutedUsers(ctx context.Context, in *Request, opts ...grpc.CallOption) (*UsersResponse, error) {
+	out := new(UsersResponse)
+	err := c.cc.Invoke(ctx, "/user.UserService/GetMutedUsers", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *UserResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *userServiceClient) RequestDataExport(ctx context.Context, in *Request, opts ...grpc.CallOption) (*DataExportResponse, error) {
+	out := new(DataExportResponse)
+	err := c.cc.Invoke(ctx, "/user.UserService/RequestDataExport", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *UserResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+func (c *userServiceClient) GetDataExport(ctx context.Context, in *DataExportRequest, opts ...grpc.CallOption) (*DataExportResponse, error) {
+	out := new(DataExportResponse)
+	err := c.cc.Invoke(ctx, "/user.UserService/GetDataExport", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.UpdatedAt) > 0 {
-		i -= len(m.UpdatedAt)
-		copy(dAtA[i:], m.UpdatedAt)
-		i = encodeVarintUser(dAtA, i, uint64(len(m.UpdatedAt)))
-		i--
-		dAtA[i] = 0x5a
+	return out, nil
+}
+
+func (c *userServiceClient) GetDataExportContent(ctx context.Context, in *DataExportRequest, opts ...grpc.CallOption) (*DataExportContent, error) {
+	out := new(DataExportContent)
+	err := c.cc.Invoke(ctx, "/user.UserService/GetDataExportContent", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.CreatedAt) > 0 {
-		i -= len(m.CreatedAt)
-		copy(dAtA[i:], m.CreatedAt)
-		i = encodeVarintUser(dAtA, i, uint64(len(m.CreatedAt)))
-		i--
-		dAtA[i] = 0x52
+	return out, nil
+}
+
+func (c *userServiceClient) CheckField(ctx context.Context, in *CheckFieldRequest, opts ...grpc.CallOption) (*CheckFieldResponse, error) {
+	out := new(CheckFieldResponse)
+	err := c.cc.Invoke(ctx, "/user.UserService/CheckField", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.Posts != 0 {
-		i = encodeVarintUser(dAtA, i, uint64(m.Posts))
-		i--
-		dAtA[i] = 0x48
+	return out, nil
+}
+
+func (c *userServiceClient) UpdateUserTokens(ctx context.Context, in *UpdateUserTokensRequest, opts ...grpc.CallOption) (*UserResponse, error) {
+	out := new(UserResponse)
+	err := c.cc.Invoke(ctx, "/user.UserService/UpdateUserTokens", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.RefreshToken) > 0 {
-		i -= len(m.RefreshToken)
-		copy(dAtA[i:], m.RefreshToken)
-		i = encodeVarintUser(dAtA, i, uint64(len(m.RefreshToken)))
-		i--
-		dAtA[i] = 0x42
+	return out, nil
+}
+
+func (c *userServiceClient) Login(ctx context.Context, in *LoginRequest, opts ...grpc.CallOption) (*LoginResponse, error) {
+	out := new(LoginResponse)
+	err := c.cc.Invoke(ctx, "/user.UserService/Login", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.AccessToken) > 0 {
-		i -= len(m.AccessToken)
-		copy(dAtA[i:], m.AccessToken)
-		i = encodeVarintUser(dAtA, i, uint64(len(m.AccessToken)))
-		i--
-		dAtA[i] = 0x3a
+	return out, nil
+}
+
+func (c *userServiceClient) GetUserForClient(ctx context.Context, in *Request, opts ...grpc.CallOption) (*UserResponse, error) {
+	out := new(UserResponse)
+	err := c.cc.Invoke(ctx, "/user.UserService/GetUserForClient", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.Password) > 0 {
-		i -= len(m.Password)
-		copy(dAtA[i:], m.Password)
-		i = encodeVarintUser(dAtA, i, uint64(len(m.Password)))
-		i--
-		dAtA[i] = 0x32
+	return out, nil
+}
+
+func (c *userServiceClient) IsFollowing(ctx context.Context, in *FollowRequest, opts ...grpc.CallOption) (*CheckFieldResponse, error) {
+	out := new(CheckFieldResponse)
+	err := c.cc.Invoke(ctx, "/user.UserService/IsFollowing", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.Email) > 0 {
-		i -= len(m.Email)
-		copy(dAtA[i:], m.Email)
-		i = encodeVarintUser(dAtA, i, uint64(len(m.Email)))
-		i--
-		dAtA[i] = 0x2a
+	return out, nil
+}
+
+func (c *userServiceClient) GetUsersByFirstNames(ctx context.Context, in *NamesRequest, opts ...grpc.CallOption) (*UsersResponse, error) {
+	out := new(UsersResponse)
+	err := c.cc.Invoke(ctx, "/user.UserService/GetUsersByFirstNames", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.UserType) > 0 {
-		i -= len(m.UserType)
-		copy(dAtA[i:], m.UserType)
-		i = encodeVarintUser(dAtA, i, uint64(len(m.UserType)))
-		i--
-		dAtA[i] = 0x22
+	return out, nil
+}
+
+func (c *userServiceClient) GetUsersByIds(ctx context.Context, in *IdsRequest, opts ...grpc.CallOption) (*UsersResponse, error) {
+	out := new(UsersResponse)
+	err := c.cc.Invoke(ctx, "/user.UserService/GetUsersByIds", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.LastName) > 0 {
-		i -= len(m.LastName)
-		copy(dAtA[i:], m.LastName)
-		i = encodeVarintUser(dAtA, i, uint64(len(m.LastName)))
-		i--
-		dAtA[i] = 0x1a
+	return out, nil
+}
+
+func (c *userServiceClient) GetAccountStatus(ctx context.Context, in *Request, opts ...grpc.CallOption) (*AccountResponse, error) {
+	out := new(AccountResponse)
+	err := c.cc.Invoke(ctx, "/user.UserService/GetAccountStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.FirstName) > 0 {
-		i -= len(m.FirstName)
-		copy(dAtA[i:], m.FirstName)
-		i = encodeVarintUser(dAtA, i, uint64(len(m.FirstName)))
-		i--
-		dAtA[i] = 0x12
+	return out, nil
+}
+
+func (c *userServiceClient) IsBlocked(ctx context.Context, in *RelationRequest, opts ...grpc.CallOption) (*CheckFieldResponse, error) {
+	out := new(CheckFieldResponse)
+	err := c.cc.Invoke(ctx, "/user.UserService/IsBlocked", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.Id) > 0 {
-		i -= len(m.Id)
-		copy(dAtA[i:], m.Id)
-		i = encodeVarintUser(dAtA, i, uint64(len(m.Id)))
-		i--
-		dAtA[i] = 0xa
+	return out, nil
+}
+
+func (c *userServiceClient) GetMutedIds(ctx context.Context, in *Request, opts ...grpc.CallOption) (*IdsRequest, error) {
+	out := new(IdsRequest)
+	err := c.cc.Invoke(ctx, "/user.UserService/GetMutedIds", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *UsersResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *userServiceClient) ChangeRoleUser(ctx context.Context, in *ChangeRoleRequest, opts ...grpc.CallOption) (*UserResponse, error) {
+	out := new(UserResponse)
+	err := c.cc.Invoke(ctx, "/user.UserService/ChangeRoleUser", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *UsersResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *userServiceClient) GetSameRoleUsers(ctx context.Context, in *Request, opts ...grpc.CallOption) (*UsersResponse, error) {
+	out := new(UsersResponse)
+	err := c.cc.Invoke(ctx, "/user.UserService/GetSameRoleUsers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *UsersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.Users) > 0 {
-		for iNdEx := len(m.Users) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Users[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintUser(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+func (c *userServiceClient) SuspendUser(ctx context.Context, in *SuspendRequest, opts ...grpc.CallOption) (*UserResponse, error) {
+	out := new(UserResponse)
+	err := c.cc.Invoke(ctx, "/user.UserService/SuspendUser", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func encodeVarintUser(dAtA []byte, offset int, v uint64) int {
-	offset -= sovUser(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (c *userServiceClient) BanUser(ctx context.Context, in *BanRequest, opts ...grpc.CallOption) (*AccountResponse, error) {
+	out := new(AccountResponse)
+	err := c.cc.Invoke(ctx, "/user.UserService/BanUser", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return out, nil
 }
-func (m *ChangeRoleRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Id)
-	if l > 0 {
-		n += 1 + l + sovUser(uint64(l))
-	}
-	l = len(m.Role)
-	if l > 0 {
-		n += 1 + l + sovUser(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+
+func (c *userServiceClient) ShadowBanUser(ctx context.Context, in *BanRequest, opts ...grpc.CallOption) (*AccountResponse, error) {
+	out := new(AccountResponse)
+	err := c.cc.Invoke(ctx, "/user.UserService/ShadowBanUser", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *CheckFieldRequest) Size() (n int) {
-	if m == nil {
-		return 0
+// UserServiceServer is the server API for UserService service.
+type UserServiceServer interface {
+	// methods...
+	CreateUser(context.Context, *UserResponse) (*UserResponse, error)
+	GetUserById(context.Context, *Request) (*UserResponse, error)
+	GetUserByEmail(context.Context, *Request) (*UserResponse, error)
+	GetAllUsers(context.Context, *GetUsersRequest) (*UsersResponse, error)
+	SearchUsers(context.Context, *Request) (*UsersResponse, error)
+	UpdateUser(context.Context, *UpdateUserRequest) (*UserResponse, error)
+	DeleteUser(context.Context, *Request) (*UserResponse, error)
+	// follows...
+	FollowUser(context.Context, *FollowRequest) (*FollowResponse, error)
+	UnfollowUser(context.Context, *FollowRequest) (*FollowResponse, error)
+	GetFollowers(context.Context, *Request) (*UsersResponse, error)
+	// blocks and mutes...
+	BlockUser(context.Context, *RelationRequest) (*RelationResponse, error)
+	UnblockUser(context.Context, *RelationRequest) (*RelationResponse, error)
+	GetBlockedUsers(context.Context, *Request) (*UsersResponse, error)
+	MuteUser(context.Context, *RelationRequest) (*RelationResponse, error)
+	UnmuteUser(context.Context, *RelationRequest) (*RelationResponse, error)
+	GetMutedUsers(context.Context, *Request) (*UsersResponse, error)
+	// data export...
+	RequestDataExport(context.Context, *Request) (*DataExportResponse, error)
+	GetDataExport(context.Context, *DataExportRequest) (*DataExportResponse, error)
+	GetDataExportContent(context.Context, *DataExportRequest) (*DataExportContent, error)
+	// check...
+	CheckField(context.Context, *CheckFieldRequest) (*CheckFieldResponse, error)
+	// Register...
+	UpdateUserTokens(context.Context, *UpdateUserTokensRequest) (*UserResponse, error)
+	Login(context.Context, *LoginRequest) (*LoginResponse, error)
+	// for Client...
+	GetUserForClient(context.Context, *Request) (*UserResponse, error)
+	IsFollowing(context.Context, *FollowRequest) (*CheckFieldResponse, error)
+	GetUsersByFirstNames(context.Context, *NamesRequest) (*UsersResponse, error)
+	GetUsersByIds(context.Context, *IdsRequest) (*UsersResponse, error)
+	GetAccountStatus(context.Context, *Request) (*AccountResponse, error)
+	IsBlocked(context.Context, *RelationRequest) (*CheckFieldResponse, error)
+	GetMutedIds(context.Context, *Request) (*IdsRequest, error)
+	// rbac...
+	ChangeRoleUser(context.Context, *ChangeRoleRequest) (*UserResponse, error)
+	GetSameRoleUsers(context.Context, *Request) (*UsersResponse, error)
+	// for Moderator...
+	SuspendUser(context.Context, *SuspendRequest) (*UserResponse, error)
+	// for Admin...
+	BanUser(context.Context, *BanRequest) (*AccountResponse, error)
+	ShadowBanUser(context.Context, *BanRequest) (*AccountResponse, error)
+}
+
+// UnimplementedUserServiceServer can be embedded to have forward compatible implementations.
+type UnimplementedUserServiceServer struct {
+}
+
+func (*UnimplementedUserServiceServer) CreateUser(ctx context.Context, req *UserResponse) (*UserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateUser not implemented")
+}
+func (*UnimplementedUserServiceServer) GetUserById(ctx context.Context, req *Request) (*UserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUserById not implemented")
+}
+func (*UnimplementedUserServiceServer) GetUserByEmail(ctx context.Context, req *Request) (*UserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUserByEmail not implemented")
+}
+func (*UnimplementedUserServiceServer) GetAllUsers(ctx context.Context, req *GetUsersRequest) (*UsersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAllUsers not implemented")
+}
+func (*UnimplementedUserServiceServer) SearchUsers(ctx context.Context, req *Request) (*UsersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchUsers not implemented")
+}
+func (*UnimplementedUserServiceServer) UpdateUser(ctx context.Context, req *UpdateUserRequest) (*UserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateUser not implemented")
+}
+func (*UnimplementedUserServiceServer) DeleteUser(ctx context.Context, req *Request) (*UserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteUser not implemented")
+}
+func (*UnimplementedUserServiceServer) FollowUser(ctx context.Context, req *FollowRequest) (*FollowResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FollowUser not implemented")
+}
+func (*UnimplementedUserServiceServer) UnfollowUser(ctx context.Context, req *FollowRequest) (*FollowResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnfollowUser not implemented")
+}
+func (*UnimplementedUserServiceServer) GetFollowers(ctx context.Context, req *Request) (*UsersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFollowers not implemented")
+}
+func (*UnimplementedUserServiceServer) BlockUser(ctx context.Context, req *RelationRequest) (*RelationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BlockUser not implemented")
+}
+func (*UnimplementedUserServiceServer) UnblockUser(ctx context.Context, req *RelationRequest) (*RelationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnblockUser not implemented")
+}
+func (*UnimplementedUserServiceServer) GetBlockedUsers(ctx context.Context, req *Request) (*UsersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBlockedUsers not implemented")
+}
+func (*UnimplementedUserServiceServer) MuteUser(ctx context.Context, req *RelationRequest) (*RelationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MuteUser not implemented")
+}
+func (*UnimplementedUserServiceServer) UnmuteUser(ctx context.Context, req *RelationRequest) (*RelationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnmuteUser not implemented")
+}
+func (*UnimplementedUserServiceServer) GetMutedUsers(ctx context.Context, req *Request) (*UsersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMutedUsers not implemented")
+}
+func (*UnimplementedUserServiceServer) RequestDataExport(ctx context.Context, req *Request) (*DataExportResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RequestDataExport not implemented")
+}
+func (*UnimplementedUserServiceServer) GetDataExport(ctx context.Context, req *DataExportRequest) (*DataExportResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDataExport not implemented")
+}
+func (*UnimplementedUserServiceServer) GetDataExportContent(ctx context.Context, req *DataExportRequest) (*DataExportContent, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDataExportContent not implemented")
+}
+func (*UnimplementedUserServiceServer) CheckField(ctx context.Context, req *CheckFieldRequest) (*CheckFieldResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckField not implemented")
+}
+func (*UnimplementedUserServiceServer) UpdateUserTokens(ctx context.Context, req *UpdateUserTokensRequest) (*UserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateUserTokens not implemented")
+}
+func (*UnimplementedUserServiceServer) Login(ctx context.Context, req *LoginRequest) (*LoginResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Login not implemented")
+}
+func (*UnimplementedUserServiceServer) GetUserForClient(ctx context.Context, req *Request) (*UserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUserForClient not implemented")
+}
+func (*UnimplementedUserServiceServer) IsFollowing(ctx context.Context, req *FollowRequest) (*CheckFieldResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IsFollowing not implemented")
+}
+func (*UnimplementedUserServiceServer) GetUsersByFirstNames(ctx context.Context, req *NamesRequest) (*UsersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUsersByFirstNames not implemented")
+}
+func (*UnimplementedUserServiceServer) GetUsersByIds(ctx context.Context, req *IdsRequest) (*UsersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUsersByIds not implemented")
+}
+func (*UnimplementedUserServiceServer) GetAccountStatus(ctx context.Context, req *Request) (*AccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAccountStatus not implemented")
+}
+func (*UnimplementedUserServiceServer) IsBlocked(ctx context.Context, req *RelationRequest) (*CheckFieldResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IsBlocked not implemented")
+}
+func (*UnimplementedUserServiceServer) GetMutedIds(ctx context.Context, req *Request) (*IdsRequest, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMutedIds not implemented")
+}
+func (*UnimplementedUserServiceServer) ChangeRoleUser(ctx context.Context, req *ChangeRoleRequest) (*UserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChangeRoleUser not implemented")
+}
+func (*UnimplementedUserServiceServer) GetSameRoleUsers(ctx context.Context, req *Request) (*UsersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSameRoleUsers not implemented")
+}
+func (*UnimplementedUserServiceServer) SuspendUser(ctx context.Context, req *SuspendRequest) (*UserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SuspendUser not implemented")
+}
+func (*UnimplementedUserServiceServer) BanUser(ctx context.Context, req *BanRequest) (*AccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BanUser not implemented")
+}
+func (*UnimplementedUserServiceServer) ShadowBanUser(ctx context.Context, req *BanRequest) (*AccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ShadowBanUser not implemented")
+}
+
+func RegisterUserServiceServer(s *grpc.Server, srv UserServiceServer) {
+	s.RegisterService(&_UserService_serviceDesc, srv)
+}
+
+func _UserService_CreateUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UserResponse)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Field)
-	if l > 0 {
-		n += 1 + l + sovUser(uint64(l))
+	if interceptor == nil {
+		return srv.(UserServiceServer).CreateUser(ctx, in)
 	}
-	l = len(m.Value)
-	if l > 0 {
-		n += 1 + l + sovUser(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/user.UserService/CreateUser",
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).CreateUser(ctx, req.(*UserResponse))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *Request) Size() (n int) {
-	if m == nil {
-		return 0
+func _UserService_GetUserById_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Request)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Str)
-	if l > 0 {
-		n += 1 + l + sovUser(uint64(l))
+	if interceptor == nil {
+		return srv.(UserServiceServer).GetUserById(ctx, in)
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/user.UserService/GetUserById",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).GetUserById(ctx, req.(*Request))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *GetUsersRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _UserService_GetUserByEmail_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Request)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.Page != 0 {
-		n += 1 + sovUser(uint64(m.Page))
+	if interceptor == nil {
+		return srv.(UserServiceServer).GetUserByEmail(ctx, in)
 	}
-	if m.Limit != 0 {
-		n += 1 + sovUser(uint64(m.Limit))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/user.UserService/GetUserByEmail",
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).GetUserByEmail(ctx, req.(*Request))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *LoginRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _UserService_GetAllUsers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUsersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Email)
-	if l > 0 {
-		n += 1 + l + sovUser(uint64(l))
+	if interceptor == nil {
+		return srv.(UserServiceServer).GetAllUsers(ctx, in)
 	}
-	l = len(m.Password)
-	if l > 0 {
-		n += 1 + l + sovUser(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/user.UserService/GetAllUsers",
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).GetAllUsers(ctx, req.(*GetUsersRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *UpdateUserTokensRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _UserService_SearchUsers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Request)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Id)
-	if l > 0 {
-		n += 1 + l + sovUser(uint64(l))
+	if interceptor == nil {
+		return srv.(UserServiceServer).SearchUsers(ctx, in)
 	}
-	l = len(m.AccessToken)
-	if l > 0 {
-		n += 1 + l + sovUser(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/user.UserService/SearchUsers",
 	}
-	l = len(m.RefreshToken)
-	if l > 0 {
-		n += 1 + l + sovUser(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).SearchUsers(ctx, req.(*Request))
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_UpdateUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return n
+	if interceptor == nil {
+		return srv.(UserServiceServer).UpdateUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/user.UserService/UpdateUser",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).UpdateUser(ctx, req.(*UpdateUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *UpdateUserRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _UserService_DeleteUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Request)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.FirstName)
-	if l > 0 {
-		n += 1 + l + sovUser(uint64(l))
+	if interceptor == nil {
+		return srv.(UserServiceServer).DeleteUser(ctx, in)
 	}
-	l = len(m.LastName)
-	if l > 0 {
-		n += 1 + l + sovUser(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/user.UserService/DeleteUser",
 	}
-	l = len(m.Email)
-	if l > 0 {
-		n += 1 + l + sovUser(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).DeleteUser(ctx, req.(*Request))
 	}
-	l = len(m.Id)
-	if l > 0 {
-		n += 1 + l + sovUser(uint64(l))
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_FollowUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FollowRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if interceptor == nil {
+		return srv.(UserServiceServer).FollowUser(ctx, in)
 	}
-	return n
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/user.UserService/FollowUser",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).FollowUser(ctx, req.(*FollowRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *CheckFieldResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _UserService_UnfollowUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FollowRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.Exists {
-		n += 2
+	if interceptor == nil {
+		return srv.(UserServiceServer).UnfollowUser(ctx, in)
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/user.UserService/UnfollowUser",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).UnfollowUser(ctx, req.(*FollowRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *LoginResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _UserService_GetFollowers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Request)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Id)
-	if l > 0 {
-		n += 1 + l + sovUser(uint64(l))
+	if interceptor == nil {
+		return srv.(UserServiceServer).GetFollowers(ctx, in)
 	}
-	l = len(m.FirstName)
-	if l > 0 {
-		n += 1 + l + sovUser(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/user.UserService/GetFollowers",
 	}
-	l = len(m.LastName)
-	if l > 0 {
-		n += 1 + l + sovUser(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).GetFollowers(ctx, req.(*Request))
 	}
-	l = len(m.UserType)
-	if l > 0 {
-		n += 1 + l + sovUser(uint64(l))
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_BlockUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RelationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	l = len(m.Email)
-	if l > 0 {
-		n += 1 + l + sovUser(uint64(l))
+	if interceptor == nil {
+		return srv.(UserServiceServer).BlockUser(ctx, in)
 	}
-	l = len(m.Password)
-	if l > 0 {
-		n += 1 + l + sovUser(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/user.UserService/BlockUser",
 	}
-	l = len(m.AccessToken)
-	if l > 0 {
-		n += 1 + l + sovUser(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).BlockUser(ctx, req.(*RelationRequest))
 	}
-	l = len(m.RefreshToken)
-	if l > 0 {
-		n += 1 + l + sovUser(uint64(l))
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_UnblockUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RelationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if interceptor == nil {
+		return srv.(UserServiceServer).UnblockUser(ctx, in)
 	}
-	return n
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/user.UserService/UnblockUser",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).UnblockUser(ctx, req.(*RelationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *UserResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _UserService_GetBlockedUsers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Request)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Id)
-	if l > 0 {
-		n += 1 + l + sovUser(uint64(l))
+	if interceptor == nil {
+		return srv.(UserServiceServer).GetBlockedUsers(ctx, in)
 	}
-	l = len(m.FirstName)
-	if l > 0 {
-		n += 1 + l + sovUser(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/user.UserService/GetBlockedUsers",
 	}
-	l = len(m.LastName)
-	if l > 0 {
-		n += 1 + l + sovUser(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).GetBlockedUsers(ctx, req.(*Request))
 	}
-	l = len(m.UserType)
-	if l > 0 {
-		n += 1 + l + sovUser(uint64(l))
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_MuteUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RelationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	l = len(m.Email)
-	if l > 0 {
-		n += 1 + l + sovUser(uint64(l))
+	if interceptor == nil {
+		return srv.(UserServiceServer).MuteUser(ctx, in)
 	}
-	l = len(m.Password)
-	if l > 0 {
-		n += 1 + l + sovUser(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/user.UserService/MuteUser",
 	}
-	l = len(m.AccessToken)
-	if l > 0 {
-		n += 1 + l + sovUser(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).MuteUser(ctx, req.(*RelationRequest))
 	}
-	l = len(m.RefreshToken)
-	if l > 0 {
-		n += 1 + l + sovUser(uint64(l))
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_UnmuteUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RelationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.Posts != 0 {
-		n += 1 + sovUser(uint64(m.Posts))
+	if interceptor == nil {
+		return srv.(UserServiceServer).UnmuteUser(ctx, in)
 	}
-	l = len(m.CreatedAt)
-	if l > 0 {
-		n += 1 + l + sovUser(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/user.UserService/UnmuteUser",
 	}
-	l = len(m.UpdatedAt)
-	if l > 0 {
-		n += 1 + l + sovUser(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).UnmuteUser(ctx, req.(*RelationRequest))
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_GetMutedUsers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Request)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return n
+	if interceptor == nil {
+		return srv.(UserServiceServer).GetMutedUsers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/user.UserService/GetMutedUsers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).GetMutedUsers(ctx, req.(*Request))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *UsersResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _UserService_RequestDataExport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Request)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if len(m.Users) > 0 {
-		for _, e := range m.Users {
-			l = e.Size()
-			n += 1 + l + sovUser(uint64(l))
+	if interceptor == nil {
+		return srv.(UserServiceServer).RequestDataExport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/user.UserService/RequestDataExport",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).RequestDataExport(ctx, req.(*Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_GetDataExport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DataExportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).GetDataExport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/user.UserService/GetDataExport",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).GetDataExport(ctx, req.(*DataExportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_GetDataExportContent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DataExportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).GetDataExportContent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/user.UserService/GetDataExportContent",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).GetDataExportContent(ctx, req.(*DataExportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_CheckField_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckFieldRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).CheckField(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/user.UserService/CheckField",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).CheckField(ctx, req.(*CheckFieldRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_UpdateUserTokens_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateUserTokensRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).UpdateUserTokens(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/user.UserService/UpdateUserTokens",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).UpdateUserTokens(ctx, req.(*UpdateUserTokensRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_Login_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LoginRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).Login(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/user.UserService/Login",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).Login(ctx, req.(*LoginRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_GetUserForClient_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).GetUserForClient(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/user.UserService/GetUserForClient",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).GetUserForClient(ctx, req.(*Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_IsFollowing_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FollowRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).IsFollowing(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/user.UserService/IsFollowing",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).IsFollowing(ctx, req.(*FollowRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_GetUsersByFirstNames_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NamesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).GetUsersByFirstNames(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/user.UserService/GetUsersByFirstNames",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).GetUsersByFirstNames(ctx, req.(*NamesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_GetUsersByIds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IdsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).GetUsersByIds(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/user.UserService/GetUsersByIds",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).GetUsersByIds(ctx, req.(*IdsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_GetAccountStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).GetAccountStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/user.UserService/GetAccountStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).GetAccountStatus(ctx, req.(*Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_IsBlocked_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RelationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).IsBlocked(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/user.UserService/IsBlocked",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).IsBlocked(ctx, req.(*RelationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_GetMutedIds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).GetMutedIds(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/user.UserService/GetMutedIds",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).GetMutedIds(ctx, req.(*Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_ChangeRoleUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChangeRoleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).ChangeRoleUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/user.UserService/ChangeRoleUser",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).ChangeRoleUser(ctx, req.(*ChangeRoleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_GetSameRoleUsers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).GetSameRoleUsers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/user.UserService/GetSameRoleUsers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).GetSameRoleUsers(ctx, req.(*Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_SuspendUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SuspendRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).SuspendUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/user.UserService/SuspendUser",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).SuspendUser(ctx, req.(*SuspendRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_BanUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BanRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).BanUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/user.UserService/BanUser",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).BanUser(ctx, req.(*BanRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_ShadowBanUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BanRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).ShadowBanUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/user.UserService/ShadowBanUser",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).ShadowBanUser(ctx, req.(*BanRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _UserService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "user.UserService",
+	HandlerType: (*UserServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateUser",
+			Handler:    _UserService_CreateUser_Handler,
+		},
+		{
+			MethodName: "GetUserById",
+			Handler:    _UserService_GetUserById_Handler,
+		},
+		{
+			MethodName: "GetUserByEmail",
+			Handler:    _UserService_GetUserByEmail_Handler,
+		},
+		{
+			MethodName: "GetAllUsers",
+			Handler:    _UserService_GetAllUsers_Handler,
+		},
+		{
+			MethodName: "SearchUsers",
+			Handler:    _UserService_SearchUsers_Handler,
+		},
+		{
+			MethodName: "UpdateUser",
+			Handler:    _UserService_UpdateUser_Handler,
+		},
+		{
+			MethodName: "DeleteUser",
+			Handler:    _UserService_DeleteUser_Handler,
+		},
+		{
+			MethodName: "FollowUser",
+			Handler:    _UserService_FollowUser_Handler,
+		},
+		{
+			MethodName: "UnfollowUser",
+			Handler:    _UserService_UnfollowUser_Handler,
+		},
+		{
+			MethodName: "GetFollowers",
+			Handler:    _UserService_GetFollowers_Handler,
+		},
+		{
+			MethodName: "BlockUser",
+			Handler:    _UserService_BlockUser_Handler,
+		},
+		{
+			MethodName: "UnblockUser",
+			Handler:    _UserService_UnblockUser_Handler,
+		},
+		{
+			MethodName: "GetBlockedUsers",
+			Handler:    _UserService_GetBlockedUsers_Handler,
+		},
+		{
+			MethodName: "MuteUser",
+			Handler:    _UserService_MuteUser_Handler,
+		},
+		{
+			MethodName: "UnmuteUser",
+			Handler:    _UserService_UnmuteUser_Handler,
+		},
+		{
+			MethodName: "GetMutedUsers",
+			Handler:    _UserService_GetMutedUsers_Handler,
+		},
+		{
+			MethodName: "RequestDataExport",
+			Handler:    _UserService_RequestDataExport_Handler,
+		},
+		{
+			MethodName: "GetDataExport",
+			Handler:    _UserService_GetDataExport_Handler,
+		},
+		{
+			MethodName: "GetDataExportContent",
+			Handler:    _UserService_GetDataExportContent_Handler,
+		},
+		{
+			MethodName: "CheckField",
+			Handler:    _UserService_CheckField_Handler,
+		},
+		{
+			MethodName: "UpdateUserTokens",
+			Handler:    _UserService_UpdateUserTokens_Handler,
+		},
+		{
+			MethodName: "Login",
+			Handler:    _UserService_Login_Handler,
+		},
+		{
+			MethodName: "GetUserForClient",
+			Handler:    _UserService_GetUserForClient_Handler,
+		},
+		{
+			MethodName: "IsFollowing",
+			Handler:    _UserService_IsFollowing_Handler,
+		},
+		{
+			MethodName: "GetUsersByFirstNames",
+			Handler:    _UserService_GetUsersByFirstNames_Handler,
+		},
+		{
+			MethodName: "GetUsersByIds",
+			Handler:    _UserService_GetUsersByIds_Handler,
+		},
+		{
+			MethodName: "GetAccountStatus",
+			Handler:    _UserService_GetAccountStatus_Handler,
+		},
+		{
+			MethodName: "IsBlocked",
+			Handler:    _UserService_IsBlocked_Handler,
+		},
+		{
+			MethodName: "GetMutedIds",
+			Handler:    _UserService_GetMutedIds_Handler,
+		},
+		{
+			MethodName: "ChangeRoleUser",
+			Handler:    _UserService_ChangeRoleUser_Handler,
+		},
+		{
+			MethodName: "GetSameRoleUsers",
+			Handler:    _UserService_GetSameRoleUsers_Handler,
+		},
+		{
+			MethodName: "SuspendUser",
+			Handler:    _UserService_SuspendUser_Handler,
+		},
+		{
+			MethodName: "BanUser",
+			Handler:    _UserService_BanUser_Handler,
+		},
+		{
+			MethodName: "ShadowBanUser",
+			Handler:    _UserService_ShadowBanUser_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "user/user.proto",
+}
+
+func (m *DataExportRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DataExportRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DataExportRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Format) > 0 {
+		i -= len(m.Format)
+		copy(dAtA[i:], m.Format)
+		i = encodeVarintUser(dAtA, i, uint64(len(m.Format)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.UserId) > 0 {
+		i -= len(m.UserId)
+		copy(dAtA[i:], m.UserId)
+		i = encodeVarintUser(dAtA, i, uint64(len(m.UserId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintUser(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DataExportResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DataExportResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DataExportResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.CompletedAt) > 0 {
+		i -= len(m.CompletedAt)
+		copy(dAtA[i:], m.CompletedAt)
+		i = encodeVarintUser(dAtA, i, uint64(len(m.CompletedAt)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.CreatedAt) > 0 {
+		i -= len(m.CreatedAt)
+		copy(dAtA[i:], m.CreatedAt)
+		i = encodeVarintUser(dAtA, i, uint64(len(m.CreatedAt)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintUser(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Status) > 0 {
+		i -= len(m.Status)
+		copy(dAtA[i:], m.Status)
+		i = encodeVarintUser(dAtA, i, uint64(len(m.Status)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.UserId) > 0 {
+		i -= len(m.UserId)
+		copy(dAtA[i:], m.UserId)
+		i = encodeVarintUser(dAtA, i, uint64(len(m.UserId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintUser(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DataExportContent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DataExportContent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DataExportContent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Content) > 0 {
+		i -= len(m.Content)
+		copy(dAtA[i:], m.Content)
+		i = encodeVarintUser(dAtA, i, uint64(len(m.Content)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.MimeType) > 0 {
+		i -= len(m.MimeType)
+		copy(dAtA[i:], m.MimeType)
+		i = encodeVarintUser(dAtA, i, uint64(len(m.MimeType)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.FileName) > 0 {
+		i -= len(m.FileName)
+		copy(dAtA[i:], m.FileName)
+		i = encodeVarintUser(dAtA, i, uint64(len(m.FileName)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ChangeRoleRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ChangeRoleRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ChangeRoleRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Role) > 0 {
+		i -= len(m.Role)
+		copy(dAtA[i:], m.Role)
+		i = encodeVarintUser(dAtA, i, uint64(len(m.Role)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintUser(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SuspendRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SuspendRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SuspendRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintUser(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Until) > 0 {
+		i -= len(m.Until)
+		copy(dAtA[i:], m.Until)
+		i = encodeVarintUser(dAtA, i, uint64(len(m.Until)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintUser(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BanRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BanRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BanRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintUser(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Until) > 0 {
+		i -= len(m.Until)
+		copy(dAtA[i:], m.Until)
+		i = encodeVarintUser(dAtA, i, uint64(len(m.Until)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Lift {
+		i--
+		if m.Lift {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintUser(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AccountResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AccountResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AccountResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.ShadowBanReason) > 0 {
+		i -= len(m.ShadowBanReason)
+		copy(dAtA[i:], m.ShadowBanReason)
+		i = encodeVarintUser(dAtA, i, uint64(len(m.ShadowBanReason)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.ShadowBannedUntil) > 0 {
+		i -= len(m.ShadowBannedUntil)
+		copy(dAtA[i:], m.ShadowBannedUntil)
+		i = encodeVarintUser(dAtA, i, uint64(len(m.ShadowBannedUntil)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.ShadowBanned {
+		i--
+		if m.ShadowBanned {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintUser(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Until) > 0 {
+		i -= len(m.Until)
+		copy(dAtA[i:], m.Until)
+		i = encodeVarintUser(dAtA, i, uint64(len(m.Until)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.State) > 0 {
+		i -= len(m.State)
+		copy(dAtA[i:], m.State)
+		i = encodeVarintUser(dAtA, i, uint64(len(m.State)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintUser(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CheckFieldRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CheckFieldRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CheckFieldRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintUser(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Field) > 0 {
+		i -= len(m.Field)
+		copy(dAtA[i:], m.Field)
+		i = encodeVarintUser(dAtA, i, uint64(len(m.Field)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Request) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Request) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Request) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.ViewerId) > 0 {
+		i -= len(m.ViewerId)
+		copy(dAtA[i:], m.ViewerId)
+		i = encodeVarintUser(dAtA, i, uint64(len(m.ViewerId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Str) > 0 {
+		i -= len(m.Str)
+		copy(dAtA[i:], m.Str)
+		i = encodeVarintUser(dAtA, i, uint64(len(m.Str)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FollowRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FollowRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FollowRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.FollowingId) > 0 {
+		i -= len(m.FollowingId)
+		copy(dAtA[i:], m.FollowingId)
+		i = encodeVarintUser(dAtA, i, uint64(len(m.FollowingId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.FollowerId) > 0 {
+		i -= len(m.FollowerId)
+		copy(dAtA[i:], m.FollowerId)
+		i = encodeVarintUser(dAtA, i, uint64(len(m.FollowerId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FollowResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FollowResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FollowResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Following {
+		i--
+		if m.Following {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.FollowingId) > 0 {
+		i -= len(m.FollowingId)
+		copy(dAtA[i:], m.FollowingId)
+		i = encodeVarintUser(dAtA, i, uint64(len(m.FollowingId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.FollowerId) > 0 {
+		i -= len(m.FollowerId)
+		copy(dAtA[i:], m.FollowerId)
+		i = encodeVarintUser(dAtA, i, uint64(len(m.FollowerId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RelationRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RelationRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RelationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.TargetId) > 0 {
+		i -= len(m.TargetId)
+		copy(dAtA[i:], m.TargetId)
+		i = encodeVarintUser(dAtA, i, uint64(len(m.TargetId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.UserId) > 0 {
+		i -= len(m.UserId)
+		copy(dAtA[i:], m.UserId)
+		i = encodeVarintUser(dAtA, i, uint64(len(m.UserId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RelationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RelationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RelationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Active {
+		i--
+		if m.Active {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.TargetId) > 0 {
+		i -= len(m.TargetId)
+		copy(dAtA[i:], m.TargetId)
+		i = encodeVarintUser(dAtA, i, uint64(len(m.TargetId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.UserId) > 0 {
+		i -= len(m.UserId)
+		copy(dAtA[i:], m.UserId)
+		i = encodeVarintUser(dAtA, i, uint64(len(m.UserId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetUsersRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetUsersRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetUsersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.ViewerId) > 0 {
+		i -= len(m.ViewerId)
+		copy(dAtA[i:], m.ViewerId)
+		i = encodeVarintUser(dAtA, i, uint64(len(m.ViewerId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Limit != 0 {
+		i = encodeVarintUser(dAtA, i, uint64(m.Limit))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Page != 0 {
+		i = encodeVarintUser(dAtA, i, uint64(m.Page))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *LoginRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LoginRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LoginRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Password) > 0 {
+		i -= len(m.Password)
+		copy(dAtA[i:], m.Password)
+		i = encodeVarintUser(dAtA, i, uint64(len(m.Password)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Email) > 0 {
+		i -= len(m.Email)
+		copy(dAtA[i:], m.Email)
+		i = encodeVarintUser(dAtA, i, uint64(len(m.Email)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UpdateUserTokensRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UpdateUserTokensRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UpdateUserTokensRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.RefreshToken) > 0 {
+		i -= len(m.RefreshToken)
+		copy(dAtA[i:], m.RefreshToken)
+		i = encodeVarintUser(dAtA, i, uint64(len(m.RefreshToken)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.AccessToken) > 0 {
+		i -= len(m.AccessToken)
+		copy(dAtA[i:], m.AccessToken)
+		i = encodeVarintUser(dAtA, i, uint64(len(m.AccessToken)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintUser(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UpdateUserRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UpdateUserRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UpdateUserRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.ExpectedVersion != 0 {
+		i = encodeVarintUser(dAtA, i, uint64(m.ExpectedVersion))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintUser(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Email) > 0 {
+		i -= len(m.Email)
+		copy(dAtA[i:], m.Email)
+		i = encodeVarintUser(dAtA, i, uint64(len(m.Email)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.LastName) > 0 {
+		i -= len(m.LastName)
+		copy(dAtA[i:], m.LastName)
+		i = encodeVarintUser(dAtA, i, uint64(len(m.LastName)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.FirstName) > 0 {
+		i -= len(m.FirstName)
+		copy(dAtA[i:], m.FirstName)
+		i = encodeVarintUser(dAtA, i, uint64(len(m.FirstName)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CheckFieldResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CheckFieldResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CheckFieldResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Exists {
+		i--
+		if m.Exists {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *LoginResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LoginResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LoginResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.RefreshToken) > 0 {
+		i -= len(m.RefreshToken)
+		copy(dAtA[i:], m.RefreshToken)
+		i = encodeVarintUser(dAtA, i, uint64(len(m.RefreshToken)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.AccessToken) > 0 {
+		i -= len(m.AccessToken)
+		copy(dAtA[i:], m.AccessToken)
+		i = encodeVarintUser(dAtA, i, uint64(len(m.AccessToken)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.Password) > 0 {
+		i -= len(m.Password)
+		copy(dAtA[i:], m.Password)
+		i = encodeVarintUser(dAtA, i, uint64(len(m.Password)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Email) > 0 {
+		i -= len(m.Email)
+		copy(dAtA[i:], m.Email)
+		i = encodeVarintUser(dAtA, i, uint64(len(m.Email)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.UserType) > 0 {
+		i -= len(m.UserType)
+		copy(dAtA[i:], m.UserType)
+		i = encodeVarintUser(dAtA, i, uint64(len(m.UserType)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.LastName) > 0 {
+		i -= len(m.LastName)
+		copy(dAtA[i:], m.LastName)
+		i = encodeVarintUser(dAtA, i, uint64(len(m.LastName)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.FirstName) > 0 {
+		i -= len(m.FirstName)
+		copy(dAtA[i:], m.FirstName)
+		i = encodeVarintUser(dAtA, i, uint64(len(m.FirstName)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintUser(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UserResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UserResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UserResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.SuspensionReason) > 0 {
+		i -= len(m.SuspensionReason)
+		copy(dAtA[i:], m.SuspensionReason)
+		i = encodeVarintUser(dAtA, i, uint64(len(m.SuspensionReason)))
+		i--
+		dAtA[i] = 0x7a
+	}
+	if len(m.SuspendedUntil) > 0 {
+		i -= len(m.SuspendedUntil)
+		copy(dAtA[i:], m.SuspendedUntil)
+		i = encodeVarintUser(dAtA, i, uint64(len(m.SuspendedUntil)))
+		i--
+		dAtA[i] = 0x72
+	}
+	if m.Version != 0 {
+		i = encodeVarintUser(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x68
+	}
+	if len(m.PurgeAfter) > 0 {
+		i -= len(m.PurgeAfter)
+		copy(dAtA[i:], m.PurgeAfter)
+		i = encodeVarintUser(dAtA, i, uint64(len(m.PurgeAfter)))
+		i--
+		dAtA[i] = 0x62
+	}
+	if len(m.UpdatedAt) > 0 {
+		i -= len(m.UpdatedAt)
+		copy(dAtA[i:], m.UpdatedAt)
+		i = encodeVarintUser(dAtA, i, uint64(len(m.UpdatedAt)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if len(m.CreatedAt) > 0 {
+		i -= len(m.CreatedAt)
+		copy(dAtA[i:], m.CreatedAt)
+		i = encodeVarintUser(dAtA, i, uint64(len(m.CreatedAt)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if m.Posts != 0 {
+		i = encodeVarintUser(dAtA, i, uint64(m.Posts))
+		i--
+		dAtA[i] = 0x48
+	}
+	if len(m.RefreshToken) > 0 {
+		i -= len(m.RefreshToken)
+		copy(dAtA[i:], m.RefreshToken)
+		i = encodeVarintUser(dAtA, i, uint64(len(m.RefreshToken)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.AccessToken) > 0 {
+		i -= len(m.AccessToken)
+		copy(dAtA[i:], m.AccessToken)
+		i = encodeVarintUser(dAtA, i, uint64(len(m.AccessToken)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.Password) > 0 {
+		i -= len(m.Password)
+		copy(dAtA[i:], m.Password)
+		i = encodeVarintUser(dAtA, i, uint64(len(m.Password)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Email) > 0 {
+		i -= len(m.Email)
+		copy(dAtA[i:], m.Email)
+		i = encodeVarintUser(dAtA, i, uint64(len(m.Email)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.UserType) > 0 {
+		i -= len(m.UserType)
+		copy(dAtA[i:], m.UserType)
+		i = encodeVarintUser(dAtA, i, uint64(len(m.UserType)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.LastName) > 0 {
+		i -= len(m.LastName)
+		copy(dAtA[i:], m.LastName)
+		i = encodeVarintUser(dAtA, i, uint64(len(m.LastName)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.FirstName) > 0 {
+		i -= len(m.FirstName)
+		copy(dAtA[i:], m.FirstName)
+		i = encodeVarintUser(dAtA, i, uint64(len(m.FirstName)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintUser(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UsersResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UsersResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UsersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Users) > 0 {
+		for iNdEx := len(m.Users) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Users[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintUser(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *NamesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *NamesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *NamesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Names) > 0 {
+		for iNdEx := len(m.Names) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Names[iNdEx])
+			copy(dAtA[i:], m.Names[iNdEx])
+			i = encodeVarintUser(dAtA, i, uint64(len(m.Names[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *IdsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *IdsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *IdsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Ids) > 0 {
+		for iNdEx := len(m.Ids) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Ids[iNdEx])
+			copy(dAtA[i:], m.Ids[iNdEx])
+			i = encodeVarintUser(dAtA, i, uint64(len(m.Ids[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintUser(dAtA []byte, offset int, v uint64) int {
+	offset -= sovUser(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *DataExportRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovUser(uint64(l))
+	}
+	l = len(m.UserId)
+	if l > 0 {
+		n += 1 + l + sovUser(uint64(l))
+	}
+	l = len(m.Format)
+	if l > 0 {
+		n += 1 + l + sovUser(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DataExportResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovUser(uint64(l))
+	}
+	l = len(m.UserId)
+	if l > 0 {
+		n += 1 + l + sovUser(uint64(l))
+	}
+	l = len(m.Status)
+	if l > 0 {
+		n += 1 + l + sovUser(uint64(l))
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovUser(uint64(l))
+	}
+	l = len(m.CreatedAt)
+	if l > 0 {
+		n += 1 + l + sovUser(uint64(l))
+	}
+	l = len(m.CompletedAt)
+	if l > 0 {
+		n += 1 + l + sovUser(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DataExportContent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FileName)
+	if l > 0 {
+		n += 1 + l + sovUser(uint64(l))
+	}
+	l = len(m.MimeType)
+	if l > 0 {
+		n += 1 + l + sovUser(uint64(l))
+	}
+	l = len(m.Content)
+	if l > 0 {
+		n += 1 + l + sovUser(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ChangeRoleRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovUser(uint64(l))
+	}
+	l = len(m.Role)
+	if l > 0 {
+		n += 1 + l + sovUser(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SuspendRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovUser(uint64(l))
+	}
+	l = len(m.Until)
+	if l > 0 {
+		n += 1 + l + sovUser(uint64(l))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovUser(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *BanRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovUser(uint64(l))
+	}
+	if m.Lift {
+		n += 2
+	}
+	l = len(m.Until)
+	if l > 0 {
+		n += 1 + l + sovUser(uint64(l))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovUser(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *AccountResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovUser(uint64(l))
+	}
+	l = len(m.State)
+	if l > 0 {
+		n += 1 + l + sovUser(uint64(l))
+	}
+	l = len(m.Until)
+	if l > 0 {
+		n += 1 + l + sovUser(uint64(l))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovUser(uint64(l))
+	}
+	if m.ShadowBanned {
+		n += 2
+	}
+	l = len(m.ShadowBannedUntil)
+	if l > 0 {
+		n += 1 + l + sovUser(uint64(l))
+	}
+	l = len(m.ShadowBanReason)
+	if l > 0 {
+		n += 1 + l + sovUser(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *CheckFieldRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Field)
+	if l > 0 {
+		n += 1 + l + sovUser(uint64(l))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovUser(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Request) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Str)
+	if l > 0 {
+		n += 1 + l + sovUser(uint64(l))
+	}
+	l = len(m.ViewerId)
+	if l > 0 {
+		n += 1 + l + sovUser(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *FollowRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FollowerId)
+	if l > 0 {
+		n += 1 + l + sovUser(uint64(l))
+	}
+	l = len(m.FollowingId)
+	if l > 0 {
+		n += 1 + l + sovUser(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *FollowResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FollowerId)
+	if l > 0 {
+		n += 1 + l + sovUser(uint64(l))
+	}
+	l = len(m.FollowingId)
+	if l > 0 {
+		n += 1 + l + sovUser(uint64(l))
+	}
+	if m.Following {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RelationRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.UserId)
+	if l > 0 {
+		n += 1 + l + sovUser(uint64(l))
+	}
+	l = len(m.TargetId)
+	if l > 0 {
+		n += 1 + l + sovUser(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RelationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.UserId)
+	if l > 0 {
+		n += 1 + l + sovUser(uint64(l))
+	}
+	l = len(m.TargetId)
+	if l > 0 {
+		n += 1 + l + sovUser(uint64(l))
+	}
+	if m.Active {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetUsersRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Page != 0 {
+		n += 1 + sovUser(uint64(m.Page))
+	}
+	if m.Limit != 0 {
+		n += 1 + sovUser(uint64(m.Limit))
+	}
+	l = len(m.ViewerId)
+	if l > 0 {
+		n += 1 + l + sovUser(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *LoginRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Email)
+	if l > 0 {
+		n += 1 + l + sovUser(uint64(l))
+	}
+	l = len(m.Password)
+	if l > 0 {
+		n += 1 + l + sovUser(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *UpdateUserTokensRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovUser(uint64(l))
+	}
+	l = len(m.AccessToken)
+	if l > 0 {
+		n += 1 + l + sovUser(uint64(l))
+	}
+	l = len(m.RefreshToken)
+	if l > 0 {
+		n += 1 + l + sovUser(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *UpdateUserRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FirstName)
+	if l > 0 {
+		n += 1 + l + sovUser(uint64(l))
+	}
+	l = len(m.LastName)
+	if l > 0 {
+		n += 1 + l + sovUser(uint64(l))
+	}
+	l = len(m.Email)
+	if l > 0 {
+		n += 1 + l + sovUser(uint64(l))
+	}
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovUser(uint64(l))
+	}
+	if m.ExpectedVersion != 0 {
+		n += 1 + sovUser(uint64(m.ExpectedVersion))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *CheckFieldResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Exists {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *LoginResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovUser(uint64(l))
+	}
+	l = len(m.FirstName)
+	if l > 0 {
+		n += 1 + l + sovUser(uint64(l))
+	}
+	l = len(m.LastName)
+	if l > 0 {
+		n += 1 + l + sovUser(uint64(l))
+	}
+	l = len(m.UserType)
+	if l > 0 {
+		n += 1 + l + sovUser(uint64(l))
+	}
+	l = len(m.Email)
+	if l > 0 {
+		n += 1 + l + sovUser(uint64(l))
+	}
+	l = len(m.Password)
+	if l > 0 {
+		n += 1 + l + sovUser(uint64(l))
+	}
+	l = len(m.AccessToken)
+	if l > 0 {
+		n += 1 + l + sovUser(uint64(l))
+	}
+	l = len(m.RefreshToken)
+	if l > 0 {
+		n += 1 + l + sovUser(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *UserResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovUser(uint64(l))
+	}
+	l = len(m.FirstName)
+	if l > 0 {
+		n += 1 + l + sovUser(uint64(l))
+	}
+	l = len(m.LastName)
+	if l > 0 {
+		n += 1 + l + sovUser(uint64(l))
+	}
+	l = len(m.UserType)
+	if l > 0 {
+		n += 1 + l + sovUser(uint64(l))
+	}
+	l = len(m.Email)
+	if l > 0 {
+		n += 1 + l + sovUser(uint64(l))
+	}
+	l = len(m.Password)
+	if l > 0 {
+		n += 1 + l + sovUser(uint64(l))
+	}
+	l = len(m.AccessToken)
+	if l > 0 {
+		n += 1 + l + sovUser(uint64(l))
+	}
+	l = len(m.RefreshToken)
+	if l > 0 {
+		n += 1 + l + sovUser(uint64(l))
+	}
+	if m.Posts != 0 {
+		n += 1 + sovUser(uint64(m.Posts))
+	}
+	l = len(m.CreatedAt)
+	if l > 0 {
+		n += 1 + l + sovUser(uint64(l))
+	}
+	l = len(m.UpdatedAt)
+	if l > 0 {
+		n += 1 + l + sovUser(uint64(l))
+	}
+	l = len(m.PurgeAfter)
+	if l > 0 {
+		n += 1 + l + sovUser(uint64(l))
+	}
+	if m.Version != 0 {
+		n += 1 + sovUser(uint64(m.Version))
+	}
+	l = len(m.SuspendedUntil)
+	if l > 0 {
+		n += 1 + l + sovUser(uint64(l))
+	}
+	l = len(m.SuspensionReason)
+	if l > 0 {
+		n += 1 + l + sovUser(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *UsersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Users) > 0 {
+		for _, e := range m.Users {
+			l = e.Size()
+			n += 1 + l + sovUser(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *NamesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Names) > 0 {
+		for _, s := range m.Names {
+			l = len(s)
+			n += 1 + l + sovUser(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *IdsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Ids) > 0 {
+		for _, s := range m.Ids {
+			l = len(s)
+			n += 1 + l + sovUser(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func sovUser(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozUser(x uint64) (n int) {
+	return sovUser(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *DataExportRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowUser
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DataExportRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DataExportRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUser
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthUser
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthUser
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UserId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUser
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthUser
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthUser
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UserId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Format", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUser
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthUser
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthUser
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Format = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipUser(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthUser
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DataExportResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowUser
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DataExportResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DataExportResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUser
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthUser
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthUser
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UserId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUser
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthUser
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthUser
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UserId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUser
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthUser
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthUser
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Status = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUser
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthUser
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthUser
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUser
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthUser
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthUser
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CreatedAt = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompletedAt", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUser
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthUser
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthUser
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CompletedAt = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipUser(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthUser
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DataExportContent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowUser
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DataExportContent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DataExportContent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FileName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUser
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthUser
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthUser
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FileName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MimeType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUser
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthUser
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthUser
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MimeType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Content", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUser
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthUser
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthUser
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Content = append(m.Content[:0], dAtA[iNdEx:postIndex]...)
+			if m.Content == nil {
+				m.Content = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipUser(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthUser
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ChangeRoleRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowUser
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ChangeRoleRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ChangeRoleRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUser
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthUser
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthUser
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUser
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthUser
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthUser
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Role = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipUser(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthUser
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SuspendRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowUser
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SuspendRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SuspendRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUser
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthUser
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthUser
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Until", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUser
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthUser
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthUser
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Until = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUser
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthUser
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthUser
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipUser(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthUser
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BanRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowUser
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BanRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BanRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUser
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthUser
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthUser
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Lift", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUser
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Lift = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Until", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUser
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthUser
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthUser
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Until = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUser
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthUser
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthUser
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipUser(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthUser
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AccountResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowUser
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AccountResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUser
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthUser
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthUser
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUser
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthUser
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthUser
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.State = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Until", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUser
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthUser
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthUser
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Until = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUser
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthUser
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthUser
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShadowBanned", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUser
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ShadowBanned = bool(v != 0)
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShadowBannedUntil", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUser
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthUser
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthUser
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ShadowBannedUntil = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShadowBanReason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUser
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthUser
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthUser
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ShadowBanReason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipUser(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthUser
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CheckFieldRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowUser
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CheckFieldRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CheckFieldRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Field", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUser
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthUser
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthUser
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Field = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUser
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthUser
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthUser
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipUser(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthUser
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Request) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowUser
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Request: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Request: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Str", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUser
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthUser
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthUser
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Str = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ViewerId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUser
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthUser
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthUser
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ViewerId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipUser(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthUser
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FollowRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowUser
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FollowRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FollowRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FollowerId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUser
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthUser
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthUser
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FollowerId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FollowingId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUser
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthUser
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthUser
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FollowingId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipUser(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthUser
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
 		}
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
-}
 
-func sovUser(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozUser(x uint64) (n int) {
-	return sovUser(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *ChangeRoleRequest) Unmarshal(dAtA []byte) error {
+func (m *FollowResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2215,15 +6425,15 @@ func (m *ChangeRoleRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ChangeRoleRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: FollowResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ChangeRoleRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: FollowResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FollowerId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2251,11 +6461,11 @@ func (m *ChangeRoleRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Id = string(dAtA[iNdEx:postIndex])
+			m.FollowerId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FollowingId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2283,8 +6493,28 @@ func (m *ChangeRoleRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Role = string(dAtA[iNdEx:postIndex])
+			m.FollowingId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Following", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUser
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Following = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipUser(dAtA[iNdEx:])
@@ -2307,7 +6537,7 @@ func (m *ChangeRoleRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CheckFieldRequest) Unmarshal(dAtA []byte) error {
+func (m *RelationRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2330,15 +6560,15 @@ func (m *CheckFieldRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CheckFieldRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: RelationRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CheckFieldRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RelationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Field", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UserId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2366,11 +6596,11 @@ func (m *CheckFieldRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Field = string(dAtA[iNdEx:postIndex])
+			m.UserId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2398,7 +6628,7 @@ func (m *CheckFieldRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Value = string(dAtA[iNdEx:postIndex])
+			m.TargetId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2422,7 +6652,7 @@ func (m *CheckFieldRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Request) Unmarshal(dAtA []byte) error {
+func (m *RelationResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2445,15 +6675,15 @@ func (m *Request) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Request: wiretype end group for non-group")
+			return fmt.Errorf("proto: RelationResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Request: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RelationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Str", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UserId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2481,8 +6711,60 @@ func (m *Request) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Str = string(dAtA[iNdEx:postIndex])
+			m.UserId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUser
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthUser
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthUser
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TargetId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Active", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUser
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Active = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipUser(dAtA[iNdEx:])
@@ -2572,6 +6854,38 @@ func (m *GetUsersRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ViewerId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUser
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthUser
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthUser
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ViewerId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipUser(dAtA[iNdEx:])
@@ -3013,6 +7327,25 @@ func (m *UpdateUserRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.Id = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpectedVersion", wireType)
+			}
+			m.ExpectedVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUser
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpectedVersion |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipUser(dAtA[iNdEx:])
@@ -3423,28 +7756,124 @@ func (m *UserResponse) Unmarshal(dAtA []byte) error {
 			if shift >= 64 {
 				return ErrIntOverflowUser
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UserResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UserResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUser
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthUser
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthUser
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FirstName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUser
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthUser
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthUser
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FirstName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUser
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthUser
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthUser
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: UserResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UserResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.LastName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UserType", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3472,11 +7901,11 @@ func (m *UserResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Id = string(dAtA[iNdEx:postIndex])
+			m.UserType = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FirstName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Email", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3504,11 +7933,11 @@ func (m *UserResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FirstName = string(dAtA[iNdEx:postIndex])
+			m.Email = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Password", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3536,11 +7965,11 @@ func (m *UserResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.LastName = string(dAtA[iNdEx:postIndex])
+			m.Password = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UserType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AccessToken", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3568,11 +7997,11 @@ func (m *UserResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.UserType = string(dAtA[iNdEx:postIndex])
+			m.AccessToken = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Email", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RefreshToken", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3600,11 +8029,30 @@ func (m *UserResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Email = string(dAtA[iNdEx:postIndex])
+			m.RefreshToken = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Posts", wireType)
+			}
+			m.Posts = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUser
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Posts |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Password", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3632,11 +8080,11 @@ func (m *UserResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Password = string(dAtA[iNdEx:postIndex])
+			m.CreatedAt = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AccessToken", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedAt", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3664,11 +8112,11 @@ func (m *UserResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AccessToken = string(dAtA[iNdEx:postIndex])
+			m.UpdatedAt = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
+		case 12:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RefreshToken", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PurgeAfter", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3696,13 +8144,13 @@ func (m *UserResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RefreshToken = string(dAtA[iNdEx:postIndex])
+			m.PurgeAfter = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 9:
+		case 13:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Posts", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
 			}
-			m.Posts = 0
+			m.Version = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowUser
@@ -3712,14 +8160,14 @@ func (m *UserResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Posts |= int64(b&0x7F) << shift
+				m.Version |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 10:
+		case 14:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SuspendedUntil", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3747,11 +8195,11 @@ func (m *UserResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CreatedAt = string(dAtA[iNdEx:postIndex])
+			m.SuspendedUntil = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 11:
+		case 15:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SuspensionReason", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3779,7 +8227,7 @@ func (m *UserResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.UpdatedAt = string(dAtA[iNdEx:postIndex])
+			m.SuspensionReason = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3888,6 +8336,172 @@ func (m *UsersResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *NamesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowUser
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NamesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NamesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Names", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUser
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthUser
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthUser
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Names = append(m.Names, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipUser(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthUser
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *IdsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowUser
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: IdsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: IdsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ids", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUser
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthUser
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthUser
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Ids = append(m.Ids, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipUser(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthUser
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipUser(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
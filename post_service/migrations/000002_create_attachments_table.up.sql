@@ -0,0 +1,17 @@
+create table "attachments"(
+    "id" uuid,
+    "post_id" uuid,
+    "user_id" uuid,
+    "file_name" text,
+    "mime_type" text,
+    "size" bigint,
+    "storage_key" text,
+    "thumbnail_key" text,
+    "created_at" timestamp default current_timestamp,
+    "deleted_at" timestamp
+);
+
+create table "blob_deletions"(
+    "storage_key" text,
+    "delete_after" timestamp default current_timestamp
+);
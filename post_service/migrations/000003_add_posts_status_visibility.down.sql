@@ -0,0 +1,5 @@
+alter table "posts"
+    drop column if exists "status",
+    drop column if exists "publish_at",
+    drop column if exists "published_at",
+    drop column if exists "visibility";
@@ -0,0 +1,7 @@
+alter table "posts"
+    add column "status" text default 'published',
+    add column "publish_at" timestamp,
+    add column "published_at" timestamp,
+    add column "visibility" text default 'public';
+
+update "posts" set "published_at" = "created_at";
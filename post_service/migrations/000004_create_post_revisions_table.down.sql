@@ -0,0 +1,2 @@
+alter table "posts" drop column if exists "edited_at";
+DROP TABLE IF EXISTS "post_revisions";
@@ -0,0 +1,16 @@
+create table "post_revisions"(
+    "post_id" uuid,
+    "revision" bigint,
+    "title" text,
+    "description" text,
+    "editor_id" uuid,
+    "restored_from" bigint,
+    "created_at" timestamp default current_timestamp,
+    unique("post_id", "revision")
+);
+
+alter table "posts" add column "edited_at" timestamp;
+
+-- existing posts start their history from the current content
+insert into "post_revisions"("post_id", "revision", "title", "description", "editor_id", "created_at")
+select "id", 1, "title", "description", "user_id", "created_at" from "posts";
@@ -0,0 +1,14 @@
+create table "tags"(
+    "id" serial primary key,
+    "name" text not null unique,
+    "created_at" timestamp default current_timestamp
+);
+
+create table "post_tags"(
+    "post_id" uuid,
+    "tag_id" integer references "tags"("id"),
+    primary key("post_id", "tag_id")
+);
+
+create index "tags_name_prefix_idx" on "tags"("name" text_pattern_ops);
+create index "post_tags_tag_id_idx" on "post_tags"("tag_id");
@@ -0,0 +1,8 @@
+create table "post_likes"(
+    "post_id" uuid,
+    "user_id" uuid,
+    "created_at" timestamp default current_timestamp,
+    primary key("post_id", "user_id")
+);
+
+create index "post_likes_user_id_idx" on "post_likes"("user_id");
@@ -0,0 +1,6 @@
+DROP INDEX IF EXISTS "blob_deletions_delete_after_idx";
+DROP INDEX IF EXISTS "attachments_post_id_idx";
+DROP INDEX IF EXISTS "posts_user_id_idx";
+
+ALTER TABLE "attachments" DROP CONSTRAINT IF EXISTS "attachments_pkey";
+ALTER TABLE "posts" DROP CONSTRAINT IF EXISTS "posts_pkey";
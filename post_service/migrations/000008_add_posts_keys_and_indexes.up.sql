@@ -0,0 +1,9 @@
+delete from "posts" where "id" is null;
+delete from "attachments" where "id" is null;
+
+alter table "posts" add primary key ("id");
+alter table "attachments" add primary key ("id");
+
+create index "posts_user_id_idx" on "posts"("user_id", "created_at" desc);
+create index "attachments_post_id_idx" on "attachments"("post_id");
+create index "blob_deletions_delete_after_idx" on "blob_deletions"("delete_after");
@@ -0,0 +1 @@
+alter table "posts" add column "version" bigint not null default 1;
@@ -0,0 +1 @@
+alter table "posts" add column "moderation_status" text not null default 'visible';
@@ -0,0 +1,8 @@
+package migrations
+
+import "embed"
+
+// FS contains migrations which are applied at startup
+//
+//go:embed *.sql
+var FS embed.FS
@@ -0,0 +1,16 @@
+package blob
+
+import (
+	"context"
+	"errors"
+)
+
+// ErrNotFound is returned when the requested key does not exist in the store
+var ErrNotFound = errors.New("blob: not found")
+
+// Store is a pluggable storage for attachment files and their thumbnails
+type Store interface {
+	Put(ctx context.Context, key, contentType string, data []byte) error
+	Get(ctx context.Context, key string) ([]byte, error)
+	Delete(ctx context.Context, key string) error
+}
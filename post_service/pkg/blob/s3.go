@@ -0,0 +1,46 @@
+package blob
+
+import (
+	"bytes"
+	"context"
+	"io"
+)
+
+// ObjectClient is the subset of an S3 compatible API the S3Store needs.
+// HTTPClient implements it for S3/MinIO, tests can use any local stand-in.
+type ObjectClient interface {
+	PutObject(ctx context.Context, bucket, key string, body io.Reader, size int64, contentType string) error
+	GetObject(ctx context.Context, bucket, key string) (io.ReadCloser, error)
+	RemoveObject(ctx context.Context, bucket, key string) error
+}
+
+// S3Store keeps blobs in a bucket of an S3 compatible object storage
+type S3Store struct {
+	client ObjectClient
+	bucket string
+}
+
+func NewS3Store(client ObjectClient, bucket string) *S3Store {
+	return &S3Store{
+		client: client,
+		bucket: bucket,
+	}
+}
+
+func (s *S3Store) Put(ctx context.Context, key, contentType string, data []byte) error {
+	return s.client.PutObject(ctx, s.bucket, key, bytes.NewReader(data), int64(len(data)), contentType)
+}
+
+func (s *S3Store) Get(ctx context.Context, key string) ([]byte, error) {
+	body, err := s.client.GetObject(ctx, s.bucket, key)
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+
+	return io.ReadAll(body)
+}
+
+func (s *S3Store) Delete(ctx context.Context, key string) error {
+	return s.client.RemoveObject(ctx, s.bucket, key)
+}
@@ -0,0 +1,168 @@
+package blob
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// HTTPClient is a minimal S3 client (path-style urls, AWS signature v4).
+// It is enough for MinIO and most S3 compatible storages.
+type HTTPClient struct {
+	Endpoint  string // http://localhost:9000
+	Region    string
+	AccessKey string
+	SecretKey string
+	HTTP      *http.Client
+}
+
+func NewHTTPClient(endpoint, region, accessKey, secretKey string) *HTTPClient {
+	if region == "" {
+		region = "us-east-1"
+	}
+
+	return &HTTPClient{
+		Endpoint:  strings.TrimRight(endpoint, "/"),
+		Region:    region,
+		AccessKey: accessKey,
+		SecretKey: secretKey,
+		HTTP:      &http.Client{Timeout: time.Minute},
+	}
+}
+
+func (c *HTTPClient) PutObject(ctx context.Context, bucket, key string, body io.Reader, size int64, contentType string) error {
+	req, err := c.newRequest(ctx, http.MethodPut, bucket, key, body)
+	if err != nil {
+		return err
+	}
+	req.ContentLength = size
+	req.Header.Set("Content-Type", contentType)
+
+	res, err := c.do(req)
+	if err != nil {
+		return err
+	}
+
+	return res.Body.Close()
+}
+
+func (c *HTTPClient) GetObject(ctx context.Context, bucket, key string) (io.ReadCloser, error) {
+	req, err := c.newRequest(ctx, http.MethodGet, bucket, key, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := c.do(req)
+	if err != nil {
+		return nil, err
+	}
+
+	return res.Body, nil
+}
+
+func (c *HTTPClient) RemoveObject(ctx context.Context, bucket, key string) error {
+	req, err := c.newRequest(ctx, http.MethodDelete, bucket, key, nil)
+	if err != nil {
+		return err
+	}
+
+	res, err := c.do(req)
+	if err == ErrNotFound {
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	return res.Body.Close()
+}
+
+func (c *HTTPClient) newRequest(ctx context.Context, method, bucket, key string, body io.Reader) (*http.Request, error) {
+	u, err := url.Parse(c.Endpoint + "/" + bucket + "/" + key)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, u.String(), body)
+	if err != nil {
+		return nil, err
+	}
+	c.sign(req, time.Now().UTC())
+
+	return req, nil
+}
+
+func (c *HTTPClient) do(req *http.Request) (*http.Response, error) {
+	res, err := c.HTTP.Do(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if res.StatusCode == http.StatusNotFound {
+		res.Body.Close()
+		return nil, ErrNotFound
+	}
+
+	if res.StatusCode >= 300 {
+		msg, _ := io.ReadAll(io.LimitReader(res.Body, 1024))
+		res.Body.Close()
+		return nil, fmt.Errorf("blob: s3 %s %s: %s: %s", req.Method, req.URL.Path, res.Status, msg)
+	}
+
+	return res, nil
+}
+
+// sign adds AWS signature v4 headers, payload is sent unsigned
+func (c *HTTPClient) sign(req *http.Request, now time.Time) {
+	const payloadHash = "UNSIGNED-PAYLOAD"
+
+	amzDate := now.Format("20060102T150405Z")
+	day := now.Format("20060102")
+
+	req.Header.Set("Host", req.URL.Host)
+	req.Header.Set("X-Amz-Date", amzDate)
+	req.Header.Set("X-Amz-Content-Sha256", payloadHash)
+
+	signedHeaders := "host;x-amz-content-sha256;x-amz-date"
+	canonicalHeaders := "host:" + req.URL.Host + "\n" +
+		"x-amz-content-sha256:" + payloadHash + "\n" +
+		"x-amz-date:" + amzDate + "\n"
+
+	canonicalRequest := strings.Join([]string{
+		req.Method,
+		req.URL.EscapedPath(),
+		req.URL.RawQuery,
+		canonicalHeaders,
+		signedHeaders,
+		payloadHash,
+	}, "\n")
+
+	scope := day + "/" + c.Region + "/s3/aws4_request"
+	stringToSign := "AWS4-HMAC-SHA256\n" + amzDate + "\n" + scope + "\n" + hashHex([]byte(canonicalRequest))
+
+	key := hmacSHA256([]byte("AWS4"+c.SecretKey), day)
+	key = hmacSHA256(key, c.Region)
+	key = hmacSHA256(key, "s3")
+	key = hmacSHA256(key, "aws4_request")
+	signature := hex.EncodeToString(hmacSHA256(key, stringToSign))
+
+	req.Header.Set("Authorization", fmt.Sprintf("AWS4-HMAC-SHA256 Credential=%s/%s, SignedHeaders=%s, Signature=%s",
+		c.AccessKey, scope, signedHeaders, signature))
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	h := hmac.New(sha256.New, key)
+	h.Write([]byte(data))
+	return h.Sum(nil)
+}
+
+func hashHex(data []byte) string {
+	h := sha256.Sum256(data)
+	return hex.EncodeToString(h[:])
+}
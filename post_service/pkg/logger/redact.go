@@ -0,0 +1,65 @@
+package logger
+
+import (
+	"strings"
+
+	"go.uber.org/zap/zapcore"
+)
+
+// Redacted replaces values of sensitive fields
+const Redacted = "[REDACTED]"
+
+// fields with these words in their keys never reach the output
+var sensitiveWords = []string{"password", "token", "secret", "authorization"}
+
+// redactCore replaces values of sensitive fields of every entry
+type redactCore struct {
+	zapcore.Core
+}
+
+func redact(core zapcore.Core) zapcore.Core {
+	return &redactCore{Core: core}
+}
+
+func (c *redactCore) With(fields []zapcore.Field) zapcore.Core {
+	return &redactCore{Core: c.Core.With(redactFields(fields))}
+}
+
+func (c *redactCore) Check(entry zapcore.Entry, checked *zapcore.CheckedEntry) *zapcore.CheckedEntry {
+	if c.Enabled(entry.Level) {
+		return checked.AddCore(entry, c)
+	}
+	return checked
+}
+
+func (c *redactCore) Write(entry zapcore.Entry, fields []zapcore.Field) error {
+	return c.Core.Write(entry, redactFields(fields))
+}
+
+func redactFields(fields []zapcore.Field) []zapcore.Field {
+	var res []zapcore.Field
+	for i, field := range fields {
+		if !Sensitive(field.Key) {
+			continue
+		}
+		if res == nil {
+			res = append([]zapcore.Field(nil), fields...)
+		}
+		res[i] = zapcore.Field{Key: field.Key, Type: zapcore.StringType, String: Redacted}
+	}
+	if res == nil {
+		return fields
+	}
+	return res
+}
+
+// Sensitive reports whether values of key must not be logged
+func Sensitive(key string) bool {
+	key = strings.ToLower(key)
+	for _, word := range sensitiveWords {
+		if strings.Contains(key, word) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,143 @@
+package media
+
+import (
+	"bytes"
+	"errors"
+	"image"
+	"image/jpeg"
+	"net/http"
+	"strings"
+
+	_ "image/gif" // image decoders
+	_ "image/png"
+)
+
+const (
+	// ThumbnailSize is max width and height of generated thumbnails
+	ThumbnailSize = 256
+
+	// MaxImagePixels is max width×height of images thumbnails are generated
+	// for, small files may declare huge images which take gigabytes decoded
+	MaxImagePixels = 50 * 1000 * 1000
+)
+
+var (
+	ErrEmptyFile       = errors.New("media: empty file")
+	ErrTooLarge        = errors.New("media: file is too large")
+	ErrUnsupportedType = errors.New("media: unsupported file type")
+	ErrImageTooLarge   = errors.New("media: image dimensions are too large")
+
+	// allowed mime types for post attachments
+	allowedTypes = map[string]bool{
+		"image/jpeg":      true,
+		"image/png":       true,
+		"image/gif":       true,
+		"image/webp":      true,
+		"application/pdf": true,
+		"application/zip": true,
+		"text/plain":      true,
+		"video/mp4":       true,
+	}
+)
+
+// Sniff detects mime type by file content (never trusts file name or client header)
+// and checks it against size limit and allowed types
+func Sniff(data []byte, maxSize int64) (string, error) {
+	if len(data) == 0 {
+		return "", ErrEmptyFile
+	}
+
+	if maxSize > 0 && int64(len(data)) > maxSize {
+		return "", ErrTooLarge
+	}
+
+	mimeType := http.DetectContentType(data)
+	// drop parameters like "; charset=utf-8"
+	if i := strings.Index(mimeType, ";"); i >= 0 {
+		mimeType = strings.TrimSpace(mimeType[:i])
+	}
+
+	if !allowedTypes[mimeType] {
+		return "", ErrUnsupportedType
+	}
+
+	return mimeType, nil
+}
+
+// IsImage reports whether a thumbnail can be generated for mime type
+func IsImage(mimeType string) bool {
+	return mimeType == "image/jpeg" || mimeType == "image/png" || mimeType == "image/gif"
+}
+
+// Thumbnail scales image down to fit ThumbnailSize and encodes it as jpeg
+func Thumbnail(data []byte) ([]byte, error) {
+	cfg, _, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+	if cfg.Width <= 0 || cfg.Height <= 0 || int64(cfg.Width)*int64(cfg.Height) > MaxImagePixels {
+		return nil, ErrImageTooLarge
+	}
+
+	src, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+
+	dst := resize(src, ThumbnailSize)
+
+	var buf bytes.Buffer
+	if err := jpeg.Encode(&buf, dst, &jpeg.Options{Quality: 80}); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+// resize keeps aspect ratio, every destination pixel is an average of source pixels it covers
+func resize(src image.Image, max int) image.Image {
+	b := src.Bounds()
+	w, h := b.Dx(), b.Dy()
+	if w <= max && h <= max {
+		return src
+	}
+
+	dw, dh := max, h*max/w
+	if h > w {
+		dw, dh = w*max/h, max
+	}
+	if dw < 1 {
+		dw = 1
+	}
+	if dh < 1 {
+		dh = 1
+	}
+
+	dst := image.NewRGBA(image.Rect(0, 0, dw, dh))
+	for y := 0; y < dh; y++ {
+		sy0, sy1 := b.Min.Y+y*h/dh, b.Min.Y+(y+1)*h/dh
+		for x := 0; x < dw; x++ {
+			sx0, sx1 := b.Min.X+x*w/dw, b.Min.X+(x+1)*w/dw
+
+			var r, g, bl, a, n uint64
+			for sy := sy0; sy < sy1; sy++ {
+				for sx := sx0; sx < sx1; sx++ {
+					cr, cg, cb, ca := src.At(sx, sy).RGBA()
+					r, g, bl, a = r+uint64(cr), g+uint64(cg), bl+uint64(cb), a+uint64(ca)
+					n++
+				}
+			}
+			if n == 0 {
+				continue
+			}
+
+			i := dst.PixOffset(x, y)
+			dst.Pix[i+0] = uint8(r / n >> 8)
+			dst.Pix[i+1] = uint8(g / n >> 8)
+			dst.Pix[i+2] = uint8(bl / n >> 8)
+			dst.Pix[i+3] = uint8(a / n >> 8)
+		}
+	}
+
+	return dst
+}
@@ -0,0 +1,70 @@
+package tags
+
+import (
+	"regexp"
+	"sort"
+	"strings"
+	"unicode"
+)
+
+const (
+	MaxLength  = 50 // in runes
+	MaxPerPost = 20
+)
+
+var hashtagRegexp = regexp.MustCompile(`(?:^|[^\p{L}\p{N}_&])#([\p{L}\p{N}_]+)`)
+
+// Normalize lowercases the tag and removes leading '#' and every character
+// except letters, digits and underscore. Empty string is returned for invalid tag.
+func Normalize(tag string) string {
+	tag = strings.TrimLeft(strings.TrimSpace(tag), "#")
+
+	var b strings.Builder
+	n := 0
+	for _, r := range strings.ToLower(tag) {
+		if !unicode.IsLetter(r) && !unicode.IsDigit(r) && r != '_' {
+			continue
+		}
+		if n == MaxLength {
+			break
+		}
+
+		b.WriteRune(r)
+		n++
+	}
+
+	return b.String()
+}
+
+// Hashtags returns tags written in the text like #golang
+func Hashtags(text string) []string {
+	res := []string{}
+	for _, match := range hashtagRegexp.FindAllStringSubmatch(text, -1) {
+		res = append(res, match[1])
+	}
+
+	return res
+}
+
+// Merge normalizes explicit tags and hashtags of the description and removes duplicates.
+// At most MaxPerPost tags are returned sorted, explicit tags are kept first when there are more.
+func Merge(explicit []string, description string) []string {
+	seen := map[string]bool{}
+	res := []string{}
+	for _, tag := range append(append([]string{}, explicit...), Hashtags(description)...) {
+		tag = Normalize(tag)
+		if tag == "" || seen[tag] {
+			continue
+		}
+		if len(res) == MaxPerPost {
+			break
+		}
+
+		seen[tag] = true
+		res = append(res, tag)
+	}
+
+	sort.Strings(res)
+
+	return res
+}
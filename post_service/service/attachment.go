@@ -0,0 +1,218 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"path"
+	"time"
+
+	p "github.com/burxondv/new-services/post-service/genproto/post"
+	"github.com/burxondv/new-services/post-service/pkg/identity"
+	"github.com/burxondv/new-services/post-service/pkg/logger"
+	"github.com/burxondv/new-services/post-service/pkg/media"
+	"github.com/burxondv/new-services/post-service/storage/repo"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *PostService) UploadAttachment(ctx context.Context, req *p.AttachmentRequest) (*p.AttachmentResponse, error) {
+	mimeType, err := media.Sniff(req.Data, s.cfg.MaxAttachmentSize)
+	if err != nil {
+		s.reqLog(ctx).Error("failed to check attachment", logger.Error(err))
+		return &p.AttachmentResponse{}, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	post, err := s.storage.Post().GetPostById(ctx, req.PostId)
+	if err == sql.ErrNoRows {
+		return &p.AttachmentResponse{}, status.Error(codes.NotFound, "post not found")
+	} else if err != nil {
+		s.reqLog(ctx).Error("failed to get post for upload attachment", logger.Error(err))
+		return &p.AttachmentResponse{}, err
+	}
+
+	// the uploader is the signed caller, not the user id of the request
+	caller, err := checkAuthor(ctx, post.UserId)
+	if err != nil {
+		return &p.AttachmentResponse{}, err
+	}
+	if _, err := s.checkAccount(ctx, caller.Subject); err != nil {
+		return &p.AttachmentResponse{}, err
+	}
+
+	att := repo.Attachment{
+		Id:         req.Id,
+		PostId:     req.PostId,
+		UserId:     caller.Subject,
+		FileName:   path.Base(req.FileName),
+		MimeType:   mimeType,
+		Size:       int64(len(req.Data)),
+		StorageKey: fmt.Sprintf("attachments/%s/%s", req.PostId, req.Id),
+	}
+
+	err = s.blob.Put(ctx, att.StorageKey, att.MimeType, req.Data)
+	if err != nil {
+		s.reqLog(ctx).Error("failed to put attachment to blob storage", logger.Error(err))
+		return &p.AttachmentResponse{}, err
+	}
+
+	if media.IsImage(mimeType) {
+		thumb, err := media.Thumbnail(req.Data)
+		if err != nil {
+			// broken image is still stored, just without thumbnail
+			s.reqLog(ctx).Error("failed to generate thumbnail", logger.Error(err))
+		} else {
+			key := fmt.Sprintf("thumbnails/%s/%s.jpg", req.PostId, req.Id)
+			if err := s.blob.Put(ctx, key, "image/jpeg", thumb); err != nil {
+				s.reqLog(ctx).Error("failed to put thumbnail to blob storage", logger.Error(err))
+			} else {
+				att.ThumbnailKey = key
+			}
+		}
+	}
+
+	res, err := s.storage.Attachment().CreateAttachment(ctx, att)
+	if err != nil {
+		s.reqLog(ctx).Error("failed to create attachment in service", logger.Error(err))
+		s.removeBlobs(ctx, att)
+		return &p.AttachmentResponse{}, err
+	}
+
+	return attachmentResponse(res), nil
+}
+
+func (s *PostService) GetAttachments(ctx context.Context, req *p.Request) (*p.AttachmentsResponse, error) {
+	if err := s.checkAttachmentsVisible(ctx, req.Str); err != nil {
+		return &p.AttachmentsResponse{}, err
+	}
+
+	attsResp := p.AttachmentsResponse{}
+	res, err := s.storage.Attachment().GetAttachments(ctx, req.Str)
+	if err != nil {
+		s.reqLog(ctx).Error("failed to get attachments in service", logger.Error(err))
+		return &p.AttachmentsResponse{}, err
+	}
+
+	for _, att := range res {
+		attsResp.Attachments = append(attsResp.Attachments, attachmentResponse(att))
+	}
+
+	return &attsResp, nil
+}
+
+func (s *PostService) GetAttachmentContent(ctx context.Context, req *p.AttachmentContentRequest) (*p.AttachmentContent, error) {
+	att, err := s.storage.Attachment().GetAttachment(ctx, req.Id)
+	if err == sql.ErrNoRows {
+		return &p.AttachmentContent{}, status.Error(codes.NotFound, "attachment not found")
+	} else if err != nil {
+		s.reqLog(ctx).Error("failed to get attachment in service", logger.Error(err))
+		return &p.AttachmentContent{}, err
+	}
+	if err := s.checkAttachmentsVisible(ctx, att.PostId); err != nil {
+		return &p.AttachmentContent{}, err
+	}
+
+	key, mimeType, fileName := att.StorageKey, att.MimeType, att.FileName
+	if req.Thumbnail {
+		if att.ThumbnailKey == "" {
+			return &p.AttachmentContent{}, status.Error(codes.NotFound, "attachment has no thumbnail")
+		}
+		key, mimeType, fileName = att.ThumbnailKey, "image/jpeg", "thumbnail_"+att.FileName+".jpg"
+	}
+
+	data, err := s.blob.Get(ctx, key)
+	if err != nil {
+		s.reqLog(ctx).Error("failed to get attachment from blob storage", logger.Error(err))
+		return &p.AttachmentContent{}, err
+	}
+
+	return &p.AttachmentContent{
+		FileName: fileName,
+		MimeType: mimeType,
+		Data:     data,
+	}, nil
+}
+
+func (s *PostService) DeleteAttachment(ctx context.Context, req *p.Request) (*p.AttachmentResponse, error) {
+	att, err := s.storage.Attachment().GetAttachment(ctx, req.Str)
+	if err == sql.ErrNoRows {
+		return &p.AttachmentResponse{}, status.Error(codes.NotFound, "attachment not found")
+	} else if err != nil {
+		s.reqLog(ctx).Error("failed to get attachment for delete attachment", logger.Error(err))
+		return &p.AttachmentResponse{}, err
+	}
+
+	post, err := s.storage.Post().GetPostById(ctx, att.PostId)
+	if err != nil && err != sql.ErrNoRows {
+		s.reqLog(ctx).Error("failed to get post for delete attachment", logger.Error(err))
+		return &p.AttachmentResponse{}, err
+	}
+	// files of deleted posts belong to the uploader
+	authorId := att.UserId
+	if err == nil {
+		authorId = post.UserId
+	}
+	if _, err := checkAuthor(ctx, authorId); err != nil {
+		return &p.AttachmentResponse{}, err
+	}
+
+	deleteAfter := time.Now().Add(time.Duration(s.cfg.BlobDeleteDelay) * time.Second)
+	res, err := s.storage.Attachment().DeleteAttachment(ctx, req.Str, deleteAfter)
+	if err == sql.ErrNoRows {
+		return &p.AttachmentResponse{}, status.Error(codes.NotFound, "attachment not found")
+	} else if err != nil {
+		s.reqLog(ctx).Error("failed to delete attachment in service", logger.Error(err))
+		return &p.AttachmentResponse{}, err
+	}
+
+	return attachmentResponse(res), nil
+}
+
+// checkAttachmentsVisible returns NotFound when the caller can't see the post,
+// so files of drafts, scheduled and private posts are not served to others
+func (s *PostService) checkAttachmentsVisible(ctx context.Context, postId string) error {
+	post, err := s.storage.Post().GetPostById(ctx, postId)
+	if err == sql.ErrNoRows {
+		return status.Error(codes.NotFound, "post not found")
+	} else if err != nil {
+		s.reqLog(ctx).Error("failed to get post for attachments", logger.Error(err))
+		return err
+	}
+
+	caller, _ := identity.FromContext(ctx)
+	ok, err := newVisibility(ctx, s.Client, caller.Subject).canView(post)
+	if err != nil {
+		s.reqLog(ctx).Error("failed to check visibility for attachments", logger.Error(err))
+		return err
+	}
+	if !ok {
+		return status.Error(codes.NotFound, "post not found")
+	}
+
+	return nil
+}
+
+func (s *PostService) removeBlobs(ctx context.Context, att repo.Attachment) {
+	for _, key := range []string{att.StorageKey, att.ThumbnailKey} {
+		if key == "" {
+			continue
+		}
+
+		if err := s.blob.Delete(ctx, key); err != nil {
+			s.reqLog(ctx).Error("failed to remove blob", logger.Error(err))
+		}
+	}
+}
+
+func attachmentResponse(att repo.Attachment) *p.AttachmentResponse {
+	return &p.AttachmentResponse{
+		Id:           att.Id,
+		PostId:       att.PostId,
+		FileName:     att.FileName,
+		MimeType:     att.MimeType,
+		FileSize:     att.Size,
+		HasThumbnail: att.ThumbnailKey != "",
+		CreatedAt:    att.CreatedAt,
+	}
+}
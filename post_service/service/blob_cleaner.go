@@ -0,0 +1,44 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/burxondv/new-services/post-service/pkg/logger"
+)
+
+// RunBlobCleaner removes blobs of deleted posts and attachments once their delay is over.
+// It blocks until ctx is done, so run it in a goroutine.
+func (s *PostService) RunBlobCleaner(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			s.cleanBlobs(ctx)
+		}
+	}
+}
+
+func (s *PostService) cleanBlobs(ctx context.Context) {
+	keys, err := s.storage.Attachment().GetDueBlobDeletions(ctx, 100)
+	if err != nil {
+		s.Logger.Error("blob cleaner: failed to get due deletions", logger.Error(err))
+		return
+	}
+
+	for _, key := range keys {
+		if err := s.blob.Delete(ctx, key); err != nil {
+			// keep the row, it is retried on next tick
+			s.Logger.Error("blob cleaner: failed to delete blob", logger.String("key", key), logger.Error(err))
+			continue
+		}
+
+		if err := s.storage.Attachment().RemoveBlobDeletion(ctx, key); err != nil {
+			s.Logger.Error("blob cleaner: failed to remove deletion", logger.String("key", key), logger.Error(err))
+		}
+	}
+}
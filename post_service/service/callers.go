@@ -0,0 +1,12 @@
+package service
+
+// InternalCallers are the services allowed to call internal methods, the
+// gateway can't call them
+var InternalCallers = map[string][]string{
+	"/post.PostService/GetPostForUser":     {"user_service"},
+	"/post.PostService/GetLikesByUser":     {"user_service"},
+	"/post.PostService/GetPostForComment":  {"comment_service"},
+	"/post.PostService/GetPostsByIds":      {"comment_service", "moderation_service"},
+	"/post.PostService/CountPostsForUsers": {"user_service"},
+	"/post.PostService/ModeratePost":       {"moderation_service"},
+}
@@ -0,0 +1,105 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/burxondv/new-services/post-service/pkg/events"
+	"github.com/burxondv/new-services/post-service/pkg/logger"
+)
+
+// consumerGroup is the group of all post service replicas in the event bus
+const consumerGroup = "post_service"
+
+// RunOutboxRelay publishes events written to outbox, it is safe to run in
+// every replica, see RelayEvents
+func (s *PostService) RunOutboxRelay(ctx context.Context, bus events.Bus, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			_, err := s.storage.Outbox().RelayEvents(ctx, 100, func(event events.Event) error {
+				return bus.Publish(ctx, event)
+			})
+			if err != nil {
+				s.Logger.Error("outbox: failed to relay events", logger.Error(err))
+			}
+		}
+	}
+}
+
+// RunConsumer handles events of other services until ctx is done, consumer
+// must be unique among replicas
+func (s *PostService) RunConsumer(ctx context.Context, bus events.Bus, consumer string) {
+	for ctx.Err() == nil {
+		err := bus.Subscribe(ctx, consumerGroup, consumer, s.HandleEvent)
+		if err != nil && ctx.Err() == nil {
+			s.Logger.Error("consumer: subscription is broken", logger.Error(err))
+			time.Sleep(time.Second)
+		}
+	}
+}
+
+// HandleEvent deletes posts of deleted users and erases them when users are
+// purged, shadow bans of users are saved to hide their posts
+func (s *PostService) HandleEvent(ctx context.Context, event events.Event) error {
+	switch event.Type {
+	case events.UserDeleted:
+		var user events.User
+		if err := event.Decode(&user); err != nil {
+			s.Logger.Error("consumer: failed to decode event", logger.String("event_id", event.Id), logger.Error(err))
+			return nil
+		}
+
+		posts, err := s.storage.Post().DeleteUserPosts(ctx, event.Id, user.Id)
+		if err != nil {
+			return err
+		}
+
+		deleteAfter := time.Now().Add(time.Duration(s.cfg.BlobDeleteDelay) * time.Second)
+		for _, post := range posts {
+			err = s.storage.Attachment().ScheduleBlobDeletion(ctx, post.Id, deleteAfter)
+			if err != nil {
+				s.Logger.Error("consumer: failed to schedule attachments deletion", logger.String("post_id", post.Id), logger.Error(err))
+			}
+		}
+		s.Logger.Info("consumer: posts of deleted user are deleted", logger.String("user_id", user.Id), logger.Int("posts", len(posts)))
+	case events.UserPurged:
+		var user events.User
+		if err := event.Decode(&user); err != nil {
+			s.Logger.Error("consumer: failed to decode event", logger.String("event_id", event.Id), logger.Error(err))
+			return nil
+		}
+
+		posts, err := s.storage.Post().PurgeUserPosts(ctx, event.Id, user.Id)
+		if err != nil {
+			return err
+		}
+		s.Logger.Info("consumer: posts of purged user are erased", logger.String("user_id", user.Id), logger.Int("posts", len(posts)))
+	case events.UserRestricted:
+		var restriction events.Restriction
+		if err := event.Decode(&restriction); err != nil {
+			s.Logger.Error("consumer: failed to decode event", logger.String("event_id", event.Id), logger.Error(err))
+			return nil
+		}
+
+		var until time.Time
+		if restriction.ShadowBannedUntil != "" {
+			var err error
+			until, err = time.Parse(time.RFC3339, restriction.ShadowBannedUntil)
+			if err != nil {
+				s.Logger.Error("consumer: failed to parse shadow ban time", logger.String("event_id", event.Id), logger.Error(err))
+				return nil
+			}
+		}
+
+		_, err := s.storage.Post().SetShadowBan(ctx, event.Id, restriction.Id, restriction.ShadowBanned, until.UTC())
+		return err
+	}
+
+	return nil
+}
@@ -5,42 +5,57 @@ import (
 
 	"github.com/burxondv/new-services/post-service/config"
 	cc "github.com/burxondv/new-services/post-service/genproto/comment"
+	cn "github.com/burxondv/new-services/post-service/genproto/notification"
 	cu "github.com/burxondv/new-services/post-service/genproto/user"
+	"github.com/burxondv/new-services/post-service/pkg/identity"
+	"github.com/burxondv/new-services/post-service/pkg/mtls"
+	"github.com/burxondv/new-services/post-service/pkg/rpcclient"
 
 	"google.golang.org/grpc"
-	"google.golang.org/grpc/credentials/insecure"
 )
 
 type Clients interface {
 	User() cu.UserServiceClient
 	Comment() cc.CommentServiceClient
+	Notification() cn.NotificationServiceClient
 }
 
 type ServiceManager struct {
-	Config         config.Config
-	userService    cu.UserServiceClient
-	commentService cc.CommentServiceClient
+	Config              config.Config
+	userService         cu.UserServiceClient
+	commentService      cc.CommentServiceClient
+	notificationService cn.NotificationServiceClient
+	conns               []*grpc.ClientConn
 }
 
-func New(cfg config.Config) (*ServiceManager, error) {
-	connUser, err := grpc.Dial(
-		fmt.Sprintf("%s:%s", cfg.UserServiceHost, cfg.UserServicePort),
-		grpc.WithTransportCredentials(insecure.NewCredentials()))
+func New(cfg config.Config, certs *mtls.Certs, signer *identity.Signer) (*ServiceManager, error) {
+	// the end user of a call is sent to the called service
+	opts := []grpc.DialOption{
+		grpc.WithChainUnaryInterceptor(signer.UnaryClientInterceptor()),
+		grpc.WithChainStreamInterceptor(signer.StreamClientInterceptor()),
+	}
+
+	connUser, err := rpcclient.Dial("post_service", "user_service", cfg.UserServiceHost, cfg.UserServicePort, certs, opts...)
 	if err != nil {
 		return nil, fmt.Errorf("user service dial host:%s, port:%s", cfg.UserServiceHost, cfg.UserServicePort)
 	}
 
-	connComment, err := grpc.Dial(
-		fmt.Sprintf("%s:%s", cfg.CommentServiceHost, cfg.CommentServicePort),
-		grpc.WithTransportCredentials(insecure.NewCredentials()))
+	connComment, err := rpcclient.Dial("post_service", "comment_service", cfg.CommentServiceHost, cfg.CommentServicePort, certs, opts...)
 	if err != nil {
 		return nil, fmt.Errorf("comment service dial host:%s, port:%s", cfg.CommentServiceHost, cfg.CommentServicePort)
 	}
 
+	connNotification, err := rpcclient.Dial("post_service", "notification_service", cfg.NotificationServiceHost, cfg.NotificationServicePort, certs, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("notification service dial host:%s, port:%s", cfg.NotificationServiceHost, cfg.NotificationServicePort)
+	}
+
 	return &ServiceManager{
-		Config:         cfg,
-		userService:    cu.NewUserServiceClient(connUser),
-		commentService: cc.NewCommentServiceClient(connComment),
+		Config:              cfg,
+		userService:         cu.NewUserServiceClient(connUser),
+		commentService:      cc.NewCommentServiceClient(connComment),
+		notificationService: cn.NewNotificationServiceClient(connNotification),
+		conns:               []*grpc.ClientConn{connUser, connComment, connNotification},
 	}, nil
 }
 
@@ -51,3 +66,18 @@ func (s *ServiceManager) User() cu.UserServiceClient {
 func (s *ServiceManager) Comment() cc.CommentServiceClient {
 	return s.commentService
 }
+
+func (s *ServiceManager) Notification() cn.NotificationServiceClient {
+	return s.notificationService
+}
+
+// Close closes connections to all services
+func (s *ServiceManager) Close() error {
+	var res error
+	for _, conn := range s.conns {
+		if err := conn.Close(); err != nil {
+			res = err
+		}
+	}
+	return res
+}
@@ -0,0 +1,80 @@
+package service
+
+import (
+	"context"
+
+	c "github.com/burxondv/new-services/post-service/genproto/comment"
+	p "github.com/burxondv/new-services/post-service/genproto/post"
+	u "github.com/burxondv/new-services/post-service/genproto/user"
+	"github.com/burxondv/new-services/post-service/pkg/loader"
+	"github.com/burxondv/new-services/post-service/pkg/logger"
+	"github.com/burxondv/new-services/post-service/pkg/rpcclient"
+)
+
+// userLoader gets users with one GetUsersByIds call, create it per request
+func (s *PostService) userLoader() *loader.Loader[string, *u.UserResponse] {
+	return loader.New(func(ctx context.Context, ids []string) (map[string]*u.UserResponse, error) {
+		res, err := s.Client.User().GetUsersByIds(ctx, &u.IdsRequest{Ids: ids})
+		if err != nil {
+			return nil, err
+		}
+
+		users := map[string]*u.UserResponse{}
+		for _, user := range res.Users {
+			users[user.Id] = user
+		}
+
+		return users, nil
+	})
+}
+
+// commentCountLoader counts comments with one CountCommentsForPosts call,
+// create it per request
+func (s *PostService) commentCountLoader() *loader.Loader[string, int64] {
+	return loader.New(func(ctx context.Context, ids []string) (map[string]int64, error) {
+		res, err := s.Client.Comment().CountCommentsForPosts(ctx, &c.IdsRequest{Ids: ids})
+		if err != nil {
+			return nil, err
+		}
+
+		return res.Counts, nil
+	})
+}
+
+// fillAuthors sets author names and comment counts of the posts with two
+// calls for any number of posts
+func (s *PostService) fillAuthors(ctx context.Context, posts ...*p.PostResponse) error {
+	if len(posts) == 0 {
+		return nil
+	}
+
+	userIds := []string{}
+	postIds := []string{}
+	for _, post := range posts {
+		userIds = append(userIds, post.UserId)
+		postIds = append(postIds, post.Id)
+	}
+
+	users, err := s.userLoader().LoadMany(ctx, userIds)
+	if err != nil {
+		return err
+	}
+
+	// posts are still useful without comment counts, they are left zero
+	// while comment_service is down
+	counts, err := s.commentCountLoader().LoadMany(ctx, postIds)
+	if rpcclient.IsUnavailable(err) {
+		s.reqLog(ctx).Warn("comment counts are skipped, comment service is unavailable", logger.Error(err))
+	} else if err != nil {
+		return err
+	}
+
+	for _, post := range posts {
+		if user, ok := users[post.UserId]; ok {
+			post.UserName = user.FirstName + " " + user.LastName
+		}
+		post.Comments = counts[post.Id]
+	}
+
+	return nil
+}
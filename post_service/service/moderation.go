@@ -0,0 +1,65 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+
+	p "github.com/burxondv/new-services/post-service/genproto/post"
+	"github.com/burxondv/new-services/post-service/pkg/logger"
+	"github.com/burxondv/new-services/post-service/pkg/moderation"
+	"github.com/burxondv/new-services/post-service/storage/repo"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// classify checks title and description of created or updated post, rejected
+// post is not saved and held post is shown only to its author until it is
+// reviewed by moderators
+func (s *PostService) classify(ctx context.Context, post *repo.Post) error {
+	res, err := s.classifier.Classify(ctx, post.Title+"\n"+post.Description)
+	if err != nil {
+		s.reqLog(ctx).Error("failed to classify post", logger.Error(err))
+		return err
+	}
+
+	switch res.Verdict {
+	case moderation.Reject:
+		return status.Errorf(codes.InvalidArgument, "post violates content rules: %s", strings.Join(res.Labels, ", "))
+	case moderation.Hold:
+		post.ModerationStatus = repo.ModerationHeld
+		post.ModerationLabels = res.Labels
+	}
+
+	return nil
+}
+
+// ModeratePost applies decision of moderator: approve makes the post visible,
+// hide shows it only to its author and remove deletes it
+func (s *PostService) ModeratePost(ctx context.Context, req *p.ModerateRequest) (*p.PostResponse, error) {
+	var moderationStatus string
+	switch req.Action {
+	case "approve":
+		moderationStatus = repo.ModerationVisible
+	case "hide":
+		moderationStatus = repo.ModerationHidden
+	case "remove":
+		if _, err := s.storage.Post().GetPostById(ctx, req.Id); err == sql.ErrNoRows {
+			return &p.PostResponse{}, status.Error(codes.NotFound, "post not found")
+		}
+		return s.deletePost(ctx, req.Id)
+	default:
+		return &p.PostResponse{}, status.Errorf(codes.InvalidArgument, "unknown moderation action %q", req.Action)
+	}
+
+	res, err := s.storage.Post().ModeratePost(ctx, req.Id, moderationStatus)
+	if err == sql.ErrNoRows {
+		return &p.PostResponse{}, status.Error(codes.NotFound, "post not found")
+	} else if err != nil {
+		s.reqLog(ctx).Error("failed to moderate post", logger.Error(err))
+		return &p.PostResponse{}, err
+	}
+
+	return postResponse(res), nil
+}
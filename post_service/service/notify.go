@@ -0,0 +1,29 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	n "github.com/burxondv/new-services/post-service/genproto/notification"
+	"github.com/burxondv/new-services/post-service/pkg/logger"
+	"github.com/burxondv/new-services/post-service/pkg/requestid"
+
+	"go.opentelemetry.io/otel/trace"
+)
+
+// notify sends notification in background, failed notification doesn't fail the request.
+// It stays in the trace and the request of ctx but is not canceled with it.
+func (s *PostService) notify(ctx context.Context, req *n.NotifyRequest) {
+	spanCtx := trace.SpanContextFromContext(ctx)
+	reqId := requestid.FromContext(ctx)
+	go func() {
+		ctx := requestid.With(trace.ContextWithSpanContext(context.Background(), spanCtx), reqId)
+		ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+		defer cancel()
+
+		_, err := s.Client.Notification().Notify(ctx, req)
+		if err != nil {
+			s.reqLog(ctx).Error("failed to send notification", logger.String("type", req.Type), logger.Error(err))
+		}
+	}()
+}
@@ -0,0 +1,155 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+
+	p "github.com/burxondv/new-services/post-service/genproto/post"
+	u "github.com/burxondv/new-services/post-service/genproto/user"
+	"github.com/burxondv/new-services/post-service/pkg/diff"
+	"github.com/burxondv/new-services/post-service/pkg/identity"
+	"github.com/burxondv/new-services/post-service/pkg/logger"
+	"github.com/burxondv/new-services/post-service/storage/repo"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *PostService) GetRevisions(ctx context.Context, req *p.RevisionsRequest) (*p.RevisionsResponse, error) {
+	if _, err := s.checkRevisionAccess(ctx, req.PostId, false); err != nil {
+		return &p.RevisionsResponse{}, err
+	}
+
+	res, err := s.storage.Post().GetRevisions(ctx, req.PostId)
+	if err != nil {
+		s.reqLog(ctx).Error("failed to get revisions", logger.Error(err))
+		return &p.RevisionsResponse{}, err
+	}
+
+	revsResp := p.RevisionsResponse{}
+	for _, rev := range res {
+		revsResp.Revisions = append(revsResp.Revisions, revisionResponse(rev))
+	}
+
+	return &revsResp, nil
+}
+
+func (s *PostService) DiffRevisions(ctx context.Context, req *p.DiffRevisionsRequest) (*p.DiffRevisionsResponse, error) {
+	if _, err := s.checkRevisionAccess(ctx, req.PostId, false); err != nil {
+		return &p.DiffRevisionsResponse{}, err
+	}
+
+	from, err := s.getRevision(ctx, req.PostId, req.From)
+	if err != nil {
+		return &p.DiffRevisionsResponse{}, err
+	}
+
+	to, err := s.getRevision(ctx, req.PostId, req.To)
+	if err != nil {
+		return &p.DiffRevisionsResponse{}, err
+	}
+
+	return &p.DiffRevisionsResponse{
+		From:        from.Revision,
+		To:          to.Revision,
+		Title:       diffLines(from.Title, to.Title),
+		Description: diffLines(from.Description, to.Description),
+	}, nil
+}
+
+func (s *PostService) RestoreRevision(ctx context.Context, req *p.RestoreRevisionRequest) (*p.PostResponse, error) {
+	caller, err := s.checkRevisionAccess(ctx, req.PostId, true)
+	if err != nil {
+		return &p.PostResponse{}, err
+	}
+	if _, err := s.checkAccount(ctx, caller.Subject); err != nil {
+		return &p.PostResponse{}, err
+	}
+
+	res, err := s.storage.Post().RestoreRevision(ctx, req.PostId, req.Revision, caller.Subject)
+	if err == sql.ErrNoRows {
+		return &p.PostResponse{}, status.Error(codes.NotFound, "revision not found")
+	} else if err != nil {
+		s.reqLog(ctx).Error("failed to restore revision", logger.Error(err))
+		return &p.PostResponse{}, err
+	}
+
+	user, err := s.Client.User().GetUserForClient(ctx, &u.Request{Str: res.UserId})
+	if err != nil {
+		s.reqLog(ctx).Error("failed to get user for restore revision", logger.Error(err))
+		return &p.PostResponse{}, err
+	}
+
+	postResp := postResponse(res)
+	postResp.UserName = user.FirstName + " " + user.LastName
+
+	// hashtags of restored description are added to current tags
+	if err := s.fillTags(ctx, postResp); err != nil {
+		return &p.PostResponse{}, err
+	}
+	if err := s.setTags(ctx, postResp, postResp.Tags); err != nil {
+		return &p.PostResponse{}, err
+	}
+
+	return postResp, nil
+}
+
+// checkRevisionAccess allows the author, moderators and admins to see
+// revisions, only the author and admins can restore them. The caller is the
+// identity signed by the gateway.
+func (s *PostService) checkRevisionAccess(ctx context.Context, postId string, restore bool) (identity.Identity, error) {
+	post, err := s.storage.Post().GetPostById(ctx, postId)
+	if err == sql.ErrNoRows {
+		return identity.Identity{}, status.Error(codes.NotFound, "post not found")
+	} else if err != nil {
+		s.reqLog(ctx).Error("failed to get post for revisions", logger.Error(err))
+		return identity.Identity{}, err
+	}
+
+	if restore {
+		return checkAuthor(ctx, post.UserId)
+	}
+
+	caller, ok := identity.FromContext(ctx)
+	if !ok {
+		return identity.Identity{}, status.Error(codes.Unauthenticated, "caller is unknown")
+	}
+	if caller.Role == "user" && caller.Subject != post.UserId {
+		return caller, status.Error(codes.PermissionDenied, "only author can see revisions of the post")
+	}
+
+	return caller, nil
+}
+
+func (s *PostService) getRevision(ctx context.Context, postId string, revision int64) (repo.Revision, error) {
+	res, err := s.storage.Post().GetRevision(ctx, postId, revision)
+	if err == sql.ErrNoRows {
+		return repo.Revision{}, status.Errorf(codes.NotFound, "revision %d not found", revision)
+	} else if err != nil {
+		s.reqLog(ctx).Error("failed to get revision", logger.Error(err))
+		return repo.Revision{}, err
+	}
+
+	return res, nil
+}
+
+func diffLines(a, b string) []*p.DiffLine {
+	res := []*p.DiffLine{}
+	for _, line := range diff.Lines(a, b) {
+		res = append(res, &p.DiffLine{Op: line.Op, Text: line.Text})
+	}
+
+	return res
+}
+
+func revisionResponse(rev repo.Revision) *p.RevisionResponse {
+	return &p.RevisionResponse{
+		PostId:       rev.PostId,
+		Revision:     rev.Revision,
+		Title:        rev.Title,
+		Description:  rev.Description,
+		EditorId:     rev.EditorId,
+		RestoredFrom: rev.RestoredFrom,
+		CreatedAt:    rev.CreatedAt,
+	}
+}
@@ -0,0 +1,43 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	n "github.com/burxondv/new-services/post-service/genproto/notification"
+	"github.com/burxondv/new-services/post-service/pkg/logger"
+	"github.com/burxondv/new-services/post-service/storage/repo"
+)
+
+// RunScheduler publishes scheduled posts when their publish time comes.
+// It is safe to run in every replica, see PublishScheduledPosts.
+func (s *PostService) RunScheduler(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			posts, err := s.storage.Post().PublishScheduledPosts(ctx, 100)
+			if err != nil {
+				s.Logger.Error("scheduler: failed to publish scheduled posts", logger.Error(err))
+				continue
+			}
+
+			for _, post := range posts {
+				s.Logger.Info("scheduler: post published", logger.String("post_id", post.Id))
+				if post.ModerationStatus != repo.ModerationVisible || post.AuthorShadowBanned {
+					continue
+				}
+				s.notify(ctx, &n.NotifyRequest{
+					Type:    "mention",
+					ActorId: post.UserId,
+					PostId:  post.Id,
+					Text:    post.Description,
+				})
+			}
+		}
+	}
+}
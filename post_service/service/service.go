@@ -2,134 +2,177 @@ package service
 
 import (
 	"context"
-	"log"
+	"database/sql"
+	"time"
 
+	"github.com/google/uuid"
 	"github.com/jmoiron/sqlx"
 
-	c "github.com/burxondv/new-services/post-service/genproto/comment"
+	"github.com/burxondv/new-services/post-service/config"
+	n "github.com/burxondv/new-services/post-service/genproto/notification"
 	p "github.com/burxondv/new-services/post-service/genproto/post"
 	u "github.com/burxondv/new-services/post-service/genproto/user"
+	"github.com/burxondv/new-services/post-service/pkg/blob"
 	"github.com/burxondv/new-services/post-service/pkg/logger"
+	"github.com/burxondv/new-services/post-service/pkg/metrics"
+	"github.com/burxondv/new-services/post-service/pkg/moderation"
+	"github.com/burxondv/new-services/post-service/pkg/pubsub"
 	grpcclient "github.com/burxondv/new-services/post-service/service/grpc_client"
 	"github.com/burxondv/new-services/post-service/storage"
 	"github.com/burxondv/new-services/post-service/storage/repo"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 type PostService struct {
-	storage storage.IStorage
-	Logger  logger.Logger
-	Client  grpcclient.Clients
+	storage    storage.IStorage
+	blob       blob.Store
+	cfg        config.Config
+	Logger     logger.Logger
+	Client     grpcclient.Clients
+	likes      *pubsub.Hub[*p.LikeEvent]
+	classifier moderation.Classifier
 }
 
-func NewPostService(db *sqlx.DB, log logger.Logger, client grpcclient.Clients) *PostService {
+func NewPostService(db *sqlx.DB, log logger.Logger, client grpcclient.Clients, store blob.Store, cfg config.Config, classifier moderation.Classifier) *PostService {
 	return &PostService{
-		storage: storage.NewStoragePg(db),
-		Logger:  log,
-		Client:  client,
+		storage:    storage.NewStoragePg(db, log),
+		blob:       store,
+		cfg:        cfg,
+		Logger:     log,
+		Client:     client,
+		likes:      pubsub.NewHub[*p.LikeEvent](streamBuffer),
+		classifier: classifier,
 	}
 }
 
+// reqLog returns logger with ids of the request and of its trace
+func (s *PostService) reqLog(ctx context.Context) logger.Logger {
+	return logger.WithContext(s.Logger, ctx)
+}
+
 func (s *PostService) CreatePost(ctx context.Context, req *p.PostRequest) (*p.PostResponse, error) {
-	postResp := p.PostResponse{}
-	res, err := s.storage.Post().CreatePost(repo.Post{
+	post := repo.Post{
 		Id:          req.Id,
 		Title:       req.Title,
 		Description: req.Description,
 		UserId:      req.UserId,
-	})
+		Status:      req.Status,
+		PublishAt:   req.PublishAt,
+		Visibility:  req.Visibility,
+
+		ModerationStatus: repo.ModerationVisible,
+	}
+	if err := validatePostState(&post); err != nil {
+		return &p.PostResponse{}, err
+	}
+	account, err := s.checkAccount(ctx, req.UserId)
 	if err != nil {
-		log.Println("failed to create post in service: ", err)
+		return &p.PostResponse{}, err
+	}
+	if err := s.classify(ctx, &post); err != nil {
 		return &p.PostResponse{}, err
 	}
 
+	res, err := s.storage.Post().CreatePost(ctx, post)
+	if err != nil {
+		s.reqLog(ctx).Error("failed to create post in service", logger.Error(err))
+		return &p.PostResponse{}, err
+	}
+	metrics.PostsCreated.Inc()
+
 	user, err := s.Client.User().GetUserForClient(ctx, &u.Request{Str: res.UserId})
 	if err != nil {
-		log.Println("failed to get user for create post: ", err)
+		s.reqLog(ctx).Error("failed to get user for create post", logger.Error(err))
 		return &p.PostResponse{}, err
 	}
-	postResp.Id = res.Id
-	postResp.Title = res.Title
-	postResp.Description = res.Description
-	postResp.UserId = res.UserId
-	postResp.Likes = res.Likes
+
+	postResp := postResponse(res)
 	postResp.UserName = user.FirstName + " " + user.LastName
-	postResp.CreatedAt = res.CreatedAt
-	postResp.UpdatedAt = res.UpdatedAt
 
-	return &postResp, nil
+	if err := s.setTags(ctx, postResp, req.Tags); err != nil {
+		return &p.PostResponse{}, err
+	}
+
+	// mentions in held post and in posts of shadow banned users are not notified
+	if res.Status == repo.StatusPublished && res.ModerationStatus == repo.ModerationVisible && !account.ShadowBanned {
+		s.notify(ctx, &n.NotifyRequest{
+			Type:    "mention",
+			ActorId: res.UserId,
+			PostId:  res.Id,
+			Text:    res.Description,
+		})
+	}
+
+	return postResp, nil
 }
 
 func (s *PostService) GetPostById(ctx context.Context, req *p.Request) (*p.PostResponse, error) {
-	postResp := p.PostResponse{}
-	res, err := s.storage.Post().GetPostById(req.Str)
+	res, err := s.storage.Post().GetPostById(ctx, req.Str)
 	if err != nil {
-		log.Println("failed to get post by id: ", err)
+		s.reqLog(ctx).Error("failed to get post by id", logger.Error(err))
 		return &p.PostResponse{}, err
 	}
 
-	postUser, err := s.Client.User().GetUserForClient(ctx, &u.Request{Str: res.UserId})
+	ok, err := newVisibility(ctx, s.Client, req.ViewerId).canView(res)
 	if err != nil {
-		log.Println("failed to get user for get post by id: ", err)
+		s.reqLog(ctx).Error("failed to check visibility for get post by id", logger.Error(err))
+		return &p.PostResponse{}, err
+	}
+	if !ok {
+		// hidden posts look like missing ones
+		return &p.PostResponse{}, status.Error(codes.NotFound, "post not found")
+	}
+
+	postResp := postResponse(res)
+	if err := s.fillAuthors(ctx, postResp); err != nil {
+		s.reqLog(ctx).Error("failed to get user and comments for get post by id", logger.Error(err))
 		return &p.PostResponse{}, err
 	}
 
-	comments, err := s.Client.Comment().GetCommentsForPost(ctx, &c.Request{Str: res.Id})
+	attachments, err := s.storage.Attachment().GetAttachments(ctx, res.Id)
 	if err != nil {
-		log.Println("failed to get comments for get post by id: ", err)
+		s.reqLog(ctx).Error("failed to get attachments for get post by id", logger.Error(err))
 		return &p.PostResponse{}, err
 	}
 
-	postResp.Id = res.Id
-	postResp.Title = res.Title
-	postResp.Description = res.Description
-	postResp.UserId = res.UserId
-	postResp.Likes = res.Likes
-	postResp.UserName = postUser.FirstName + " " + postUser.LastName
-	postResp.Comments = int64(len(comments.Comments))
-	postResp.CreatedAt = res.CreatedAt
-	postResp.UpdatedAt = res.UpdatedAt
+	for _, att := range attachments {
+		postResp.Attachments = append(postResp.Attachments, attachmentResponse(att))
+	}
+
+	if err := s.fillTags(ctx, postResp); err != nil {
+		return &p.PostResponse{}, err
+	}
 
-	return &postResp, nil
+	return postResp, nil
 }
 
 func (s *PostService) GetPostByUserId(ctx context.Context, req *p.Request) (*p.PostsResponse, error) {
 	postsResp := p.PostsResponse{}
-	res, err := s.storage.Post().GetPostByUserId(req.Str)
+	res, err := s.storage.Post().GetPostByUserId(ctx, req.Str)
 	if err != nil {
-		log.Println("failed to get post by user id: ", err)
+		s.reqLog(ctx).Error("failed to get post by user id", logger.Error(err))
 		return &p.PostsResponse{}, err
 	}
 
-	postUser, err := s.Client.User().GetUserForClient(ctx, &u.Request{Str: req.Str})
+	res, err = newVisibility(ctx, s.Client, req.ViewerId).filter(res)
 	if err != nil {
-		log.Println("failed to get user for get post by user id: ", err)
+		s.reqLog(ctx).Error("failed to check visibility for get post by user id", logger.Error(err))
 		return &p.PostsResponse{}, err
 	}
 
 	for _, ps := range res {
-		post := p.PostResponse{}
-		post.Id = ps.Id
-		post.Title = ps.Title
-		post.Description = ps.Description
-		post.Likes = ps.Likes
-		post.UserId = postUser.Id
-		post.CreatedAt = ps.CreatedAt
-		post.UpdatedAt = ps.UpdatedAt
-		postsResp.Posts = append(postsResp.Posts, &post)
+		postsResp.Posts = append(postsResp.Posts, postResponse(ps))
 	}
 
-	for _, p := range postsResp.Posts {
-		p.UserName = postUser.FirstName + " " + postUser.LastName
+	if err := s.fillAuthors(ctx, postsResp.Posts...); err != nil {
+		s.reqLog(ctx).Error("failed to get user and comments for get post by user id", logger.Error(err))
+		return &p.PostsResponse{}, err
 	}
 
-	for _, post := range postsResp.Posts {
-		comments, err := s.Client.Comment().GetCommentsForPost(ctx, &c.Request{Str: post.Id})
-		if err != nil {
-			log.Println("failed to get comments for get post by user id: ", err)
-			return &p.PostsResponse{}, err
-		}
-
-		post.Comments = int64(len(comments.Comments))
+	if err := s.fillTags(ctx, postsResp.Posts...); err != nil {
+		return &p.PostsResponse{}, err
 	}
 
 	return &postsResp, nil
@@ -137,183 +180,350 @@ func (s *PostService) GetPostByUserId(ctx context.Context, req *p.Request) (*p.P
 
 func (s *PostService) GetPostForUser(ctx context.Context, req *p.Request) (*p.PostsResponse, error) {
 	postsResp := p.PostsResponse{}
-	res, err := s.storage.Post().GetPostForUser(req.Str)
+	res, err := s.storage.Post().GetPostForUser(ctx, req.Str)
+	if err != nil {
+		s.reqLog(ctx).Error("failed to get post for user", logger.Error(err))
+		return &p.PostsResponse{}, err
+	}
+
+	res, err = newVisibility(ctx, s.Client, req.ViewerId).filter(res)
 	if err != nil {
-		log.Println("failed to get post for user: ", err)
+		s.reqLog(ctx).Error("failed to check visibility for get post for user", logger.Error(err))
 		return &p.PostsResponse{}, err
 	}
 
 	for _, ps := range res {
-		post := p.PostResponse{}
-		post.Id = ps.Id
-		post.Title = ps.Title
-		post.Description = ps.Description
-		post.Likes = ps.Likes
-		post.UserId = ps.UserId
-		post.CreatedAt = ps.CreatedAt
-		post.UpdatedAt = ps.UpdatedAt
-		postsResp.Posts = append(postsResp.Posts, &post)
+		postsResp.Posts = append(postsResp.Posts, postResponse(ps))
 	}
 
 	return &postsResp, nil
 }
 
+// GetPostsByIds returns found posts without visibility checks like
+// GetPostForComment, it lets other services get many posts with one call
+func (s *PostService) GetPostsByIds(ctx context.Context, req *p.IdsRequest) (*p.PostsResponse, error) {
+	postsResp := p.PostsResponse{}
+	if len(req.Ids) == 0 {
+		return &postsResp, nil
+	}
+
+	res, err := s.storage.Post().GetPostsByIds(ctx, req.Ids)
+	if err != nil {
+		s.reqLog(ctx).Error("failed to get posts by ids", logger.Error(err))
+		return &p.PostsResponse{}, err
+	}
+
+	for _, ps := range res {
+		postsResp.Posts = append(postsResp.Posts, postResponse(ps))
+	}
+
+	return &postsResp, nil
+}
+
+// CountPostsForUsers counts posts of many users which the viewer can see, it
+// lets user service count posts of a page of users with one call
+func (s *PostService) CountPostsForUsers(ctx context.Context, req *p.IdsRequest) (*p.PostCountsResponse, error) {
+	if len(req.Ids) == 0 {
+		return &p.PostCountsResponse{}, nil
+	}
+
+	res, err := s.storage.Post().GetPostsForUsers(ctx, req.Ids)
+	if err != nil {
+		s.reqLog(ctx).Error("failed to get posts for users", logger.Error(err))
+		return &p.PostCountsResponse{}, err
+	}
+
+	res, err = newVisibility(ctx, s.Client, req.ViewerId).filter(res)
+	if err != nil {
+		s.reqLog(ctx).Error("failed to check visibility for count posts for users", logger.Error(err))
+		return &p.PostCountsResponse{}, err
+	}
+
+	counts := map[string]int64{}
+	for _, ps := range res {
+		counts[ps.UserId]++
+	}
+
+	return &p.PostCountsResponse{Counts: counts}, nil
+}
+
 func (s *PostService) GetPostForComment(ctx context.Context, req *p.Request) (*p.PostResponse, error) {
-	postResp := p.PostResponse{}
-	res, err := s.storage.Post().GetPostForComment(req.Str)
+	res, err := s.storage.Post().GetPostForComment(ctx, req.Str)
 	if err != nil {
-		log.Println("failed to get post for comment: ", err)
+		s.reqLog(ctx).Error("failed to get post for comment", logger.Error(err))
 		return &p.PostResponse{}, err
 	}
 
-	postResp.Id = res.Id
-	postResp.Title = res.Title
-	postResp.Description = res.Description
-	postResp.Likes = res.Likes
-	postResp.UserId = res.UserId
-	postResp.CreatedAt = res.CreatedAt
-	postResp.UpdatedAt = res.UpdatedAt
-
-	return &postResp, nil
+	return postResponse(res), nil
 }
 
 func (s *PostService) SearchPosts(ctx context.Context, req *p.Request) (*p.PostsResponse, error) {
 	postsResp := p.PostsResponse{}
-	res, err := s.storage.Post().SearchPosts(req.Str)
+	res, err := s.storage.Post().SearchPosts(ctx, req.Str)
 	if err != nil {
-		log.Println("failed to get posts by search title: ", err)
+		s.reqLog(ctx).Error("failed to get posts by search title", logger.Error(err))
 		return &p.PostsResponse{}, err
 	}
 
-	for _, ps := range res {
-		post := p.PostResponse{}
-		post.Id = ps.Id
-		post.Title = ps.Title
-		post.Description = ps.Description
-		post.Likes = ps.Likes
-		post.UserId = ps.UserId
-		post.CreatedAt = ps.CreatedAt
-		post.UpdatedAt = ps.UpdatedAt
-		postsResp.Posts = append(postsResp.Posts, &post)
-	}
-
-	for _, post := range postsResp.Posts {
-		postUser, err := s.Client.User().GetUserForClient(ctx, &u.Request{Str: post.UserId})
-		if err != nil {
-			log.Println("failed to get user for get posts by search title: ", err)
-			return &p.PostsResponse{}, err
-		}
+	vis, err := newVisibility(ctx, s.Client, req.ViewerId).withoutMuted()
+	if err != nil {
+		s.reqLog(ctx).Error("failed to get muted users for search posts", logger.Error(err))
+		return &p.PostsResponse{}, err
+	}
+
+	res, err = vis.filter(res)
+	if err != nil {
+		s.reqLog(ctx).Error("failed to check visibility for search posts", logger.Error(err))
+		return &p.PostsResponse{}, err
+	}
 
-		post.UserName = postUser.FirstName + " " + postUser.LastName
+	for _, ps := range res {
+		postsResp.Posts = append(postsResp.Posts, postResponse(ps))
+	}
 
-		comments, err := s.Client.Comment().GetCommentsForPost(ctx, &c.Request{Str: post.Id})
-		if err != nil {
-			log.Println("failed to get comments for get posts by search title: ", err)
-			return &p.PostsResponse{}, err
-		}
+	if err := s.fillAuthors(ctx, postsResp.Posts...); err != nil {
+		s.reqLog(ctx).Error("failed to get users and comments for get posts by search title", logger.Error(err))
+		return &p.PostsResponse{}, err
+	}
 
-		post.Comments = int64(len(comments.Comments))
+	if err := s.fillTags(ctx, postsResp.Posts...); err != nil {
+		return &p.PostsResponse{}, err
 	}
 
 	return &postsResp, nil
 }
 
 func (s *PostService) LikePost(ctx context.Context, req *p.LikeRequest) (*p.PostResponse, error) {
-	postResp := p.PostResponse{}
-	res, err := s.storage.Post().LikePost(req.PostId, req.IsLiked)
+	if _, err := s.checkAccount(ctx, req.UserId); err != nil {
+		return &p.PostResponse{}, err
+	}
+
+	post, err := s.storage.Post().GetPostById(ctx, req.PostId)
+	if err == sql.ErrNoRows {
+		return &p.PostResponse{}, status.Error(codes.NotFound, "post not found")
+	} else if err != nil {
+		s.reqLog(ctx).Error("failed to get post for like post", logger.Error(err))
+		return &p.PostResponse{}, err
+	}
+
+	// posts the user can't see and posts of authors who blocked the user
+	// look like missing ones
+	v := newVisibility(ctx, s.Client, req.UserId)
+	ok, err := v.canView(post)
 	if err != nil {
-		log.Println("failed to like post: ", err)
+		s.reqLog(ctx).Error("failed to check visibility for like post", logger.Error(err))
 		return &p.PostResponse{}, err
 	}
-	if !req.IsLiked {
-		res.Likes -= 1
+	blocked, err := v.blockedBy(post.UserId)
+	if err != nil {
+		s.reqLog(ctx).Error("failed to check block for like post", logger.Error(err))
+		return &p.PostResponse{}, err
+	}
+	if !ok || blocked {
+		return &p.PostResponse{}, status.Error(codes.NotFound, "post not found")
 	}
 
-	postUser, err := s.Client.User().GetUserForClient(ctx, &u.Request{Str: res.UserId})
+	res, err := s.storage.Post().LikePost(ctx, req.PostId, req.UserId, req.IsLiked)
 	if err != nil {
-		log.Println("failed to get user for like post: ", err)
+		s.reqLog(ctx).Error("failed to like post", logger.Error(err))
 		return &p.PostResponse{}, err
 	}
 
-	postResp.Id = res.Id
-	postResp.Title = res.Title
-	postResp.Description = res.Description
-	postResp.Likes = res.Likes
-	postResp.UserId = res.UserId
-	postResp.UserName = postUser.FirstName + " " + postUser.LastName
-	postResp.CreatedAt = res.CreatedAt
-	postResp.UpdatedAt = res.UpdatedAt
+	postResp := postResponse(res)
+	if err := s.fillAuthors(ctx, postResp); err != nil {
+		s.reqLog(ctx).Error("failed to get user and comments for like post", logger.Error(err))
+		return &p.PostResponse{}, err
+	}
 
-	comments, err := s.Client.Comment().GetCommentsForPost(ctx, &c.Request{Str: postResp.Id})
+	s.likes.Publish(res.Id, &p.LikeEvent{
+		Id:      uuid.NewString(),
+		PostId:  res.Id,
+		UserId:  req.UserId,
+		IsLiked: req.IsLiked,
+		Likes:   postResp.Likes,
+	})
+
+	if req.IsLiked && req.UserId != "" {
+		s.notify(ctx, &n.NotifyRequest{
+			Type:    "like",
+			UserId:  res.UserId,
+			ActorId: req.UserId,
+			PostId:  res.Id,
+		})
+	}
+
+	return postResp, nil
+}
+
+// GetLikesByUser returns posts liked by the user, it is used to export data
+// of the user
+func (s *PostService) GetLikesByUser(ctx context.Context, req *p.Request) (*p.LikesResponse, error) {
+	res, err := s.storage.Post().GetLikesByUser(ctx, req.Str)
 	if err != nil {
-		log.Println("failed to get comments for like post: ", err)
-		return &p.PostResponse{}, err
+		s.reqLog(ctx).Error("failed to get likes by user", logger.Error(err))
+		return &p.LikesResponse{}, err
 	}
 
-	postResp.Comments = int64(len(comments.Comments))
+	likes := &p.LikesResponse{}
+	for _, like := range res {
+		likes.Likes = append(likes.Likes, &p.LikeResponse{
+			PostId:    like.PostId,
+			PostTitle: like.PostTitle,
+			CreatedAt: like.CreatedAt,
+		})
+	}
 
-	return &postResp, nil
+	return likes, nil
 }
 
 func (s *PostService) UpdatePost(ctx context.Context, req *p.UpdatePostRequest) (*p.PostResponse, error) {
-	res, err := s.storage.Post().UpdatePost(repo.Post{
+	old, err := s.storage.Post().GetPostById(ctx, req.Id)
+	if err != nil {
+		s.reqLog(ctx).Error("failed to get post for update post", logger.Error(err))
+		return &p.PostResponse{}, err
+	}
+	caller, err := checkAuthor(ctx, old.UserId)
+	if err != nil {
+		return &p.PostResponse{}, err
+	}
+	editorId := caller.Subject
+	if _, err := s.checkAccount(ctx, editorId); err != nil {
+		return &p.PostResponse{}, err
+	}
+	if req.ExpectedVersion != 0 && req.ExpectedVersion != old.Version {
+		return &p.PostResponse{}, versionConflict(old.Version)
+	}
+
+	// status and visibility are changed only when they are given
+	post := repo.Post{
 		Title:       req.Title,
 		Description: req.Description,
 		Id:          req.Id,
-	})
-	if err != nil {
-		log.Println("failed to update post: ", err)
+		Status:      req.Status,
+		PublishAt:   req.PublishAt,
+		Visibility:  req.Visibility,
+		EditorId:    editorId,
+		Version:     req.ExpectedVersion,
+	}
+	if post.Status == "" {
+		post.Status = old.Status
+		if post.PublishAt == "" {
+			post.PublishAt = old.PublishAt
+		}
+	}
+	if post.Visibility == "" {
+		post.Visibility = old.Visibility
+	}
+	if post.Status == old.Status && post.PublishAt == old.PublishAt && old.Status == repo.StatusScheduled {
+		// unchanged schedule may already be in the past, it is published by scheduler soon
+		publishAt, _ := parseTime(old.PublishAt)
+		post.PublishAt = publishAt.UTC().Format("2006-01-02 15:04:05")
+	} else if err := validatePostState(&post); err != nil {
+		return &p.PostResponse{}, err
+	}
+	if err := s.classify(ctx, &post); err != nil {
+		return &p.PostResponse{}, err
+	}
+
+	res, err := s.storage.Post().UpdatePost(ctx, post)
+	if err == sql.ErrNoRows && req.ExpectedVersion != 0 {
+		// the post was changed after it was read above
+		return &p.PostResponse{}, versionConflict(0)
+	} else if err != nil {
+		s.reqLog(ctx).Error("failed to update post", logger.Error(err))
 		return &p.PostResponse{}, err
 	}
 
 	user, err := s.Client.User().GetUserForClient(ctx, &u.Request{Str: res.UserId})
 	if err != nil {
-		log.Println("failed to get user for update post: ", err)
+		s.reqLog(ctx).Error("failed to get user for update post", logger.Error(err))
 		return &p.PostResponse{}, err
 	}
 
-	return &p.PostResponse{
-		Id:          res.Id,
-		Title:       res.Title,
-		Description: res.Description,
-		Likes:       res.Likes,
-		UserId:      res.UserId,
-		UserName:    user.FirstName + " " + user.LastName,
-		CreatedAt:   res.CreatedAt,
-		UpdatedAt:   res.UpdatedAt,
-	}, nil
+	postResp := postResponse(res)
+	postResp.UserName = user.FirstName + " " + user.LastName
+
+	explicit := req.Tags
+	if !req.TagsSet {
+		explicit, err = s.explicitTags(ctx, old)
+		if err != nil {
+			return &p.PostResponse{}, err
+		}
+	}
+	if err := s.setTags(ctx, postResp, explicit); err != nil {
+		return &p.PostResponse{}, err
+	}
+
+	return postResp, nil
 }
 
 func (s *PostService) DeletePost(ctx context.Context, req *p.Request) (*p.PostResponse, error) {
-	postResp := p.PostResponse{}
-	res, err := s.storage.Post().DeletePost(req.Str)
-	if err != nil {
-		log.Println("failed to delete post: ", err)
+	post, err := s.storage.Post().GetPostById(ctx, req.Str)
+	if err == sql.ErrNoRows {
+		return &p.PostResponse{}, status.Error(codes.NotFound, "post not found")
+	} else if err != nil {
+		s.reqLog(ctx).Error("failed to get post for delete post", logger.Error(err))
+		return &p.PostResponse{}, err
+	}
+	if _, err := checkAuthor(ctx, post.UserId); err != nil {
 		return &p.PostResponse{}, err
 	}
 
-	postUser, err := s.Client.User().GetUserForClient(ctx, &u.Request{Str: res.UserId})
+	return s.deletePost(ctx, req.Str)
+}
+
+// deletePost deletes the post without checking the caller, moderators
+// remove posts of others with it
+func (s *PostService) deletePost(ctx context.Context, id string) (*p.PostResponse, error) {
+	res, err := s.storage.Post().DeletePost(ctx, id)
 	if err != nil {
-		log.Println("failed to get user for delete post: ", err)
+		s.reqLog(ctx).Error("failed to delete post", logger.Error(err))
 		return &p.PostResponse{}, err
 	}
 
-	postResp.Id = res.Id
-	postResp.Title = res.Title
-	postResp.Description = res.Description
-	postResp.Likes = res.Likes
-	postResp.UserId = res.UserId
-	postResp.UserName = postUser.FirstName + " " + postUser.LastName
-	postResp.CreatedAt = res.CreatedAt
-	postResp.UpdatedAt = res.UpdatedAt
-
-	comments, err := s.Client.Comment().GetCommentsForPost(ctx, &c.Request{Str: res.Id})
+	// blobs are removed later by blob cleaner
+	deleteAfter := time.Now().Add(time.Duration(s.cfg.BlobDeleteDelay) * time.Second)
+	err = s.storage.Attachment().ScheduleBlobDeletion(ctx, res.Id, deleteAfter)
 	if err != nil {
-		log.Println("failed to get comments for delete post: ", err)
+		s.reqLog(ctx).Error("failed to schedule attachments deletion for delete post", logger.Error(err))
+		return &p.PostResponse{}, err
+	}
+
+	postResp := postResponse(res)
+	if err := s.fillAuthors(ctx, postResp); err != nil {
+		s.reqLog(ctx).Error("failed to get user and comments for delete post", logger.Error(err))
 		return &p.PostResponse{}, err
 	}
 
-	postResp.Comments = int64(len(comments.Comments))
+	return postResp, nil
+}
+
+// versionConflict is returned when an update is based on a stale version of
+// the post, current is 0 when it is not known
+func versionConflict(current int64) error {
+	if current == 0 {
+		return status.Error(codes.FailedPrecondition, "post was changed by another update")
+	}
 
-	return &postResp, err
+	return status.Errorf(codes.FailedPrecondition, "post was changed by another update, current version is %d", current)
+}
+
+func postResponse(post repo.Post) *p.PostResponse {
+	return &p.PostResponse{
+		Id:          post.Id,
+		Title:       post.Title,
+		Description: post.Description,
+		Likes:       post.Likes,
+		UserId:      post.UserId,
+		Status:      post.Status,
+		PublishAt:   post.PublishAt,
+		Visibility:  post.Visibility,
+		Edited:      post.EditedAt != "",
+		EditedAt:    post.EditedAt,
+		CreatedAt:   post.CreatedAt,
+		UpdatedAt:   post.UpdatedAt,
+		Version:     post.Version,
+
+		ModerationStatus: post.ModerationStatus,
+	}
 }
@@ -0,0 +1,26 @@
+package service
+
+import (
+	p "github.com/burxondv/new-services/post-service/genproto/post"
+)
+
+// events are dropped for a stream which falls behind by more than streamBuffer
+const streamBuffer = 64
+
+// StreamLikes sends like counts of posts after every like or unlike until
+// the client cancels the stream
+func (s *PostService) StreamLikes(req *p.StreamRequest, stream p.PostService_StreamLikesServer) error {
+	likes, cancel := s.likes.Subscribe(req.PostId)
+	defer cancel()
+
+	for {
+		select {
+		case <-stream.Context().Done():
+			return nil
+		case like := <-likes:
+			if err := stream.Send(like); err != nil {
+				return err
+			}
+		}
+	}
+}
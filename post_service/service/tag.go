@@ -0,0 +1,183 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	p "github.com/burxondv/new-services/post-service/genproto/post"
+	"github.com/burxondv/new-services/post-service/pkg/logger"
+	"github.com/burxondv/new-services/post-service/pkg/tags"
+	"github.com/burxondv/new-services/post-service/storage/repo"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	defaultTagsLimit     = 10
+	maxTagsLimit         = 100
+	defaultTrendingHours = 24
+	maxTrendingHours     = 30 * 24
+)
+
+func (s *PostService) GetPostsByTag(ctx context.Context, req *p.TagPostsRequest) (*p.PostsResponse, error) {
+	tag := tags.Normalize(req.Tag)
+	if tag == "" {
+		return &p.PostsResponse{}, status.Error(codes.InvalidArgument, "tag is required")
+	}
+
+	limit := tagsLimit(req.Limit)
+	page := req.Page
+	if page < 1 {
+		page = 1
+	}
+
+	res, err := s.storage.Tag().GetPostsByTag(ctx, tag, limit, (page-1)*limit)
+	if err != nil {
+		s.reqLog(ctx).Error("failed to get posts by tag", logger.Error(err))
+		return &p.PostsResponse{}, err
+	}
+
+	vis, err := newVisibility(ctx, s.Client, req.ViewerId).withoutMuted()
+	if err != nil {
+		s.reqLog(ctx).Error("failed to get muted users for get posts by tag", logger.Error(err))
+		return &p.PostsResponse{}, err
+	}
+
+	res, err = vis.filter(res)
+	if err != nil {
+		s.reqLog(ctx).Error("failed to check visibility for get posts by tag", logger.Error(err))
+		return &p.PostsResponse{}, err
+	}
+
+	postsResp := p.PostsResponse{}
+	for _, ps := range res {
+		postsResp.Posts = append(postsResp.Posts, postResponse(ps))
+	}
+
+	if err := s.fillAuthors(ctx, postsResp.Posts...); err != nil {
+		s.reqLog(ctx).Error("failed to get users and comments for get posts by tag", logger.Error(err))
+		return &p.PostsResponse{}, err
+	}
+
+	if err := s.fillTags(ctx, postsResp.Posts...); err != nil {
+		return &p.PostsResponse{}, err
+	}
+
+	return &postsResp, nil
+}
+
+func (s *PostService) AutocompleteTags(ctx context.Context, req *p.AutocompleteTagsRequest) (*p.TagsResponse, error) {
+	prefix := tags.Normalize(req.Prefix)
+	if prefix == "" {
+		return &p.TagsResponse{}, nil
+	}
+
+	res, err := s.storage.Tag().SearchTags(ctx, prefix, tagsLimit(req.Limit))
+	if err != nil {
+		s.reqLog(ctx).Error("failed to autocomplete tags", logger.Error(err))
+		return &p.TagsResponse{}, err
+	}
+
+	return tagsResponse(res), nil
+}
+
+func (s *PostService) GetTrendingTags(ctx context.Context, req *p.TrendingTagsRequest) (*p.TagsResponse, error) {
+	hours := req.Hours
+	if hours <= 0 {
+		hours = defaultTrendingHours
+	} else if hours > maxTrendingHours {
+		hours = maxTrendingHours
+	}
+
+	// published_at is in UTC
+	since := time.Now().UTC().Add(-time.Duration(hours) * time.Hour)
+	res, err := s.storage.Tag().GetTrendingTags(ctx, since, tagsLimit(req.Limit))
+	if err != nil {
+		s.reqLog(ctx).Error("failed to get trending tags", logger.Error(err))
+		return &p.TagsResponse{}, err
+	}
+
+	return tagsResponse(res), nil
+}
+
+// setTags saves explicit tags and hashtags of description, they are set to the response too
+func (s *PostService) setTags(ctx context.Context, post *p.PostResponse, explicit []string) error {
+	postTags := tags.Merge(explicit, post.Description)
+	err := s.storage.Tag().SetPostTags(ctx, post.Id, postTags)
+	if err != nil {
+		s.reqLog(ctx).Error("failed to set post tags", logger.Error(err))
+		return err
+	}
+
+	post.Tags = postTags
+
+	return nil
+}
+
+// explicitTags returns saved tags of the post which are not hashtags of its
+// description, they are kept when the post is updated without tags
+func (s *PostService) explicitTags(ctx context.Context, post repo.Post) ([]string, error) {
+	res, err := s.storage.Tag().GetPostTags(ctx, []string{post.Id})
+	if err != nil {
+		s.reqLog(ctx).Error("failed to get post tags", logger.Error(err))
+		return nil, err
+	}
+
+	hashtags := map[string]bool{}
+	for _, tag := range tags.Merge(nil, post.Description) {
+		hashtags[tag] = true
+	}
+
+	explicit := []string{}
+	for _, tag := range res[post.Id] {
+		if !hashtags[tag] {
+			explicit = append(explicit, tag)
+		}
+	}
+
+	return explicit, nil
+}
+
+// fillTags gets tags of all posts with one query
+func (s *PostService) fillTags(ctx context.Context, posts ...*p.PostResponse) error {
+	if len(posts) == 0 {
+		return nil
+	}
+
+	ids := []string{}
+	for _, post := range posts {
+		ids = append(ids, post.Id)
+	}
+
+	res, err := s.storage.Tag().GetPostTags(ctx, ids)
+	if err != nil {
+		s.reqLog(ctx).Error("failed to get post tags", logger.Error(err))
+		return err
+	}
+
+	for _, post := range posts {
+		post.Tags = res[post.Id]
+	}
+
+	return nil
+}
+
+func tagsLimit(limit int64) int64 {
+	if limit <= 0 {
+		return defaultTagsLimit
+	} else if limit > maxTagsLimit {
+		return maxTagsLimit
+	}
+
+	return limit
+}
+
+func tagsResponse(res []repo.Tag) *p.TagsResponse {
+	tagsResp := p.TagsResponse{}
+	for _, tag := range res {
+		tagsResp.Tags = append(tagsResp.Tags, &p.TagResponse{Name: tag.Name, Posts: tag.Posts})
+	}
+
+	return &tagsResp
+}
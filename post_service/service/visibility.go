@@ -0,0 +1,173 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	u "github.com/burxondv/new-services/post-service/genproto/user"
+	grpcclient "github.com/burxondv/new-services/post-service/service/grpc_client"
+	"github.com/burxondv/new-services/post-service/storage/repo"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// visibility decides which posts the viewer can see,
+// following checks are cached for the lifetime of one request
+type visibility struct {
+	ctx       context.Context
+	client    grpcclient.Clients
+	viewerId  string
+	following map[string]bool
+
+	// muted users are hidden by filter when it is not nil
+	muted map[string]bool
+}
+
+func newVisibility(ctx context.Context, client grpcclient.Clients, viewerId string) *visibility {
+	return &visibility{
+		ctx:       ctx,
+		client:    client,
+		viewerId:  viewerId,
+		following: map[string]bool{},
+	}
+}
+
+func (v *visibility) canView(post repo.Post) (bool, error) {
+	if v.viewerId != "" && v.viewerId == post.UserId {
+		return true, nil
+	}
+
+	if post.Status != repo.StatusPublished || post.ModerationStatus != repo.ModerationVisible || post.AuthorShadowBanned {
+		return false, nil
+	}
+
+	switch post.Visibility {
+	case repo.VisibilityPrivate:
+		return false, nil
+	case repo.VisibilityFollowers:
+		if v.viewerId == "" {
+			return false, nil
+		}
+
+		following, ok := v.following[post.UserId]
+		if !ok {
+			res, err := v.client.User().IsFollowing(v.ctx, &u.FollowRequest{
+				FollowerId:  v.viewerId,
+				FollowingId: post.UserId,
+			})
+			if err != nil {
+				return false, err
+			}
+
+			following = res.Exists
+			v.following[post.UserId] = following
+		}
+
+		return following, nil
+	default:
+		return true, nil
+	}
+}
+
+// blockedBy reports whether the author blocked the viewer, blocked viewers
+// can't interact with posts of the author
+func (v *visibility) blockedBy(authorId string) (bool, error) {
+	if v.viewerId == "" || v.viewerId == authorId {
+		return false, nil
+	}
+
+	res, err := v.client.User().IsBlocked(v.ctx, &u.RelationRequest{UserId: authorId, TargetId: v.viewerId})
+	if err != nil {
+		return false, err
+	}
+
+	return res.Exists, nil
+}
+
+// withoutMuted makes filter hide posts of users muted by the viewer, it is
+// used by listings while profile of muted user still shows the posts
+func (v *visibility) withoutMuted() (*visibility, error) {
+	v.muted = map[string]bool{}
+	if v.viewerId == "" {
+		return v, nil
+	}
+
+	res, err := v.client.User().GetMutedIds(v.ctx, &u.Request{Str: v.viewerId})
+	if err != nil {
+		return v, err
+	}
+
+	for _, id := range res.Ids {
+		v.muted[id] = true
+	}
+
+	return v, nil
+}
+
+func (v *visibility) filter(posts []repo.Post) ([]repo.Post, error) {
+	res := []repo.Post{}
+	for _, post := range posts {
+		if v.muted[post.UserId] {
+			continue
+		}
+
+		ok, err := v.canView(post)
+		if err != nil {
+			return []repo.Post{}, err
+		}
+
+		if ok {
+			res = append(res, post)
+		}
+	}
+
+	return res, nil
+}
+
+// validatePostState checks status, publish time and visibility of created or updated post
+// and fills default values. Publish time is returned in UTC.
+func validatePostState(post *repo.Post) error {
+	if post.Status == "" {
+		post.Status = repo.StatusPublished
+	}
+
+	if post.Visibility == "" {
+		post.Visibility = repo.VisibilityPublic
+	}
+
+	switch post.Visibility {
+	case repo.VisibilityPublic, repo.VisibilityFollowers, repo.VisibilityPrivate:
+	default:
+		return status.Errorf(codes.InvalidArgument, "unknown visibility %q", post.Visibility)
+	}
+
+	switch post.Status {
+	case repo.StatusDraft, repo.StatusPublished:
+		post.PublishAt = ""
+	case repo.StatusScheduled:
+		publishAt, err := parseTime(post.PublishAt)
+		if err != nil {
+			return status.Error(codes.InvalidArgument, "publish_at must be RFC3339 time for scheduled post")
+		}
+
+		if !publishAt.After(time.Now()) {
+			return status.Error(codes.InvalidArgument, "publish_at must be in the future")
+		}
+
+		post.PublishAt = publishAt.UTC().Format("2006-01-02 15:04:05")
+	default:
+		return status.Errorf(codes.InvalidArgument, "unknown status %q", post.Status)
+	}
+
+	return nil
+}
+
+func parseTime(str string) (time.Time, error) {
+	t, err := time.Parse(time.RFC3339, str)
+	if err != nil {
+		return time.Parse("2006-01-02 15:04:05", str)
+	}
+
+	return t, nil
+}
@@ -0,0 +1,197 @@
+package postgres
+
+import (
+	"context"
+	"time"
+
+	"github.com/burxondv/new-services/post-service/pkg/logger"
+	"github.com/burxondv/new-services/post-service/storage/repo"
+)
+
+func (r *AttachmentRepo) CreateAttachment(ctx context.Context, att repo.Attachment) (repo.Attachment, error) {
+	var res repo.Attachment
+	err := r.db.QueryRowContext(ctx, `
+		insert into
+			attachments(id, post_id, user_id, file_name, mime_type, size, storage_key, thumbnail_key)
+		values
+			($1, $2, $3, $4, $5, $6, $7, $8)
+		returning
+			id, post_id, user_id, file_name, mime_type, size, storage_key, thumbnail_key, created_at`,
+		att.Id, att.PostId, att.UserId, att.FileName, att.MimeType, att.Size, att.StorageKey, att.ThumbnailKey).Scan(
+		&res.Id, &res.PostId, &res.UserId, &res.FileName, &res.MimeType, &res.Size, &res.StorageKey, &res.ThumbnailKey, &res.CreatedAt)
+
+	if err != nil {
+		logger.WithContext(r.log, ctx).Error("failed to create attachment in sql", logger.Error(err))
+		return repo.Attachment{}, err
+	}
+
+	return res, nil
+}
+
+func (r *AttachmentRepo) GetAttachment(ctx context.Context, id string) (repo.Attachment, error) {
+	var res repo.Attachment
+	err := r.db.QueryRowContext(ctx, `
+		select
+			id, post_id, user_id, file_name, mime_type, size, storage_key, thumbnail_key, created_at
+		from
+			attachments
+		where
+			id = $1 and deleted_at is null`, id).Scan(
+		&res.Id, &res.PostId, &res.UserId, &res.FileName, &res.MimeType, &res.Size, &res.StorageKey, &res.ThumbnailKey, &res.CreatedAt)
+
+	if err != nil {
+		logger.WithContext(r.log, ctx).Error("failed to get attachment in sql", logger.Error(err))
+		return repo.Attachment{}, err
+	}
+
+	return res, nil
+}
+
+func (r *AttachmentRepo) GetAttachments(ctx context.Context, postId string) ([]repo.Attachment, error) {
+	res := []repo.Attachment{}
+	rows, err := r.db.QueryContext(ctx, `
+		select
+			id, post_id, user_id, file_name, mime_type, size, storage_key, thumbnail_key, created_at
+		from
+			attachments
+		where
+			post_id = $1 and deleted_at is null
+		order by created_at`, postId)
+
+	if err != nil {
+		logger.WithContext(r.log, ctx).Error("failed to get attachments in sql", logger.Error(err))
+		return []repo.Attachment{}, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		att := repo.Attachment{}
+
+		err = rows.Scan(
+			&att.Id,
+			&att.PostId,
+			&att.UserId,
+			&att.FileName,
+			&att.MimeType,
+			&att.Size,
+			&att.StorageKey,
+			&att.ThumbnailKey,
+			&att.CreatedAt,
+		)
+		if err != nil {
+			logger.WithContext(r.log, ctx).Error("failed to scan attachment in sql", logger.Error(err))
+			return []repo.Attachment{}, err
+		}
+
+		res = append(res, att)
+	}
+
+	return res, nil
+}
+
+func (r *AttachmentRepo) DeleteAttachment(ctx context.Context, id string, deleteAfter time.Time) (repo.Attachment, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return repo.Attachment{}, err
+	}
+	defer tx.Rollback()
+
+	var res repo.Attachment
+	err = tx.QueryRowContext(ctx, `
+		update
+			attachments
+		set
+			deleted_at = $1
+		where
+			id = $2 and deleted_at is null
+		returning
+			id, post_id, user_id, file_name, mime_type, size, storage_key, thumbnail_key, created_at`, time.Now(), id).Scan(
+		&res.Id, &res.PostId, &res.UserId, &res.FileName, &res.MimeType, &res.Size, &res.StorageKey, &res.ThumbnailKey, &res.CreatedAt)
+	if err != nil {
+		logger.WithContext(r.log, ctx).Error("failed to delete attachment in sql", logger.Error(err))
+		return repo.Attachment{}, err
+	}
+
+	_, err = tx.ExecContext(ctx, `
+		insert into
+			blob_deletions(storage_key, delete_after)
+		select key, $2::timestamp from unnest(array[$1::text, $3::text]) as key where key <> ''`, res.StorageKey, deleteAfter, res.ThumbnailKey)
+	if err != nil {
+		logger.WithContext(r.log, ctx).Error("failed to schedule attachment blob deletion in sql", logger.Error(err))
+		return repo.Attachment{}, err
+	}
+
+	return res, tx.Commit()
+}
+
+func (r *AttachmentRepo) ScheduleBlobDeletion(ctx context.Context, postId string, deleteAfter time.Time) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	_, err = tx.ExecContext(ctx, `
+		insert into
+			blob_deletions(storage_key, delete_after)
+		select storage_key, $2::timestamp from attachments where post_id = $1 and deleted_at is null
+		union all
+		select thumbnail_key, $2::timestamp from attachments where post_id = $1 and deleted_at is null and thumbnail_key <> ''`, postId, deleteAfter)
+	if err != nil {
+		logger.WithContext(r.log, ctx).Error("failed to schedule blob deletion in sql", logger.Error(err))
+		return err
+	}
+
+	_, err = tx.ExecContext(ctx, `
+		update
+			attachments
+		set
+			deleted_at = $1
+		where
+			post_id = $2 and deleted_at is null`, time.Now(), postId)
+	if err != nil {
+		logger.WithContext(r.log, ctx).Error("failed to delete post attachments in sql", logger.Error(err))
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func (r *AttachmentRepo) GetDueBlobDeletions(ctx context.Context, limit int) ([]string, error) {
+	keys := []string{}
+	rows, err := r.db.QueryContext(ctx, `
+		select
+			storage_key
+		from
+			blob_deletions
+		where
+			delete_after <= $1
+		limit $2`, time.Now(), limit)
+
+	if err != nil {
+		logger.WithContext(r.log, ctx).Error("failed to get blob deletions in sql", logger.Error(err))
+		return []string{}, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var key string
+		if err := rows.Scan(&key); err != nil {
+			logger.WithContext(r.log, ctx).Error("failed to scan blob deletion in sql", logger.Error(err))
+			return []string{}, err
+		}
+
+		keys = append(keys, key)
+	}
+
+	return keys, nil
+}
+
+func (r *AttachmentRepo) RemoveBlobDeletion(ctx context.Context, key string) error {
+	_, err := r.db.ExecContext(ctx, `delete from blob_deletions where storage_key = $1`, key)
+	if err != nil {
+		logger.WithContext(r.log, ctx).Error("failed to remove blob deletion in sql", logger.Error(err))
+	}
+
+	return err
+}
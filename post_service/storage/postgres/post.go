@@ -1,233 +1,451 @@
 package postgres
 
 import (
-	"fmt"
-	"log"
+	"context"
+	"database/sql"
 	"time"
 
+	"github.com/burxondv/new-services/post-service/pkg/events"
+	"github.com/burxondv/new-services/post-service/pkg/logger"
 	"github.com/burxondv/new-services/post-service/storage/repo"
+
+	"github.com/lib/pq"
 )
 
-func (r *PostRepo) CreatePost(post repo.Post) (repo.Post, error) {
-	var res repo.Post
-	err := r.db.QueryRow(`
+// postColumns are selected by every post query, scanned by scanPost
+const postColumns = `id, title, description, likes, user_id, status, publish_at, visibility, edited_at, created_at, updated_at, version, moderation_status, ` + authorShadowBanned
+
+// authorShadowBanned is true for posts of shadow banned users, ban without
+// until does not expire
+const authorShadowBanned = `exists(select 1 from shadow_bans sb where sb.user_id = posts.user_id and (sb.until is null or sb.until > timezone('utc', now())))`
+
+type scanner interface {
+	Scan(dest ...interface{}) error
+}
+
+func scanPost(row scanner) (repo.Post, error) {
+	var (
+		post      repo.Post
+		publishAt sql.NullTime
+		editedAt  sql.NullTime
+	)
+
+	err := row.Scan(
+		&post.Id,
+		&post.Title,
+		&post.Description,
+		&post.Likes,
+		&post.UserId,
+		&post.Status,
+		&publishAt,
+		&post.Visibility,
+		&editedAt,
+		&post.CreatedAt,
+		&post.UpdatedAt,
+		&post.Version,
+		&post.ModerationStatus,
+		&post.AuthorShadowBanned,
+	)
+	if err != nil {
+		return repo.Post{}, err
+	}
+
+	if publishAt.Valid {
+		post.PublishAt = publishAt.Time.Format(time.RFC3339)
+	}
+
+	if editedAt.Valid {
+		post.EditedAt = editedAt.Time.Format(time.RFC3339)
+	}
+
+	return post, nil
+}
+
+func scanPosts(rows *sql.Rows) ([]repo.Post, error) {
+	defer rows.Close()
+
+	res := []repo.Post{}
+	for rows.Next() {
+		post, err := scanPost(rows)
+		if err != nil {
+			return []repo.Post{}, err
+		}
+
+		res = append(res, post)
+	}
+
+	return res, rows.Err()
+}
+
+// execRows executes query and returns number of affected rows
+func execRows(ctx context.Context, tx *sql.Tx, query string, args ...interface{}) (int64, error) {
+	res, err := tx.ExecContext(ctx, query, args...)
+	if err != nil {
+		return 0, err
+	}
+
+	return res.RowsAffected()
+}
+
+// nullString is used for optional timestamps, postgres casts text to timestamp
+func nullString(str string) sql.NullString {
+	return sql.NullString{String: str, Valid: str != ""}
+}
+
+func (r *PostRepo) CreatePost(ctx context.Context, post repo.Post) (repo.Post, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return repo.Post{}, err
+	}
+	defer tx.Rollback()
+
+	res, err := scanPost(tx.QueryRowContext(ctx, `
 		insert into 
-			posts(id, title, description, user_id) 
+			posts(id, title, description, user_id, status, publish_at, visibility, published_at, moderation_status) 
 		values
-			($1, $2, $3, $4) 
+			($1, $2, $3, $4, $5, $6, $7, case when $5 = 'published' then timezone('utc', now()) end, coalesce($8, 'visible')) 
 		returning 
-			id, title, description, likes, user_id, created_at, updated_at`, post.Id, post.Title, post.Description, post.UserId).Scan(&res.Id, &res.Title, &res.Description, &res.Likes, &res.UserId, &res.CreatedAt, &res.UpdatedAt)
+			`+postColumns, post.Id, post.Title, post.Description, post.UserId, post.Status, nullString(post.PublishAt), post.Visibility, nullString(post.ModerationStatus)))
 
 	if err != nil {
-		log.Println("failed to create post in sql: ", err)
+		logger.WithContext(r.log, ctx).Error("failed to create post in sql", logger.Error(err))
 		return repo.Post{}, err
 	}
 
-	return res, nil
+	err = insertRevision(ctx, tx, res.Id, res.UserId, 0)
+	if err != nil {
+		logger.WithContext(r.log, ctx).Error("failed to create post revision in sql", logger.Error(err))
+		return repo.Post{}, err
+	}
+
+	err = insertEvent(ctx, tx, events.PostCreated, res.Id, events.Post{Id: res.Id, UserId: res.UserId, Title: res.Title})
+	if err != nil {
+		logger.WithContext(r.log, ctx).Error("failed to create post created event in sql", logger.Error(err))
+		return repo.Post{}, err
+	}
+
+	if res.ModerationStatus == repo.ModerationHeld {
+		err = insertEvent(ctx, tx, events.PostHeld, res.Id, events.Held{Id: res.Id, UserId: res.UserId, Labels: post.ModerationLabels})
+		if err != nil {
+			logger.WithContext(r.log, ctx).Error("failed to create post held event in sql", logger.Error(err))
+			return repo.Post{}, err
+		}
+	}
+
+	return res, tx.Commit()
 }
 
-func (r *PostRepo) GetPostById(id string) (repo.Post, error) {
-	res := repo.Post{}
-	err := r.db.QueryRow(`
+func (r *PostRepo) GetPostById(ctx context.Context, id string) (repo.Post, error) {
+	res, err := scanPost(r.db.QueryRowContext(ctx, `
 		select 
-			id, title, description, likes, user_id, created_at, updated_at 
+			`+postColumns+` 
 		from 
 			posts 
 		where 
-			id = $1 and deleted_at is null`, id).Scan(&res.Id, &res.Title, &res.Description, &res.Likes, &res.UserId, &res.CreatedAt, &res.UpdatedAt)
+			id = $1 and deleted_at is null`, id))
 
 	if err != nil {
-		log.Println("failed to get post in sql: ", err)
+		logger.WithContext(r.log, ctx).Error("failed to get post in sql", logger.Error(err))
 		return repo.Post{}, err
 	}
 
 	return res, nil
 }
 
-func (r *PostRepo) GetPostByUserId(id string) ([]repo.Post, error) {
-	res := []repo.Post{}
-	rows, err := r.db.Query(`
+func (r *PostRepo) GetPostByUserId(ctx context.Context, id string) ([]repo.Post, error) {
+	rows, err := r.db.QueryContext(ctx, `
 		select 
-			id, title, description, likes, user_id, created_at, updated_at 
+			`+postColumns+` 
 		from 
 			posts 
 		where 
 			user_id = $1 and deleted_at is null`, id)
 
 	if err != nil {
-		log.Println("failed to get post by user id in sql: ", err)
+		logger.WithContext(r.log, ctx).Error("failed to get post by user id in sql", logger.Error(err))
 		return []repo.Post{}, err
 	}
 
-	for rows.Next() {
-		post := repo.Post{}
-
-		err = rows.Scan(
-			&post.Id,
-			&post.Title,
-			&post.Description,
-			&post.Likes,
-			&post.UserId,
-			&post.CreatedAt,
-			&post.UpdatedAt,
-		)
-
-		if err != nil {
-			log.Println("failed to scan post by user id in sql: ", err)
-			return []repo.Post{}, err
-		}
-
-		res = append(res, post)
+	res, err := scanPosts(rows)
+	if err != nil {
+		logger.WithContext(r.log, ctx).Error("failed to scan post by user id in sql", logger.Error(err))
+		return []repo.Post{}, err
 	}
 
 	return res, nil
 }
 
-func (r *PostRepo) GetPostForUser(id string) ([]repo.Post, error) {
-	res := []repo.Post{}
-	rows, err := r.db.Query(`
+func (r *PostRepo) GetPostForUser(ctx context.Context, id string) ([]repo.Post, error) {
+	rows, err := r.db.QueryContext(ctx, `
 		select 
-			id, title, description, likes, user_id, created_at, updated_at 
+			`+postColumns+` 
 		from 
 			posts 
 		where
 			user_id = $1 and deleted_at is null`, id)
 
 	if err != nil {
-		log.Println("failed to get post for user in sql: ", err)
+		logger.WithContext(r.log, ctx).Error("failed to get post for user in sql", logger.Error(err))
 		return []repo.Post{}, err
 	}
 
-	for rows.Next() {
-		post := repo.Post{}
-
-		err = rows.Scan(
-			&post.Id,
-			&post.Title,
-			&post.Description,
-			&post.Likes,
-			&post.UserId,
-			&post.CreatedAt,
-			&post.UpdatedAt,
-		)
+	res, err := scanPosts(rows)
+	if err != nil {
+		logger.WithContext(r.log, ctx).Error("failed to scan post: in sql", logger.Error(err))
+		return []repo.Post{}, nil
+	}
 
-		if err != nil {
-			log.Println("failed to scan post: in sql: ", err)
-			return []repo.Post{}, nil
-		}
+	return res, nil
+}
 
-		res = append(res, post)
+// GetPostsByIds returns found posts of ids with one query
+func (r *PostRepo) GetPostsByIds(ctx context.Context, ids []string) ([]repo.Post, error) {
+	rows, err := r.db.QueryContext(ctx, `
+		select 
+			`+postColumns+` 
+		from 
+			posts 
+		where 
+			id = any($1::uuid[]) and deleted_at is null`, pq.Array(ids))
+	if err != nil {
+		logger.WithContext(r.log, ctx).Error("failed to get posts by ids in sql", logger.Error(err))
+		return []repo.Post{}, err
 	}
 
-	return res, nil
+	return scanPosts(rows)
 }
 
-func (r *PostRepo) GetPostForComment(id string) (repo.Post, error) {
-	res := repo.Post{}
-	err := r.db.QueryRow(`
+// GetPostsForUsers returns posts of many users with one query
+func (r *PostRepo) GetPostsForUsers(ctx context.Context, userIds []string) ([]repo.Post, error) {
+	rows, err := r.db.QueryContext(ctx, `
 		select 
-			id, title, description, likes, user_id, created_at, updated_at 
+			`+postColumns+` 
 		from 
 			posts 
 		where 
-			id = $1 and deleted_at is null`, id).Scan(&res.Id, &res.Title, &res.Description, &res.Likes, &res.UserId, &res.CreatedAt, &res.UpdatedAt)
+			user_id = any($1::uuid[]) and deleted_at is null`, pq.Array(userIds))
+	if err != nil {
+		logger.WithContext(r.log, ctx).Error("failed to get posts for users in sql", logger.Error(err))
+		return []repo.Post{}, err
+	}
+
+	return scanPosts(rows)
+}
+
+func (r *PostRepo) GetPostForComment(ctx context.Context, id string) (repo.Post, error) {
+	res, err := scanPost(r.db.QueryRowContext(ctx, `
+		select 
+			`+postColumns+` 
+		from 
+			posts 
+		where 
+			id = $1 and deleted_at is null`, id))
 
 	if err != nil {
-		log.Println("failed to get post in sql: ", err)
+		logger.WithContext(r.log, ctx).Error("failed to get post in sql", logger.Error(err))
 		return repo.Post{}, err
 	}
 
 	return res, nil
 }
 
-func (r *PostRepo) SearchPosts(title string) ([]repo.Post, error) {
-	res := []repo.Post{}
-	query := fmt.Sprint("select id, title, description, likes, user_id, created_at, updated_at from posts where title ilike '%" + title + "%' and deleted_at is null")
-
-	rows, err := r.db.Query(query)
+func (r *PostRepo) SearchPosts(ctx context.Context, title string) ([]repo.Post, error) {
+	rows, err := r.db.QueryContext(ctx, `
+		select 
+			`+postColumns+` 
+		from 
+			posts 
+		where 
+			title ilike '%' || $1 || '%' and deleted_at is null`, title)
 	if err != nil {
-		log.Println("failed to search post in sql: ", err)
+		logger.WithContext(r.log, ctx).Error("failed to search post in sql", logger.Error(err))
 		return []repo.Post{}, nil
 	}
 
-	for rows.Next() {
-		post := repo.Post{}
-
-		err = rows.Scan(
-			&post.Id,
-			&post.Title,
-			&post.Description,
-			&post.Likes,
-			&post.UserId,
-			&post.CreatedAt,
-			&post.UpdatedAt,
-		)
-		if err != nil {
-			log.Println("failed to scanning post in sql: ", err)
-			return []repo.Post{}, nil
-		}
-
-		res = append(res, post)
+	res, err := scanPosts(rows)
+	if err != nil {
+		logger.WithContext(r.log, ctx).Error("failed to scanning post in sql", logger.Error(err))
+		return []repo.Post{}, nil
 	}
 
 	return res, nil
 }
 
-func (r *PostRepo) LikePost(postId string, isLiked bool) (repo.Post, error) {
-	res := repo.Post{}
-	if isLiked {
-		err := r.db.QueryRow(`
+// LikePost records like of the user once, so likes counter is changed only
+// when the user likes or unlikes the post for the first time. Likes without
+// user are only counted.
+func (r *PostRepo) LikePost(ctx context.Context, postId, userId string, isLiked bool) (repo.Post, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return repo.Post{}, err
+	}
+	defer tx.Rollback()
+
+	res, err := scanPost(tx.QueryRowContext(ctx, `
+		select 
+			`+postColumns+` 
+		from 
+			posts 
+		where 
+			id = $1 and deleted_at is null
+		for update`, postId))
+	if err != nil {
+		logger.WithContext(r.log, ctx).Error("failed to like post in sql", logger.Error(err))
+		return repo.Post{}, err
+	}
+
+	var delta int64
+	switch {
+	case userId == "":
+		if isLiked {
+			delta = 1
+		}
+	case isLiked:
+		delta, err = execRows(ctx, tx, `
+			insert into
+				post_likes(post_id, user_id)
+			values
+				($1, $2)
+			on conflict do nothing`, postId, userId)
+	default:
+		delta, err = execRows(ctx, tx, `delete from post_likes where post_id = $1 and user_id = $2`, postId, userId)
+		delta = -delta
+	}
+	if err != nil {
+		logger.WithContext(r.log, ctx).Error("failed to save like of user in sql", logger.Error(err))
+		return repo.Post{}, err
+	}
+
+	if delta != 0 {
+		res, err = scanPost(tx.QueryRowContext(ctx, `
 			update 
 				posts 
 			set 
-				likes = likes + 1 
+				likes = greatest(likes + $1, 0) 
 			where 
-				id = $1 and deleted_at is null
+				id = $2
 			returning 
-				id, title, description, likes, user_id, created_at, updated_at`, postId).Scan(&res.Id, &res.Title, &res.Description, &res.Likes, &res.UserId, &res.CreatedAt, &res.UpdatedAt)
+				`+postColumns, delta, postId))
 		if err != nil {
-			log.Println("failed to like post in sql: ", err)
+			logger.WithContext(r.log, ctx).Error("failed to like post in sql", logger.Error(err))
 			return repo.Post{}, err
 		}
-	} else {
-		err := r.db.QueryRow(`
-			select 
-				id, title, description, likes + 1, user_id, created_at, updated_at 
-			from 
-				posts 
-			where 
-				id = $1 and deleted_at is null`, postId).Scan(&res.Id, &res.Title, &res.Description, &res.Likes, &res.UserId, &res.CreatedAt, &res.UpdatedAt)
+	}
+
+	return res, tx.Commit()
+}
 
+// GetLikesByUser returns posts liked by the user, newest likes first
+func (r *PostRepo) GetLikesByUser(ctx context.Context, userId string) ([]repo.Like, error) {
+	rows, err := r.db.QueryContext(ctx, `
+		select
+			l.post_id, p.title, l.created_at
+		from
+			post_likes l
+		join
+			posts p on p.id = l.post_id
+		where
+			l.user_id = $1 and p.deleted_at is null
+		order by l.created_at desc`, userId)
+	if err != nil {
+		logger.WithContext(r.log, ctx).Error("failed to get likes by user in sql", logger.Error(err))
+		return []repo.Like{}, err
+	}
+	defer rows.Close()
+
+	res := []repo.Like{}
+	for rows.Next() {
+		like := repo.Like{}
+		err = rows.Scan(&like.PostId, &like.PostTitle, &like.CreatedAt)
 		if err != nil {
-			log.Println("failed to like post in sql: ", err)
-			return repo.Post{}, err
+			logger.WithContext(r.log, ctx).Error("failed to scanning like in sql", logger.Error(err))
+			return []repo.Like{}, err
 		}
+
+		res = append(res, like)
 	}
 
-	return res, nil
+	return res, rows.Err()
 }
 
-func (r *PostRepo) UpdatePost(post repo.Post) (repo.Post, error) {
-	res := repo.Post{}
-	err := r.db.QueryRow(`
+// UpdatePost saves every update as a new revision. Post is marked as edited
+// only when title or description is changed. When post.Version is given the
+// post is updated only if it has this version, otherwise sql.ErrNoRows is
+// returned. Visible post is held when post.ModerationStatus is held, other
+// moderation statuses are kept.
+func (r *PostRepo) UpdatePost(ctx context.Context, post repo.Post) (repo.Post, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return repo.Post{}, err
+	}
+	defer tx.Rollback()
+
+	res, err := scanPost(tx.QueryRowContext(ctx, `
 		update
 			posts 
 		set 
-			title = $1, description = $2, updated_at = $3 
+			title = $1, description = $2, updated_at = $3, status = $5, publish_at = $6, visibility = $7,
+			published_at = case when $5 = 'published' then coalesce(published_at, timezone('utc', now())) end,
+			edited_at = case when title is distinct from $1 or description is distinct from $2 then $3::timestamp else edited_at end,
+			version = version + 1,
+			moderation_status = case when $9 = 'held' and moderation_status = 'visible' then 'held' else moderation_status end
 		where 
-			id = $4 and deleted_at is null
-		returning id, title, description, likes, user_id, created_at, updated_at`, post.Title, post.Description, time.Now(), post.Id).Scan(&res.Id, &res.Title, &res.Description, &res.Likes, &res.UserId, &res.CreatedAt, &res.UpdatedAt)
+			id = $4 and deleted_at is null and ($8 = 0 or version = $8)
+		returning `+postColumns, post.Title, post.Description, time.Now(), post.Id, post.Status, nullString(post.PublishAt), post.Visibility, post.Version, post.ModerationStatus))
 	if err != nil {
-		log.Println("failed to update post in sql in sql: ", err)
+		logger.WithContext(r.log, ctx).Error("failed to update post in sql in sql", logger.Error(err))
+		return repo.Post{}, err
+	}
+
+	editorId := post.EditorId
+	if editorId == "" {
+		editorId = res.UserId
+	}
+
+	err = insertRevision(ctx, tx, res.Id, editorId, 0)
+	if err != nil {
+		logger.WithContext(r.log, ctx).Error("failed to create post revision in sql", logger.Error(err))
+		return repo.Post{}, err
+	}
+
+	if post.ModerationStatus == repo.ModerationHeld && res.ModerationStatus == repo.ModerationHeld {
+		err = insertEvent(ctx, tx, events.PostHeld, res.Id, events.Held{Id: res.Id, UserId: res.UserId, Labels: post.ModerationLabels})
+		if err != nil {
+			logger.WithContext(r.log, ctx).Error("failed to create post held event in sql", logger.Error(err))
+			return repo.Post{}, err
+		}
+	}
+
+	return res, tx.Commit()
+}
+
+// ModeratePost sets moderation status of the post, content and version are
+// not changed
+func (r *PostRepo) ModeratePost(ctx context.Context, id, status string) (repo.Post, error) {
+	res, err := scanPost(r.db.QueryRowContext(ctx, `
+		update
+			posts
+		set
+			moderation_status = $1, updated_at = $2
+		where
+			id = $3 and deleted_at is null
+		returning `+postColumns, status, time.Now(), id))
+	if err != nil {
+		logger.WithContext(r.log, ctx).Error("failed to moderate post in sql", logger.Error(err))
 		return repo.Post{}, err
 	}
 
 	return res, nil
 }
 
-func (r *PostRepo) DeletePost(id string) (repo.Post, error) {
-	post := repo.Post{}
-	err := r.db.QueryRow(`
+func (r *PostRepo) DeletePost(ctx context.Context, id string) (repo.Post, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return repo.Post{}, err
+	}
+	defer tx.Rollback()
+
+	post, err := scanPost(tx.QueryRowContext(ctx, `
 		update 
 			posts 
 		set 
@@ -235,12 +453,257 @@ func (r *PostRepo) DeletePost(id string) (repo.Post, error) {
 		where 
 			id = $2 and deleted_at is null
 		returning 
-			id, title, description, likes, user_id, created_at, updated_at`, time.Now(), id).Scan(&post.Id, &post.Title, &post.Description, &post.Likes, &post.UserId, &post.CreatedAt, &post.UpdatedAt)
+			`+postColumns, time.Now(), id))
 
 	if err != nil {
-		log.Println("failed to delete post in sql: ", err)
+		logger.WithContext(r.log, ctx).Error("failed to delete post in sql", logger.Error(err))
 		return repo.Post{}, err
 	}
 
-	return post, nil
+	err = insertEvent(ctx, tx, events.PostDeleted, post.Id, events.Post{Id: post.Id, UserId: post.UserId, Title: post.Title})
+	if err != nil {
+		logger.WithContext(r.log, ctx).Error("failed to create post deleted event in sql", logger.Error(err))
+		return repo.Post{}, err
+	}
+
+	return post, tx.Commit()
+}
+
+// DeleteUserPosts deletes posts of deleted user once per event, PostDeleted
+// event is written for every post
+func (r *PostRepo) DeleteUserPosts(ctx context.Context, eventId, userId string) ([]repo.Post, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return []repo.Post{}, err
+	}
+	defer tx.Rollback()
+
+	ok, err := markProcessed(ctx, tx, eventId)
+	if err != nil {
+		logger.WithContext(r.log, ctx).Error("failed to mark event processed in sql", logger.Error(err))
+		return []repo.Post{}, err
+	}
+	if !ok {
+		return []repo.Post{}, nil
+	}
+
+	rows, err := tx.QueryContext(ctx, `
+		update
+			posts
+		set
+			deleted_at = $1
+		where
+			user_id = $2 and deleted_at is null
+		returning
+			`+postColumns, time.Now(), userId)
+	if err != nil {
+		logger.WithContext(r.log, ctx).Error("failed to delete user posts in sql", logger.Error(err))
+		return []repo.Post{}, err
+	}
+
+	res, err := scanPosts(rows)
+	if err != nil {
+		return []repo.Post{}, err
+	}
+
+	for _, post := range res {
+		err = insertEvent(ctx, tx, events.PostDeleted, post.Id, events.Post{Id: post.Id, UserId: post.UserId, Title: post.Title})
+		if err != nil {
+			logger.WithContext(r.log, ctx).Error("failed to create post deleted event in sql", logger.Error(err))
+			return []repo.Post{}, err
+		}
+	}
+
+	return res, tx.Commit()
+}
+
+// SetShadowBan saves or removes shadow ban of the user once per event, zero
+// until does not expire. It reports whether the event was handled.
+func (r *PostRepo) SetShadowBan(ctx context.Context, eventId, userId string, banned bool, until time.Time) (bool, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return false, err
+	}
+	defer tx.Rollback()
+
+	ok, err := markProcessed(ctx, tx, eventId)
+	if err != nil {
+		logger.WithContext(r.log, ctx).Error("failed to mark event processed in sql", logger.Error(err))
+		return false, err
+	}
+	if !ok {
+		return false, nil
+	}
+
+	if banned {
+		_, err = tx.ExecContext(ctx, `
+			insert into
+				shadow_bans(user_id, until)
+			values
+				($1, $2)
+			on conflict (user_id) do update
+			set
+				until = excluded.until`, userId, sql.NullTime{Time: until, Valid: !until.IsZero()})
+	} else {
+		_, err = tx.ExecContext(ctx, `delete from shadow_bans where user_id = $1`, userId)
+	}
+	if err != nil {
+		logger.WithContext(r.log, ctx).Error("failed to set shadow ban in sql", logger.Error(err))
+		return false, err
+	}
+
+	err = insertEvent(ctx, tx, events.PostsRestricted, userId, events.User{Id: userId})
+	if err != nil {
+		logger.WithContext(r.log, ctx).Error("failed to create posts restricted event in sql", logger.Error(err))
+		return false, err
+	}
+
+	return true, tx.Commit()
+}
+
+// PurgeUserPosts erases posts of purged user with their history, tags,
+// likes and attachments once per event. Likes of the user are erased too.
+// Blobs of attachments are deleted by blob cleaner, PostPurged event is
+// written for every post.
+func (r *PostRepo) PurgeUserPosts(ctx context.Context, eventId, userId string) ([]repo.Post, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return []repo.Post{}, err
+	}
+	defer tx.Rollback()
+
+	ok, err := markProcessed(ctx, tx, eventId)
+	if err != nil {
+		logger.WithContext(r.log, ctx).Error("failed to mark event processed in sql", logger.Error(err))
+		return []repo.Post{}, err
+	}
+	if !ok {
+		return []repo.Post{}, nil
+	}
+
+	_, err = tx.ExecContext(ctx, `
+		insert into
+			blob_deletions(storage_key, delete_after)
+		select storage_key, $2::timestamp from attachments where user_id = $1 and deleted_at is null
+		union all
+		select thumbnail_key, $2::timestamp from attachments where user_id = $1 and deleted_at is null and thumbnail_key <> ''`, userId, time.Now())
+	if err != nil {
+		logger.WithContext(r.log, ctx).Error("failed to schedule blob deletion of purged user in sql", logger.Error(err))
+		return []repo.Post{}, err
+	}
+
+	rows, err := tx.QueryContext(ctx, `delete from posts where user_id = $1 returning `+postColumns, userId)
+	if err != nil {
+		logger.WithContext(r.log, ctx).Error("failed to purge user posts in sql", logger.Error(err))
+		return []repo.Post{}, err
+	}
+
+	res, err := scanPosts(rows)
+	if err != nil {
+		return []repo.Post{}, err
+	}
+
+	postIds := make([]string, 0, len(res))
+	for _, post := range res {
+		postIds = append(postIds, post.Id)
+	}
+
+	// attachments of the user on other posts are erased too
+	for _, query := range []string{
+		`delete from attachments where post_id = any($1::uuid[]) or user_id = $2`,
+		`delete from post_revisions where post_id = any($1::uuid[])`,
+		`delete from post_tags where post_id = any($1::uuid[])`,
+		`delete from post_likes where post_id = any($1::uuid[])`,
+	} {
+		_, err = tx.ExecContext(ctx, query, pq.Array(postIds), userId)
+		if err != nil {
+			logger.WithContext(r.log, ctx).Error("failed to purge user posts in sql", logger.Error(err))
+			return []repo.Post{}, err
+		}
+	}
+
+	_, err = tx.ExecContext(ctx, `
+		update
+			posts p
+		set
+			likes = greatest(p.likes - 1, 0)
+		from
+			post_likes l
+		where
+			l.post_id = p.id and l.user_id = $1`, userId)
+	if err != nil {
+		logger.WithContext(r.log, ctx).Error("failed to unlike posts of purged user in sql", logger.Error(err))
+		return []repo.Post{}, err
+	}
+
+	_, err = tx.ExecContext(ctx, `delete from post_likes where user_id = $1`, userId)
+	if err != nil {
+		logger.WithContext(r.log, ctx).Error("failed to purge user likes in sql", logger.Error(err))
+		return []repo.Post{}, err
+	}
+
+	_, err = tx.ExecContext(ctx, `delete from shadow_bans where user_id = $1`, userId)
+	if err != nil {
+		logger.WithContext(r.log, ctx).Error("failed to purge shadow ban of user in sql", logger.Error(err))
+		return []repo.Post{}, err
+	}
+
+	for _, post := range res {
+		err = insertEvent(ctx, tx, events.PostPurged, post.Id, events.Post{Id: post.Id, UserId: post.UserId, Title: post.Title})
+		if err != nil {
+			logger.WithContext(r.log, ctx).Error("failed to create post purged event in sql", logger.Error(err))
+			return []repo.Post{}, err
+		}
+	}
+
+	return res, tx.Commit()
+}
+
+// PublishScheduledPosts publishes due scheduled posts. The status condition in update makes
+// every post published exactly once, skip locked lets several replicas run it at the same time.
+// PostPublished event is written for every post.
+func (r *PostRepo) PublishScheduledPosts(ctx context.Context, limit int) ([]repo.Post, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return []repo.Post{}, err
+	}
+	defer tx.Rollback()
+
+	rows, err := tx.QueryContext(ctx, `
+		update
+			posts
+		set
+			status = 'published', published_at = timezone('utc', now()), updated_at = $1, version = version + 1
+		where id in (
+			select
+				id
+			from
+				posts
+			where
+				status = 'scheduled' and publish_at <= timezone('utc', now()) and deleted_at is null
+			order by publish_at
+			limit $2
+			for update skip locked
+		) and status = 'scheduled'
+		returning `+postColumns, time.Now(), limit)
+	if err != nil {
+		logger.WithContext(r.log, ctx).Error("failed to publish scheduled posts in sql", logger.Error(err))
+		return []repo.Post{}, err
+	}
+
+	res, err := scanPosts(rows)
+	if err != nil {
+		logger.WithContext(r.log, ctx).Error("failed to scan published posts in sql", logger.Error(err))
+		return []repo.Post{}, err
+	}
+
+	for _, post := range res {
+		err = insertEvent(ctx, tx, events.PostPublished, post.Id, events.Post{Id: post.Id, UserId: post.UserId, Title: post.Title})
+		if err != nil {
+			logger.WithContext(r.log, ctx).Error("failed to create post published event in sql", logger.Error(err))
+			return []repo.Post{}, err
+		}
+	}
+
+	return res, tx.Commit()
 }
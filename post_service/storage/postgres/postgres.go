@@ -1,13 +1,55 @@
 package postgres
 
-import "github.com/jmoiron/sqlx"
+import (
+	"github.com/burxondv/new-services/post-service/pkg/logger"
+
+	"github.com/jmoiron/sqlx"
+)
 
 type PostRepo struct {
-	db *sqlx.DB
+	db  *sqlx.DB
+	log logger.Logger
 }
 
-func NewPostRepo(db *sqlx.DB) *PostRepo {
+func NewPostRepo(db *sqlx.DB, log logger.Logger) *PostRepo {
 	return &PostRepo{
-		db: db,
+		db:  db,
+		log: log,
+	}
+}
+
+type AttachmentRepo struct {
+	db  *sqlx.DB
+	log logger.Logger
+}
+
+func NewAttachmentRepo(db *sqlx.DB, log logger.Logger) *AttachmentRepo {
+	return &AttachmentRepo{
+		db:  db,
+		log: log,
+	}
+}
+
+type TagRepo struct {
+	db  *sqlx.DB
+	log logger.Logger
+}
+
+func NewTagRepo(db *sqlx.DB, log logger.Logger) *TagRepo {
+	return &TagRepo{
+		db:  db,
+		log: log,
+	}
+}
+
+type OutboxRepo struct {
+	db  *sqlx.DB
+	log logger.Logger
+}
+
+func NewOutboxRepo(db *sqlx.DB, log logger.Logger) *OutboxRepo {
+	return &OutboxRepo{
+		db:  db,
+		log: log,
 	}
 }
@@ -0,0 +1,129 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/burxondv/new-services/post-service/pkg/logger"
+	"github.com/burxondv/new-services/post-service/storage/repo"
+)
+
+// insertRevision saves current title and description of the post as its next revision.
+// Revisions are never updated or deleted, restoring adds a new one with restored_from.
+func insertRevision(ctx context.Context, tx *sql.Tx, postId, editorId string, restoredFrom int64) error {
+	_, err := tx.ExecContext(ctx, `
+		insert into
+			post_revisions(post_id, revision, title, description, editor_id, restored_from)
+		select
+			id,
+			coalesce((select max(revision) from post_revisions where post_id = $1), 0) + 1,
+			title, description, $2, nullif($3, 0)
+		from
+			posts
+		where
+			id = $1`, postId, editorId, restoredFrom)
+
+	return err
+}
+
+func scanRevision(row scanner) (repo.Revision, error) {
+	var (
+		res          repo.Revision
+		restoredFrom sql.NullInt64
+	)
+
+	err := row.Scan(
+		&res.PostId,
+		&res.Revision,
+		&res.Title,
+		&res.Description,
+		&res.EditorId,
+		&restoredFrom,
+		&res.CreatedAt,
+	)
+	if err != nil {
+		return repo.Revision{}, err
+	}
+
+	res.RestoredFrom = restoredFrom.Int64
+
+	return res, nil
+}
+
+func (r *PostRepo) GetRevisions(ctx context.Context, postId string) ([]repo.Revision, error) {
+	rows, err := r.db.QueryContext(ctx, `
+		select
+			post_id, revision, title, description, editor_id, restored_from, created_at
+		from
+			post_revisions
+		where
+			post_id = $1
+		order by revision desc`, postId)
+	if err != nil {
+		logger.WithContext(r.log, ctx).Error("failed to get revisions in sql", logger.Error(err))
+		return []repo.Revision{}, err
+	}
+	defer rows.Close()
+
+	res := []repo.Revision{}
+	for rows.Next() {
+		rev, err := scanRevision(rows)
+		if err != nil {
+			logger.WithContext(r.log, ctx).Error("failed to scanning revisions in sql", logger.Error(err))
+			return []repo.Revision{}, err
+		}
+
+		res = append(res, rev)
+	}
+
+	return res, rows.Err()
+}
+
+func (r *PostRepo) GetRevision(ctx context.Context, postId string, revision int64) (repo.Revision, error) {
+	res, err := scanRevision(r.db.QueryRowContext(ctx, `
+		select
+			post_id, revision, title, description, editor_id, restored_from, created_at
+		from
+			post_revisions
+		where
+			post_id = $1 and revision = $2`, postId, revision))
+	if err != nil {
+		logger.WithContext(r.log, ctx).Error("failed to get revision in sql", logger.Error(err))
+		return repo.Revision{}, err
+	}
+
+	return res, nil
+}
+
+// RestoreRevision brings back title and description of the given revision
+func (r *PostRepo) RestoreRevision(ctx context.Context, postId string, revision int64, editorId string) (repo.Post, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return repo.Post{}, err
+	}
+	defer tx.Rollback()
+
+	res, err := scanPost(tx.QueryRowContext(ctx, `
+		update
+			posts
+		set
+			(title, description) = (select title, description from post_revisions where post_id = $1 and revision = $2),
+			updated_at = $3, edited_at = $3, version = version + 1
+		where
+			id = $1 and deleted_at is null and
+			exists(select 1 from post_revisions where post_id = $1 and revision = $2)
+		returning `+postColumns, postId, revision, time.Now()))
+	if err != nil {
+		logger.WithContext(r.log, ctx).Error("failed to restore revision in sql", logger.Error(err))
+		return repo.Post{}, err
+	}
+
+	err = insertRevision(ctx, tx, postId, editorId, revision)
+	if err != nil {
+		logger.WithContext(r.log, ctx).Error("failed to create post revision in sql", logger.Error(err))
+		return repo.Post{}, err
+	}
+
+	return res, tx.Commit()
+}
@@ -0,0 +1,176 @@
+package postgres
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/burxondv/new-services/post-service/pkg/logger"
+	"github.com/burxondv/new-services/post-service/storage/repo"
+
+	"github.com/lib/pq"
+)
+
+// SetPostTags replaces tags of the post, new tags are created
+func (r *TagRepo) SetPostTags(ctx context.Context, postId string, tags []string) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	_, err = tx.ExecContext(ctx, `
+		insert into
+			tags(name)
+		select unnest($1::text[])
+		on conflict (name) do nothing`, pq.Array(tags))
+	if err != nil {
+		logger.WithContext(r.log, ctx).Error("failed to create tags in sql", logger.Error(err))
+		return err
+	}
+
+	_, err = tx.ExecContext(ctx, `
+		delete from
+			post_tags
+		where
+			post_id = $1 and tag_id not in (select id from tags where name = any($2))`, postId, pq.Array(tags))
+	if err != nil {
+		logger.WithContext(r.log, ctx).Error("failed to remove post tags in sql", logger.Error(err))
+		return err
+	}
+
+	_, err = tx.ExecContext(ctx, `
+		insert into
+			post_tags(post_id, tag_id)
+		select $1, id from tags where name = any($2)
+		on conflict do nothing`, postId, pq.Array(tags))
+	if err != nil {
+		logger.WithContext(r.log, ctx).Error("failed to add post tags in sql", logger.Error(err))
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// GetPostTags returns tag names by post id
+func (r *TagRepo) GetPostTags(ctx context.Context, postIds []string) (map[string][]string, error) {
+	rows, err := r.db.QueryContext(ctx, `
+		select
+			pt.post_id, t.name
+		from
+			post_tags pt
+		join
+			tags t on t.id = pt.tag_id
+		where
+			pt.post_id = any($1::uuid[])
+		order by t.name`, pq.Array(postIds))
+	if err != nil {
+		logger.WithContext(r.log, ctx).Error("failed to get post tags in sql", logger.Error(err))
+		return map[string][]string{}, err
+	}
+	defer rows.Close()
+
+	res := map[string][]string{}
+	for rows.Next() {
+		var postId, name string
+		if err := rows.Scan(&postId, &name); err != nil {
+			logger.WithContext(r.log, ctx).Error("failed to scanning post tags in sql", logger.Error(err))
+			return map[string][]string{}, err
+		}
+
+		res[postId] = append(res[postId], name)
+	}
+
+	return res, rows.Err()
+}
+
+// GetPostsByTag returns published posts with the tag, newest first
+func (r *TagRepo) GetPostsByTag(ctx context.Context, tag string, limit, offset int64) ([]repo.Post, error) {
+	rows, err := r.db.QueryContext(ctx, `
+		select
+			`+postColumns+`
+		from
+			posts
+		where
+			id in (
+				select pt.post_id from post_tags pt join tags t on t.id = pt.tag_id where t.name = $1
+			) and status = 'published' and deleted_at is null
+		order by published_at desc
+		limit $2 offset $3`, tag, limit, offset)
+	if err != nil {
+		logger.WithContext(r.log, ctx).Error("failed to get posts by tag in sql", logger.Error(err))
+		return []repo.Post{}, err
+	}
+
+	res, err := scanPosts(rows)
+	if err != nil {
+		logger.WithContext(r.log, ctx).Error("failed to scanning posts by tag in sql", logger.Error(err))
+		return []repo.Post{}, err
+	}
+
+	return res, nil
+}
+
+// SearchTags returns tags starting with prefix, most used in posts everyone
+// can see first
+func (r *TagRepo) SearchTags(ctx context.Context, prefix string, limit int64) ([]repo.Tag, error) {
+	prefix = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`).Replace(prefix)
+
+	return r.queryTags(ctx, `
+		select
+			t.name, count(p.id)
+		from
+			tags t
+		left join
+			post_tags pt on pt.tag_id = t.id
+		left join
+			posts p on p.id = pt.post_id and p.status = 'published' and p.visibility = 'public' and p.moderation_status = 'visible' and p.deleted_at is null and
+				not exists(select 1 from shadow_bans sb where sb.user_id = p.user_id and (sb.until is null or sb.until > timezone('utc', now())))
+		where
+			t.name like $1 || '%'
+		group by t.name
+		order by count(p.id) desc, t.name
+		limit $2`, prefix, limit)
+}
+
+// GetTrendingTags returns tags which are used most in posts everyone can see
+// published since the given time
+func (r *TagRepo) GetTrendingTags(ctx context.Context, since time.Time, limit int64) ([]repo.Tag, error) {
+	return r.queryTags(ctx, `
+		select
+			t.name, count(*)
+		from
+			tags t
+		join
+			post_tags pt on pt.tag_id = t.id
+		join
+			posts p on p.id = pt.post_id
+		where
+			p.status = 'published' and p.visibility = 'public' and p.moderation_status = 'visible' and p.deleted_at is null and p.published_at >= $1 and
+			not exists(select 1 from shadow_bans sb where sb.user_id = p.user_id and (sb.until is null or sb.until > timezone('utc', now())))
+		group by t.name
+		order by count(*) desc, t.name
+		limit $2`, since, limit)
+}
+
+func (r *TagRepo) queryTags(ctx context.Context, query string, args ...interface{}) ([]repo.Tag, error) {
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		logger.WithContext(r.log, ctx).Error("failed to get tags in sql", logger.Error(err))
+		return []repo.Tag{}, err
+	}
+	defer rows.Close()
+
+	res := []repo.Tag{}
+	for rows.Next() {
+		tag := repo.Tag{}
+		if err := rows.Scan(&tag.Name, &tag.Posts); err != nil {
+			logger.WithContext(r.log, ctx).Error("failed to scanning tags in sql", logger.Error(err))
+			return []repo.Tag{}, err
+		}
+
+		res = append(res, tag)
+	}
+
+	return res, rows.Err()
+}
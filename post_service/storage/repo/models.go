@@ -8,6 +8,71 @@ type Post struct {
 	Likes       int64
 	UserName    string
 	Comments    int64
+	Status      string
+	PublishAt   string
+	Visibility  string
+	EditorId    string // who made the update, saved in revision
+	EditedAt    string
 	CreatedAt   string
 	UpdatedAt   string
+	Version     int64 // expected version on update, 0 skips the check
+
+	ModerationStatus string
+	ModerationLabels []string // labels of classifier rules which held the post, not stored
+
+	AuthorShadowBanned bool // posts of shadow banned users are shown only to them, not stored
+}
+
+// post statuses...
+const (
+	StatusDraft     = "draft"
+	StatusScheduled = "scheduled"
+	StatusPublished = "published"
+)
+
+// post visibility levels...
+const (
+	VisibilityPublic    = "public"
+	VisibilityFollowers = "followers"
+	VisibilityPrivate   = "private"
+)
+
+// moderation statuses, not visible posts are shown only to their authors...
+const (
+	ModerationVisible = "visible"
+	ModerationHeld    = "held" // waits for review
+	ModerationHidden  = "hidden"
+)
+
+type Like struct {
+	PostId    string
+	PostTitle string
+	CreatedAt string
+}
+
+type Attachment struct {
+	Id           string
+	PostId       string
+	UserId       string
+	FileName     string
+	MimeType     string
+	Size         int64
+	StorageKey   string
+	ThumbnailKey string
+	CreatedAt    string
+}
+
+type Revision struct {
+	PostId       string
+	Revision     int64
+	Title        string
+	Description  string
+	EditorId     string
+	RestoredFrom int64
+	CreatedAt    string
+}
+
+type Tag struct {
+	Name  string
+	Posts int64
 }
@@ -1,15 +1,60 @@
 package repo
 
+import (
+	"context"
+	"time"
+
+	"github.com/burxondv/new-services/post-service/pkg/events"
+)
+
 type PostStorageI interface {
-	CreatePost(Post) (Post, error)
-	GetPostById(string) (Post, error)
-	GetPostByUserId(string) ([]Post, error)
-	SearchPosts(string) ([]Post, error)
-	LikePost(post_id string, is_liked bool) (Post, error)
-	UpdatePost(Post) (Post, error)
-	DeletePost(string) (Post, error)
+	CreatePost(context.Context, Post) (Post, error)
+	GetPostById(context.Context, string) (Post, error)
+	GetPostByUserId(context.Context, string) ([]Post, error)
+	SearchPosts(context.Context, string) ([]Post, error)
+	LikePost(ctx context.Context, postId, userId string, isLiked bool) (Post, error)
+	GetLikesByUser(ctx context.Context, userId string) ([]Like, error)
+	UpdatePost(context.Context, Post) (Post, error)
+	DeletePost(context.Context, string) (Post, error)
+	ModeratePost(ctx context.Context, id, status string) (Post, error)
+
+	// revisions...
+	GetRevisions(ctx context.Context, postId string) ([]Revision, error)
+	GetRevision(ctx context.Context, postId string, revision int64) (Revision, error)
+	RestoreRevision(ctx context.Context, postId string, revision int64, editorId string) (Post, error)
+
+	// scheduler...
+	PublishScheduledPosts(ctx context.Context, limit int) ([]Post, error)
+
+	// events...
+	DeleteUserPosts(ctx context.Context, eventId, userId string) ([]Post, error)
+	PurgeUserPosts(ctx context.Context, eventId, userId string) ([]Post, error)
+	SetShadowBan(ctx context.Context, eventId, userId string, banned bool, until time.Time) (bool, error)
 
 	// for Clients...
-	GetPostForUser(string) ([]Post, error)
-	GetPostForComment(string) (Post, error)
-}
\ No newline at end of file
+	GetPostForUser(context.Context, string) ([]Post, error)
+	GetPostForComment(context.Context, string) (Post, error)
+	GetPostsByIds(ctx context.Context, ids []string) ([]Post, error)
+	GetPostsForUsers(ctx context.Context, userIds []string) ([]Post, error)
+}
+type AttachmentStorageI interface {
+	CreateAttachment(context.Context, Attachment) (Attachment, error)
+	GetAttachment(ctx context.Context, id string) (Attachment, error)
+	GetAttachments(ctx context.Context, postId string) ([]Attachment, error)
+	DeleteAttachment(ctx context.Context, id string, deleteAfter time.Time) (Attachment, error)
+
+	// blobs of deleted posts...
+	ScheduleBlobDeletion(ctx context.Context, postId string, deleteAfter time.Time) error
+	GetDueBlobDeletions(ctx context.Context, limit int) ([]string, error)
+	RemoveBlobDeletion(ctx context.Context, key string) error
+}
+type TagStorageI interface {
+	SetPostTags(ctx context.Context, postId string, tags []string) error
+	GetPostTags(ctx context.Context, postIds []string) (map[string][]string, error)
+	GetPostsByTag(ctx context.Context, tag string, limit, offset int64) ([]Post, error)
+	SearchTags(ctx context.Context, prefix string, limit int64) ([]Tag, error)
+	GetTrendingTags(ctx context.Context, since time.Time, limit int64) ([]Tag, error)
+}
+type OutboxStorageI interface {
+	RelayEvents(ctx context.Context, limit int, publish func(events.Event) error) (int, error)
+}
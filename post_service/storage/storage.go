@@ -1,6 +1,7 @@
 package storage
 
 import (
+	"github.com/burxondv/new-services/post-service/pkg/logger"
 	"github.com/burxondv/new-services/post-service/storage/postgres"
 	"github.com/burxondv/new-services/post-service/storage/repo"
 
@@ -9,20 +10,41 @@ import (
 
 type IStorage interface {
 	Post() repo.PostStorageI
+	Attachment() repo.AttachmentStorageI
+	Tag() repo.TagStorageI
+	Outbox() repo.OutboxStorageI
 }
 
 type storagePg struct {
-	db       *sqlx.DB
-	postRepo repo.PostStorageI
+	db             *sqlx.DB
+	postRepo       repo.PostStorageI
+	attachmentRepo repo.AttachmentStorageI
+	tagRepo        repo.TagStorageI
+	outboxRepo     repo.OutboxStorageI
 }
 
-func NewStoragePg(db *sqlx.DB) *storagePg {
+func NewStoragePg(db *sqlx.DB, log logger.Logger) *storagePg {
 	return &storagePg{
-		db:       db,
-		postRepo: postgres.NewPostRepo(db),
+		db:             db,
+		postRepo:       postgres.NewPostRepo(db, log),
+		attachmentRepo: postgres.NewAttachmentRepo(db, log),
+		tagRepo:        postgres.NewTagRepo(db, log),
+		outboxRepo:     postgres.NewOutboxRepo(db, log),
 	}
 }
 
 func (s storagePg) Post() repo.PostStorageI {
 	return s.postRepo
 }
+
+func (s storagePg) Attachment() repo.AttachmentStorageI {
+	return s.attachmentRepo
+}
+
+func (s storagePg) Tag() repo.TagStorageI {
+	return s.tagRepo
+}
+
+func (s storagePg) Outbox() repo.OutboxStorageI {
+	return s.outboxRepo
+}
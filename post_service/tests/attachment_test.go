@@ -0,0 +1,114 @@
+package tests
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"hash/crc32"
+	"image"
+	"image/png"
+	"testing"
+
+	"github.com/burxondv/new-services/post-service/pkg/blob"
+	"github.com/burxondv/new-services/post-service/pkg/media"
+)
+
+func TestMedia_Sniff(t *testing.T) {
+	var img bytes.Buffer
+	if err := png.Encode(&img, image.NewRGBA(image.Rect(0, 0, 10, 10))); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name     string
+		input    []byte
+		maxSize  int64
+		wantMime string
+		wantErr  error
+	}{
+		{name: "png image", input: img.Bytes(), maxSize: 1 << 20, wantMime: "image/png"},
+		{name: "plain text", input: []byte("hello world"), maxSize: 1 << 20, wantMime: "text/plain"},
+		{name: "empty file", input: nil, maxSize: 1 << 20, wantErr: media.ErrEmptyFile},
+		{name: "too large", input: img.Bytes(), maxSize: 10, wantErr: media.ErrTooLarge},
+		{name: "html is not allowed", input: []byte("<html><body>hi</body></html>"), maxSize: 1 << 20, wantErr: media.ErrUnsupportedType},
+	}
+
+	for _, tCase := range tests {
+		t.Run(tCase.name, func(t *testing.T) {
+			got, err := media.Sniff(tCase.input, tCase.maxSize)
+			if err != tCase.wantErr {
+				t.Fatalf("%s: expected error: %v, got: %v", tCase.name, tCase.wantErr, err)
+			}
+			if got != tCase.wantMime {
+				t.Fatalf("%s: expected: %v, got: %v", tCase.name, tCase.wantMime, got)
+			}
+		})
+	}
+}
+
+func TestMedia_Thumbnail(t *testing.T) {
+	var img bytes.Buffer
+	if err := png.Encode(&img, image.NewRGBA(image.Rect(0, 0, 1024, 512))); err != nil {
+		t.Fatal(err)
+	}
+
+	thumb, err := media.Thumbnail(img.Bytes())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cfg, format, err := image.DecodeConfig(bytes.NewReader(thumb))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if format != "jpeg" || cfg.Width != media.ThumbnailSize || cfg.Height != media.ThumbnailSize/2 {
+		t.Fatalf("unexpected thumbnail: %s %dx%d", format, cfg.Width, cfg.Height)
+	}
+}
+
+func TestMedia_ThumbnailTooLarge(t *testing.T) {
+	var img bytes.Buffer
+	if err := png.Encode(&img, image.NewRGBA(image.Rect(0, 0, 1, 1))); err != nil {
+		t.Fatal(err)
+	}
+
+	// the header declares a 100000x100000 image, its CRC is fixed so only
+	// the dimensions are wrong
+	data := img.Bytes()
+	binary.BigEndian.PutUint32(data[16:], 100000)
+	binary.BigEndian.PutUint32(data[20:], 100000)
+	binary.BigEndian.PutUint32(data[29:], crc32.ChecksumIEEE(data[12:29]))
+
+	if _, err := media.Thumbnail(data); err != media.ErrImageTooLarge {
+		t.Fatalf("expected: %v, got: %v", media.ErrImageTooLarge, err)
+	}
+}
+
+func TestBlob_LocalStore(t *testing.T) {
+	ctx := context.Background()
+	store, err := blob.NewLocalStore(t.TempDir())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := store.Put(ctx, "attachments/post/file", "text/plain", []byte("data")); err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := store.Get(ctx, "attachments/post/file")
+	if err != nil || string(got) != "data" {
+		t.Fatalf("expected: data, got: %s, err: %v", got, err)
+	}
+
+	if err := store.Delete(ctx, "attachments/post/file"); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := store.Get(ctx, "attachments/post/file"); err != blob.ErrNotFound {
+		t.Fatalf("expected: %v, got: %v", blob.ErrNotFound, err)
+	}
+
+	if err := store.Put(ctx, "../outside", "text/plain", []byte("data")); err == nil {
+		t.Fatal("expected error for key outside of root")
+	}
+}
@@ -0,0 +1,40 @@
+package tests
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/burxondv/new-services/post-service/pkg/backoff"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBackoff_Wait(t *testing.T) {
+	calls := 0
+	err := backoff.Wait(context.Background(), 10*time.Second, func(ctx context.Context) error {
+		calls++
+		if calls < 3 {
+			return errors.New("not ready")
+		}
+		return nil
+	}, nil)
+	assert.Nil(t, err)
+	assert.Equal(t, 3, calls)
+}
+
+func TestBackoff_Timeout(t *testing.T) {
+	var delays []time.Duration
+	err := backoff.Wait(context.Background(), 2*time.Second, func(ctx context.Context) error {
+		return errors.New("not ready")
+	}, func(err error, delay time.Duration) {
+		delays = append(delays, delay)
+	})
+	assert.EqualError(t, err, "not ready")
+	assert.NotEmpty(t, delays)
+	for i := 1; i < len(delays); i++ {
+		// delay doubles, jitter takes up to half of it
+		assert.True(t, delays[i] >= delays[i-1]/2)
+	}
+}
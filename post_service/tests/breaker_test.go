@@ -0,0 +1,63 @@
+package tests
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/burxondv/new-services/post-service/pkg/rpcclient"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestBreaker_Opens(t *testing.T) {
+	b := rpcclient.NewBreaker("comment_service", 3, time.Hour)
+	down := status.Error(codes.Unavailable, "connection refused")
+
+	for i := 0; i < 3; i++ {
+		assert.Nil(t, b.Allow())
+		b.Done(down)
+	}
+	assert.Equal(t, rpcclient.Open, b.State())
+
+	err := b.Allow()
+	assert.Equal(t, codes.Unavailable, status.Code(err))
+	assert.True(t, rpcclient.IsUnavailable(err))
+}
+
+func TestBreaker_IgnoresOtherErrors(t *testing.T) {
+	b := rpcclient.NewBreaker("comment_service", 2, time.Hour)
+
+	for i := 0; i < 5; i++ {
+		assert.Nil(t, b.Allow())
+		b.Done(status.Error(codes.NotFound, "comment not found"))
+		b.Done(errors.New("bad request"))
+	}
+	assert.Equal(t, rpcclient.Closed, b.State())
+}
+
+func TestBreaker_HalfOpen(t *testing.T) {
+	b := rpcclient.NewBreaker("comment_service", 1, 50*time.Millisecond)
+	down := status.Error(codes.Unavailable, "connection refused")
+
+	assert.Nil(t, b.Allow())
+	b.Done(down)
+	assert.NotNil(t, b.Allow())
+
+	time.Sleep(60 * time.Millisecond)
+	// only one probe is let through
+	assert.Nil(t, b.Allow())
+	assert.NotNil(t, b.Allow())
+
+	// failed probe opens it again
+	b.Done(down)
+	assert.Equal(t, rpcclient.Open, b.State())
+
+	time.Sleep(60 * time.Millisecond)
+	assert.Nil(t, b.Allow())
+	b.Done(nil)
+	assert.Equal(t, rpcclient.Closed, b.State())
+	assert.Nil(t, b.Allow())
+}
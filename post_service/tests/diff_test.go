@@ -0,0 +1,58 @@
+package tests
+
+import (
+	"testing"
+
+	"github.com/burxondv/new-services/post-service/pkg/diff"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDiff_Lines(t *testing.T) {
+	tests := []struct {
+		name string
+		a    string
+		b    string
+		want []diff.Line
+	}{
+		{
+			name: "same text",
+			a:    "hello\nworld",
+			b:    "hello\nworld",
+			want: []diff.Line{{Op: diff.Equal, Text: "hello"}, {Op: diff.Equal, Text: "world"}},
+		},
+		{
+			name: "changed line",
+			a:    "hello\nworld\nbye",
+			b:    "hello\ngophers\nbye",
+			want: []diff.Line{
+				{Op: diff.Equal, Text: "hello"},
+				{Op: diff.Delete, Text: "world"},
+				{Op: diff.Insert, Text: "gophers"},
+				{Op: diff.Equal, Text: "bye"},
+			},
+		},
+		{
+			name: "from empty",
+			a:    "",
+			b:    "new",
+			want: []diff.Line{{Op: diff.Insert, Text: "new"}},
+		},
+		{
+			name: "to empty",
+			a:    "old",
+			b:    "",
+			want: []diff.Line{{Op: diff.Delete, Text: "old"}},
+		},
+		{
+			name: "both empty",
+			want: []diff.Line{},
+		},
+	}
+
+	for _, tCase := range tests {
+		t.Run(tCase.name, func(t *testing.T) {
+			assert.Equal(t, tCase.want, diff.Lines(tCase.a, tCase.b))
+		})
+	}
+}
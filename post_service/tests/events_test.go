@@ -0,0 +1,77 @@
+package tests
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/burxondv/new-services/post-service/pkg/events"
+	"github.com/burxondv/new-services/post-service/pkg/logger"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEvents_New(t *testing.T) {
+	event, err := events.New(events.PostCreated, "post-1", events.Post{Id: "post-1", UserId: "user-1", Title: "Title"})
+	require.Nil(t, err)
+	assert.NotEmpty(t, event.Id)
+	assert.Equal(t, events.PostCreated, event.Type)
+	assert.Equal(t, "post-1", event.AggregateId)
+
+	var post events.Post
+	require.Nil(t, event.Decode(&post))
+	assert.Equal(t, events.Post{Id: "post-1", UserId: "user-1", Title: "Title"}, post)
+}
+
+func TestEvents_MemoryBus(t *testing.T) {
+	bus := events.NewMemoryBus(logger.New("debug", "test"))
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var (
+		mu       sync.Mutex
+		received = map[string][]string{}
+		attempts = map[string]int{}
+		wg       sync.WaitGroup
+	)
+	subscribe := func(group string, fail bool) {
+		defer wg.Done()
+		bus.Subscribe(ctx, group, "consumer", func(ctx context.Context, event events.Event) error {
+			mu.Lock()
+			defer mu.Unlock()
+
+			attempts[group+event.Id]++
+			if fail && attempts[group+event.Id] == 1 {
+				return errors.New("temporary error")
+			}
+			received[group] = append(received[group], event.Type)
+			return nil
+		})
+	}
+	wg.Add(2)
+	go subscribe("posts", false)
+	go subscribe("comments", true)
+
+	for _, eventType := range []string{events.UserCreated, events.UserDeleted} {
+		event, err := events.New(eventType, "user-1", events.User{Id: "user-1"})
+		require.Nil(t, err)
+		require.Nil(t, bus.Publish(ctx, event))
+	}
+
+	assert.Eventually(t, func() bool {
+		mu.Lock()
+		defer mu.Unlock()
+		return len(received["posts"]) == 2 && len(received["comments"]) == 2
+	}, time.Second, 10*time.Millisecond)
+
+	cancel()
+	wg.Wait()
+
+	// every group receives every event once, failed handler is retried
+	assert.Equal(t, []string{events.UserCreated, events.UserDeleted}, received["posts"])
+	assert.Equal(t, []string{events.UserCreated, events.UserDeleted}, received["comments"])
+	assert.Len(t, bus.Events(), 2)
+}
@@ -0,0 +1,83 @@
+package tests
+
+import (
+	"context"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/burxondv/new-services/post-service/pkg/identity"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// sendIdentity returns metadata which the client interceptor sends for ctx
+func sendIdentity(t *testing.T, signer *identity.Signer, ctx context.Context) metadata.MD {
+	var md metadata.MD
+	invoker := func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, opts ...grpc.CallOption) error {
+		md, _ = metadata.FromOutgoingContext(ctx)
+		return nil
+	}
+	require.Nil(t, signer.UnaryClientInterceptor()(ctx, "/post.PostService/UpdatePost", nil, nil, nil, invoker))
+	return md
+}
+
+// receiveIdentity passes md through the server interceptor
+func receiveIdentity(signer *identity.Signer, md metadata.MD) (identity.Identity, bool, error) {
+	var (
+		id identity.Identity
+		ok bool
+	)
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		id, ok = identity.FromContext(ctx)
+		return nil, nil
+	}
+	info := &grpc.UnaryServerInfo{FullMethod: "/post.PostService/UpdatePost"}
+	_, err := signer.UnaryServerInterceptor()(metadata.NewIncomingContext(context.Background(), md), nil, info, handler)
+	return id, ok, err
+}
+
+func TestIdentity_Propagated(t *testing.T) {
+	signer := identity.NewSigner("secret", time.Minute)
+	ctx := identity.With(context.Background(), identity.Identity{Subject: "user-1", Role: "user"})
+
+	id, ok, err := receiveIdentity(signer, sendIdentity(t, signer, ctx))
+	assert.Nil(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, identity.Identity{Subject: "user-1", Role: "user"}, id)
+	assert.False(t, id.IsAdmin())
+
+	// calls of services themselves have no identity
+	_, ok, err = receiveIdentity(signer, sendIdentity(t, signer, context.Background()))
+	assert.Nil(t, err)
+	assert.False(t, ok)
+}
+
+func TestIdentity_Rejected(t *testing.T) {
+	signer := identity.NewSigner("secret", time.Minute)
+	ctx := identity.With(context.Background(), identity.Identity{Subject: "user-1", Role: "user"})
+
+	md := sendIdentity(t, signer, ctx)
+	md.Set(identity.RoleKey, "admin")
+	_, _, err := receiveIdentity(signer, md)
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	md = sendIdentity(t, identity.NewSigner("other", time.Minute), ctx)
+	_, _, err = receiveIdentity(signer, md)
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	// issue time is covered by the signature
+	md = sendIdentity(t, signer, ctx)
+	md.Set(identity.IssuedKey, strconv.FormatInt(time.Now().Add(time.Hour).Unix(), 10))
+	_, _, err = receiveIdentity(signer, md)
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	// signature is valid but expired
+	_, _, err = receiveIdentity(identity.NewSigner("secret", 0), sendIdentity(t, signer, ctx))
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+}
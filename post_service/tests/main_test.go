@@ -7,6 +7,7 @@ import (
 
 	"github.com/burxondv/new-services/post-service/config"
 	"github.com/burxondv/new-services/post-service/pkg/db"
+	"github.com/burxondv/new-services/post-service/pkg/logger"
 	"github.com/burxondv/new-services/post-service/storage/postgres"
 )
 
@@ -19,7 +20,7 @@ func TestMain(m *testing.M) {
 		log.Println("failed to connect to database: ", err)
 	}
 
-	pgRepo = postgres.NewPostRepo(connDB)
+	pgRepo = postgres.NewPostRepo(connDB, logger.New("debug", "test"))
 
 	os.Exit(m.Run())
 }
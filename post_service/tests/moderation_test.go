@@ -0,0 +1,72 @@
+package tests
+
+import (
+	"context"
+	"testing"
+
+	"github.com/burxondv/new-services/post-service/pkg/moderation"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestModeration_RuleClassifier(t *testing.T) {
+	classifier, err := moderation.NewRuleClassifier([]moderation.Rule{
+		{Label: "spam", Keyword: "buy followers", Verdict: moderation.Hold},
+		{Label: "spam", Pattern: `https?://bit\.ly/`, Verdict: moderation.Hold},
+		{Label: "card", Pattern: `\b\d{4} \d{4} \d{4} \d{4}\b`, Verdict: moderation.Reject},
+	})
+	require.NoError(t, err)
+
+	tests := []struct {
+		name    string
+		text    string
+		verdict string
+		labels  []string
+	}{
+		{name: "clean", text: "I love my job", verdict: moderation.Allow},
+		{name: "keyword case insensitive", text: "BUY Followers here", verdict: moderation.Hold, labels: []string{"spam"}},
+		{name: "keyword whole words", text: "rebuy followersclub", verdict: moderation.Allow},
+		{name: "labels are not repeated", text: "buy followers https://bit.ly/x", verdict: moderation.Hold, labels: []string{"spam"}},
+		{name: "most severe verdict", text: "buy followers, pay to 1234 5678 1234 5678", verdict: moderation.Reject, labels: []string{"spam", "card"}},
+	}
+
+	for _, tCase := range tests {
+		t.Run(tCase.name, func(t *testing.T) {
+			res, err := classifier.Classify(context.Background(), tCase.text)
+			assert.NoError(t, err)
+			assert.Equal(t, tCase.verdict, res.Verdict)
+			assert.Equal(t, tCase.labels, res.Labels)
+		})
+	}
+}
+
+func TestModeration_InvalidRules(t *testing.T) {
+	tests := []struct {
+		name string
+		rule moderation.Rule
+	}{
+		{name: "no label", rule: moderation.Rule{Keyword: "spam", Verdict: moderation.Hold}},
+		{name: "no keyword and pattern", rule: moderation.Rule{Label: "spam", Verdict: moderation.Hold}},
+		{name: "keyword and pattern", rule: moderation.Rule{Label: "spam", Keyword: "spam", Pattern: "spam", Verdict: moderation.Hold}},
+		{name: "unknown verdict", rule: moderation.Rule{Label: "spam", Keyword: "spam", Verdict: "ban"}},
+		{name: "allow verdict", rule: moderation.Rule{Label: "spam", Keyword: "spam", Verdict: moderation.Allow}},
+		{name: "invalid pattern", rule: moderation.Rule{Label: "spam", Pattern: "(", Verdict: moderation.Hold}},
+	}
+
+	for _, tCase := range tests {
+		t.Run(tCase.name, func(t *testing.T) {
+			_, err := moderation.NewRuleClassifier([]moderation.Rule{tCase.rule})
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestModeration_DefaultRules(t *testing.T) {
+	rules, err := moderation.LoadRules("../config/moderation_rules.json")
+	require.NoError(t, err)
+	assert.NotEmpty(t, rules)
+
+	_, err = moderation.NewRuleClassifier(rules)
+	assert.NoError(t, err)
+}
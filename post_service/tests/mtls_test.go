@@ -0,0 +1,118 @@
+package tests
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/burxondv/new-services/post-service/pkg/logger"
+	"github.com/burxondv/new-services/post-service/pkg/mtls"
+	"github.com/burxondv/new-services/post-service/pkg/rpcclient"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/status"
+)
+
+// writeCert signs a certificate of name with parent, parent nil makes a CA
+func writeCert(t *testing.T, dir, name string, parent *x509.Certificate, parentKey *ecdsa.PrivateKey) (*x509.Certificate, *ecdsa.PrivateKey) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.Nil(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(time.Now().UnixNano()),
+		Subject:      pkix.Name{CommonName: name},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+	}
+	if parent == nil {
+		template.IsCA = true
+		template.BasicConstraintsValid = true
+		template.KeyUsage |= x509.KeyUsageCertSign
+		parent, parentKey = template, key
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, parent, &key.PublicKey, parentKey)
+	require.Nil(t, err)
+	keyDer, err := x509.MarshalECPrivateKey(key)
+	require.Nil(t, err)
+
+	require.Nil(t, os.WriteFile(filepath.Join(dir, name+".crt"), pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0o600))
+	require.Nil(t, os.WriteFile(filepath.Join(dir, name+".key"), pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDer}), 0o600))
+
+	cert, err := x509.ParseCertificate(der)
+	require.Nil(t, err)
+	return cert, key
+}
+
+func loadCerts(t *testing.T, dir, name string) *mtls.Certs {
+	certs, err := mtls.Load(mtls.Config{
+		CertFile: filepath.Join(dir, name+".crt"),
+		KeyFile:  filepath.Join(dir, name+".key"),
+		CAFile:   filepath.Join(dir, "ca.crt"),
+	}, logger.New("debug", "test"))
+	require.Nil(t, err)
+	return certs
+}
+
+func TestMTLS_InternalCallers(t *testing.T) {
+	dir := t.TempDir()
+	ca, caKey := writeCert(t, dir, "ca", nil, nil)
+	for _, name := range []string{"comment_service", "post_service", "user_service"} {
+		writeCert(t, dir, name, ca, caKey)
+	}
+
+	server := loadCerts(t, dir, "comment_service")
+	s := grpc.NewServer(
+		grpc.Creds(server.ServerCredentials()),
+		grpc.UnaryInterceptor(server.UnaryServerInterceptor(map[string][]string{
+			"/grpc.health.v1.Health/Check": {"post_service"},
+		})),
+	)
+	healthpb.RegisterHealthServer(s, health.NewServer())
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	require.Nil(t, err)
+	go s.Serve(lis)
+	defer s.Stop()
+	_, port, _ := net.SplitHostPort(lis.Addr().String())
+
+	check := func(caller string, certs *mtls.Certs, name string) error {
+		conn, err := rpcclient.Dial(caller, name, "127.0.0.1", port, certs)
+		require.Nil(t, err)
+		defer conn.Close()
+
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		_, err = healthpb.NewHealthClient(conn).Check(ctx, &healthpb.HealthCheckRequest{})
+		return err
+	}
+
+	assert.Nil(t, check("post_service", loadCerts(t, dir, "post_service"), "comment_service"))
+
+	err = check("user_service", loadCerts(t, dir, "user_service"), "comment_service")
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	// server is not the expected service
+	err = check("post_service", loadCerts(t, dir, "post_service"), "user_service")
+	assert.Equal(t, codes.Unavailable, status.Code(err))
+
+	// plaintext clients can't connect
+	err = check("post_service", nil, "comment_service")
+	assert.Equal(t, codes.Unavailable, status.Code(err))
+}
@@ -1,13 +1,20 @@
 package tests
 
 import (
+	"context"
+	"database/sql"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/burxondv/new-services/post-service/config"
 	"github.com/burxondv/new-services/post-service/pkg/db"
+	"github.com/burxondv/new-services/post-service/pkg/events"
+	"github.com/burxondv/new-services/post-service/pkg/logger"
 	"github.com/burxondv/new-services/post-service/storage/postgres"
 	"github.com/burxondv/new-services/post-service/storage/repo"
 
+	"github.com/google/uuid"
 	"github.com/stretchr/testify/suite"
 )
 
@@ -15,11 +22,15 @@ type PostSuiteTest struct {
 	suite.Suite
 	CleanUpfunc func()
 	repo        repo.PostStorageI
+	outbox      repo.OutboxStorageI
+	tags        repo.TagStorageI
 }
 
 func (s *PostSuiteTest) SetupSuite() {
 	pgPool, cleanUp := db.ConnectToDBForSuite(config.Load())
-	s.repo = postgres.NewPostRepo(pgPool)
+	s.repo = postgres.NewPostRepo(pgPool, logger.New("debug", "test"))
+	s.outbox = postgres.NewOutboxRepo(pgPool, logger.New("debug", "test"))
+	s.tags = postgres.NewTagRepo(pgPool, logger.New("debug", "test"))
 	s.CleanUpfunc = cleanUp
 }
 
@@ -30,21 +41,21 @@ func (s *PostSuiteTest) TestPostCrud() {
 		UserId:      "5",
 	}
 
-	createPostResp, err := s.repo.CreatePost(post)
+	createPostResp, err := s.repo.CreatePost(context.Background(), post)
 	s.Nil(err)
 	s.NotNil(createPostResp)
 	s.Equal(post.Title, createPostResp.Title)
 	s.Equal(post.Description, createPostResp.Description)
 	s.Equal(post.UserId, createPostResp.UserId)
 
-	getPostResp, err := s.repo.GetPostById(createPostResp.Id)
+	getPostResp, err := s.repo.GetPostById(context.Background(), createPostResp.Id)
 	s.Nil(err)
 	s.NotNil(getPostResp)
 	s.Equal(getPostResp.Title, post.Title)
 	s.Equal(getPostResp.Description, post.Description)
 	s.Equal(getPostResp.UserId, post.UserId)
 
-	allPostsResp, err := s.repo.GetPostByUserId(getPostResp.UserId)
+	allPostsResp, err := s.repo.GetPostByUserId(context.Background(), getPostResp.UserId)
 	s.Nil(err)
 	s.NotNil(allPostsResp)
 
@@ -54,17 +65,340 @@ func (s *PostSuiteTest) TestPostCrud() {
 		Id:          getPostResp.Id,
 	}
 
-	updateRes, err := s.repo.UpdatePost(updateBody)
+	updateRes, err := s.repo.UpdatePost(context.Background(), updateBody)
 	s.Nil(err)
 	s.NotNil(updateRes)
 
-	deleteResp, err := s.repo.DeletePost(getPostResp.Id)
+	deleteResp, err := s.repo.DeletePost(context.Background(), getPostResp.Id)
 	s.Nil(err)
 	s.NotNil(deleteResp)
 	s.Equal(deleteResp.Title, updateBody.Title)
 	s.Equal(deleteResp.Description, updateBody.Description)
 }
 
+func (s *PostSuiteTest) TestUpdatePostVersion() {
+	post, err := s.repo.CreatePost(context.Background(), repo.Post{
+		Id:          uuid.NewString(),
+		Title:       "Post of two editors",
+		Description: "Only the first update of the same version is saved",
+		UserId:      uuid.NewString(),
+	})
+	s.Require().Nil(err)
+	s.Equal(int64(1), post.Version)
+
+	post.Title = "First update"
+	updated, err := s.repo.UpdatePost(context.Background(), post)
+	s.Nil(err)
+	s.Equal(int64(2), updated.Version)
+
+	// the second editor still has the first version
+	post.Title = "Second update"
+	_, err = s.repo.UpdatePost(context.Background(), post)
+	s.ErrorIs(err, sql.ErrNoRows)
+
+	post.Version = 0
+	updated, err = s.repo.UpdatePost(context.Background(), post)
+	s.Nil(err)
+	s.Equal(int64(3), updated.Version)
+
+	_, err = s.repo.DeletePost(context.Background(), post.Id)
+	s.Nil(err)
+}
+
+func (s *PostSuiteTest) TestModeratePost() {
+	post, err := s.repo.CreatePost(context.Background(), repo.Post{
+		Id:               uuid.NewString(),
+		Title:            "Held post",
+		Description:      "cheap followers",
+		UserId:           uuid.NewString(),
+		ModerationStatus: repo.ModerationHeld,
+		ModerationLabels: []string{"spam"},
+	})
+	s.Require().Nil(err)
+	s.Equal(repo.ModerationHeld, post.ModerationStatus)
+
+	approved, err := s.repo.ModeratePost(context.Background(), post.Id, repo.ModerationVisible)
+	s.Nil(err)
+	s.Equal(repo.ModerationVisible, approved.ModerationStatus)
+	s.Equal(post.Version, approved.Version)
+
+	hidden, err := s.repo.ModeratePost(context.Background(), post.Id, repo.ModerationHidden)
+	s.Nil(err)
+	s.Equal(repo.ModerationHidden, hidden.ModerationStatus)
+
+	// hidden post is not held again by update
+	hidden.ModerationStatus = repo.ModerationHeld
+	updated, err := s.repo.UpdatePost(context.Background(), hidden)
+	s.Nil(err)
+	s.Equal(repo.ModerationHidden, updated.ModerationStatus)
+
+	_, err = s.repo.DeletePost(context.Background(), post.Id)
+	s.Nil(err)
+
+	_, err = s.repo.ModeratePost(context.Background(), post.Id, repo.ModerationVisible)
+	s.ErrorIs(err, sql.ErrNoRows)
+}
+
+func (s *PostSuiteTest) TestShadowBan() {
+	userId := uuid.NewString()
+	post, err := s.repo.CreatePost(context.Background(), repo.Post{
+		Id:          uuid.NewString(),
+		Title:       "Post of shadow banned user",
+		Description: "It is shown only to its author",
+		UserId:      userId,
+	})
+	s.Require().Nil(err)
+	s.False(post.AuthorShadowBanned)
+
+	eventId := uuid.NewString()
+	ok, err := s.repo.SetShadowBan(context.Background(), eventId, userId, true, time.Time{})
+	s.Nil(err)
+	s.True(ok)
+
+	// the same event is handled once
+	ok, err = s.repo.SetShadowBan(context.Background(), eventId, userId, false, time.Time{})
+	s.Nil(err)
+	s.False(ok)
+
+	got, err := s.repo.GetPostById(context.Background(), post.Id)
+	s.Nil(err)
+	s.True(got.AuthorShadowBanned)
+
+	// expired ban is ignored
+	_, err = s.repo.SetShadowBan(context.Background(), uuid.NewString(), userId, true, time.Now().UTC().Add(-time.Minute))
+	s.Nil(err)
+	got, err = s.repo.GetPostById(context.Background(), post.Id)
+	s.Nil(err)
+	s.False(got.AuthorShadowBanned)
+
+	_, err = s.repo.SetShadowBan(context.Background(), uuid.NewString(), userId, false, time.Time{})
+	s.Nil(err)
+
+	_, err = s.repo.DeletePost(context.Background(), post.Id)
+	s.Nil(err)
+}
+
+func (s *PostSuiteTest) TestTagsOfPublicPosts() {
+	tag := "tag" + strings.ReplaceAll(uuid.NewString(), "-", "")
+	userId := uuid.NewString()
+
+	ids := []string{}
+	for _, visibility := range []string{repo.VisibilityPublic, repo.VisibilityPrivate, repo.VisibilityFollowers} {
+		post, err := s.repo.CreatePost(context.Background(), repo.Post{
+			Id:          uuid.NewString(),
+			Title:       "Tagged post",
+			Description: "#" + tag,
+			UserId:      userId,
+			Status:      repo.StatusPublished,
+			Visibility:  visibility,
+		})
+		s.Require().Nil(err)
+		s.Nil(s.tags.SetPostTags(context.Background(), post.Id, []string{tag}))
+		ids = append(ids, post.Id)
+	}
+
+	// only posts everyone can see are counted
+	found, err := s.tags.SearchTags(context.Background(), tag, 10)
+	s.Nil(err)
+	s.Equal([]repo.Tag{{Name: tag, Posts: 1}}, found)
+
+	trending, err := s.tags.GetTrendingTags(context.Background(), time.Now().UTC().Add(-time.Hour), 100)
+	s.Nil(err)
+	s.Contains(trending, repo.Tag{Name: tag, Posts: 1})
+
+	for _, id := range ids {
+		_, err = s.repo.DeletePost(context.Background(), id)
+		s.Nil(err)
+	}
+}
+
+func (s *PostSuiteTest) TestRevisions() {
+	post, err := s.repo.CreatePost(context.Background(), repo.Post{
+		Id:          uuid.NewString(),
+		Title:       "First title",
+		Description: "first line\nsecond line",
+		UserId:      uuid.NewString(),
+		Status:      repo.StatusPublished,
+		Visibility:  repo.VisibilityPublic,
+	})
+	s.Nil(err)
+	s.Empty(post.EditedAt)
+
+	post.Title = "Second title"
+	updated, err := s.repo.UpdatePost(context.Background(), post)
+	s.Nil(err)
+	s.NotEmpty(updated.EditedAt)
+
+	revisions, err := s.repo.GetRevisions(context.Background(), post.Id)
+	s.Nil(err)
+	s.Len(revisions, 2)
+	s.Equal(int64(2), revisions[0].Revision)
+	s.Equal("Second title", revisions[0].Title)
+
+	restored, err := s.repo.RestoreRevision(context.Background(), post.Id, 1, post.UserId)
+	s.Nil(err)
+	s.Equal("First title", restored.Title)
+
+	rev, err := s.repo.GetRevision(context.Background(), post.Id, 3)
+	s.Nil(err)
+	s.Equal("First title", rev.Title)
+	s.Equal(int64(1), rev.RestoredFrom)
+
+	_, err = s.repo.RestoreRevision(context.Background(), post.Id, 10, post.UserId)
+	s.Equal(sql.ErrNoRows, err)
+
+	_, err = s.repo.DeletePost(context.Background(), post.Id)
+	s.Nil(err)
+}
+
+func (s *PostSuiteTest) TestPublishScheduledPosts() {
+	post := repo.Post{
+		Id:          uuid.NewString(),
+		Title:       "Scheduled post",
+		Description: "It is published by scheduler",
+		UserId:      uuid.NewString(),
+		Status:      repo.StatusScheduled,
+		PublishAt:   time.Now().UTC().Add(-time.Minute).Format("2006-01-02 15:04:05"),
+		Visibility:  repo.VisibilityFollowers,
+	}
+
+	createPostResp, err := s.repo.CreatePost(context.Background(), post)
+	s.Nil(err)
+	s.Equal(repo.StatusScheduled, createPostResp.Status)
+	s.Equal(repo.VisibilityFollowers, createPostResp.Visibility)
+
+	published, err := s.repo.PublishScheduledPosts(context.Background(), 100)
+	s.Nil(err)
+
+	ids := []string{}
+	for _, p := range published {
+		s.Equal(repo.StatusPublished, p.Status)
+		ids = append(ids, p.Id)
+	}
+	s.Contains(ids, createPostResp.Id)
+
+	published, err = s.repo.PublishScheduledPosts(context.Background(), 100)
+	s.Nil(err)
+	for _, p := range published {
+		s.NotEqual(createPostResp.Id, p.Id)
+	}
+
+	bus := events.NewMemoryBus(logger.New("debug", "test"))
+	for {
+		n, err := s.outbox.RelayEvents(context.Background(), 100, func(event events.Event) error {
+			return bus.Publish(context.Background(), event)
+		})
+		s.Require().Nil(err)
+		if n == 0 {
+			break
+		}
+	}
+
+	types := []string{}
+	for _, event := range bus.Events() {
+		if event.AggregateId == createPostResp.Id {
+			types = append(types, event.Type)
+		}
+	}
+	s.Equal([]string{events.PostCreated, events.PostPublished}, types)
+
+	_, err = s.repo.DeletePost(context.Background(), createPostResp.Id)
+	s.Nil(err)
+}
+
+func (s *PostSuiteTest) TestDeleteUserPosts() {
+	userId := uuid.NewString()
+	post, err := s.repo.CreatePost(context.Background(), repo.Post{
+		Id:          uuid.NewString(),
+		Title:       "Post of deleted user",
+		Description: "It is deleted by UserDeleted event",
+		UserId:      userId,
+	})
+	s.Require().Nil(err)
+
+	eventId := uuid.NewString()
+	deleted, err := s.repo.DeleteUserPosts(context.Background(), eventId, userId)
+	s.Nil(err)
+	s.Require().Len(deleted, 1)
+	s.Equal(post.Id, deleted[0].Id)
+
+	// the same event is handled once
+	deleted, err = s.repo.DeleteUserPosts(context.Background(), eventId, userId)
+	s.Nil(err)
+	s.Len(deleted, 0)
+
+	_, err = s.repo.GetPostById(context.Background(), post.Id)
+	s.Equal(sql.ErrNoRows, err)
+
+	bus := events.NewMemoryBus(logger.New("debug", "test"))
+	for {
+		n, err := s.outbox.RelayEvents(context.Background(), 100, func(event events.Event) error {
+			return bus.Publish(context.Background(), event)
+		})
+		s.Require().Nil(err)
+		if n == 0 {
+			break
+		}
+	}
+
+	types := []string{}
+	for _, event := range bus.Events() {
+		if event.AggregateId == post.Id {
+			types = append(types, event.Type)
+		}
+	}
+	s.Equal([]string{events.PostCreated, events.PostDeleted}, types)
+}
+
+func (s *PostSuiteTest) TestLikePostAndPurge() {
+	userId := uuid.NewString()
+	post, err := s.repo.CreatePost(context.Background(), repo.Post{
+		Id:          uuid.NewString(),
+		Title:       "Post of purged user",
+		Description: "It is erased by UserPurged event",
+		UserId:      userId,
+	})
+	s.Require().Nil(err)
+
+	posts, err := s.repo.GetPostsByIds(context.Background(), []string{post.Id, uuid.NewString()})
+	s.Nil(err)
+	s.Len(posts, 1)
+
+	posts, err = s.repo.GetPostsForUsers(context.Background(), []string{userId, uuid.NewString()})
+	s.Nil(err)
+	s.Require().Len(posts, 1)
+	s.Equal(post.Id, posts[0].Id)
+
+	likerId := uuid.NewString()
+	liked, err := s.repo.LikePost(context.Background(), post.Id, likerId, true)
+	s.Nil(err)
+	s.Equal(int64(1), liked.Likes)
+
+	// the second like of the same user is not counted
+	liked, err = s.repo.LikePost(context.Background(), post.Id, likerId, true)
+	s.Nil(err)
+	s.Equal(int64(1), liked.Likes)
+
+	likes, err := s.repo.GetLikesByUser(context.Background(), likerId)
+	s.Nil(err)
+	s.Require().Len(likes, 1)
+	s.Equal(post.Id, likes[0].PostId)
+
+	eventId := uuid.NewString()
+	purged, err := s.repo.PurgeUserPosts(context.Background(), eventId, userId)
+	s.Nil(err)
+	s.Require().Len(purged, 1)
+	s.Equal(post.Id, purged[0].Id)
+
+	purged, err = s.repo.PurgeUserPosts(context.Background(), eventId, userId)
+	s.Nil(err)
+	s.Len(purged, 0)
+
+	likes, err = s.repo.GetLikesByUser(context.Background(), likerId)
+	s.Nil(err)
+	s.Len(likes, 0)
+}
+
 func (suite *PostSuiteTest) TearDownSuite() {
 	suite.CleanUpfunc()
 }
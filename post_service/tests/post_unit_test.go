@@ -1,6 +1,7 @@
 package tests
 
 import (
+	"context"
 	"log"
 	"reflect"
 	"testing"
@@ -20,11 +21,15 @@ func TestPost_Create(t *testing.T) {
 			input: repo.Post{
 				Title:       "coding",
 				Description: "I was start 18",
+				Status:      repo.StatusPublished,
+				Visibility:  repo.VisibilityPublic,
 				UserId:      "7",
 			},
 			want: repo.Post{
 				Title:       "coding",
 				Description: "I was start 18",
+				Status:      repo.StatusPublished,
+				Visibility:  repo.VisibilityPublic,
 				UserId:      "7",
 			},
 			wantErr: false,
@@ -33,7 +38,7 @@ func TestPost_Create(t *testing.T) {
 
 	for _, tCase := range tests {
 		t.Run(tCase.name, func(t *testing.T) {
-			got, err := pgRepo.CreatePost(tCase.input)
+			got, err := pgRepo.CreatePost(context.Background(), tCase.input)
 			if err != nil {
 				log.Printf("%s: expected: %v, got: %v", tCase.name, tCase.wantErr, err)
 			}
@@ -64,6 +69,8 @@ func TestPost_Get(t *testing.T) {
 				Id:          "30",
 				Title:       "how",
 				Description: "i don't know",
+				Status:      repo.StatusPublished,
+				Visibility:  repo.VisibilityPublic,
 				UserId:      "9",
 			},
 			wantErr: false,
@@ -72,7 +79,7 @@ func TestPost_Get(t *testing.T) {
 
 	for _, tCase := range tests {
 		t.Run(tCase.name, func(t *testing.T) {
-			got, err := pgRepo.GetPostById(tCase.input)
+			got, err := pgRepo.GetPostById(context.Background(), tCase.input)
 			if err != nil {
 				log.Printf("%s: expected: %v, got: %v", tCase.name, tCase.wantErr, err)
 			}
@@ -100,11 +107,15 @@ func TestPost_Update(t *testing.T) {
 			input: repo.Post{
 				Title:       "new_updated_title",
 				Description: "new_updated_description",
+				Status:      repo.StatusPublished,
+				Visibility:  repo.VisibilityPublic,
 				Id:          "31",
 			},
 			want: repo.Post{
 				Title:       "new_updated_title",
 				Description: "new_updated_description",
+				Status:      repo.StatusPublished,
+				Visibility:  repo.VisibilityPublic,
 				Id:          "31",
 			},
 			wantErr: false,
@@ -113,7 +124,7 @@ func TestPost_Update(t *testing.T) {
 
 	for _, tCase := range tests {
 		t.Run(tCase.name, func(t *testing.T) {
-			got, err := pgRepo.UpdatePost(tCase.input)
+			got, err := pgRepo.UpdatePost(context.Background(), tCase.input)
 			if err != nil {
 				log.Printf("%s: expected: %v, err: %v", tCase.name, tCase.wantErr, err)
 			}
@@ -121,6 +132,7 @@ func TestPost_Update(t *testing.T) {
 			tCase.want.Likes = got.Likes
 			tCase.want.Comments = got.Comments
 			tCase.want.UserId = got.UserId
+			tCase.want.EditedAt = got.EditedAt
 			tCase.want.CreatedAt = got.CreatedAt
 			tCase.want.UpdatedAt = got.UpdatedAt
 
@@ -144,6 +156,8 @@ func TestPost_Delete(t *testing.T) {
 			want: repo.Post{
 				Title:       "new_updated_title",
 				Description: "new_updated_description",
+				Status:      repo.StatusPublished,
+				Visibility:  repo.VisibilityPublic,
 				Id:          "31",
 				UserId:      "9",
 			},
@@ -153,13 +167,14 @@ func TestPost_Delete(t *testing.T) {
 
 	for _, tCase := range tests {
 		t.Run(tCase.name, func(t *testing.T) {
-			got, err := pgRepo.DeletePost(tCase.input)
+			got, err := pgRepo.DeletePost(context.Background(), tCase.input)
 			if err != nil {
 				log.Printf("%s: expected: %v, got: %v", tCase.name, err, got)
 			}
 
 			tCase.want.Likes = got.Likes
 			tCase.want.Comments = got.Comments
+			tCase.want.EditedAt = got.EditedAt
 			tCase.want.CreatedAt = got.CreatedAt
 			tCase.want.UpdatedAt = got.UpdatedAt
 
@@ -0,0 +1,51 @@
+package tests
+
+import (
+	"context"
+	"testing"
+
+	"github.com/burxondv/new-services/post-service/pkg/logger"
+	"github.com/burxondv/new-services/post-service/pkg/requestid"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// call sends ctx through the client and the server interceptors and returns
+// context seen by the handler
+func call(t *testing.T, ctx context.Context) context.Context {
+	var outgoing context.Context
+	invoker := func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, opts ...grpc.CallOption) error {
+		outgoing = ctx
+		return nil
+	}
+	require.Nil(t, requestid.UnaryClientInterceptor("post_service")(ctx, "/post.PostService/GetPostById", nil, nil, nil, invoker))
+
+	md, _ := metadata.FromOutgoingContext(outgoing)
+	var handled context.Context
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		handled = ctx
+		return nil, nil
+	}
+	info := &grpc.UnaryServerInfo{FullMethod: "/post.PostService/GetPostById"}
+	_, err := logger.UnaryServerInterceptor(logger.New("debug", "test"))(metadata.NewIncomingContext(context.Background(), md), nil, info, handler)
+	require.Nil(t, err)
+
+	return handled
+}
+
+func TestRequestId_Propagated(t *testing.T) {
+	ctx := call(t, requestid.With(context.Background(), "request-1"))
+	assert.Equal(t, "request-1", requestid.FromContext(ctx))
+	assert.Equal(t, "post_service", requestid.Caller(ctx))
+}
+
+func TestRequestId_Generated(t *testing.T) {
+	ctx := call(t, context.Background())
+	assert.True(t, requestid.Valid(requestid.FromContext(ctx)))
+
+	assert.False(t, requestid.Valid("with space"))
+	assert.False(t, requestid.Valid(""))
+}
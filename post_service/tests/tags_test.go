@@ -0,0 +1,62 @@
+package tests
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/burxondv/new-services/post-service/pkg/tags"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTags_Normalize(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  string
+	}{
+		{name: "lowercase", input: "GoLang", want: "golang"},
+		{name: "hash and spaces", input: "  #Go_Lang ", want: "go_lang"},
+		{name: "punctuation removed", input: "c++", want: "c"},
+		{name: "unicode letters", input: "Tошкент", want: "tошкент"},
+		{name: "only symbols", input: "#!?", want: ""},
+		{name: "too long", input: strings.Repeat("a", 60), want: strings.Repeat("a", tags.MaxLength)},
+	}
+
+	for _, tCase := range tests {
+		t.Run(tCase.name, func(t *testing.T) {
+			assert.Equal(t, tCase.want, tags.Normalize(tCase.input))
+		})
+	}
+}
+
+func TestTags_Hashtags(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  []string
+	}{
+		{name: "several", input: "#go is fun, #Backend!", want: []string{"go", "Backend"}},
+		{name: "not in the middle of word", input: "mail@host#tag c#", want: []string{}},
+		{name: "html entity is not tag", input: "a &#39; b", want: []string{}},
+		{name: "new line", input: "first\n#second", want: []string{"second"}},
+	}
+
+	for _, tCase := range tests {
+		t.Run(tCase.name, func(t *testing.T) {
+			assert.Equal(t, tCase.want, tags.Hashtags(tCase.input))
+		})
+	}
+}
+
+func TestTags_Merge(t *testing.T) {
+	got := tags.Merge([]string{"Go", "#docker", ""}, "learning #go and #K8s")
+	assert.Equal(t, []string{"docker", "go", "k8s"}, got)
+
+	many := []string{}
+	for i := 0; i < tags.MaxPerPost+5; i++ {
+		many = append(many, strings.Repeat("t", i+1))
+	}
+	assert.Len(t, tags.Merge(many, "#extra"), tags.MaxPerPost)
+	assert.NotContains(t, tags.Merge(many, "#extra"), "extra")
+}
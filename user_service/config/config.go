@@ -15,12 +15,51 @@ type Config struct {
 	Environment      string
 	LogLevel         string
 
-	UserServiceHost  string
-	UserServicePort  string
-	PostServiceHost  string
-	PostServicePort  string
+	UserServiceHost    string
+	UserServicePort    string
+	PostServiceHost    string
+	PostServicePort    string
 	CommentServiceHost string
 	CommentServicePort string
+
+	NotificationServiceHost string
+	NotificationServicePort string
+
+	// events...
+	RedisHost           string
+	RedisPort           string
+	OutboxRelayInterval int // in milliseconds
+
+	// account deletion and data export...
+	AccountPurgeDelay    int // in seconds
+	AccountPurgeInterval int // in seconds
+	DataExportInterval   int // in seconds
+	DataExportTimeout    int // in seconds, stuck exports are taken again after it
+
+	// tracing...
+	TraceExporter    string // none, otlp, stdout or file
+	OTLPEndpoint     string
+	TraceFile        string
+	TraceSampleRatio float64
+
+	// metrics...
+	MetricsPort string
+
+	// startup and shutdown...
+	StartupTimeout      int // in seconds, how long the database is waited for
+	HealthCheckInterval int // in seconds
+	ShutdownTimeout     int // in seconds, calls still running after it are canceled
+
+	// tls...
+	TLSEnabled        bool // services are called with mutual TLS, certificates are made by `make dev-certs` in api_gateway
+	TLSCertFile       string
+	TLSKeyFile        string
+	TLSCAFile         string
+	TLSReloadInterval int // in seconds, how often certificate files are checked for changes
+
+	// identity...
+	IdentitySecret string // signs the end user of gRPC calls, the same in the gateway and all services
+	IdentityMaxAge int    // in seconds, how long a signed identity is accepted
 }
 
 func Load() Config {
@@ -33,7 +72,7 @@ func Load() Config {
 	c.PostgresDatabase = cast.ToString(getOrReturnDefault("POSTGRES_DATABASE", "new_userdb"))
 	c.Environment = cast.ToString(getOrReturnDefault("ENVIRONMENT", "develop"))
 	c.LogLevel = cast.ToString(getOrReturnDefault("LOG_LEVEL", "debug"))
-	
+
 	c.UserServiceHost = cast.ToString(getOrReturnDefault("USER_SERVICE_HOST", "localhost"))
 	c.UserServicePort = cast.ToString(getOrReturnDefault("USER_SERVICE_PORT", ":8000"))
 
@@ -43,6 +82,45 @@ func Load() Config {
 	c.CommentServiceHost = cast.ToString(getOrReturnDefault("COMMENT_SERVICE_HOST", "localhost"))
 	c.CommentServicePort = cast.ToString(getOrReturnDefault("COMMENT_SERVICE_PORT", "8020"))
 
+	c.NotificationServiceHost = cast.ToString(getOrReturnDefault("NOTIFICATION_SERVICE_HOST", "localhost"))
+	c.NotificationServicePort = cast.ToString(getOrReturnDefault("NOTIFICATION_SERVICE_PORT", "8030"))
+
+	// events...
+	c.RedisHost = cast.ToString(getOrReturnDefault("REDIS_HOST", "localhost"))
+	c.RedisPort = cast.ToString(getOrReturnDefault("REDIS_PORT", "6379"))
+	c.OutboxRelayInterval = cast.ToInt(getOrReturnDefault("OUTBOX_RELAY_INTERVAL", 500))
+
+	// account deletion and data export...
+	c.AccountPurgeDelay = cast.ToInt(getOrReturnDefault("ACCOUNT_PURGE_DELAY", 30*24*3600))
+	c.AccountPurgeInterval = cast.ToInt(getOrReturnDefault("ACCOUNT_PURGE_INTERVAL", 3600))
+	c.DataExportInterval = cast.ToInt(getOrReturnDefault("DATA_EXPORT_INTERVAL", 5))
+	c.DataExportTimeout = cast.ToInt(getOrReturnDefault("DATA_EXPORT_TIMEOUT", 600))
+
+	// tracing...
+	c.TraceExporter = cast.ToString(getOrReturnDefault("TRACE_EXPORTER", "none"))
+	c.OTLPEndpoint = cast.ToString(getOrReturnDefault("OTLP_ENDPOINT", "localhost:4317"))
+	c.TraceFile = cast.ToString(getOrReturnDefault("TRACE_FILE", "traces.json"))
+	c.TraceSampleRatio = cast.ToFloat64(getOrReturnDefault("TRACE_SAMPLE_RATIO", 1.0))
+
+	// metrics...
+	c.MetricsPort = cast.ToString(getOrReturnDefault("METRICS_PORT", ":9100"))
+
+	// startup and shutdown...
+	c.StartupTimeout = cast.ToInt(getOrReturnDefault("STARTUP_TIMEOUT", 60))
+	c.HealthCheckInterval = cast.ToInt(getOrReturnDefault("HEALTH_CHECK_INTERVAL", 5))
+	c.ShutdownTimeout = cast.ToInt(getOrReturnDefault("SHUTDOWN_TIMEOUT", 15))
+
+	// tls...
+	c.TLSEnabled = cast.ToBool(getOrReturnDefault("TLS_ENABLED", false))
+	c.TLSCertFile = cast.ToString(getOrReturnDefault("TLS_CERT_FILE", "../certs/user_service.crt"))
+	c.TLSKeyFile = cast.ToString(getOrReturnDefault("TLS_KEY_FILE", "../certs/user_service.key"))
+	c.TLSCAFile = cast.ToString(getOrReturnDefault("TLS_CA_FILE", "../certs/ca.crt"))
+	c.TLSReloadInterval = cast.ToInt(getOrReturnDefault("TLS_RELOAD_INTERVAL", 30))
+
+	// identity...
+	c.IdentitySecret = cast.ToString(getOrReturnDefault("IDENTITY_SECRET", "dev-identity-secret"))
+	c.IdentityMaxAge = cast.ToInt(getOrReturnDefault("IDENTITY_MAX_AGE", 60))
+
 	return c
 }
 
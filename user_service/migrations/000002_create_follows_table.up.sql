@@ -0,0 +1,6 @@
+CREATE TABLE IF NOT EXISTS "follows" (
+    "follower_id" uuid,
+    "following_id" uuid,
+    "created_at" timestamp DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE ("follower_id", "following_id")
+);
@@ -0,0 +1,10 @@
+create table "outbox"(
+    "id" uuid primary key,
+    "type" text not null,
+    "aggregate_id" text not null,
+    "payload" jsonb not null,
+    "created_at" timestamp default current_timestamp,
+    "published_at" timestamp
+);
+
+create index "outbox_unpublished_idx" on "outbox"("created_at") where "published_at" is null;
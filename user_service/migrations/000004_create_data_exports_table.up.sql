@@ -0,0 +1,16 @@
+alter table "users" add column "purge_after" timestamp;
+
+create index "users_purge_after_idx" on "users"("purge_after") where "purge_after" is not null;
+
+create table "data_exports"(
+    "id" uuid primary key,
+    "user_id" uuid not null,
+    "status" text not null default 'pending',
+    "data" bytea,
+    "error" text not null default '',
+    "created_at" timestamp default current_timestamp,
+    "started_at" timestamp,
+    "completed_at" timestamp
+);
+
+create index "data_exports_status_idx" on "data_exports"("created_at") where "status" in ('pending', 'processing');
@@ -0,0 +1,11 @@
+drop index if exists "follows_following_id_idx";
+drop index if exists "users_email_key";
+
+alter table "users" drop constraint if exists "users_pkey";
+
+alter table "users"
+    alter column "created_at" type time,
+    alter column "updated_at" type time,
+    alter column "deleted_at" type time;
+
+-- user_type and refresh_token are kept, the code does not work without them
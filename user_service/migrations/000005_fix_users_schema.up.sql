@@ -0,0 +1,19 @@
+-- the code always expected these columns, they were added by hand before
+alter table "users"
+    add column if not exists "user_type" text not null default 'user',
+    add column if not exists "refresh_token" text not null default '';
+
+-- TIME keeps no date, existing values are moved to the day of the migration
+alter table "users"
+    alter column "created_at" type timestamp using current_date + "created_at",
+    alter column "updated_at" type timestamp using current_date + "updated_at",
+    alter column "deleted_at" type timestamp using current_date + "deleted_at";
+
+delete from "users" where "id" is null;
+
+alter table "users" add primary key ("id");
+
+-- deleted users keep their emails until they are purged
+create unique index "users_email_key" on "users"(lower("email")) where "deleted_at" is null;
+
+create index "follows_following_id_idx" on "follows"("following_id");
@@ -0,0 +1 @@
+alter table "users" add column "version" bigint not null default 1;
@@ -0,0 +1,2 @@
+ALTER TABLE "users" DROP COLUMN IF EXISTS "suspension_reason";
+ALTER TABLE "users" DROP COLUMN IF EXISTS "suspended_until";
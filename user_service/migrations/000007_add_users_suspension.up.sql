@@ -0,0 +1,2 @@
+alter table "users" add column "suspended_until" timestamp;
+alter table "users" add column "suspension_reason" text not null default '';
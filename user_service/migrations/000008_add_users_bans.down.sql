@@ -0,0 +1,6 @@
+ALTER TABLE "users" DROP COLUMN IF EXISTS "shadow_ban_reason";
+ALTER TABLE "users" DROP COLUMN IF EXISTS "shadow_banned_until";
+ALTER TABLE "users" DROP COLUMN IF EXISTS "shadow_banned";
+ALTER TABLE "users" DROP COLUMN IF EXISTS "ban_reason";
+ALTER TABLE "users" DROP COLUMN IF EXISTS "banned_until";
+ALTER TABLE "users" DROP COLUMN IF EXISTS "banned";
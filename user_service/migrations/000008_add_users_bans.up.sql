@@ -0,0 +1,6 @@
+alter table "users" add column "banned" boolean not null default false;
+alter table "users" add column "banned_until" timestamp;
+alter table "users" add column "ban_reason" text not null default '';
+alter table "users" add column "shadow_banned" boolean not null default false;
+alter table "users" add column "shadow_banned_until" timestamp;
+alter table "users" add column "shadow_ban_reason" text not null default '';
@@ -0,0 +1,14 @@
+create table if not exists "blocks" (
+    "user_id" uuid not null,
+    "target_id" uuid not null,
+    "created_at" timestamp default current_timestamp,
+    primary key ("user_id", "target_id")
+);
+create index if not exists "blocks_target_id_idx" on "blocks" ("target_id");
+
+create table if not exists "mutes" (
+    "user_id" uuid not null,
+    "target_id" uuid not null,
+    "created_at" timestamp default current_timestamp,
+    primary key ("user_id", "target_id")
+);
@@ -0,0 +1 @@
+alter table "outbox" add column "claimed_until" timestamp;
@@ -0,0 +1,59 @@
+package export
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/json"
+	"sort"
+	"time"
+)
+
+// Formats of the export...
+const (
+	FormatZip  = "zip"
+	FormatJSON = "json"
+)
+
+// Zip writes every section of JSON object data into its own indented
+// <section>.json file, sections are written in order of their names
+func Zip(data []byte, modified time.Time) ([]byte, error) {
+	sections := map[string]json.RawMessage{}
+	if err := json.Unmarshal(data, &sections); err != nil {
+		return nil, err
+	}
+
+	names := make([]string, 0, len(sections))
+	for name := range sections {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	buf := bytes.Buffer{}
+	w := zip.NewWriter(&buf)
+	for _, name := range names {
+		f, err := w.CreateHeader(&zip.FileHeader{
+			Name:     name + ".json",
+			Method:   zip.Deflate,
+			Modified: modified,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		section := bytes.Buffer{}
+		if err = json.Indent(&section, sections[name], "", "  "); err != nil {
+			return nil, err
+		}
+		section.WriteByte('\n')
+
+		if _, err = section.WriteTo(f); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := w.Close(); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,78 @@
+package logger
+
+import (
+	"context"
+	"time"
+
+	"github.com/burxondv/new-services/user-service/pkg/requestid"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+// UnaryServerInterceptor puts id of the request into the context of the
+// handler and logs every RPC with its method, duration, code and caller
+func UnaryServerInterceptor(l Logger) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		ctx = requestid.FromIncoming(ctx)
+		start := time.Now()
+		res, err := handler(ctx, req)
+		logCall(l, ctx, info.FullMethod, start, err)
+		return res, err
+	}
+}
+
+// StreamServerInterceptor is UnaryServerInterceptor for streams, they are
+// logged when closed
+func StreamServerInterceptor(l Logger) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx := requestid.FromIncoming(ss.Context())
+		start := time.Now()
+		err := handler(srv, &serverStream{ServerStream: ss, ctx: ctx})
+		logCall(l, ctx, info.FullMethod, start, err)
+		return err
+	}
+}
+
+type serverStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *serverStream) Context() context.Context {
+	return s.ctx
+}
+
+func logCall(l Logger, ctx context.Context, method string, start time.Time, err error) {
+	code := status.Code(err)
+	fields := []Field{
+		String("method", method),
+		Duration("duration", time.Since(start)),
+		String("code", code.String()),
+		String("caller", caller(ctx)),
+	}
+
+	l = WithContext(l, ctx)
+	switch code {
+	case codes.OK:
+		l.Info("grpc call", fields...)
+	case codes.Unknown, codes.Internal, codes.Unavailable, codes.DataLoss, codes.DeadlineExceeded:
+		l.Error("grpc call", append(fields, Error(err))...)
+	default:
+		l.Warn("grpc call", append(fields, Error(err))...)
+	}
+}
+
+// caller is name of the calling service or its address when it did not
+// send its name
+func caller(ctx context.Context) string {
+	if name := requestid.Caller(ctx); name != "" {
+		return name
+	}
+	if p, ok := peer.FromContext(ctx); ok {
+		return p.Addr.String()
+	}
+	return "unknown"
+}
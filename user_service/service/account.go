@@ -0,0 +1,32 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/burxondv/new-services/user-service/pkg/logger"
+)
+
+// RunAccountPurger erases deleted users after their grace period. It blocks
+// until ctx is done, so run it in a goroutine.
+func (s *UserService) RunAccountPurger(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			users, err := s.storage.User().PurgeDeletedUsers(ctx, 100)
+			if err != nil {
+				s.Logger.Error("purger: failed to purge deleted users", logger.Error(err))
+				continue
+			}
+
+			for _, user := range users {
+				s.Logger.Info("purger: deleted user is erased", logger.String("user_id", user.Id))
+			}
+		}
+	}
+}
@@ -0,0 +1,113 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	u "github.com/burxondv/new-services/user-service/genproto/user"
+	"github.com/burxondv/new-services/user-service/pkg/logger"
+	"github.com/burxondv/new-services/user-service/storage/repo"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// BanUser bans the user until the given time or forever, banned user can not
+// log in or write
+func (s *UserService) BanUser(ctx context.Context, req *u.BanRequest) (*u.AccountResponse, error) {
+	return s.banUser(ctx, req, false)
+}
+
+// ShadowBanUser hides content of the user from everyone except the user, the
+// user is not told about it
+func (s *UserService) ShadowBanUser(ctx context.Context, req *u.BanRequest) (*u.AccountResponse, error) {
+	return s.banUser(ctx, req, true)
+}
+
+func (s *UserService) banUser(ctx context.Context, req *u.BanRequest, shadow bool) (*u.AccountResponse, error) {
+	ban := repo.Ban{Lift: req.Lift, Reason: req.Reason}
+	if req.Until != "" && !req.Lift {
+		until, err := time.Parse(time.RFC3339, req.Until)
+		if err != nil {
+			return &u.AccountResponse{}, status.Error(codes.InvalidArgument, "until must be RFC3339 time")
+		}
+		if !until.After(time.Now()) {
+			return &u.AccountResponse{}, status.Error(codes.InvalidArgument, "until must be in the future")
+		}
+		ban.Until = until.UTC()
+	}
+
+	res, err := s.storage.User().BanUser(ctx, req.Id, shadow, ban)
+	if err == sql.ErrNoRows {
+		return &u.AccountResponse{}, status.Error(codes.NotFound, "user not found")
+	} else if err != nil {
+		s.reqLog(ctx).Error("failed to ban user in service", logger.Error(err))
+		return &u.AccountResponse{}, err
+	}
+
+	return accountResponse(res, time.Now()), nil
+}
+
+// GetAccountStatus returns restrictions of the user, it lets gateway and
+// other services enforce them
+func (s *UserService) GetAccountStatus(ctx context.Context, req *u.Request) (*u.AccountResponse, error) {
+	res, err := s.storage.User().GetAccount(ctx, req.Str)
+	if err == sql.ErrNoRows {
+		return &u.AccountResponse{}, status.Error(codes.NotFound, "user not found")
+	} else if err != nil {
+		s.reqLog(ctx).Error("failed to get account in service", logger.Error(err))
+		return &u.AccountResponse{}, err
+	}
+
+	return accountResponse(res, time.Now()), nil
+}
+
+// checkAccount returns PermissionDenied if the user is banned or suspended
+func (s *UserService) checkAccount(ctx context.Context, id string) error {
+	res, err := s.storage.User().GetAccount(ctx, id)
+	if err != nil {
+		s.reqLog(ctx).Error("failed to get account in service", logger.Error(err))
+		return err
+	}
+
+	account := accountResponse(res, time.Now())
+	switch account.State {
+	case repo.AccountBanned:
+		if account.Until == "" {
+			return status.Errorf(codes.PermissionDenied, "account is banned: %s", account.Reason)
+		}
+		return status.Errorf(codes.PermissionDenied, "account is banned until %s: %s", account.Until, account.Reason)
+	case repo.AccountSuspended:
+		return status.Errorf(codes.PermissionDenied, "account is suspended until %s: %s", account.Until, account.Reason)
+	}
+
+	return nil
+}
+
+func accountResponse(account repo.Account, now time.Time) *u.AccountResponse {
+	state, until, reason := account.State(now)
+	res := &u.AccountResponse{
+		Id:     account.Id,
+		State:  state,
+		Until:  formatTime(until),
+		Reason: reason,
+	}
+
+	if account.IsShadowBanned(now) {
+		res.ShadowBanned = true
+		res.ShadowBannedUntil = formatTime(account.ShadowBannedUntil)
+		res.ShadowBanReason = account.ShadowBanReason
+	}
+
+	return res
+}
+
+// formatTime formats time as RFC3339, zero time is empty
+func formatTime(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+
+	return t.Format(time.RFC3339)
+}
@@ -0,0 +1,12 @@
+package service
+
+// InternalCallers are the services allowed to call internal methods, the
+// gateway can't call them
+var InternalCallers = map[string][]string{
+	"/user.UserService/GetUserForClient":     {"post_service", "notification_service"},
+	"/user.UserService/GetUsersByIds":        {"post_service", "comment_service", "notification_service"},
+	"/user.UserService/GetUsersByFirstNames": {"notification_service"},
+	"/user.UserService/GetMutedIds":          {"post_service", "comment_service"},
+	"/user.UserService/IsBlocked":            {"post_service", "comment_service"},
+	"/user.UserService/IsFollowing":          {"post_service"},
+}
@@ -0,0 +1,30 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/burxondv/new-services/user-service/pkg/events"
+	"github.com/burxondv/new-services/user-service/pkg/logger"
+)
+
+// RunOutboxRelay publishes events written to outbox, it is safe to run in
+// every replica, see RelayEvents
+func (s *UserService) RunOutboxRelay(ctx context.Context, bus events.Bus, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			_, err := s.storage.Outbox().RelayEvents(ctx, 100, func(event events.Event) error {
+				return bus.Publish(ctx, event)
+			})
+			if err != nil {
+				s.Logger.Error("outbox: failed to relay events", logger.Error(err))
+			}
+		}
+	}
+}
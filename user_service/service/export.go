@@ -0,0 +1,232 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"time"
+
+	c "github.com/burxondv/new-services/user-service/genproto/comment"
+	p "github.com/burxondv/new-services/user-service/genproto/post"
+	u "github.com/burxondv/new-services/user-service/genproto/user"
+	"github.com/burxondv/new-services/user-service/pkg/export"
+	"github.com/burxondv/new-services/user-service/pkg/logger"
+	"github.com/burxondv/new-services/user-service/storage/repo"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// RequestDataExport creates pending export of the user, it is built by
+// RunExporter
+func (s *UserService) RequestDataExport(ctx context.Context, req *u.Request) (*u.DataExportResponse, error) {
+	_, err := s.storage.User().GetUserById(ctx, req.Str)
+	if err == sql.ErrNoRows {
+		return &u.DataExportResponse{}, status.Error(codes.NotFound, "user not found")
+	} else if err != nil {
+		s.reqLog(ctx).Error("failed to get user for data export in service", logger.Error(err))
+		return &u.DataExportResponse{}, err
+	}
+
+	res, err := s.storage.Export().CreateDataExport(ctx, req.Str)
+	if err != nil {
+		s.reqLog(ctx).Error("failed to request data export in service", logger.Error(err))
+		return &u.DataExportResponse{}, err
+	}
+
+	return exportResponse(res), nil
+}
+
+func (s *UserService) GetDataExport(ctx context.Context, req *u.DataExportRequest) (*u.DataExportResponse, error) {
+	res, err := s.getDataExport(ctx, req)
+	if err != nil {
+		return &u.DataExportResponse{}, err
+	}
+
+	return exportResponse(res), nil
+}
+
+// GetDataExportContent returns ready export as zip archive or json file
+func (s *UserService) GetDataExportContent(ctx context.Context, req *u.DataExportRequest) (*u.DataExportContent, error) {
+	res, err := s.getDataExport(ctx, req)
+	if err != nil {
+		return &u.DataExportContent{}, err
+	}
+
+	if res.Status != repo.ExportReady {
+		return &u.DataExportContent{}, status.Errorf(codes.FailedPrecondition, "data export is %s", res.Status)
+	}
+
+	switch req.Format {
+	case export.FormatJSON:
+		return &u.DataExportContent{
+			FileName: "export-" + res.Id + ".json",
+			MimeType: "application/json",
+			Content:  res.Data,
+		}, nil
+	case "", export.FormatZip:
+		completedAt, _ := time.Parse(time.RFC3339, res.CompletedAt)
+		content, err := export.Zip(res.Data, completedAt)
+		if err != nil {
+			s.reqLog(ctx).Error("failed to zip data export in service", logger.Error(err))
+			return &u.DataExportContent{}, err
+		}
+
+		return &u.DataExportContent{
+			FileName: "export-" + res.Id + ".zip",
+			MimeType: "application/zip",
+			Content:  content,
+		}, nil
+	default:
+		return &u.DataExportContent{}, status.Error(codes.InvalidArgument, "format must be zip or json")
+	}
+}
+
+// getDataExport returns export of the owner only, exports of other users
+// are not found
+func (s *UserService) getDataExport(ctx context.Context, req *u.DataExportRequest) (repo.DataExport, error) {
+	res, err := s.storage.Export().GetDataExport(ctx, req.Id)
+	if err == sql.ErrNoRows || (err == nil && res.UserId != req.UserId) {
+		return repo.DataExport{}, status.Error(codes.NotFound, "data export not found")
+	} else if err != nil {
+		s.reqLog(ctx).Error("failed to get data export in service", logger.Error(err))
+		return repo.DataExport{}, err
+	}
+
+	return res, nil
+}
+
+func exportResponse(res repo.DataExport) *u.DataExportResponse {
+	return &u.DataExportResponse{
+		Id:          res.Id,
+		UserId:      res.UserId,
+		Status:      res.Status,
+		Error:       res.Error,
+		CreatedAt:   res.CreatedAt,
+		CompletedAt: res.CompletedAt,
+	}
+}
+
+// RunExporter builds requested exports one by one. It blocks until ctx is
+// done, so run it in a goroutine.
+func (s *UserService) RunExporter(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			for ctx.Err() == nil && s.buildNextExport(ctx) {
+			}
+		}
+	}
+}
+
+// buildNextExport returns false when there is no export to build
+func (s *UserService) buildNextExport(ctx context.Context) bool {
+	staleBefore := time.Now().Add(-time.Duration(s.cfg.DataExportTimeout) * time.Second)
+	job, err := s.storage.Export().ClaimDataExport(ctx, staleBefore)
+	if err == sql.ErrNoRows {
+		return false
+	} else if err != nil {
+		s.Logger.Error("exporter: failed to claim data export", logger.Error(err))
+		return false
+	}
+
+	data, err := s.collectUserData(ctx, job.UserId)
+	if ctx.Err() != nil {
+		// export is taken again after timeout
+		return false
+	}
+
+	exportErr := ""
+	if err != nil {
+		s.Logger.Error("exporter: failed to collect user data", logger.String("export_id", job.Id), logger.Error(err))
+		exportErr = "failed to collect user data"
+	}
+
+	if err = s.storage.Export().CompleteDataExport(ctx, job.Id, data, exportErr); err != nil {
+		s.Logger.Error("exporter: failed to complete data export", logger.String("export_id", job.Id), logger.Error(err))
+		return false
+	}
+
+	s.Logger.Info("exporter: data export is built", logger.String("export_id", job.Id), logger.Bool("ok", exportErr == ""))
+	return true
+}
+
+// collectUserData returns json object with a section for every kind of data
+// of the user
+func (s *UserService) collectUserData(ctx context.Context, userId string) ([]byte, error) {
+	user, err := s.storage.User().GetUserById(ctx, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	// the user sees all own posts including drafts and private ones
+	posts, err := s.Client.Post().GetPostForUser(ctx, &p.Request{Str: userId, ViewerId: userId})
+	if err != nil {
+		return nil, err
+	}
+
+	comments, err := s.Client.Comment().GetCommentsByUser(ctx, &c.Request{Str: userId})
+	if err != nil {
+		return nil, err
+	}
+
+	likes, err := s.Client.Post().GetLikesByUser(ctx, &p.Request{Str: userId})
+	if err != nil {
+		return nil, err
+	}
+
+	followers, err := s.storage.User().GetFollowers(ctx, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	following, err := s.storage.User().GetFollowing(ctx, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(map[string]interface{}{
+		"profile": &u.UserResponse{
+			Id:        user.Id,
+			FirstName: user.FirstName,
+			LastName:  user.LastName,
+			UserType:  user.UserType,
+			Email:     user.Email,
+			CreatedAt: user.CreatedAt,
+			UpdatedAt: user.UpdatedAt,
+		},
+		"posts":     nonNil(posts.Posts),
+		"comments":  nonNil(comments.Comments),
+		"likes":     nonNil(likes.Likes),
+		"followers": followUsers(followers),
+		"following": followUsers(following),
+	})
+}
+
+// followUsers keeps public fields of followers and following users
+func followUsers(users []repo.User) []*u.UserResponse {
+	res := []*u.UserResponse{}
+	for _, user := range users {
+		res = append(res, &u.UserResponse{
+			Id:        user.Id,
+			FirstName: user.FirstName,
+			LastName:  user.LastName,
+		})
+	}
+
+	return res
+}
+
+// nonNil makes empty sections [] instead of null
+func nonNil[T any](items []T) []T {
+	if items == nil {
+		return []T{}
+	}
+
+	return items
+}
@@ -0,0 +1,100 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+
+	n "github.com/burxondv/new-services/user-service/genproto/notification"
+	u "github.com/burxondv/new-services/user-service/genproto/user"
+	"github.com/burxondv/new-services/user-service/pkg/logger"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *UserService) FollowUser(ctx context.Context, req *u.FollowRequest) (*u.FollowResponse, error) {
+	if req.FollowerId == req.FollowingId {
+		return &u.FollowResponse{}, status.Error(codes.InvalidArgument, "you can't follow yourself")
+	}
+
+	_, err := s.storage.User().GetUserById(ctx, req.FollowingId)
+	if err == sql.ErrNoRows {
+		return &u.FollowResponse{}, status.Error(codes.NotFound, "user not found")
+	} else if err != nil {
+		s.reqLog(ctx).Error("failed to get user for follow in service", logger.Error(err))
+		return &u.FollowResponse{}, err
+	}
+
+	blocked, err := s.storage.User().IsBlocked(ctx, req.FollowingId, req.FollowerId)
+	if err != nil {
+		s.reqLog(ctx).Error("failed to check block for follow in service", logger.Error(err))
+		return &u.FollowResponse{}, err
+	}
+	if blocked {
+		return &u.FollowResponse{}, status.Error(codes.PermissionDenied, "you are blocked by the user")
+	}
+
+	err = s.storage.User().FollowUser(ctx, req.FollowerId, req.FollowingId)
+	if err != nil {
+		s.reqLog(ctx).Error("failed to follow user in service", logger.Error(err))
+		return &u.FollowResponse{}, err
+	}
+
+	s.notify(ctx, &n.NotifyRequest{
+		Type:    "follow",
+		UserId:  req.FollowingId,
+		ActorId: req.FollowerId,
+	})
+
+	return &u.FollowResponse{
+		FollowerId:  req.FollowerId,
+		FollowingId: req.FollowingId,
+		Following:   true,
+	}, nil
+}
+
+func (s *UserService) UnfollowUser(ctx context.Context, req *u.FollowRequest) (*u.FollowResponse, error) {
+	err := s.storage.User().UnfollowUser(ctx, req.FollowerId, req.FollowingId)
+	if err != nil {
+		s.reqLog(ctx).Error("failed to unfollow user in service", logger.Error(err))
+		return &u.FollowResponse{}, err
+	}
+
+	return &u.FollowResponse{
+		FollowerId:  req.FollowerId,
+		FollowingId: req.FollowingId,
+		Following:   false,
+	}, nil
+}
+
+func (s *UserService) GetFollowers(ctx context.Context, req *u.Request) (*u.UsersResponse, error) {
+	usersResp := u.UsersResponse{}
+	res, err := s.storage.User().GetFollowers(ctx, req.Str)
+	if err != nil {
+		s.reqLog(ctx).Error("failed to get followers in service", logger.Error(err))
+		return &u.UsersResponse{}, err
+	}
+
+	for _, user := range res {
+		usersResp.Users = append(usersResp.Users, &u.UserResponse{
+			Id:        user.Id,
+			FirstName: user.FirstName,
+			LastName:  user.LastName,
+			UserType:  user.UserType,
+			CreatedAt: user.CreatedAt,
+			UpdatedAt: user.UpdatedAt,
+		})
+	}
+
+	return &usersResp, nil
+}
+
+func (s *UserService) IsFollowing(ctx context.Context, req *u.FollowRequest) (*u.CheckFieldResponse, error) {
+	exists, err := s.storage.User().IsFollowing(ctx, req.FollowerId, req.FollowingId)
+	if err != nil {
+		s.reqLog(ctx).Error("failed to check following in service", logger.Error(err))
+		return &u.CheckFieldResponse{}, err
+	}
+
+	return &u.CheckFieldResponse{Exists: exists}, nil
+}
@@ -0,0 +1,150 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+
+	u "github.com/burxondv/new-services/user-service/genproto/user"
+	"github.com/burxondv/new-services/user-service/pkg/logger"
+	"github.com/burxondv/new-services/user-service/storage/repo"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// BlockUser blocks the target for the user: the target can not follow the
+// user, comment on the user's posts or find the user in search. Follows
+// between them are removed.
+func (s *UserService) BlockUser(ctx context.Context, req *u.RelationRequest) (*u.RelationResponse, error) {
+	if err := s.checkRelation(ctx, req, "block"); err != nil {
+		return &u.RelationResponse{}, err
+	}
+
+	err := s.storage.User().BlockUser(ctx, req.UserId, req.TargetId)
+	if err != nil {
+		s.reqLog(ctx).Error("failed to block user in service", logger.Error(err))
+		return &u.RelationResponse{}, err
+	}
+
+	return relationResponse(req, true), nil
+}
+
+func (s *UserService) UnblockUser(ctx context.Context, req *u.RelationRequest) (*u.RelationResponse, error) {
+	err := s.storage.User().UnblockUser(ctx, req.UserId, req.TargetId)
+	if err != nil {
+		s.reqLog(ctx).Error("failed to unblock user in service", logger.Error(err))
+		return &u.RelationResponse{}, err
+	}
+
+	return relationResponse(req, false), nil
+}
+
+func (s *UserService) GetBlockedUsers(ctx context.Context, req *u.Request) (*u.UsersResponse, error) {
+	res, err := s.storage.User().GetBlocked(ctx, req.Str)
+	if err != nil {
+		s.reqLog(ctx).Error("failed to get blocked users in service", logger.Error(err))
+		return &u.UsersResponse{}, err
+	}
+
+	return relationUsers(res), nil
+}
+
+// IsBlocked reports whether the user blocked the target
+func (s *UserService) IsBlocked(ctx context.Context, req *u.RelationRequest) (*u.CheckFieldResponse, error) {
+	exists, err := s.storage.User().IsBlocked(ctx, req.UserId, req.TargetId)
+	if err != nil {
+		s.reqLog(ctx).Error("failed to check block in service", logger.Error(err))
+		return &u.CheckFieldResponse{}, err
+	}
+
+	return &u.CheckFieldResponse{Exists: exists}, nil
+}
+
+// MuteUser hides posts and comments of the target from listings of the user,
+// the target is not notified
+func (s *UserService) MuteUser(ctx context.Context, req *u.RelationRequest) (*u.RelationResponse, error) {
+	if err := s.checkRelation(ctx, req, "mute"); err != nil {
+		return &u.RelationResponse{}, err
+	}
+
+	err := s.storage.User().MuteUser(ctx, req.UserId, req.TargetId)
+	if err != nil {
+		s.reqLog(ctx).Error("failed to mute user in service", logger.Error(err))
+		return &u.RelationResponse{}, err
+	}
+
+	return relationResponse(req, true), nil
+}
+
+func (s *UserService) UnmuteUser(ctx context.Context, req *u.RelationRequest) (*u.RelationResponse, error) {
+	err := s.storage.User().UnmuteUser(ctx, req.UserId, req.TargetId)
+	if err != nil {
+		s.reqLog(ctx).Error("failed to unmute user in service", logger.Error(err))
+		return &u.RelationResponse{}, err
+	}
+
+	return relationResponse(req, false), nil
+}
+
+func (s *UserService) GetMutedUsers(ctx context.Context, req *u.Request) (*u.UsersResponse, error) {
+	res, err := s.storage.User().GetMuted(ctx, req.Str)
+	if err != nil {
+		s.reqLog(ctx).Error("failed to get muted users in service", logger.Error(err))
+		return &u.UsersResponse{}, err
+	}
+
+	return relationUsers(res), nil
+}
+
+// GetMutedIds lets other services filter muted users out of listings
+func (s *UserService) GetMutedIds(ctx context.Context, req *u.Request) (*u.IdsRequest, error) {
+	ids, err := s.storage.User().GetMutedIds(ctx, req.Str)
+	if err != nil {
+		s.reqLog(ctx).Error("failed to get muted ids in service", logger.Error(err))
+		return &u.IdsRequest{}, err
+	}
+
+	return &u.IdsRequest{Ids: ids}, nil
+}
+
+// checkRelation checks that the target of block or mute is another existing
+// user
+func (s *UserService) checkRelation(ctx context.Context, req *u.RelationRequest, action string) error {
+	if req.UserId == req.TargetId {
+		return status.Errorf(codes.InvalidArgument, "you can't %s yourself", action)
+	}
+
+	_, err := s.storage.User().GetUserById(ctx, req.TargetId)
+	if err == sql.ErrNoRows {
+		return status.Error(codes.NotFound, "user not found")
+	} else if err != nil {
+		s.reqLog(ctx).Error("failed to get user for relation in service", logger.String("action", action), logger.Error(err))
+		return err
+	}
+
+	return nil
+}
+
+func relationResponse(req *u.RelationRequest, active bool) *u.RelationResponse {
+	return &u.RelationResponse{
+		UserId:   req.UserId,
+		TargetId: req.TargetId,
+		Active:   active,
+	}
+}
+
+func relationUsers(users []repo.User) *u.UsersResponse {
+	usersResp := &u.UsersResponse{}
+	for _, user := range users {
+		usersResp.Users = append(usersResp.Users, &u.UserResponse{
+			Id:        user.Id,
+			FirstName: user.FirstName,
+			LastName:  user.LastName,
+			UserType:  user.UserType,
+			CreatedAt: user.CreatedAt,
+			UpdatedAt: user.UpdatedAt,
+		})
+	}
+
+	return usersResp
+}
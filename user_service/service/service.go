@@ -3,38 +3,48 @@ package service
 import (
 	"context"
 	"database/sql"
-	"fmt"
-	"log"
 	"strings"
+	"time"
 
 	"github.com/jmoiron/sqlx"
 
+	"github.com/burxondv/new-services/user-service/config"
 	p "github.com/burxondv/new-services/user-service/genproto/post"
 	u "github.com/burxondv/new-services/user-service/genproto/user"
 	"github.com/burxondv/new-services/user-service/pkg/logger"
+	"github.com/burxondv/new-services/user-service/pkg/metrics"
 	grpcclient "github.com/burxondv/new-services/user-service/service/grpc_client"
 	"github.com/burxondv/new-services/user-service/storage"
 	"github.com/burxondv/new-services/user-service/storage/repo"
 
 	"golang.org/x/crypto/bcrypt"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 type UserService struct {
 	storage storage.IStorage
+	cfg     config.Config
 	Logger  logger.Logger
 	Client  grpcclient.Clients
 }
 
-func NewUserService(db *sqlx.DB, log logger.Logger, client grpcclient.Clients) *UserService {
+func NewUserService(db *sqlx.DB, log logger.Logger, client grpcclient.Clients, cfg config.Config) *UserService {
 	return &UserService{
-		storage: storage.NewStoragePg(db),
+		storage: storage.NewStoragePg(db, log),
+		cfg:     cfg,
 		Logger:  log,
 		Client:  client,
 	}
 }
 
+// reqLog returns logger with ids of the request and of its trace
+func (s *UserService) reqLog(ctx context.Context) logger.Logger {
+	return logger.WithContext(s.Logger, ctx)
+}
+
 func (s *UserService) CreateUser(ctx context.Context, req *u.UserResponse) (*u.UserResponse, error) {
-	res, err := s.storage.User().CreateUser(repo.User{
+	res, err := s.storage.User().CreateUser(ctx, repo.User{
 		Id:           req.Id,
 		FirstName:    req.FirstName,
 		LastName:     req.LastName,
@@ -43,9 +53,10 @@ func (s *UserService) CreateUser(ctx context.Context, req *u.UserResponse) (*u.U
 		RefreshToken: req.RefreshToken,
 	})
 	if err != nil {
-		log.Println("failed to create user in service: ", err)
+		s.reqLog(ctx).Error("failed to create user in service", logger.Error(err))
 		return &u.UserResponse{}, err
 	}
+	metrics.UsersRegistered.Inc()
 
 	return &u.UserResponse{
 		Id:           res.Id,
@@ -57,14 +68,15 @@ func (s *UserService) CreateUser(ctx context.Context, req *u.UserResponse) (*u.U
 		RefreshToken: res.RefreshToken,
 		CreatedAt:    res.CreatedAt,
 		UpdatedAt:    res.UpdatedAt,
+		Version:      res.Version,
 	}, nil
 }
 
 func (s *UserService) GetUserById(ctx context.Context, req *u.Request) (*u.UserResponse, error) {
 	userResp := u.UserResponse{}
-	res, err := s.storage.User().GetUserById(req.Str)
+	res, err := s.storage.User().GetUserById(ctx, req.Str)
 	if err != nil {
-		log.Println("failed to get user in service: ", err)
+		s.reqLog(ctx).Error("failed to get user in service", logger.Error(err))
 		return &u.UserResponse{}, err
 	}
 	userResp.Id = res.Id
@@ -72,13 +84,13 @@ func (s *UserService) GetUserById(ctx context.Context, req *u.Request) (*u.UserR
 	userResp.LastName = res.LastName
 	userResp.UserType = res.UserType
 	userResp.Email = res.Email
-	userResp.RefreshToken = res.RefreshToken
 	userResp.CreatedAt = res.CreatedAt
 	userResp.UpdatedAt = res.UpdatedAt
+	userResp.Version = res.Version
 
-	postRes, err := s.Client.Post().GetPostForUser(ctx, &p.Request{Str: req.Str})
+	postRes, err := s.Client.Post().GetPostForUser(ctx, &p.Request{Str: req.Str, ViewerId: req.ViewerId})
 	if err != nil {
-		log.Println("failed to get post in user service: ", err)
+		s.reqLog(ctx).Error("failed to get post in user service", logger.Error(err))
 		return &u.UserResponse{}, err
 	}
 
@@ -89,9 +101,9 @@ func (s *UserService) GetUserById(ctx context.Context, req *u.Request) (*u.UserR
 
 func (s *UserService) GetUserByEmail(ctx context.Context, req *u.Request) (*u.UserResponse, error) {
 	userResp := u.UserResponse{}
-	res, err := s.storage.User().GetUserByEmail(req.Str)
+	res, err := s.storage.User().GetUserByEmail(ctx, req.Str)
 	if err != nil {
-		log.Println("failed to get user by email: ", err)
+		s.reqLog(ctx).Error("failed to get user by email", logger.Error(err))
 		return &u.UserResponse{}, err
 	}
 
@@ -104,9 +116,9 @@ func (s *UserService) GetUserByEmail(ctx context.Context, req *u.Request) (*u.Us
 	userResp.CreatedAt = res.CreatedAt
 	userResp.UpdatedAt = res.UpdatedAt
 
-	posts, err := s.Client.Post().GetPostForUser(ctx, &p.Request{Str: userResp.Id})
+	posts, err := s.Client.Post().GetPostForUser(ctx, &p.Request{Str: userResp.Id, ViewerId: req.ViewerId})
 	if err != nil {
-		log.Println("failed to get post in user email service: ", err)
+		s.reqLog(ctx).Error("failed to get post in user email service", logger.Error(err))
 		return &u.UserResponse{}, err
 	}
 
@@ -117,9 +129,9 @@ func (s *UserService) GetUserByEmail(ctx context.Context, req *u.Request) (*u.Us
 
 func (s *UserService) GetUserForClient(ctx context.Context, req *u.Request) (*u.UserResponse, error) {
 	userResp := u.UserResponse{}
-	res, err := s.storage.User().GetUserById(req.Str)
+	res, err := s.storage.User().GetUserById(ctx, req.Str)
 	if err != nil {
-		log.Println("failed to get user for clients in service: ", err)
+		s.reqLog(ctx).Error("failed to get user for clients in service", logger.Error(err))
 		return &u.UserResponse{}, err
 	}
 
@@ -131,15 +143,65 @@ func (s *UserService) GetUserForClient(ctx context.Context, req *u.Request) (*u.
 	userResp.RefreshToken = res.RefreshToken
 	userResp.CreatedAt = res.CreatedAt
 	userResp.UpdatedAt = res.UpdatedAt
+	userResp.Version = res.Version
 
 	return &userResp, nil
 }
 
+func (s *UserService) GetUsersByFirstNames(ctx context.Context, req *u.NamesRequest) (*u.UsersResponse, error) {
+	res, err := s.storage.User().GetUsersByFirstNames(ctx, req.Names)
+	if err != nil {
+		s.reqLog(ctx).Error("failed to get users by first names in service", logger.Error(err))
+		return &u.UsersResponse{}, err
+	}
+
+	return clientUsersResponse(res), nil
+}
+
+// GetUsersByIds lets other services get authors of many posts or comments
+// with one call, deleted users are missing in the response
+func (s *UserService) GetUsersByIds(ctx context.Context, req *u.IdsRequest) (*u.UsersResponse, error) {
+	if len(req.Ids) == 0 {
+		return &u.UsersResponse{}, nil
+	}
+
+	res, err := s.storage.User().GetUsersByIds(ctx, req.Ids)
+	if err != nil {
+		s.reqLog(ctx).Error("failed to get users by ids in service", logger.Error(err))
+		return &u.UsersResponse{}, err
+	}
+
+	return clientUsersResponse(res), nil
+}
+
+func clientUsersResponse(res []repo.User) *u.UsersResponse {
+	usersResp := u.UsersResponse{}
+	for _, val := range res {
+		usersResp.Users = append(usersResp.Users, &u.UserResponse{
+			Id:        val.Id,
+			FirstName: val.FirstName,
+			LastName:  val.LastName,
+			UserType:  val.UserType,
+			Email:     val.Email,
+			CreatedAt: val.CreatedAt,
+			UpdatedAt: val.UpdatedAt,
+		})
+	}
+
+	return &usersResp
+}
+
 func (s *UserService) GetAllUsers(ctx context.Context, req *u.GetUsersRequest) (*u.UsersResponse, error) {
 	usersResp := u.UsersResponse{}
-	res, err := s.storage.User().GetAllUsers(req.Page, req.Limit)
+	res, err := s.storage.User().GetAllUsers(ctx, req.Page, req.Limit)
+	if err != nil {
+		s.reqLog(ctx).Error("failed to get all users in service", logger.Error(err))
+		return &u.UsersResponse{}, err
+	}
+
+	counts, err := s.countPosts(ctx, res, req.ViewerId)
 	if err != nil {
-		log.Println("failed to get all users in service: ", err)
+		s.reqLog(ctx).Error("failed to count posts in user servcise", logger.Error(err))
 		return &u.UsersResponse{}, err
 	}
 
@@ -152,16 +214,9 @@ func (s *UserService) GetAllUsers(ctx context.Context, req *u.GetUsersRequest) (
 			Email:     user.Email,
 			CreatedAt: user.CreatedAt,
 			UpdatedAt: user.UpdatedAt,
+			Posts:     counts[user.Id],
 		}
 
-		post, err := s.Client.Post().GetPostForUser(ctx, &p.Request{Str: user.Id})
-		if err != nil {
-			log.Println("failed to get post in user servcise: ", err)
-			return &u.UsersResponse{}, err
-		}
-
-		userResp.Posts = int64(len(post.Posts))
-
 		usersResp.Users = append(usersResp.Users, &userResp)
 	}
 
@@ -170,9 +225,15 @@ func (s *UserService) GetAllUsers(ctx context.Context, req *u.GetUsersRequest) (
 
 func (s *UserService) SearchUsers(ctx context.Context, req *u.Request) (*u.UsersResponse, error) {
 	usersResp := u.UsersResponse{}
-	res, err := s.storage.User().SearchUsers(req.Str)
+	res, err := s.storage.User().SearchUsers(ctx, req.Str, req.ViewerId)
+	if err != nil {
+		s.reqLog(ctx).Error("failed to searching user by name", logger.Error(err))
+		return &u.UsersResponse{}, err
+	}
+
+	counts, err := s.countPosts(ctx, res, req.ViewerId)
 	if err != nil {
-		log.Println("failed to searching user by name: ", err)
+		s.reqLog(ctx).Error("failed to count posts in user service", logger.Error(err))
 		return &u.UsersResponse{}, err
 	}
 
@@ -185,35 +246,49 @@ func (s *UserService) SearchUsers(ctx context.Context, req *u.Request) (*u.Users
 			Email:     user.Email,
 			CreatedAt: user.CreatedAt,
 			UpdatedAt: user.UpdatedAt,
+			Posts:     counts[user.Id],
 		}
 
-		post, err := s.Client.Post().GetPostForUser(ctx, &p.Request{Str: user.Id})
-		if err != nil {
-			log.Println("failed to get post in user service: ", err)
-			return &u.UsersResponse{}, err
-		}
-
-		userResp.Posts = int64(len(post.Posts))
-
 		usersResp.Users = append(usersResp.Users, &userResp)
 	}
 
 	return &usersResp, nil
 }
 
+// countPosts counts posts of a page of users which the viewer can see with
+// one call
+func (s *UserService) countPosts(ctx context.Context, users []repo.User, viewerId string) (map[string]int64, error) {
+	ids := []string{}
+	for _, user := range users {
+		ids = append(ids, user.Id)
+	}
+
+	res, err := s.Client.Post().CountPostsForUsers(ctx, &p.IdsRequest{Ids: ids, ViewerId: viewerId})
+	if err != nil {
+		return map[string]int64{}, err
+	}
+
+	return res.Counts, nil
+}
+
 func (s *UserService) UpdateUser(ctx context.Context, req *u.UpdateUserRequest) (*u.UserResponse, error) {
 	userResp := u.UserResponse{}
-	res, err := s.storage.User().UpdateUser(repo.User{
+	res, err := s.storage.User().UpdateUser(ctx, repo.User{
 		Id:        req.Id,
 		FirstName: req.FirstName,
 		LastName:  req.LastName,
 		Email:     req.Email,
+		Version:   req.ExpectedVersion,
 	})
-	if err != nil {
-		log.Println("failed to update user in service: ", err)
+	if err == sql.ErrNoRows && req.ExpectedVersion != 0 {
+		if _, err := s.storage.User().GetUserById(ctx, req.Id); err == nil {
+			return &u.UserResponse{}, status.Error(codes.FailedPrecondition, "user was changed by another update")
+		}
+		return &u.UserResponse{}, status.Error(codes.NotFound, "user not found")
+	} else if err != nil {
+		s.reqLog(ctx).Error("failed to update user in service", logger.Error(err))
 		return &u.UserResponse{}, err
 	}
-	fmt.Println(req)
 	userResp.Id = req.Id
 	userResp.FirstName = res.FirstName
 	userResp.LastName = res.LastName
@@ -221,10 +296,11 @@ func (s *UserService) UpdateUser(ctx context.Context, req *u.UpdateUserRequest)
 	userResp.Email = res.Email
 	userResp.CreatedAt = res.CreatedAt
 	userResp.UpdatedAt = res.UpdatedAt
+	userResp.Version = res.Version
 
-	post, err := s.Client.Post().GetPostForUser(ctx, &p.Request{Str: req.Id})
+	post, err := s.Client.Post().GetPostForUser(ctx, &p.Request{Str: req.Id, ViewerId: req.Id})
 	if err != nil {
-		log.Println("failed to get post in user delete service: ", err)
+		s.reqLog(ctx).Error("failed to get post in user delete service", logger.Error(err))
 		return &u.UserResponse{}, err
 	}
 
@@ -235,9 +311,18 @@ func (s *UserService) UpdateUser(ctx context.Context, req *u.UpdateUserRequest)
 
 func (s *UserService) DeleteUser(ctx context.Context, req *u.Request) (*u.UserResponse, error) {
 	userResp := u.UserResponse{}
-	res, err := s.storage.User().DeleteUser(req.Str)
+	res, err := s.storage.User().DeleteUser(ctx, req.Str)
 	if err != nil {
-		log.Println("failed to delete user: ", err)
+		s.reqLog(ctx).Error("failed to delete user", logger.Error(err))
+		return &u.UserResponse{}, err
+	}
+
+	// posts and comments are deleted by consumers of UserDeleted at once
+	// and erased with the user after the grace period
+	purgeAfter := time.Now().Add(time.Duration(s.cfg.AccountPurgeDelay) * time.Second)
+	err = s.storage.User().SchedulePurge(ctx, res.Id, purgeAfter)
+	if err != nil {
+		s.reqLog(ctx).Error("failed to schedule user purge", logger.Error(err))
 		return &u.UserResponse{}, err
 	}
 
@@ -248,10 +333,11 @@ func (s *UserService) DeleteUser(ctx context.Context, req *u.Request) (*u.UserRe
 	userResp.Email = res.Email
 	userResp.CreatedAt = res.CreatedAt
 	userResp.UpdatedAt = res.UpdatedAt
+	userResp.PurgeAfter = purgeAfter.Format(time.RFC3339)
 
 	post, err := s.Client.Post().GetPostForUser(ctx, &p.Request{Str: req.Str})
 	if err != nil {
-		log.Println("failed to get post in user service: ", err)
+		s.reqLog(ctx).Error("failed to get post in user service", logger.Error(err))
 		return &u.UserResponse{}, err
 	}
 
@@ -261,9 +347,9 @@ func (s *UserService) DeleteUser(ctx context.Context, req *u.Request) (*u.UserRe
 }
 
 func (s *UserService) CheckField(ctx context.Context, req *u.CheckFieldRequest) (*u.CheckFieldResponse, error) {
-	res, err := s.storage.User().CheckField(req)
+	res, err := s.storage.User().CheckField(ctx, req)
 	if err != nil {
-		log.Println("failed to check field: ", err)
+		s.reqLog(ctx).Error("failed to check field", logger.Error(err))
 		return &u.CheckFieldResponse{}, err
 	}
 
@@ -274,19 +360,23 @@ func (s *UserService) Login(ctx context.Context, req *u.LoginRequest) (*u.LoginR
 	req.Email = strings.ToLower(req.Email)
 	req.Email = strings.TrimSpace(req.Email)
 
-	user, err := s.storage.User().GetUserByEmail(req.Email)
+	user, err := s.storage.User().GetUserByEmail(ctx, req.Email)
 
 	if err == sql.ErrNoRows {
-		log.Println("failed to get user by email, not found: ", err)
+		s.reqLog(ctx).Error("failed to get user by email, not found", logger.Error(err))
 		return &u.LoginResponse{}, err
 	} else if err != nil {
-		log.Println("failed to get user by email, internal server error: ", err)
+		s.reqLog(ctx).Error("failed to get user by email, internal server error", logger.Error(err))
 		return &u.LoginResponse{}, err
 	}
 
 	err = bcrypt.CompareHashAndPassword([]byte(user.Password), []byte(req.Password))
 	if err != nil {
-		log.Println("failed to compare password: ", err)
+		s.reqLog(ctx).Error("failed to compare password", logger.Error(err))
+		return &u.LoginResponse{}, err
+	}
+
+	if err := s.checkAccount(ctx, user.Id); err != nil {
 		return &u.LoginResponse{}, err
 	}
 
@@ -302,9 +392,9 @@ func (s *UserService) Login(ctx context.Context, req *u.LoginRequest) (*u.LoginR
 }
 
 func (s *UserService) UpdateUserTokens(ctx context.Context, req *u.UpdateUserTokensRequest) (*u.UserResponse, error) {
-	res, err := s.storage.User().UpdateUserTokens(req)
+	res, err := s.storage.User().UpdateUserTokens(ctx, req)
 	if err != nil {
-		log.Println("failed to update user tokens in user service: ", err)
+		s.reqLog(ctx).Error("failed to update user tokens in user service", logger.Error(err))
 		return nil, err
 	}
 
@@ -312,19 +402,43 @@ func (s *UserService) UpdateUserTokens(ctx context.Context, req *u.UpdateUserTok
 }
 
 func (s *UserService) ChangeRoleUser(ctx context.Context, req *u.ChangeRoleRequest) (*u.UserResponse, error) {
-	res, err := s.storage.User().ChangeRoleUser(req)
+	res, err := s.storage.User().ChangeRoleUser(ctx, req)
 	if err != nil {
-		log.Println("failed to update user role in user service: ", err)
+		s.reqLog(ctx).Error("failed to update user role in user service", logger.Error(err))
 		return nil, err
 	}
 
 	return res, nil
 }
 
+// SuspendUser suspends the user until the given time, empty time lifts the
+// suspension
+func (s *UserService) SuspendUser(ctx context.Context, req *u.SuspendRequest) (*u.UserResponse, error) {
+	var until time.Time
+	if req.Until != "" {
+		var err error
+		until, err = time.Parse(time.RFC3339, req.Until)
+		if err != nil {
+			return &u.UserResponse{}, status.Error(codes.InvalidArgument, "until must be RFC3339 time")
+		}
+		until = until.UTC()
+	}
+
+	res, err := s.storage.User().SuspendUser(ctx, req.Id, until, req.Reason)
+	if err == sql.ErrNoRows {
+		return &u.UserResponse{}, status.Error(codes.NotFound, "user not found")
+	} else if err != nil {
+		s.reqLog(ctx).Error("failed to suspend user in service", logger.Error(err))
+		return &u.UserResponse{}, err
+	}
+
+	return res, nil
+}
+
 func (s *UserService) GetSameRoleUsers(ctx context.Context, req *u.Request) (*u.UsersResponse, error) {
-	res, err := s.storage.User().GetSameRoleUsers(req.Str)
+	res, err := s.storage.User().GetSameRoleUsers(ctx, req.Str)
 	if err != nil {
-		log.Println("failed to get the same role users in service: ", err)
+		s.reqLog(ctx).Error("failed to get the same role users in service", logger.Error(err))
 		return nil, err
 	}
 
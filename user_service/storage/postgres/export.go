@@ -0,0 +1,130 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/burxondv/new-services/user-service/pkg/logger"
+	"github.com/burxondv/new-services/user-service/storage/repo"
+
+	"github.com/google/uuid"
+)
+
+// exportColumns are selected by every data export query without data,
+// scanned by scanExport
+const exportColumns = `id, user_id, status, error, created_at, completed_at`
+
+type scanner interface {
+	Scan(dest ...interface{}) error
+}
+
+// scanExport scans exportColumns and then extra columns into dest
+func scanExport(row scanner, dest ...interface{}) (repo.DataExport, error) {
+	var (
+		res         repo.DataExport
+		createdAt   time.Time
+		completedAt sql.NullTime
+	)
+
+	err := row.Scan(append([]interface{}{&res.Id, &res.UserId, &res.Status, &res.Error, &createdAt, &completedAt}, dest...)...)
+	if err != nil {
+		return repo.DataExport{}, err
+	}
+
+	res.CreatedAt = createdAt.Format(time.RFC3339)
+	if completedAt.Valid {
+		res.CompletedAt = completedAt.Time.Format(time.RFC3339)
+	}
+
+	return res, nil
+}
+
+func (r *ExportRepo) CreateDataExport(ctx context.Context, userId string) (repo.DataExport, error) {
+	res, err := scanExport(r.db.QueryRowContext(ctx, `
+		insert into
+			data_exports(id, user_id)
+		values
+			($1, $2)
+		returning
+			`+exportColumns, uuid.NewString(), userId))
+	if err != nil {
+		logger.WithContext(r.log, ctx).Error("failed to create data export in sql", logger.Error(err))
+		return repo.DataExport{}, err
+	}
+
+	return res, nil
+}
+
+// GetDataExport returns the export with data when it is ready
+func (r *ExportRepo) GetDataExport(ctx context.Context, id string) (repo.DataExport, error) {
+	var data []byte
+	res, err := scanExport(r.db.QueryRowContext(ctx, `
+		select
+			`+exportColumns+`, data
+		from
+			data_exports
+		where
+			id = $1`, id), &data)
+	if err != nil {
+		logger.WithContext(r.log, ctx).Error("failed to get data export in sql", logger.Error(err))
+		return repo.DataExport{}, err
+	}
+	res.Data = data
+
+	return res, nil
+}
+
+// ClaimDataExport takes the oldest pending export. Exports which are
+// processing since staleBefore are taken again, so the export is resumed
+// when the worker is stopped in the middle. Skip locked lets several
+// replicas run it at the same time, sql.ErrNoRows is returned when there is
+// nothing to do.
+func (r *ExportRepo) ClaimDataExport(ctx context.Context, staleBefore time.Time) (repo.DataExport, error) {
+	res, err := scanExport(r.db.QueryRowContext(ctx, `
+		update
+			data_exports
+		set
+			status = 'processing', started_at = $1
+		where id = (
+			select
+				id
+			from
+				data_exports
+			where
+				status = 'pending' or (status = 'processing' and started_at < $2)
+			order by created_at
+			limit 1
+			for update skip locked
+		)
+		returning
+			`+exportColumns, time.Now(), staleBefore))
+	if err != nil && err != sql.ErrNoRows {
+		logger.WithContext(r.log, ctx).Error("failed to claim data export in sql", logger.Error(err))
+	}
+
+	return res, err
+}
+
+// CompleteDataExport saves data of the export, export is failed when
+// exportErr is not empty
+func (r *ExportRepo) CompleteDataExport(ctx context.Context, id string, data []byte, exportErr string) error {
+	status := repo.ExportReady
+	if exportErr != "" {
+		status = repo.ExportFailed
+	}
+
+	_, err := r.db.ExecContext(ctx, `
+		update
+			data_exports
+		set
+			status = $1, data = $2, error = $3, completed_at = $4
+		where
+			id = $5`, status, data, exportErr, time.Now(), id)
+	if err != nil {
+		logger.WithContext(r.log, ctx).Error("failed to complete data export in sql", logger.Error(err))
+		return err
+	}
+
+	return nil
+}
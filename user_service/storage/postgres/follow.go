@@ -0,0 +1,106 @@
+package postgres
+
+import (
+	"context"
+
+	"github.com/burxondv/new-services/user-service/pkg/logger"
+	"github.com/burxondv/new-services/user-service/storage/repo"
+)
+
+func (r *UserRepo) FollowUser(ctx context.Context, followerId, followingId string) error {
+	_, err := r.db.ExecContext(ctx, `
+		insert into
+			follows(follower_id, following_id)
+		values
+			($1, $2)
+		on conflict do nothing`, followerId, followingId)
+
+	if err != nil {
+		logger.WithContext(r.log, ctx).Error("failed to follow user in sql", logger.Error(err))
+		return err
+	}
+
+	return nil
+}
+
+func (r *UserRepo) UnfollowUser(ctx context.Context, followerId, followingId string) error {
+	_, err := r.db.ExecContext(ctx, `
+		delete from
+			follows
+		where
+			follower_id = $1 and following_id = $2`, followerId, followingId)
+
+	if err != nil {
+		logger.WithContext(r.log, ctx).Error("failed to unfollow user in sql", logger.Error(err))
+		return err
+	}
+
+	return nil
+}
+
+func (r *UserRepo) GetFollowers(ctx context.Context, userId string) ([]repo.User, error) {
+	return r.getFollows(ctx, "follower_id", "following_id", userId)
+}
+
+// GetFollowing returns users followed by the user
+func (r *UserRepo) GetFollowing(ctx context.Context, userId string) ([]repo.User, error) {
+	return r.getFollows(ctx, "following_id", "follower_id", userId)
+}
+
+// getFollows returns users of userColumn in follows where column is userId
+func (r *UserRepo) getFollows(ctx context.Context, userColumn, column, userId string) ([]repo.User, error) {
+	var res []repo.User
+	rows, err := r.db.QueryContext(ctx, `
+		select
+			u.id, u.first_name, u.last_name, u.user_type, u.email, u.created_at, u.updated_at
+		from
+			follows f
+		join
+			users u on u.id = f.`+userColumn+`
+		where
+			f.`+column+` = $1 and u.deleted_at is null
+		order by f.created_at desc`, userId)
+
+	if err != nil {
+		logger.WithContext(r.log, ctx).Error("failed to get follows in sql", logger.Error(err))
+		return []repo.User{}, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		temp := repo.User{}
+
+		err = rows.Scan(
+			&temp.Id,
+			&temp.FirstName,
+			&temp.LastName,
+			&temp.UserType,
+			&temp.Email,
+			&temp.CreatedAt,
+			&temp.UpdatedAt,
+		)
+		if err != nil {
+			logger.WithContext(r.log, ctx).Error("failed to scanning follows in sql", logger.Error(err))
+			return []repo.User{}, err
+		}
+
+		res = append(res, temp)
+	}
+
+	return res, nil
+}
+
+func (r *UserRepo) IsFollowing(ctx context.Context, followerId, followingId string) (bool, error) {
+	var exists bool
+	err := r.db.QueryRowContext(ctx, `
+		select exists(
+			select 1 from follows where follower_id = $1 and following_id = $2
+		)`, followerId, followingId).Scan(&exists)
+
+	if err != nil {
+		logger.WithContext(r.log, ctx).Error("failed to check following in sql", logger.Error(err))
+		return false, err
+	}
+
+	return exists, nil
+}
@@ -1,13 +1,43 @@
 package postgres
 
-import "github.com/jmoiron/sqlx"
+import (
+	"github.com/burxondv/new-services/user-service/pkg/logger"
+
+	"github.com/jmoiron/sqlx"
+)
 
 type UserRepo struct {
-	db *sqlx.DB
+	db  *sqlx.DB
+	log logger.Logger
 }
 
-func NewUserRepo(db *sqlx.DB) *UserRepo {
+func NewUserRepo(db *sqlx.DB, log logger.Logger) *UserRepo {
 	return &UserRepo{
-		db: db,
+		db:  db,
+		log: log,
+	}
+}
+
+type OutboxRepo struct {
+	db  *sqlx.DB
+	log logger.Logger
+}
+
+func NewOutboxRepo(db *sqlx.DB, log logger.Logger) *OutboxRepo {
+	return &OutboxRepo{
+		db:  db,
+		log: log,
+	}
+}
+
+type ExportRepo struct {
+	db  *sqlx.DB
+	log logger.Logger
+}
+
+func NewExportRepo(db *sqlx.DB, log logger.Logger) *ExportRepo {
+	return &ExportRepo{
+		db:  db,
+		log: log,
 	}
 }
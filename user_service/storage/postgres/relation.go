@@ -0,0 +1,168 @@
+package postgres
+
+import (
+	"context"
+
+	"github.com/burxondv/new-services/user-service/pkg/logger"
+	"github.com/burxondv/new-services/user-service/storage/repo"
+)
+
+// BlockUser blocks the target for the user, follows between them are removed
+// in both directions
+func (r *UserRepo) BlockUser(ctx context.Context, userId, targetId string) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	_, err = tx.ExecContext(ctx, `
+		insert into
+			blocks(user_id, target_id)
+		values
+			($1, $2)
+		on conflict do nothing`, userId, targetId)
+	if err != nil {
+		logger.WithContext(r.log, ctx).Error("failed to block user in sql", logger.Error(err))
+		return err
+	}
+
+	_, err = tx.ExecContext(ctx, `
+		delete from
+			follows
+		where
+			(follower_id = $1 and following_id = $2) or (follower_id = $2 and following_id = $1)`, userId, targetId)
+	if err != nil {
+		logger.WithContext(r.log, ctx).Error("failed to delete follows of blocked user in sql", logger.Error(err))
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func (r *UserRepo) UnblockUser(ctx context.Context, userId, targetId string) error {
+	_, err := r.db.ExecContext(ctx, `delete from blocks where user_id = $1 and target_id = $2`, userId, targetId)
+	if err != nil {
+		logger.WithContext(r.log, ctx).Error("failed to unblock user in sql", logger.Error(err))
+		return err
+	}
+
+	return nil
+}
+
+// GetBlocked returns users blocked by the user
+func (r *UserRepo) GetBlocked(ctx context.Context, userId string) ([]repo.User, error) {
+	return r.getRelations(ctx, "blocks", userId)
+}
+
+// IsBlocked reports whether the user blocked the target
+func (r *UserRepo) IsBlocked(ctx context.Context, userId, targetId string) (bool, error) {
+	var exists bool
+	err := r.db.QueryRowContext(ctx, `
+		select exists(
+			select 1 from blocks where user_id = $1 and target_id = $2
+		)`, userId, targetId).Scan(&exists)
+
+	if err != nil {
+		logger.WithContext(r.log, ctx).Error("failed to check block in sql", logger.Error(err))
+		return false, err
+	}
+
+	return exists, nil
+}
+
+func (r *UserRepo) MuteUser(ctx context.Context, userId, targetId string) error {
+	_, err := r.db.ExecContext(ctx, `
+		insert into
+			mutes(user_id, target_id)
+		values
+			($1, $2)
+		on conflict do nothing`, userId, targetId)
+	if err != nil {
+		logger.WithContext(r.log, ctx).Error("failed to mute user in sql", logger.Error(err))
+		return err
+	}
+
+	return nil
+}
+
+func (r *UserRepo) UnmuteUser(ctx context.Context, userId, targetId string) error {
+	_, err := r.db.ExecContext(ctx, `delete from mutes where user_id = $1 and target_id = $2`, userId, targetId)
+	if err != nil {
+		logger.WithContext(r.log, ctx).Error("failed to unmute user in sql", logger.Error(err))
+		return err
+	}
+
+	return nil
+}
+
+// GetMuted returns users muted by the user
+func (r *UserRepo) GetMuted(ctx context.Context, userId string) ([]repo.User, error) {
+	return r.getRelations(ctx, "mutes", userId)
+}
+
+// GetMutedIds returns ids of users muted by the user, including deleted ones
+func (r *UserRepo) GetMutedIds(ctx context.Context, userId string) ([]string, error) {
+	rows, err := r.db.QueryContext(ctx, `select target_id from mutes where user_id = $1`, userId)
+	if err != nil {
+		logger.WithContext(r.log, ctx).Error("failed to get muted ids in sql", logger.Error(err))
+		return []string{}, err
+	}
+	defer rows.Close()
+
+	res := []string{}
+	for rows.Next() {
+		var id string
+		if err = rows.Scan(&id); err != nil {
+			logger.WithContext(r.log, ctx).Error("failed to scanning muted id in sql", logger.Error(err))
+			return []string{}, err
+		}
+
+		res = append(res, id)
+	}
+
+	return res, rows.Err()
+}
+
+// getRelations returns targets of the user in table
+func (r *UserRepo) getRelations(ctx context.Context, table, userId string) ([]repo.User, error) {
+	var res []repo.User
+	rows, err := r.db.QueryContext(ctx, `
+		select
+			u.id, u.first_name, u.last_name, u.user_type, u.email, u.created_at, u.updated_at
+		from
+			`+table+` t
+		join
+			users u on u.id = t.target_id
+		where
+			t.user_id = $1 and u.deleted_at is null
+		order by t.created_at desc`, userId)
+
+	if err != nil {
+		logger.WithContext(r.log, ctx).Error("failed to get relations in sql", logger.String("table", table), logger.Error(err))
+		return []repo.User{}, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		temp := repo.User{}
+
+		err = rows.Scan(
+			&temp.Id,
+			&temp.FirstName,
+			&temp.LastName,
+			&temp.UserType,
+			&temp.Email,
+			&temp.CreatedAt,
+			&temp.UpdatedAt,
+		)
+		if err != nil {
+			logger.WithContext(r.log, ctx).Error("failed to scanning relations in sql", logger.String("table", table), logger.Error(err))
+			return []repo.User{}, err
+		}
+
+		res = append(res, temp)
+	}
+
+	return res, nil
+}
@@ -1,53 +1,69 @@
 package postgres
 
 import (
+	"context"
 	"database/sql"
 	"fmt"
-	"log"
 	"time"
 
 	u "github.com/burxondv/new-services/user-service/genproto/user"
+	"github.com/burxondv/new-services/user-service/pkg/events"
+	"github.com/burxondv/new-services/user-service/pkg/logger"
 	"github.com/burxondv/new-services/user-service/storage/repo"
+
+	"github.com/lib/pq"
 )
 
-func (r *UserRepo) CreateUser(user repo.User) (repo.User, error) {
+func (r *UserRepo) CreateUser(ctx context.Context, user repo.User) (repo.User, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return repo.User{}, err
+	}
+	defer tx.Rollback()
+
 	var res repo.User
-	err := r.db.QueryRow(`
+	err = tx.QueryRowContext(ctx, `
 		insert into 
 			users(id, first_name, last_name, email, password, refresh_token)
 		values
 			($1, $2, $3, $4, $5, $6)
 		returning 
-			id, first_name, last_name, user_type, email, refresh_token, created_at, updated_at`, user.Id, user.FirstName, user.LastName, user.Email, user.Password, user.RefreshToken).Scan(&res.Id, &res.FirstName, &res.LastName, &res.UserType, &res.Email, &res.RefreshToken, &res.CreatedAt, &res.UpdatedAt)
+			id, first_name, last_name, user_type, email, refresh_token, created_at, updated_at, version`, user.Id, user.FirstName, user.LastName, user.Email, user.Password, user.RefreshToken).Scan(&res.Id, &res.FirstName, &res.LastName, &res.UserType, &res.Email, &res.RefreshToken, &res.CreatedAt, &res.UpdatedAt, &res.Version)
 
 	if err != nil {
-		log.Println("failed to create user in sql: ", err)
+		logger.WithContext(r.log, ctx).Error("failed to create user in sql", logger.Error(err))
 		return repo.User{}, err
 	}
 
-	return res, nil
+	err = insertEvent(ctx, tx, events.UserCreated, res.Id, userEvent(res))
+	if err != nil {
+		logger.WithContext(r.log, ctx).Error("failed to create user created event in sql", logger.Error(err))
+		return repo.User{}, err
+	}
+
+	return res, tx.Commit()
 }
 
-func (r *UserRepo) GetUserById(id string) (repo.User, error) {
+func (r *UserRepo) GetUserById(ctx context.Context, id string) (repo.User, error) {
 	var res repo.User
-	err := r.db.QueryRow(`
+	err := r.db.QueryRowContext(ctx, `
 		select 
-			id, first_name, last_name, user_type, email, refresh_token, created_at, updated_at
+			id, first_name, last_name, user_type, email, refresh_token, created_at, updated_at, version
 		from 
 			users 
-		where id = $1 and deleted_at is null`, id).Scan(&res.Id, &res.FirstName, &res.LastName, &res.UserType, &res.Email, &res.RefreshToken, &res.CreatedAt, &res.UpdatedAt)
+		where id = $1 and deleted_at is null`, id).Scan(&res.Id, &res.FirstName, &res.LastName, &res.UserType, &res.Email, &res.RefreshToken, &res.CreatedAt, &res.UpdatedAt, &res.Version)
 
 	if err != nil {
-		log.Println("failed to get user by id in sql: ", err)
+		logger.WithContext(r.log, ctx).Error("failed to get user by id in sql", logger.Error(err))
 		return repo.User{}, err
 	}
 
 	return res, nil
 }
 
-func (r *UserRepo) GetUserForClient(user_id string) (repo.User, error) {
+func (r *UserRepo) GetUserForClient(ctx context.Context, user_id string) (repo.User, error) {
 	var res repo.User
-	err := r.db.QueryRow(`
+	err := r.db.QueryRowContext(ctx, `
 		select 
 			id, first_name, last_name, user_type, email, created_at, updated_at 
 		from 
@@ -55,31 +71,79 @@ func (r *UserRepo) GetUserForClient(user_id string) (repo.User, error) {
 		where id = $1 and deleted_at is null`, user_id).Scan(&res.Id, &res.FirstName, &res.LastName, &res.UserType, &res.Email, &res.CreatedAt, &res.UpdatedAt)
 
 	if err != nil {
-		log.Println("failed to get user for client in sql: ", err)
+		logger.WithContext(r.log, ctx).Error("failed to get user for client in sql", logger.Error(err))
 		return repo.User{}, err
 	}
 
 	return res, nil
 }
 
-func (r *UserRepo) GetUserByEmail(email string) (repo.User, error) {
+// GetUsersByFirstNames is used for mentions, first name is unique like username
+func (r *UserRepo) GetUsersByFirstNames(ctx context.Context, names []string) ([]repo.User, error) {
+	return r.getUsers(ctx, "lower(first_name) = any($1)", pq.Array(names))
+}
+
+// GetUsersByIds returns found users of ids with one query
+func (r *UserRepo) GetUsersByIds(ctx context.Context, ids []string) ([]repo.User, error) {
+	return r.getUsers(ctx, "id = any($1::uuid[])", pq.Array(ids))
+}
+
+func (r *UserRepo) getUsers(ctx context.Context, where string, arg interface{}) ([]repo.User, error) {
+	var res []repo.User
+	rows, err := r.db.QueryContext(ctx, `
+		select 
+			id, first_name, last_name, user_type, email, created_at, updated_at 
+		from 
+			users 
+		where `+where+` and deleted_at is null`, arg)
+
+	if err != nil {
+		logger.WithContext(r.log, ctx).Error("failed to get users in sql", logger.Error(err))
+		return []repo.User{}, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		temp := repo.User{}
+
+		err = rows.Scan(
+			&temp.Id,
+			&temp.FirstName,
+			&temp.LastName,
+			&temp.UserType,
+			&temp.Email,
+			&temp.CreatedAt,
+			&temp.UpdatedAt,
+		)
+		if err != nil {
+			logger.WithContext(r.log, ctx).Error("failed to scanning users in sql", logger.Error(err))
+			return []repo.User{}, err
+		}
+
+		res = append(res, temp)
+	}
+
+	return res, nil
+}
+
+func (r *UserRepo) GetUserByEmail(ctx context.Context, email string) (repo.User, error) {
 	var res repo.User
 	query := fmt.Sprint("select id, first_name, last_name, user_type, email, password, refresh_token, created_at, updated_at from users where email ilike '%" + email + "%' and deleted_at is null")
 
-	err := r.db.QueryRow(query).Scan(&res.Id, &res.FirstName, &res.LastName, &res.UserType, &res.Email, &res.Password, &res.RefreshToken, &res.CreatedAt, &res.UpdatedAt)
+	err := r.db.QueryRowContext(ctx, query).Scan(&res.Id, &res.FirstName, &res.LastName, &res.UserType, &res.Email, &res.Password, &res.RefreshToken, &res.CreatedAt, &res.UpdatedAt)
 
 	if err != nil {
-		log.Println("failed to get user by email in sql: ", err)
+		logger.WithContext(r.log, ctx).Error("failed to get user by email in sql", logger.Error(err))
 		return repo.User{}, err
 	}
 
 	return res, nil
 }
 
-func (r *UserRepo) GetAllUsers(page, limit int64) ([]repo.User, error) {
+func (r *UserRepo) GetAllUsers(ctx context.Context, page, limit int64) ([]repo.User, error) {
 	var res []repo.User
 	offset := (page - 1) * limit
-	rows, err := r.db.Query(`
+	rows, err := r.db.QueryContext(ctx, `
 		select 
 			id, first_name, last_name, user_type, email, created_at, updated_at 
 		from 
@@ -89,7 +153,7 @@ func (r *UserRepo) GetAllUsers(page, limit int64) ([]repo.User, error) {
 		limit $1 offset $2`, limit, offset)
 
 	if err != nil {
-		log.Println("failed to get all users in sql: ", err)
+		logger.WithContext(r.log, ctx).Error("failed to get all users in sql", logger.Error(err))
 		return []repo.User{}, err
 	}
 
@@ -106,7 +170,7 @@ func (r *UserRepo) GetAllUsers(page, limit int64) ([]repo.User, error) {
 			&temp.UpdatedAt,
 		)
 		if err != nil {
-			log.Println("failed to scanning all users in sql: ", err)
+			logger.WithContext(r.log, ctx).Error("failed to scanning all users in sql", logger.Error(err))
 			return []repo.User{}, err
 		}
 
@@ -116,13 +180,19 @@ func (r *UserRepo) GetAllUsers(page, limit int64) ([]repo.User, error) {
 	return res, nil
 }
 
-func (r *UserRepo) SearchUsers(req string) ([]repo.User, error) {
+// SearchUsers finds users by name, users who blocked the viewer are not found
+func (r *UserRepo) SearchUsers(ctx context.Context, name, viewerId string) ([]repo.User, error) {
 	var res []repo.User
-	query := fmt.Sprint("select id, first_name, last_name, user_type, email, created_at, updated_at from users where first_name ilike '%" + req + "%' or last_name ilike '%" + req + "%' and deleted_at is null")
-
-	rows, err := r.db.Query(query)
+	rows, err := r.db.QueryContext(ctx, `
+		select
+			id, first_name, last_name, user_type, email, created_at, updated_at
+		from
+			users u
+		where
+			(first_name ilike '%' || $1 || '%' or last_name ilike '%' || $1 || '%') and deleted_at is null and
+			not exists(select 1 from blocks b where b.user_id = u.id and b.target_id::text = $2)`, name, viewerId)
 	if err != nil {
-		log.Println("failed to searching user in sql: ", err)
+		logger.WithContext(r.log, ctx).Error("failed to searching user in sql", logger.Error(err))
 		return []repo.User{}, err
 	}
 
@@ -139,7 +209,7 @@ func (r *UserRepo) SearchUsers(req string) ([]repo.User, error) {
 			&temp.UpdatedAt,
 		)
 		if err != nil {
-			log.Println("failed to scanning search user in sql: ", err)
+			logger.WithContext(r.log, ctx).Error("failed to scanning search user in sql", logger.Error(err))
 			return []repo.User{}, err
 		}
 
@@ -149,29 +219,36 @@ func (r *UserRepo) SearchUsers(req string) ([]repo.User, error) {
 	return res, nil
 }
 
-func (r *UserRepo) UpdateUser(user repo.User) (repo.User, error) {
+// UpdateUser updates the user only if it has user.Version when it is given,
+// otherwise sql.ErrNoRows is returned
+func (r *UserRepo) UpdateUser(ctx context.Context, user repo.User) (repo.User, error) {
 	res := repo.User{}
-	err := r.db.QueryRow(`
+	err := r.db.QueryRowContext(ctx, `
 		update
 			users
 		set
-			first_name = $1, last_name = $2, email = $3, updated_at = $4
+			first_name = $1, last_name = $2, email = $3, updated_at = $4, version = version + 1
 		where 
-			id = $5 and deleted_at is null
-		returning id, first_name, last_name, user_type, email, created_at, updated_at`, user.FirstName, user.LastName, user.Email, time.Now(), user.Id).Scan(&res.Id, &res.FirstName, &res.LastName, &res.UserType, &res.Email, &res.CreatedAt, &res.UpdatedAt)
+			id = $5 and deleted_at is null and ($6 = 0 or version = $6)
+		returning id, first_name, last_name, user_type, email, created_at, updated_at, version`, user.FirstName, user.LastName, user.Email, time.Now(), user.Id, user.Version).Scan(&res.Id, &res.FirstName, &res.LastName, &res.UserType, &res.Email, &res.CreatedAt, &res.UpdatedAt, &res.Version)
 
 	if err != nil {
-		log.Println("failed to update user in sql: ", err)
+		logger.WithContext(r.log, ctx).Error("failed to update user in sql", logger.Error(err))
 		return repo.User{}, err
 	}
 
-	fmt.Println("res -:>>>", res)
 	return res, nil
 }
 
-func (r *UserRepo) DeleteUser(user_id string) (repo.User, error) {
+func (r *UserRepo) DeleteUser(ctx context.Context, user_id string) (repo.User, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return repo.User{}, err
+	}
+	defer tx.Rollback()
+
 	temp := repo.User{}
-	err := r.db.QueryRow(`
+	err = tx.QueryRowContext(ctx, `
 		update 
 			users
 		set 
@@ -182,17 +259,128 @@ func (r *UserRepo) DeleteUser(user_id string) (repo.User, error) {
 			id, first_name, last_name, user_type, email, created_at, updated_at`, time.Now(), user_id).Scan(&temp.Id, &temp.FirstName, &temp.LastName, &temp.UserType, &temp.Email, &temp.CreatedAt, &temp.UpdatedAt)
 
 	if err != nil {
-		log.Println("failed to delete user in sql", err)
+		logger.WithContext(r.log, ctx).Error("failed to delete user in sql", logger.Error(err))
+		return repo.User{}, err
+	}
+
+	// posts, comments and notifications of the user are deleted by consumers
+	err = insertEvent(ctx, tx, events.UserDeleted, temp.Id, userEvent(temp))
+	if err != nil {
+		logger.WithContext(r.log, ctx).Error("failed to create user deleted event in sql", logger.Error(err))
 		return repo.User{}, err
 	}
 
-	return temp, nil
+	return temp, tx.Commit()
+}
+
+// SchedulePurge sets the time after which deleted user is erased, see
+// PurgeDeletedUsers
+func (r *UserRepo) SchedulePurge(ctx context.Context, userId string, purgeAfter time.Time) error {
+	_, err := r.db.ExecContext(ctx, `
+		update
+			users
+		set
+			purge_after = $1
+		where
+			id = $2 and deleted_at is not null`, purgeAfter, userId)
+	if err != nil {
+		logger.WithContext(r.log, ctx).Error("failed to schedule user purge in sql", logger.Error(err))
+		return err
+	}
+
+	return nil
+}
+
+// PurgeDeletedUsers erases deleted users whose grace period is over with
+// their follows and data exports. UserPurged event is written for every
+// user, other services erase data of the user by it. Skip locked lets
+// several replicas run it at the same time.
+func (r *UserRepo) PurgeDeletedUsers(ctx context.Context, limit int) ([]repo.User, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return []repo.User{}, err
+	}
+	defer tx.Rollback()
+
+	rows, err := tx.QueryContext(ctx, `
+		delete from
+			users
+		where id in (
+			select
+				id
+			from
+				users
+			where
+				purge_after <= $1
+			limit $2
+			for update skip locked
+		)
+		returning
+			id, first_name, last_name, coalesce(user_type, ''), coalesce(email, '')`, time.Now(), limit)
+	if err != nil {
+		logger.WithContext(r.log, ctx).Error("failed to purge deleted users in sql", logger.Error(err))
+		return []repo.User{}, err
+	}
+
+	res := []repo.User{}
+	for rows.Next() {
+		temp := repo.User{}
+		err = rows.Scan(&temp.Id, &temp.FirstName, &temp.LastName, &temp.UserType, &temp.Email)
+		if err != nil {
+			rows.Close()
+			logger.WithContext(r.log, ctx).Error("failed to scanning purged user in sql", logger.Error(err))
+			return []repo.User{}, err
+		}
+
+		res = append(res, temp)
+	}
+	rows.Close()
+	if err = rows.Err(); err != nil {
+		return []repo.User{}, err
+	}
+
+	ids := make([]string, 0, len(res))
+	for _, user := range res {
+		ids = append(ids, user.Id)
+	}
+
+	for _, query := range []string{
+		`delete from follows where follower_id = any($1::uuid[]) or following_id = any($1::uuid[])`,
+		`delete from blocks where user_id = any($1::uuid[]) or target_id = any($1::uuid[])`,
+		`delete from mutes where user_id = any($1::uuid[]) or target_id = any($1::uuid[])`,
+		`delete from data_exports where user_id = any($1::uuid[])`,
+	} {
+		_, err = tx.ExecContext(ctx, query, pq.Array(ids))
+		if err != nil {
+			logger.WithContext(r.log, ctx).Error("failed to purge data of deleted users in sql", logger.Error(err))
+			return []repo.User{}, err
+		}
+	}
+
+	for _, user := range res {
+		err = insertEvent(ctx, tx, events.UserPurged, user.Id, userEvent(user))
+		if err != nil {
+			logger.WithContext(r.log, ctx).Error("failed to create user purged event in sql", logger.Error(err))
+			return []repo.User{}, err
+		}
+	}
+
+	return res, tx.Commit()
 }
 
-func (r *UserRepo) CheckField(req *u.CheckFieldRequest) (*u.CheckFieldResponse, error) {
+func userEvent(user repo.User) events.User {
+	return events.User{
+		Id:        user.Id,
+		FirstName: user.FirstName,
+		LastName:  user.LastName,
+		Email:     user.Email,
+	}
+}
+
+func (r *UserRepo) CheckField(ctx context.Context, req *u.CheckFieldRequest) (*u.CheckFieldResponse, error) {
 	query := fmt.Sprintf("select 1 from users where %s=$1", req.Field)
 	var temp int
-	err := r.db.QueryRow(query, req.Value).Scan(&temp)
+	err := r.db.QueryRowContext(ctx, query, req.Value).Scan(&temp)
 	if err == sql.ErrNoRows {
 		return &u.CheckFieldResponse{Exists: false}, nil
 	}
@@ -208,9 +396,9 @@ func (r *UserRepo) CheckField(req *u.CheckFieldRequest) (*u.CheckFieldResponse,
 	return &u.CheckFieldResponse{Exists: false}, nil
 }
 
-func (r *UserRepo) UpdateUserTokens(req *u.UpdateUserTokensRequest) (*u.UserResponse, error) {
+func (r *UserRepo) UpdateUserTokens(ctx context.Context, req *u.UpdateUserTokensRequest) (*u.UserResponse, error) {
 	res := u.UserResponse{}
-	err := r.db.QueryRow(`
+	err := r.db.QueryRowContext(ctx, `
 		update
 			users
 		set 
@@ -220,35 +408,200 @@ func (r *UserRepo) UpdateUserTokens(req *u.UpdateUserTokensRequest) (*u.UserResp
 		returning id, first_name, last_name, user_type, email, refresh_token, created_at, updated_at`, req.RefreshToken, req.Id).Scan(&res.Id, &res.FirstName, &res.LastName, &res.UserType, &res.Email, &res.RefreshToken, &res.CreatedAt, &res.UpdatedAt)
 
 	if err != nil {
-		log.Println("failed to update tokens in sql: ", err)
+		logger.WithContext(r.log, ctx).Error("failed to update tokens in sql", logger.Error(err))
 		return nil, err
 	}
 
 	return &res, nil
 }
 
-func (r *UserRepo) ChangeRoleUser(req *u.ChangeRoleRequest) (*u.UserResponse, error) {
+func (r *UserRepo) ChangeRoleUser(ctx context.Context, req *u.ChangeRoleRequest) (*u.UserResponse, error) {
 	res := u.UserResponse{}
-	err := r.db.QueryRow(`
+	err := r.db.QueryRowContext(ctx, `
 		update
 			users
 		set
-			user_type = $1, updated_at = $2
+			user_type = $1, updated_at = $2, version = version + 1
 		where
 			id = $3
-		returning id, first_name, last_name, user_type, email, refresh_token, created_at, updated_at`, req.Role, time.Now(), req.Id).Scan(&res.Id, &res.FirstName, &res.LastName, &res.UserType, &res.Email, &res.RefreshToken, &res.CreatedAt, &res.UpdatedAt)
+		returning id, first_name, last_name, user_type, email, refresh_token, created_at, updated_at, version`, req.Role, time.Now(), req.Id).Scan(&res.Id, &res.FirstName, &res.LastName, &res.UserType, &res.Email, &res.RefreshToken, &res.CreatedAt, &res.UpdatedAt, &res.Version)
 
 	if err != nil {
-		log.Println("failed to update user type in sql: ", err)
+		logger.WithContext(r.log, ctx).Error("failed to update user type in sql", logger.Error(err))
 		return nil, err
 	}
 
 	return &res, nil
 }
 
-func (r *UserRepo) GetSameRoleUsers(str string) (*u.UsersResponse, error) {
+// SuspendUser sets time until which the user is suspended, zero until lifts
+// the suspension
+func (r *UserRepo) SuspendUser(ctx context.Context, id string, until time.Time, reason string) (*u.UserResponse, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	var (
+		res            u.UserResponse
+		suspendedUntil sql.NullTime
+	)
+	err = tx.QueryRowContext(ctx, `
+		update
+			users
+		set
+			suspended_until = $1, suspension_reason = $2, updated_at = $3
+		where
+			id = $4 and deleted_at is null
+		returning id, first_name, last_name, user_type, email, created_at, updated_at, version, suspended_until, suspension_reason`,
+		sql.NullTime{Time: until, Valid: !until.IsZero()}, reason, time.Now(), id).Scan(
+		&res.Id, &res.FirstName, &res.LastName, &res.UserType, &res.Email, &res.CreatedAt, &res.UpdatedAt, &res.Version, &suspendedUntil, &res.SuspensionReason)
+	if err != nil {
+		logger.WithContext(r.log, ctx).Error("failed to suspend user in sql", logger.Error(err))
+		return nil, err
+	}
+
+	if suspendedUntil.Valid {
+		res.SuspendedUntil = suspendedUntil.Time.Format(time.RFC3339)
+	}
+
+	if _, err = insertRestrictedEvent(ctx, tx, id); err != nil {
+		logger.WithContext(r.log, ctx).Error("failed to create user restricted event in sql", logger.Error(err))
+		return nil, err
+	}
+
+	return &res, tx.Commit()
+}
+
+// BanUser sets or lifts ban of the user, or shadow ban if shadow is true
+func (r *UserRepo) BanUser(ctx context.Context, id string, shadow bool, ban repo.Ban) (repo.Account, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return repo.Account{}, err
+	}
+	defer tx.Rollback()
+
+	query := `
+		update
+			users
+		set
+			banned = $1, banned_until = $2, ban_reason = $3, updated_at = $4
+		where
+			id = $5 and deleted_at is null`
+	if shadow {
+		query = `
+		update
+			users
+		set
+			shadow_banned = $1, shadow_banned_until = $2, shadow_ban_reason = $3, updated_at = $4
+		where
+			id = $5 and deleted_at is null`
+	}
+
+	until := sql.NullTime{Time: ban.Until, Valid: !ban.Lift && !ban.Until.IsZero()}
+	reason := ban.Reason
+	if ban.Lift {
+		reason = ""
+	}
+
+	result, err := tx.ExecContext(ctx, query, !ban.Lift, until, reason, time.Now(), id)
+	if err != nil {
+		logger.WithContext(r.log, ctx).Error("failed to ban user in sql", logger.Error(err))
+		return repo.Account{}, err
+	}
+	if n, err := result.RowsAffected(); err != nil {
+		return repo.Account{}, err
+	} else if n == 0 {
+		return repo.Account{}, sql.ErrNoRows
+	}
+
+	res, err := insertRestrictedEvent(ctx, tx, id)
+	if err != nil {
+		logger.WithContext(r.log, ctx).Error("failed to create user restricted event in sql", logger.Error(err))
+		return repo.Account{}, err
+	}
+
+	return res, tx.Commit()
+}
+
+// accountColumns are selected by account queries, scanned by scanAccount
+const accountColumns = `
+	id, suspended_until, suspension_reason, banned, banned_until, ban_reason,
+	shadow_banned, shadow_banned_until, shadow_ban_reason`
+
+func scanAccount(row scanner) (repo.Account, error) {
+	var (
+		res               repo.Account
+		suspendedUntil    sql.NullTime
+		bannedUntil       sql.NullTime
+		shadowBannedUntil sql.NullTime
+	)
+
+	err := row.Scan(
+		&res.Id,
+		&suspendedUntil,
+		&res.SuspensionReason,
+		&res.Banned,
+		&bannedUntil,
+		&res.BanReason,
+		&res.ShadowBanned,
+		&shadowBannedUntil,
+		&res.ShadowBanReason,
+	)
+	if err != nil {
+		return repo.Account{}, err
+	}
+
+	res.SuspendedUntil = suspendedUntil.Time
+	res.BannedUntil = bannedUntil.Time
+	res.ShadowBannedUntil = shadowBannedUntil.Time
+
+	return res, nil
+}
+
+func (r *UserRepo) GetAccount(ctx context.Context, id string) (repo.Account, error) {
+	res, err := scanAccount(r.db.QueryRowContext(ctx, `select `+accountColumns+` from users where id = $1 and deleted_at is null`, id))
+	if err != nil {
+		logger.WithContext(r.log, ctx).Error("failed to get account in sql", logger.Error(err))
+		return repo.Account{}, err
+	}
+
+	return res, nil
+}
+
+// insertRestrictedEvent writes all restrictions of the user to outbox, so
+// consumers can replace their copy of them
+func insertRestrictedEvent(ctx context.Context, tx *sql.Tx, id string) (repo.Account, error) {
+	res, err := scanAccount(tx.QueryRowContext(ctx, `select `+accountColumns+` from users where id = $1`, id))
+	if err != nil {
+		return repo.Account{}, err
+	}
+
+	err = insertEvent(ctx, tx, events.UserRestricted, res.Id, events.Restriction{
+		Id:                res.Id,
+		SuspendedUntil:    formatTime(res.SuspendedUntil),
+		Banned:            res.Banned,
+		BannedUntil:       formatTime(res.BannedUntil),
+		ShadowBanned:      res.ShadowBanned,
+		ShadowBannedUntil: formatTime(res.ShadowBannedUntil),
+	})
+
+	return res, err
+}
+
+// formatTime formats time as RFC3339, zero time is empty
+func formatTime(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+
+	return t.Format(time.RFC3339)
+}
+
+func (r *UserRepo) GetSameRoleUsers(ctx context.Context, str string) (*u.UsersResponse, error) {
 	users := u.UsersResponse{}
-	rows, err := r.db.Query(`
+	rows, err := r.db.QueryContext(ctx, `
 		select
 			id, first_name, last_name, user_type, email, refresh_token, created_at, updated_at
 		from
@@ -257,7 +610,7 @@ func (r *UserRepo) GetSameRoleUsers(str string) (*u.UsersResponse, error) {
 			user_type = $1 and deleted_at is null`, str)
 
 	if err != nil {
-		log.Println("failed to get the same role users in sql: ", err)
+		logger.WithContext(r.log, ctx).Error("failed to get the same role users in sql", logger.Error(err))
 		return nil, err
 	}
 
@@ -275,7 +628,7 @@ func (r *UserRepo) GetSameRoleUsers(str string) (*u.UsersResponse, error) {
 			&temp.UpdatedAt,
 		)
 		if err != nil {
-			log.Println("failed to scan the same role users in sql: ", err)
+			logger.WithContext(r.log, ctx).Error("failed to scan the same role users in sql", logger.Error(err))
 			return nil, err
 		}
 
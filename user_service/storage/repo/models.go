@@ -1,5 +1,7 @@
 package repo
 
+import "time"
+
 type User struct {
 	Id           string
 	FirstName    string
@@ -11,4 +13,70 @@ type User struct {
 	RefreshToken string
 	CreatedAt    string
 	UpdatedAt    string
-}
\ No newline at end of file
+	PurgeAfter   string
+	Version      int64 // expected version on update, 0 skips the check
+}
+
+// Account holds restrictions of the user, zero times do not expire
+type Account struct {
+	Id                string
+	SuspendedUntil    time.Time
+	SuspensionReason  string
+	Banned            bool
+	BannedUntil       time.Time
+	BanReason         string
+	ShadowBanned      bool
+	ShadowBannedUntil time.Time
+	ShadowBanReason   string
+}
+
+// Ban sets or lifts ban or shadow ban, zero Until does not expire
+type Ban struct {
+	Lift   bool
+	Until  time.Time
+	Reason string
+}
+
+// account states, ban is stronger than suspension...
+const (
+	AccountActive    = "active"
+	AccountSuspended = "suspended"
+	AccountBanned    = "banned"
+)
+
+// State returns the effective state of the account at the time with its end
+// and reason, expired restrictions are ignored
+func (a Account) State(now time.Time) (string, time.Time, string) {
+	if a.Banned && (a.BannedUntil.IsZero() || a.BannedUntil.After(now)) {
+		return AccountBanned, a.BannedUntil, a.BanReason
+	}
+	if a.SuspendedUntil.After(now) {
+		return AccountSuspended, a.SuspendedUntil, a.SuspensionReason
+	}
+
+	return AccountActive, time.Time{}, ""
+}
+
+// IsShadowBanned reports whether content of the user is shown only to the
+// user at the time
+func (a Account) IsShadowBanned(now time.Time) bool {
+	return a.ShadowBanned && (a.ShadowBannedUntil.IsZero() || a.ShadowBannedUntil.After(now))
+}
+
+type DataExport struct {
+	Id          string
+	UserId      string
+	Status      string
+	Data        []byte
+	Error       string
+	CreatedAt   string
+	CompletedAt string
+}
+
+// data export statuses...
+const (
+	ExportPending    = "pending"
+	ExportProcessing = "processing"
+	ExportReady      = "ready"
+	ExportFailed     = "failed"
+)
\ No newline at end of file
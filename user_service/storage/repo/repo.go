@@ -1,29 +1,70 @@
 package repo
 
 import (
+	"context"
+	"time"
+
 	u "github.com/burxondv/new-services/user-service/genproto/user"
+	"github.com/burxondv/new-services/user-service/pkg/events"
 )
 
 type UserStoreI interface {
 	// methods...
-	CreateUser(User) (User, error)
-	GetUserById(string) (User, error)
-	GetUserByEmail(string) (User, error)
-	GetAllUsers(page, limit int64) ([]User, error)
-	SearchUsers(string) ([]User, error)
-	UpdateUser(User) (User, error)
-	DeleteUser(string) (User, error)
+	CreateUser(context.Context, User) (User, error)
+	GetUserById(context.Context, string) (User, error)
+	GetUserByEmail(context.Context, string) (User, error)
+	GetAllUsers(ctx context.Context, page, limit int64) ([]User, error)
+	SearchUsers(ctx context.Context, name, viewerId string) ([]User, error)
+	UpdateUser(context.Context, User) (User, error)
+	DeleteUser(context.Context, string) (User, error)
+
+	// purge...
+	SchedulePurge(ctx context.Context, userId string, purgeAfter time.Time) error
+	PurgeDeletedUsers(ctx context.Context, limit int) ([]User, error)
+
+	// follows...
+	FollowUser(ctx context.Context, followerId, followingId string) error
+	UnfollowUser(ctx context.Context, followerId, followingId string) error
+	GetFollowers(ctx context.Context, userId string) ([]User, error)
+	GetFollowing(ctx context.Context, userId string) ([]User, error)
+	IsFollowing(ctx context.Context, followerId, followingId string) (bool, error)
+
+	// blocks and mutes...
+	BlockUser(ctx context.Context, userId, targetId string) error
+	UnblockUser(ctx context.Context, userId, targetId string) error
+	GetBlocked(ctx context.Context, userId string) ([]User, error)
+	IsBlocked(ctx context.Context, userId, targetId string) (bool, error)
+	MuteUser(ctx context.Context, userId, targetId string) error
+	UnmuteUser(ctx context.Context, userId, targetId string) error
+	GetMuted(ctx context.Context, userId string) ([]User, error)
+	GetMutedIds(ctx context.Context, userId string) ([]string, error)
 
 	// check...
-	CheckField(*u.CheckFieldRequest) (*u.CheckFieldResponse, error)
+	CheckField(context.Context, *u.CheckFieldRequest) (*u.CheckFieldResponse, error)
 
 	// Register...
-	UpdateUserTokens(*u.UpdateUserTokensRequest) (*u.UserResponse, error)
+	UpdateUserTokens(context.Context, *u.UpdateUserTokensRequest) (*u.UserResponse, error)
 
 	// for Client...
-	GetUserForClient(string) (User, error)
+	GetUserForClient(context.Context, string) (User, error)
+	GetUsersByFirstNames(ctx context.Context, names []string) ([]User, error)
+	GetUsersByIds(ctx context.Context, ids []string) ([]User, error)
 
 	// Casbin...
-	ChangeRoleUser(*u.ChangeRoleRequest) (*u.UserResponse, error)
-	GetSameRoleUsers(string) (*u.UsersResponse, error)
+	ChangeRoleUser(context.Context, *u.ChangeRoleRequest) (*u.UserResponse, error)
+	SuspendUser(ctx context.Context, id string, until time.Time, reason string) (*u.UserResponse, error)
+	BanUser(ctx context.Context, id string, shadow bool, ban Ban) (Account, error)
+	GetAccount(ctx context.Context, id string) (Account, error)
+	GetSameRoleUsers(context.Context, string) (*u.UsersResponse, error)
+}
+
+type ExportStorageI interface {
+	CreateDataExport(ctx context.Context, userId string) (DataExport, error)
+	GetDataExport(ctx context.Context, id string) (DataExport, error)
+	ClaimDataExport(ctx context.Context, staleBefore time.Time) (DataExport, error)
+	CompleteDataExport(ctx context.Context, id string, data []byte, exportErr string) error
+}
+
+type OutboxStorageI interface {
+	RelayEvents(ctx context.Context, limit int, publish func(events.Event) error) (int, error)
 }
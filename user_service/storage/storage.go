@@ -1,6 +1,7 @@
 package storage
 
 import (
+	"github.com/burxondv/new-services/user-service/pkg/logger"
 	"github.com/burxondv/new-services/user-service/storage/postgres"
 	"github.com/burxondv/new-services/user-service/storage/repo"
 
@@ -9,20 +10,34 @@ import (
 
 type IStorage interface {
 	User() repo.UserStoreI
+	Export() repo.ExportStorageI
+	Outbox() repo.OutboxStorageI
 }
 
 type storagePg struct {
-	db       *sqlx.DB
-	userRepo repo.UserStoreI
+	db         *sqlx.DB
+	userRepo   repo.UserStoreI
+	exportRepo repo.ExportStorageI
+	outboxRepo repo.OutboxStorageI
 }
 
-func NewStoragePg(db *sqlx.DB) *storagePg {
+func NewStoragePg(db *sqlx.DB, log logger.Logger) *storagePg {
 	return &storagePg{
-		db:       db,
-		userRepo: postgres.NewUserRepo(db),
+		db:         db,
+		userRepo:   postgres.NewUserRepo(db, log),
+		exportRepo: postgres.NewExportRepo(db, log),
+		outboxRepo: postgres.NewOutboxRepo(db, log),
 	}
 }
 
 func (s storagePg) User() repo.UserStoreI {
 	return s.userRepo
 }
+
+func (s storagePg) Export() repo.ExportStorageI {
+	return s.exportRepo
+}
+
+func (s storagePg) Outbox() repo.OutboxStorageI {
+	return s.outboxRepo
+}
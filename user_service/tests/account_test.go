@@ -0,0 +1,80 @@
+package postgres
+
+import (
+	"testing"
+	"time"
+
+	"github.com/burxondv/new-services/user-service/storage/repo"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccount_State(t *testing.T) {
+	now := time.Now()
+	tests := []struct {
+		name    string
+		account repo.Account
+		state   string
+		until   time.Time
+		reason  string
+	}{
+		{
+			name:    "active",
+			account: repo.Account{},
+			state:   repo.AccountActive,
+		},
+		{
+			name:    "suspended",
+			account: repo.Account{SuspendedUntil: now.Add(time.Hour), SuspensionReason: "spam"},
+			state:   repo.AccountSuspended,
+			until:   now.Add(time.Hour),
+			reason:  "spam",
+		},
+		{
+			name:    "suspension expired",
+			account: repo.Account{SuspendedUntil: now.Add(-time.Hour), SuspensionReason: "spam"},
+			state:   repo.AccountActive,
+		},
+		{
+			name:    "banned forever",
+			account: repo.Account{Banned: true, BanReason: "fraud"},
+			state:   repo.AccountBanned,
+			reason:  "fraud",
+		},
+		{
+			name: "ban is stronger than suspension",
+			account: repo.Account{
+				SuspendedUntil: now.Add(time.Hour),
+				Banned:         true,
+				BannedUntil:    now.Add(2 * time.Hour),
+				BanReason:      "fraud",
+			},
+			state:  repo.AccountBanned,
+			until:  now.Add(2 * time.Hour),
+			reason: "fraud",
+		},
+		{
+			name:    "ban expired",
+			account: repo.Account{Banned: true, BannedUntil: now.Add(-time.Hour), BanReason: "fraud"},
+			state:   repo.AccountActive,
+		},
+	}
+
+	for _, tCase := range tests {
+		t.Run(tCase.name, func(t *testing.T) {
+			state, until, reason := tCase.account.State(now)
+			assert.Equal(t, tCase.state, state)
+			assert.Equal(t, tCase.until, until)
+			assert.Equal(t, tCase.reason, reason)
+		})
+	}
+}
+
+func TestAccount_IsShadowBanned(t *testing.T) {
+	now := time.Now()
+
+	assert.False(t, repo.Account{}.IsShadowBanned(now))
+	assert.True(t, repo.Account{ShadowBanned: true}.IsShadowBanned(now))
+	assert.True(t, repo.Account{ShadowBanned: true, ShadowBannedUntil: now.Add(time.Hour)}.IsShadowBanned(now))
+	assert.False(t, repo.Account{ShadowBanned: true, ShadowBannedUntil: now.Add(-time.Hour)}.IsShadowBanned(now))
+}
@@ -0,0 +1,45 @@
+package postgres
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/json"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/burxondv/new-services/user-service/pkg/export"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExport_Zip(t *testing.T) {
+	data := []byte(`{"profile":{"id":"1","first_name":"Ali"},"posts":[{"id":"2"}],"comments":[]}`)
+
+	content, err := export.Zip(data, time.Now())
+	require.NoError(t, err)
+
+	r, err := zip.NewReader(bytes.NewReader(content), int64(len(content)))
+	require.NoError(t, err)
+
+	names := []string{}
+	for _, f := range r.File {
+		names = append(names, f.Name)
+	}
+	assert.Equal(t, []string{"comments.json", "posts.json", "profile.json"}, names)
+
+	f, err := r.File[2].Open()
+	require.NoError(t, err)
+	defer f.Close()
+
+	profile, err := io.ReadAll(f)
+	require.NoError(t, err)
+
+	var got map[string]string
+	require.NoError(t, json.Unmarshal(profile, &got))
+	assert.Equal(t, map[string]string{"id": "1", "first_name": "Ali"}, got)
+
+	_, err = export.Zip([]byte(`[]`), time.Now())
+	assert.Error(t, err)
+}
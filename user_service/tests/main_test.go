@@ -7,6 +7,7 @@ import (
 
 	"github.com/burxondv/new-services/user-service/config"
 	"github.com/burxondv/new-services/user-service/pkg/db"
+	"github.com/burxondv/new-services/user-service/pkg/logger"
 	"github.com/burxondv/new-services/user-service/storage/postgres"
 )
 
@@ -19,7 +20,7 @@ func TestMain(m *testing.M) {
 		log.Println("failed to connect to database: ", err)
 	}
 
-	pgRepo = postgres.NewUserRepo(connDb)
+	pgRepo = postgres.NewUserRepo(connDb, logger.New("debug", "test"))
 
 	os.Exit(m.Run())
 }
@@ -0,0 +1,41 @@
+package postgres
+
+import (
+	"testing"
+	"testing/fstest"
+
+	"github.com/burxondv/new-services/user-service/migrations"
+	"github.com/burxondv/new-services/user-service/pkg/migrate"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMigrations_Embedded(t *testing.T) {
+	list, err := migrate.List(migrations.FS)
+	require.NoError(t, err)
+	require.NotEmpty(t, list)
+
+	for i, m := range list {
+		assert.Equal(t, uint(i+1), m.Version)
+	}
+	assert.Equal(t, "create_users_table", list[0].Name)
+}
+
+func TestMigrations_List(t *testing.T) {
+	list, err := migrate.List(fstest.MapFS{
+		"000002_b.up.sql":   {},
+		"000002_b.down.sql": {},
+		"000001_a.up.sql":   {},
+		"000001_a.down.sql": {},
+		"migrations.go":     {},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []migrate.Migration{{Version: 1, Name: "a"}, {Version: 2, Name: "b"}}, list)
+
+	_, err = migrate.List(fstest.MapFS{"000001_a.up.sql": {}})
+	assert.Error(t, err)
+
+	_, err = migrate.List(fstest.MapFS{"000001_a.up.sql": {}, "000001_b.down.sql": {}})
+	assert.Error(t, err)
+}
@@ -1,6 +1,7 @@
 package postgres
 
 import (
+	"context"
 	"errors"
 	"reflect"
 	"testing"
@@ -42,7 +43,7 @@ func TestAssert_User_Create(t *testing.T) {
 		t.Run(tCase.name, func(t *testing.T) {
 			id := uuid.New()
 			tCase.req.Id = id.String()
-			got, err := pgRepo.CreateUser(tCase.req)
+			got, err := pgRepo.CreateUser(context.Background(), tCase.req)
 			if err != nil && tCase.err != nil {
 				ast.True(tCase.err.Error() == err.Error())
 			}
@@ -83,7 +84,7 @@ func TestAssert_User_Get(t *testing.T) {
 
 	for _, tCase := range tests {
 		t.Run(tCase.name, func(t *testing.T) {
-			res, err := pgRepo.GetUserById(tCase.req)
+			res, err := pgRepo.GetUserById(context.Background(), tCase.req)
 			if tCase.err != nil && err != nil {
 				ast.True(tCase.err.Error() == err.Error())
 			}
@@ -128,7 +129,7 @@ func TestAssert_User_Update(t *testing.T) {
 
 	for _, tCase := range tests {
 		t.Run(tCase.name, func(t *testing.T) {
-			got, err := pgRepo.UpdateUser(tCase.req)
+			got, err := pgRepo.UpdateUser(context.Background(), tCase.req)
 			if tCase.err != nil && err != nil {
 				ast.True(tCase.err.Error() == err.Error())
 			}
@@ -168,7 +169,7 @@ func TestUser_Delete(t *testing.T) {
 
 	for _, tCase := range tests {
 		t.Run(tCase.name, func(t *testing.T) {
-			res, err := pgRepo.DeleteUser(tCase.req)
+			res, err := pgRepo.DeleteUser(context.Background(), tCase.req)
 			if tCase.err != nil && err != nil {
 				ast.True(tCase.err.Error() == err.Error())
 			}
@@ -1,16 +1,21 @@
 package postgres
 
 import (
+	"context"
+	"database/sql"
 	"fmt"
 
 	"github.com/burxondv/new-services/user-service/config"
 	u "github.com/burxondv/new-services/user-service/genproto/user"
 	"github.com/burxondv/new-services/user-service/pkg/db"
+	"github.com/burxondv/new-services/user-service/pkg/logger"
 	"github.com/burxondv/new-services/user-service/storage/postgres"
 	"github.com/burxondv/new-services/user-service/storage/repo"
 
 	"testing"
+	"time"
 
+	"github.com/google/uuid"
 	"github.com/stretchr/testify/suite"
 )
 
@@ -22,7 +27,7 @@ type UserSuiteTest struct {
 
 func (s *UserSuiteTest) SetupSuite() {
 	pgPool, cleanUp := db.ConnectToDBForSuite(config.Load())
-	s.repo = postgres.NewUserRepo(pgPool)
+	s.repo = postgres.NewUserRepo(pgPool, logger.New("debug", "test"))
 	s.CleanUpfunc = cleanUp
 }
 
@@ -33,14 +38,14 @@ func (s *UserSuiteTest) TestUserCrud() {
 		Email:     "drew@gmail.com",
 	}
 
-	createUserResp, err := s.repo.CreateUser(repo.User{FirstName: user.FirstName, LastName: user.LastName, Email: user.Email})
+	createUserResp, err := s.repo.CreateUser(context.Background(), repo.User{FirstName: user.FirstName, LastName: user.LastName, Email: user.Email})
 	fmt.Println(createUserResp)
 	s.Nil(err)
 	s.NotNil(createUserResp)
 	s.Equal(user.FirstName, createUserResp.FirstName)
 	s.Equal(user.LastName, createUserResp.LastName)
 
-	getUserResp, err := s.repo.GetUserById(createUserResp.Id)
+	getUserResp, err := s.repo.GetUserById(context.Background(), createUserResp.Id)
 	s.Nil(err)
 	s.NotNil(getUserResp)
 	s.Equal(getUserResp.FirstName, user.FirstName)
@@ -53,20 +58,187 @@ func (s *UserSuiteTest) TestUserCrud() {
 		Email:     "eminem@gmail.com",
 	}
 
-	updateResp, err := s.repo.UpdateUser(updateBody)
+	updateBody.Version = getUserResp.Version
+	updateResp, err := s.repo.UpdateUser(context.Background(), updateBody)
 	s.Nil(err)
 	s.NotNil(updateResp)
+	s.Equal(getUserResp.Version+1, updateResp.Version)
 
-	usersResp, err := s.repo.GetAllUsers(1, 100)
+	// update based on the old version is rejected
+	_, err = s.repo.UpdateUser(context.Background(), updateBody)
+	s.ErrorIs(err, sql.ErrNoRows)
+
+	usersResp, err := s.repo.GetAllUsers(context.Background(), 1, 100)
 	s.Nil(err)
 	s.NotNil(usersResp)
 
-	deleteResp, err := s.repo.DeleteUser(createUserResp.Id)
+	deleteResp, err := s.repo.DeleteUser(context.Background(), createUserResp.Id)
 	s.Nil(err)
 	s.NotNil(deleteResp)
 
 }
 
+func (s *UserSuiteTest) TestFollow() {
+	follower, err := s.repo.CreateUser(context.Background(), repo.User{Id: uuid.NewString(), FirstName: "Follower", LastName: "One", Email: "follower@gmail.com"})
+	s.Nil(err)
+	following, err := s.repo.CreateUser(context.Background(), repo.User{Id: uuid.NewString(), FirstName: "Following", LastName: "Two", Email: "following@gmail.com"})
+	s.Nil(err)
+
+	users, err := s.repo.GetUsersByIds(context.Background(), []string{follower.Id, following.Id})
+	s.Nil(err)
+	s.Len(users, 2)
+
+	s.Nil(s.repo.FollowUser(context.Background(), follower.Id, following.Id))
+	// following twice is not an error
+	s.Nil(s.repo.FollowUser(context.Background(), follower.Id, following.Id))
+
+	exists, err := s.repo.IsFollowing(context.Background(), follower.Id, following.Id)
+	s.Nil(err)
+	s.True(exists)
+
+	followers, err := s.repo.GetFollowers(context.Background(), following.Id)
+	s.Nil(err)
+	s.Len(followers, 1)
+	s.Equal(follower.Id, followers[0].Id)
+
+	followings, err := s.repo.GetFollowing(context.Background(), follower.Id)
+	s.Nil(err)
+	s.Len(followings, 1)
+	s.Equal(following.Id, followings[0].Id)
+
+	s.Nil(s.repo.UnfollowUser(context.Background(), follower.Id, following.Id))
+
+	exists, err = s.repo.IsFollowing(context.Background(), follower.Id, following.Id)
+	s.Nil(err)
+	s.False(exists)
+
+	_, err = s.repo.DeleteUser(context.Background(), follower.Id)
+	s.Nil(err)
+	_, err = s.repo.DeleteUser(context.Background(), following.Id)
+	s.Nil(err)
+}
+
+func (s *UserSuiteTest) TestPurgeDeletedUsers() {
+	user, err := s.repo.CreateUser(context.Background(), repo.User{Id: uuid.NewString(), FirstName: "Purged", LastName: "User", Email: "purged@gmail.com"})
+	s.Require().Nil(err)
+
+	_, err = s.repo.DeleteUser(context.Background(), user.Id)
+	s.Require().Nil(err)
+	s.Nil(s.repo.SchedulePurge(context.Background(), user.Id, time.Now().Add(-time.Minute)))
+
+	purged, err := s.repo.PurgeDeletedUsers(context.Background(), 100)
+	s.Nil(err)
+	ids := []string{}
+	for _, p := range purged {
+		ids = append(ids, p.Id)
+	}
+	s.Contains(ids, user.Id)
+
+	purged, err = s.repo.PurgeDeletedUsers(context.Background(), 100)
+	s.Nil(err)
+	s.Len(purged, 0)
+}
+
+func (s *UserSuiteTest) TestBanUser() {
+	user, err := s.repo.CreateUser(context.Background(), repo.User{Id: uuid.NewString(), FirstName: "Banned", LastName: "User", Email: "banned@gmail.com"})
+	s.Require().Nil(err)
+
+	until := time.Now().UTC().Add(time.Hour).Truncate(time.Second)
+	account, err := s.repo.BanUser(context.Background(), user.Id, false, repo.Ban{Until: until, Reason: "spam"})
+	s.Nil(err)
+	s.True(account.Banned)
+	s.Equal(until, account.BannedUntil)
+	s.Equal("spam", account.BanReason)
+
+	account, err = s.repo.BanUser(context.Background(), user.Id, true, repo.Ban{Reason: "bots"})
+	s.Nil(err)
+	s.True(account.ShadowBanned)
+	s.True(account.ShadowBannedUntil.IsZero())
+
+	// lifting the ban keeps shadow ban
+	account, err = s.repo.BanUser(context.Background(), user.Id, false, repo.Ban{Lift: true, Reason: "spam"})
+	s.Nil(err)
+	s.False(account.Banned)
+	s.Equal("", account.BanReason)
+	s.True(account.ShadowBanned)
+
+	got, err := s.repo.GetAccount(context.Background(), user.Id)
+	s.Nil(err)
+	s.Equal(account, got)
+
+	_, err = s.repo.DeleteUser(context.Background(), user.Id)
+	s.Nil(err)
+
+	_, err = s.repo.BanUser(context.Background(), user.Id, false, repo.Ban{})
+	s.ErrorIs(err, sql.ErrNoRows)
+}
+
+func (s *UserSuiteTest) TestBlockAndMute() {
+	user, err := s.repo.CreateUser(context.Background(), repo.User{Id: uuid.NewString(), FirstName: "Blocker", LastName: "One", Email: "blocker@gmail.com"})
+	s.Require().Nil(err)
+	target, err := s.repo.CreateUser(context.Background(), repo.User{Id: uuid.NewString(), FirstName: "Blocked", LastName: "Two", Email: "blocked@gmail.com"})
+	s.Require().Nil(err)
+
+	s.Nil(s.repo.FollowUser(context.Background(), user.Id, target.Id))
+	s.Nil(s.repo.FollowUser(context.Background(), target.Id, user.Id))
+
+	s.Nil(s.repo.BlockUser(context.Background(), user.Id, target.Id))
+	// blocking twice is not an error
+	s.Nil(s.repo.BlockUser(context.Background(), user.Id, target.Id))
+
+	blocked, err := s.repo.IsBlocked(context.Background(), user.Id, target.Id)
+	s.Nil(err)
+	s.True(blocked)
+	blocked, err = s.repo.IsBlocked(context.Background(), target.Id, user.Id)
+	s.Nil(err)
+	s.False(blocked)
+
+	// follows are removed in both directions
+	following, err := s.repo.IsFollowing(context.Background(), user.Id, target.Id)
+	s.Nil(err)
+	s.False(following)
+	following, err = s.repo.IsFollowing(context.Background(), target.Id, user.Id)
+	s.Nil(err)
+	s.False(following)
+
+	users, err := s.repo.GetBlocked(context.Background(), user.Id)
+	s.Nil(err)
+	s.Len(users, 1)
+	s.Equal(target.Id, users[0].Id)
+
+	// the blocker is hidden from search of the blocked user only
+	found, err := s.repo.SearchUsers(context.Background(), "Blocker", target.Id)
+	s.Nil(err)
+	s.Len(found, 0)
+	found, err = s.repo.SearchUsers(context.Background(), "Blocker", "")
+	s.Nil(err)
+	s.Len(found, 1)
+
+	s.Nil(s.repo.UnblockUser(context.Background(), user.Id, target.Id))
+	blocked, err = s.repo.IsBlocked(context.Background(), user.Id, target.Id)
+	s.Nil(err)
+	s.False(blocked)
+
+	s.Nil(s.repo.MuteUser(context.Background(), user.Id, target.Id))
+	ids, err := s.repo.GetMutedIds(context.Background(), user.Id)
+	s.Nil(err)
+	s.Equal([]string{target.Id}, ids)
+
+	users, err = s.repo.GetMuted(context.Background(), user.Id)
+	s.Nil(err)
+	s.Len(users, 1)
+
+	s.Nil(s.repo.UnmuteUser(context.Background(), user.Id, target.Id))
+	ids, err = s.repo.GetMutedIds(context.Background(), user.Id)
+	s.Nil(err)
+	s.Len(ids, 0)
+
+	_, err = s.repo.DeleteUser(context.Background(), user.Id)
+	s.Nil(err)
+	_, err = s.repo.DeleteUser(context.Background(), target.Id)
+	s.Nil(err)
+}
+
 func (suite *UserSuiteTest) TearDownSuite() {
 	suite.CleanUpfunc()
 }
@@ -1,6 +1,7 @@
 package postgres
 
 import (
+	"context"
 	"log"
 	"reflect"
 	"testing"
@@ -33,7 +34,7 @@ func TestUnit_User_Create(t *testing.T) {
 
 	for _, tCase := range tests {
 		t.Run(tCase.name, func(t *testing.T) {
-			got, err := pgRepo.CreateUser(tCase.input)
+			got, err := pgRepo.CreateUser(context.Background(), tCase.input)
 			if err != nil {
 				log.Printf("%s: expected: %v, got: %v", tCase.name, tCase.wantErr, err)
 			}
@@ -73,7 +74,7 @@ func TestUnit_User_Get(t *testing.T) {
 
 	for _, tCase := range tests {
 		t.Run(tCase.name, func(t *testing.T) {
-			got, err := pgRepo.GetUserById(tCase.input)
+			got, err := pgRepo.GetUserById(context.Background(), tCase.input)
 			if err != nil {
 				log.Printf("%s: expected: %v, got: %v", tCase.name, tCase.wantErr, err)
 			}
@@ -116,7 +117,7 @@ func TestUnit_User_Update(t *testing.T) {
 
 	for _, tCase := range tests {
 		t.Run(tCase.name, func(t *testing.T) {
-			got, err := pgRepo.UpdateUser(tCase.input)
+			got, err := pgRepo.UpdateUser(context.Background(), tCase.input)
 			if err != nil {
 				log.Printf("%s: expected: %v, got: %v", tCase.name, tCase.wantErr, err)
 			}
@@ -155,7 +156,7 @@ func TestUnit_User_Delete(t *testing.T) {
 
 	for _, tCase := range tests {
 		t.Run(tCase.name, func(t *testing.T) {
-			got, err := pgRepo.DeleteUser(tCase.input)
+			got, err := pgRepo.DeleteUser(context.Background(), tCase.input)
 			if err != nil {
 				log.Printf("%s: expected: %v, got: %v", tCase.name, tCase.wantErr, got)
 			}